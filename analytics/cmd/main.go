@@ -59,11 +59,12 @@ func addBackfiller(root *cobra.Command) {
 
 func addVaaCountCommand(parent *cobra.Command) {
 	var input, output string
+	var opts metrics.JobOptions
 	vaaCountCmd := &cobra.Command{
 		Use:   "vaa-count",
 		Short: "Generate vaa-count metrics from a vaa csv file",
 		Run: func(_ *cobra.Command, _ []string) {
-			metrics.RunVaaCount(input, output)
+			metrics.RunVaaCount(input, output, opts)
 		},
 	}
 	// input flag
@@ -72,18 +73,20 @@ func addVaaCountCommand(parent *cobra.Command) {
 	// output flag
 	vaaCountCmd.Flags().StringVar(&output, "output", "", "path to output file")
 	vaaCountCmd.MarkFlagRequired("output")
+	addJobFlags(vaaCountCmd, &opts, "analytics-vaa-count", true)
 	parent.AddCommand(vaaCountCmd)
 }
 
 func addVaaVolumeFromFileCommand(parent *cobra.Command) {
 	var input, output, prices, vaaPayloadParserURL, p2pNetwork string
+	var opts metrics.JobOptions
 
 	//vaa-volume from csv file
 	vaaVolumeFileCmd := &cobra.Command{
 		Use:   "file",
 		Short: "Generate volume metrics from a VAA csv file",
 		Run: func(_ *cobra.Command, _ []string) {
-			metrics.RunVaaVolumeFromFile(input, output, prices, vaaPayloadParserURL, p2pNetwork)
+			metrics.RunVaaVolumeFromFile(input, output, prices, vaaPayloadParserURL, p2pNetwork, opts)
 		},
 	}
 
@@ -104,17 +107,20 @@ func addVaaVolumeFromFileCommand(parent *cobra.Command) {
 	vaaVolumeFileCmd.Flags().StringVar(&p2pNetwork, "p2p-network", "", "P2P network")
 	vaaVolumeFileCmd.MarkFlagRequired("p2p-network")
 
+	addJobFlags(vaaVolumeFileCmd, &opts, "analytics-vaa-volume-file", true)
+
 	parent.AddCommand(vaaVolumeFileCmd)
 }
 
 func addVaaVolumeFromMongoCommand(parent *cobra.Command) {
 	var mongoUri, mongoDb, output, prices, vaaPayloadParserURL, p2pNetwork string
+	var opts metrics.JobOptions
 	//vaa-volume from MongoDB
 	vaaVolumeMongoCmd := &cobra.Command{
 		Use:   "mongo",
 		Short: "Generate volume metrics from MongoDB",
 		Run: func(_ *cobra.Command, _ []string) {
-			metrics.RunVaaVolumeFromMongo(mongoUri, mongoDb, output, prices, vaaPayloadParserURL, p2pNetwork)
+			metrics.RunVaaVolumeFromMongo(mongoUri, mongoDb, output, prices, vaaPayloadParserURL, p2pNetwork, opts)
 		},
 	}
 
@@ -136,18 +142,21 @@ func addVaaVolumeFromMongoCommand(parent *cobra.Command) {
 	vaaVolumeMongoCmd.Flags().StringVar(&p2pNetwork, "p2p-network", "", "P2P network")
 	vaaVolumeMongoCmd.MarkFlagRequired("p2p-network")
 
+	addJobFlags(vaaVolumeMongoCmd, &opts, "analytics-vaa-volume-mongo", false)
+
 	parent.AddCommand(vaaVolumeMongoCmd)
 
 }
 
 func addVaaVolumeV3FromVaasCollectionDump(parent *cobra.Command) {
 	var vaasBsonFile, output, prices, vaaPayloadParserURL, p2pNetwork string
+	var opts metrics.JobOptions
 	//vaa-volume from MongoDB
 	vaaVolumeMongoCmd := &cobra.Command{
 		Use:   "vaa-volume-v3",
 		Short: "Generate volume metrics from MongoDB vaas collection dump",
 		Run: func(_ *cobra.Command, _ []string) {
-			metrics.RunBackFillerVaaVolumeV3(vaasBsonFile, output, prices, vaaPayloadParserURL, p2pNetwork)
+			metrics.RunBackFillerVaaVolumeV3(vaasBsonFile, output, prices, vaaPayloadParserURL, p2pNetwork, opts)
 		},
 	}
 
@@ -171,9 +180,25 @@ func addVaaVolumeV3FromVaasCollectionDump(parent *cobra.Command) {
 	vaaVolumeMongoCmd.Flags().StringVar(&p2pNetwork, "p2p-network", "", "P2P network")
 	vaaVolumeMongoCmd.MarkFlagRequired("p2p-network")
 
+	addJobFlags(vaaVolumeMongoCmd, &opts, "analytics-vaa-volume-v3", true)
+
 	parent.AddCommand(vaaVolumeMongoCmd)
 }
 
+// addJobFlags adds the flags of the resumable backfills. The jobs that read a file store their checkpoint in a file.
+func addJobFlags(cmd *cobra.Command, opts *metrics.JobOptions, jobName string, checkpointFile bool) {
+	cmd.Flags().StringVar(&opts.JobName, "job-name", jobName, "name of the job used to store its checkpoint")
+	cmd.Flags().Int64Var(&opts.PageSize, "page-size", 1000, "number of VAAs read at a time")
+	cmd.Flags().IntVar(&opts.Workers, "num-workers", 1, "number of VAAs processed concurrently")
+	cmd.Flags().Int64Var(&opts.RequestsPerMinute, "requests-per-minute", 0, "maximum number of VAAs processed per minute (default no limit)")
+	cmd.Flags().BoolVar(&opts.Resume, "resume", false, "resume the job from its last checkpoint")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "read the VAAs without processing them")
+	cmd.Flags().StringVar(&opts.FailuresFile, "failures-file", "", "csv file where the VAAs that failed are appended")
+	if checkpointFile {
+		cmd.Flags().StringVar(&opts.CheckpointFile, "checkpoint-file", "checkpoints.json", "file where the checkpoint of the job is stored")
+	}
+}
+
 func addVaaVolumeCommand(parent *cobra.Command) {
 
 	vaaVolumeCmd := &cobra.Command{
@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/backfill"
+	"go.uber.org/zap"
+)
+
+// JobOptions are the options of the resumable metrics backfills.
+type JobOptions struct {
+	JobName           string
+	PageSize          int64
+	Workers           int
+	RequestsPerMinute int64
+	Resume            bool
+	DryRun            bool
+	FailuresFile      string
+	// CheckpointFile is the file where the checkpoints of the jobs that read a file are stored.
+	CheckpointFile string
+}
+
+func (o JobOptions) config() backfill.Config {
+	return backfill.Config{
+		Name:              o.JobName,
+		PageSize:          o.PageSize,
+		Workers:           o.Workers,
+		RequestsPerMinute: o.RequestsPerMinute,
+		Resume:            o.Resume,
+		DryRun:            o.DryRun,
+		FailuresFile:      o.FailuresFile,
+	}
+}
+
+// runJob runs a backfill job until it completes or is interrupted by a signal.
+func runJob[T any](opts JobOptions, source backfill.Source[T], strategy backfill.Strategy[T], checkpoints backfill.CheckpointStore, logger *zap.Logger) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	job := backfill.NewJob[T](opts.config(), source, strategy, checkpoints, logger)
+	checkpoint, err := job.Run(ctx)
+	if checkpoint != nil {
+		logger.Info("Finished backfill",
+			zap.Uint64("processed", checkpoint.Processed),
+			zap.Uint64("failed", checkpoint.Failed))
+	}
+	if err != nil {
+		logger.Error("Backfill interrupted, run it again with --resume to continue", zap.Error(err))
+	}
+	return err
+}
+
+// outputFile is the file where the line protocol entries of a backfill are written by its workers.
+type outputFile struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// createOutputFile creates the output file, or opens it to append the new entries when the job is resumed.
+func createOutputFile(path string, resume bool) (*outputFile, error) {
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if resume {
+		flags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+	file, err := os.OpenFile(path, flags, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &outputFile{file: file}, nil
+}
+
+// WriteLine appends a line protocol entry to the file.
+func (f *outputFile) WriteLine(line string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, err := f.file.WriteString(line)
+	return err
+}
+
+// Close closes the file.
+func (f *outputFile) Close() error {
+	return f.file.Close()
+}
@@ -2,29 +2,40 @@ package metrics
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/metric"
-	"github.com/wormhole-foundation/wormhole-explorer/analytics/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/common/backfill"
+	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
 )
 
-func RunVaaCount(inputFile, outputFile string) {
+func RunVaaCount(inputFile, outputFile string, opts JobOptions) {
+
+	logger := logger.New("wormhole-explorer-analytics")
 
 	// Create the output file
-	fout, err := os.Create(outputFile)
+	fout, err := createOutputFile(outputFile, opts.Resume)
 	if err != nil {
-		panic(err)
+		logger.Fatal("creating output file", zap.Error(err))
 	}
 	defer fout.Close()
 
 	time30DaysAgo := time.Now().Add(-30 * 24 * time.Hour)
 	fmt.Println(time30DaysAgo)
 
-	// Define a processor function that will be called for each input VAA
-	processorFunc := func(vaa *sdk.VAA) error {
+	// Define a strategy that will be called for each line of the input file
+	strategy := backfill.StrategyFunc[string](func(_ context.Context, line string) error {
+
+		vaa, err := parseVaaLine(line)
+		if err != nil {
+			return err
+		}
 
 		// Call the analytics module to generate the data point for this VAA
 		point, err := metric.MakePointForVaaCount(vaa)
@@ -42,15 +53,31 @@ func RunVaaCount(inputFile, outputFile string) {
 		}
 
 		// Write a new in the dump file
-		line := convertPointToLineProtocol(point)
-		if _, err := fout.Write([]byte(line)); err != nil {
-			return err
-		}
+		return fout.WriteLine(convertPointToLineProtocol(point))
+	})
 
-		return nil
+	err = runJob[string](opts, backfill.NewLineSource(inputFile), strategy,
+		backfill.NewFileCheckpointStore(opts.CheckpointFile), logger)
+	if err != nil {
+		os.Exit(1)
 	}
+}
 
-	csvParser := parser.NewVaaCsvParser(processorFunc, inputFile)
+// parseVaaLine parses a line of a VAA csv file with the format id,hexvaa.
+func parseVaaLine(line string) (*sdk.VAA, error) {
+	tt := strings.Split(line, ",")
+	if len(tt) != 2 {
+		return nil, fmt.Errorf("invalid line: %s", line)
+	}
 
-	csvParser.Start(context.Background())
+	data, err := hex.DecodeString(tt[1])
+	if err != nil {
+		return nil, fmt.Errorf("error decoding: %v", err)
+	}
+
+	vaa, err := sdk.Unmarshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling vaa: %v", err)
+	}
+	return vaa, nil
 }
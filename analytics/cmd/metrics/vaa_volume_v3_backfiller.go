@@ -3,25 +3,26 @@ package metrics
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
+
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/cmd/token"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/metric"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/prices"
+	"github.com/wormhole-foundation/wormhole-explorer/common/backfill"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
 	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/bson/bsonrw"
 	"go.uber.org/zap"
-	"io"
-	"log"
-	"os"
-	"time"
 )
 
-func RunBackFillerVaaVolumeV3(vaasBsonFile, outputFile, pricesFile, vaaPayloadParserURL, p2pNetwork string) {
+func RunBackFillerVaaVolumeV3(vaasBsonFile, outputFile, pricesFile, vaaPayloadParserURL, p2pNetwork string, opts JobOptions) {
 
 	defer func() {
 		fmt.Println("exiting RunBackFillerVaaVolumeV3")
@@ -35,21 +36,11 @@ func RunBackFillerVaaVolumeV3(vaasBsonFile, outputFile, pricesFile, vaaPayloadPa
 
 	loggerInstance.Info("starting wormhole-explorer-analytics", zap.String("command", "RunBackFillerVaaVolumeV3"))
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	// Open BSON vaasFile
-	vaasFile, err := os.Open(vaasBsonFile)
-	if err != nil {
-		log.Fatal(err)
-	}
-	defer vaasFile.Close()
-
-	failedVaas, err := os.OpenFile("failed_vaas.log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	fout, err := createOutputFile(outputFile, opts.Resume)
 	if err != nil {
-		loggerInstance.Fatal("creating failedVaas file", zap.Error(err))
+		loggerInstance.Fatal("creating output file", zap.Error(err))
 	}
-	defer failedVaas.Close()
+	defer fout.Close()
 
 	// create a parserVAAAPIClient
 	parserVAAAPIClient, err := parser.NewParserVAAAPIClient(10, vaaPayloadParserURL, loggerInstance)
@@ -65,172 +56,96 @@ func RunBackFillerVaaVolumeV3(vaasBsonFile, outputFile, pricesFile, vaaPayloadPa
 
 	loggerInstance.Info("loaded historical prices")
 
-	vaasChan := make(chan vaaChanData)
-	defer close(vaasChan)
-
-	batchSize := 1
-	workersPool := make(chan struct{}, batchSize)
-	for i := 0; i < batchSize; i++ {
-		workersPool <- struct{}{}
-	}
-
-	lpChan := make(chan lpChanData)
-
-	go processVaas(ctx, workersPool, vaasChan, converter, loggerInstance, lpChan, failedVaas)
-	go processLineProtocols(ctx, outputFile, loggerInstance, lpChan, failedVaas)
-
-	i := uint64(1)
-	offset := uint64(1)
-
-	for {
-		// Read the length of the next BSON document (first 4 bytes)
-		var docLength int32
-		err = binary.Read(vaasFile, binary.LittleEndian, &docLength)
-		if err == io.EOF {
-			loggerInstance.Info("found end of file,exiting main goroutine...")
-			break
-		} else if err != nil {
-			log.Fatal(err)
-		}
-
-		// Read the complete BSON document based on the length
-		buffer := make([]byte, docLength)
-		binary.LittleEndian.PutUint32(buffer[:4], uint32(docLength)) // Set the length in the buffer
-		_, err = io.ReadFull(vaasFile, buffer[4:])
-		if err != nil {
-			log.Fatal(err)
-		}
-
-		if i < offset {
-			i++
-			continue
-		}
-
-		// Create a BSON document reader
-		docReader := bsonrw.NewBSONDocumentReader(buffer)
+	strategy := backfill.StrategyFunc[*repository.VaaDoc](func(ctx context.Context, vaaDoc *repository.VaaDoc) error {
+		parsedPayload, point, _, err := converter.Convert(ctx, vaaDoc.Vaa)
 		if err != nil {
-			log.Fatal(err)
+			return fmt.Errorf("failed to convert vaaDoc: %w", err)
 		}
 
-		// Create a BSON decoder
-		decoder, errDecoder := bson.NewDecoder(docReader)
-		if errDecoder != nil {
-			log.Fatal(errDecoder)
-		}
-
-		// Decode the document
-		var vaaDoc repository.VaaDoc
-		err = decoder.Decode(&vaaDoc)
-		if err != nil {
-			log.Fatal(err)
+		m := &metric.Metric{}
+		dummyParams := &metric.Params{Vaa: &vaa.VAA{}}
+		vaaVolumeV3Point := m.MakePointVaaVolumeV3(point, dummyParams, parsedPayload)
+		if err := fout.WriteLine(convertPointToLineProtocol(vaaVolumeV3Point)); err != nil {
+			return fmt.Errorf("failed to write line protocol to file: %w", err)
 		}
+		loggerInstance.Debug("wrote line protocol to file", zap.String("vaaId", vaaDoc.ID))
+		return nil
+	})
 
-		// wait for a worker to be available or exit in case the ctx is cancelled
-		select {
-		case <-workersPool:
-			vaasChan <- vaaChanData{vaa: vaaDoc, i: i}
-			break
-		case <-ctx.Done():
-			return
-		}
-		i++
+	err = runJob[*repository.VaaDoc](opts, &bsonVaaSource{path: vaasBsonFile}, strategy,
+		backfill.NewFileCheckpointStore(opts.CheckpointFile), loggerInstance)
+	if err != nil {
+		os.Exit(1)
 	}
-	loggerInstance.Info("finished processing vaas")
-	loggerInstance.Info("waiting for workers to finish...")
-	<-workersPool
-	loggerInstance.Info("finished waiting, now cancelling ctx.")
-	cancel()
-
 }
 
-func processVaas(ctx context.Context, workersPool chan struct{}, vaasChan chan vaaChanData, converter *VaaConverter, logger *zap.Logger, lpChan chan lpChanData, failedVaas *os.File) {
-	for {
-		select {
-		case vaaData, isOpen := <-vaasChan:
-			if !isOpen {
-				logger.Info("exiting processVaas,vaasChan is closed")
-				return
-			}
-			go processVaa(ctx, workersPool, vaaData, converter, logger, lpChan, failedVaas)
-		case <-ctx.Done():
-			logger.Info("exiting processVaas, ctx cancelled")
-			return
-		}
-	}
-
+// bsonVaaSource reads the vaa documents of a dump of the vaas collection, using the byte offset
+// following the last document as cursor.
+type bsonVaaSource struct {
+	path string
 }
 
-func processVaa(ctx context.Context, workersPool chan struct{}, vaaData vaaChanData, converter *VaaConverter, logger *zap.Logger, lpChan chan lpChanData, failedVaas *os.File) {
-	defer func() {
-		workersPool <- struct{}{}
-	}()
-	parsedPayload, point, _, err := converter.Convert(context.Background(), vaaData.vaa.Vaa)
-
-	if err != nil {
-		_, errLog := failedVaas.WriteString("vaa: " + vaaData.vaa.ID + "|error: " + err.Error() + "\n")
-		if errLog != nil {
-			fmt.Printf("\n[processVaa]:failed to write to failedVaas.log. error:" + errLog.Error())
-			return
+// Next returns the page of vaa documents following the cursor.
+func (s *bsonVaaSource) Next(_ context.Context, cursor string, limit int64) ([]*repository.VaaDoc, string, error) {
+	var offset int64
+	if cursor != "" {
+		var err error
+		offset, err = strconv.ParseInt(cursor, 10, 64)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid cursor %q: %w", cursor, err)
 		}
-		logger.Error("[processVaa]:failed to convert vaaDoc", zap.Error(err), zap.Uint64("index", vaaData.i))
-		return
 	}
 
-	m := &metric.Metric{}
-	dummyParams := &metric.Params{Vaa: &vaa.VAA{}}
-	vaaVolumeV3Point := m.MakePointVaaVolumeV3(point, dummyParams, parsedPayload)
-	data := lpChanData{
-		vaaId: vaaData.vaa.ID,
-		lp:    convertPointToLineProtocol(vaaVolumeV3Point),
-		index: vaaData.i,
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, "", err
 	}
-
-	select {
-	case lpChan <- data:
-		break
-	case <-ctx.Done():
-		logger.Info("exiting processVaa, ctx cancelled")
-		break
+	defer f.Close()
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, "", err
 	}
 
-}
-
-func processLineProtocols(ctx context.Context, outputFile string, logger *zap.Logger, lpChan chan lpChanData, failedVaas *os.File) {
+	var vaas []*repository.VaaDoc
+	for int64(len(vaas)) < limit {
+		// Read the length of the next BSON document (first 4 bytes)
+		var docLength int32
+		err := binary.Read(f, binary.LittleEndian, &docLength)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if docLength < 5 {
+			return nil, "", fmt.Errorf("invalid bson document length %d at offset %d", docLength, offset)
+		}
 
-	fout, err := os.OpenFile(outputFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
-		logger.Fatal("creating output file", zap.Error(err))
-	}
-	defer fout.Close()
+		// Read the complete BSON document based on the length
+		buffer := make([]byte, docLength)
+		binary.LittleEndian.PutUint32(buffer[:4], uint32(docLength))
+		if _, err := io.ReadFull(f, buffer[4:]); err != nil {
+			return nil, "", err
+		}
 
-	for {
-		select {
-		case lpData := <-lpChan:
-			_, err = fout.Write([]byte(lpData.lp))
-			if err != nil {
-				_, errLog := failedVaas.WriteString("vaa: " + lpData.vaaId + "|error: " + err.Error() + "\n")
-				if errLog != nil {
-					fmt.Printf("\n[%s][processLineProtocols]:failed to write to failedVaas.log. error:%s|output_file_error=%s", time.Now().Format(time.RFC3339), errLog.Error(), err.Error())
-					return
-				}
-				logger.Error("[processLineProtocols]:failed to write line protocol to file", zap.Error(err))
-			} else {
-				logger.Info("wrote line protocol to file", zap.String("vaaId", lpData.vaaId), zap.Uint64("index", lpData.index))
-			}
-		case <-ctx.Done():
-			logger.Info("exiting processLineProtocols, ctx cancelled")
-			return
+		var vaaDoc repository.VaaDoc
+		if err := bson.Unmarshal(buffer, &vaaDoc); err != nil {
+			return nil, "", fmt.Errorf("invalid bson document at offset %d: %w", offset, err)
 		}
+		vaas = append(vaas, &vaaDoc)
+		offset += int64(docLength)
+	}
+	if len(vaas) == 0 {
+		return nil, cursor, nil
 	}
+	return vaas, strconv.FormatInt(offset, 10), nil
 }
 
-type vaaChanData struct {
-	vaa repository.VaaDoc
-	i   uint64 // indicate the number of vaa
+// Query returns the path of the dump.
+func (s *bsonVaaSource) Query() string {
+	return "file=" + s.path
 }
 
-type lpChanData struct {
-	vaaId string
-	lp    string
-	index uint64
+// Key returns the id of the vaa.
+func (s *bsonVaaSource) Key(v *repository.VaaDoc) string {
+	return v.ID
 }
@@ -1,16 +1,15 @@
 package metrics
 
 import (
-	"bufio"
 	"context"
 	"encoding/hex"
 	"fmt"
-	"io"
 	"os"
 	"strings"
 
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/cmd/token"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/prices"
+	"github.com/wormhole-foundation/wormhole-explorer/common/backfill"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
@@ -23,9 +22,8 @@ type LineParser struct {
 
 // read a csv file with VAAs and convert into a decoded csv file
 // ready to upload to the database
-func RunVaaVolumeFromFile(inputFile, outputFile, pricesFile, vaaPayloadParserURL, p2pNetwork string) {
+func RunVaaVolumeFromFile(inputFile, outputFile, pricesFile, vaaPayloadParserURL, p2pNetwork string, opts JobOptions) {
 
-	ctx := context.Background()
 	// build logger
 	logger := logger.New("wormhole-explorer-analytics")
 
@@ -43,13 +41,6 @@ func RunVaaVolumeFromFile(inputFile, outputFile, pricesFile, vaaPayloadParserURL
 	// create a token provider
 	tokenProvider := domain.NewTokenProvider(p2pNetwork)
 
-	// open input file
-	f, err := os.Open(inputFile)
-	if err != nil {
-		logger.Fatal("opening input file", zap.Error(err))
-	}
-	defer f.Close()
-
 	// create missing tokens file
 	missingTokensFile := "missing_tokens.csv"
 	fmissingTokens, err := os.Create(missingTokensFile)
@@ -59,7 +50,7 @@ func RunVaaVolumeFromFile(inputFile, outputFile, pricesFile, vaaPayloadParserURL
 	defer fmissingTokens.Close()
 
 	//open output file for writing
-	fout, err := os.Create(outputFile)
+	fout, err := createOutputFile(outputFile, opts.Resume)
 	if err != nil {
 		logger.Fatal("creating output file", zap.Error(err))
 	}
@@ -73,38 +64,16 @@ func RunVaaVolumeFromFile(inputFile, outputFile, pricesFile, vaaPayloadParserURL
 	lp := NewLineParser(converter)
 	logger.Info("loaded historical prices")
 
-	r := bufio.NewReader(f)
-
-	c := 0
-	i := 0
-	// read file line by line and send to workpool
-	for {
-		line, _, err := r.ReadLine() //loading chunk into buffer
+	strategy := backfill.StrategyFunc[string](func(ctx context.Context, line string) error {
+		nl, err := lp.ParseLine(ctx, []byte(line))
 		if err != nil {
-			if err == io.EOF {
-				break
-			}
-			logger.Fatal("a real error happened here", zap.Error(err))
-		}
-		nl, err := lp.ParseLine(ctx, line)
-		if err != nil {
-			//fmt.Printf(",")
-		} else {
-			c++
-			fout.Write([]byte(nl))
-
-			if c == 10000 {
-				fmt.Printf(".")
-				c = 0
-				i := i + 1
-				if i == 10 {
-					fmt.Printf("\n")
-					i = 0
-				}
-			}
+			return err
 		}
+		return fout.WriteLine(nl)
+	})
 
-	}
+	jobErr := runJob[string](opts, backfill.NewLineSource(inputFile), strategy,
+		backfill.NewFileCheckpointStore(opts.CheckpointFile), logger)
 
 	missingTokensCount := 0
 	converter.MissingTokensCounter.Range(
@@ -116,6 +85,10 @@ func RunVaaVolumeFromFile(inputFile, outputFile, pricesFile, vaaPayloadParserURL
 
 	logger.Info("missing tokens", zap.Int("count", missingTokensCount))
 
+	if jobErr != nil {
+		os.Exit(1)
+	}
+
 	logger.Info("finished wormhole-explorer-analytics")
 
 }
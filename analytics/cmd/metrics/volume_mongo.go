@@ -8,6 +8,7 @@ import (
 
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/cmd/token"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/prices"
+	"github.com/wormhole-foundation/wormhole-explorer/common/backfill"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
@@ -18,7 +19,7 @@ import (
 
 // read a csv file with VAAs and convert into a decoded csv file
 // ready to upload to the database
-func RunVaaVolumeFromMongo(mongoUri, mongoDb, outputFile, pricesFile, vaaPayloadParserURL, p2pNetwork string) {
+func RunVaaVolumeFromMongo(mongoUri, mongoDb, outputFile, pricesFile, vaaPayloadParserURL, p2pNetwork string, opts JobOptions) {
 
 	rootCtx := context.Background()
 
@@ -57,7 +58,7 @@ func RunVaaVolumeFromMongo(mongoUri, mongoDb, outputFile, pricesFile, vaaPayload
 	defer fmissingTokens.Close()
 
 	//open output file for writing
-	fout, err := os.Create(outputFile)
+	fout, err := createOutputFile(outputFile, opts.Resume)
 	if err != nil {
 		logger.Fatal("creating output file", zap.Error(err))
 	}
@@ -70,49 +71,21 @@ func RunVaaVolumeFromMongo(mongoUri, mongoDb, outputFile, pricesFile, vaaPayload
 	converter := NewVaaConverter(priceCache, tokenResolver.GetTransferredTokenByVaa, tokenProvider)
 	logger.Info("loaded historical prices")
 
-	endTime := time.Now()
 	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
 
 	// start backfilling
-	page := int64(0)
-	c := 0
-	for {
-		logger.Info("Processing page", zap.Int64("page", page),
-			zap.String("start_time", startTime.Format(time.RFC3339)),
-			zap.String("end_time", endTime.Format(time.RFC3339)))
-
-		vaas, err := vaaRepository.FindPageByTimeRange(rootCtx, startTime, endTime, page, 1000, true)
+	strategy := backfill.StrategyFunc[*repository.VaaDoc](func(ctx context.Context, v *repository.VaaDoc) error {
+		logger.Debug("Processing vaa", zap.String("id", v.ID))
+		_, _, nl, err := converter.Convert(ctx, v.Vaa)
 		if err != nil {
-			logger.Error("Failed to get vaas", zap.Error(err))
-			break
+			return err
 		}
-
-		if len(vaas) == 0 {
-			logger.Info("Empty page", zap.Int64("page", page))
-			break
-		}
-		for i, v := range vaas {
-			logger.Debug("Processing vaa", zap.String("id", v.ID))
-			_, _, nl, err := converter.Convert(rootCtx, v.Vaa)
-			if err != nil {
-				//fmt.Printf(",")
-			} else {
-				c++
-				fout.Write([]byte(nl))
-
-				if c == 10000 {
-					fmt.Printf(".")
-					c = 0
-					i := i + 1
-					if i == 10 {
-						fmt.Printf("\n")
-						i = 0
-					}
-				}
-			}
-		}
-		page++
-	}
+		return fout.WriteLine(nl)
+	})
+	// the query has no end time, so it's the same when the job is resumed.
+	query := repository.VaaQuery{StartTime: &startTime}
+	jobErr := runJob[*repository.VaaDoc](opts, backfill.NewVaaSource(vaaRepository, query), strategy,
+		backfill.NewMongoCheckpointStore(db.Database), logger)
 
 	logger.Info("closing MongoDB connection...")
 	db.DisconnectWithTimeout(10 * time.Second)
@@ -127,6 +100,10 @@ func RunVaaVolumeFromMongo(mongoUri, mongoDb, outputFile, pricesFile, vaaPayload
 
 	logger.Info("missing tokens", zap.Int("count", missingTokensCount))
 
+	if jobErr != nil {
+		os.Exit(1)
+	}
+
 	logger.Info("finished wormhole-explorer-analytics")
 
 }
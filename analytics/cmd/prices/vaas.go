@@ -4,7 +4,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/shopspring/decimal"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/cmd/token"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/metric"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
@@ -65,7 +64,7 @@ func RunVaasPrices(cfg VaasPrices) {
 	tokenProvider := domain.NewTokenProvider(cfg.P2PNetwork)
 
 	// create a price api
-	api := apiPrices.NewPricesApi(cfg.NotionalUrl, logger)
+	api := apiPrices.NewPricesApi(cfg.NotionalUrl, 10*time.Second, logger)
 
 	query := repository.VaaQuery{
 		StartTime:      cfg.StartTime,
@@ -118,12 +117,12 @@ func RunVaasPrices(cfg VaasPrices) {
 				logger,
 				vaa,
 				transferPricesCollection,
-				func(tokenID, coinGeckoID string, timestamp time.Time) (decimal.Decimal, error) {
+				func(tokenID, coinGeckoID string, timestamp time.Time) (*metric.TokenPrice, error) {
 					price, err := api.GetPriceByTime(ctx, coinGeckoID, timestamp)
 					if err != nil {
-						return decimal.NewFromInt(0), err
+						return nil, err
 					}
-					return price, nil
+					return &metric.TokenPrice{Price: price, Source: metric.PriceSourceHistorical}, nil
 				},
 				transferredToken,
 				tokenProvider,
@@ -30,6 +30,9 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	health "github.com/wormhole-foundation/wormhole-explorer/common/health"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
+	apiPrices "github.com/wormhole-foundation/wormhole-explorer/common/prices"
+	"github.com/wormhole-foundation/wormhole-explorer/common/tokenregistry"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
 )
@@ -99,12 +102,17 @@ func Run() {
 	tokenResolver := token.NewTokenResolver(parserVAAAPIClient, logger)
 
 	// create a token provider
-	tokenProvider := domain.NewTokenProvider(config.P2pNetwork)
+	tokenRegistry := tokenregistry.NewRegistry(tokenregistry.NewRepository(db.Database, logger), tokenregistry.DefaultRefreshInterval, logger)
+	if err := tokenRegistry.Start(rootCtx); err != nil {
+		logger.Fatal("failed to load token registry", zap.Error(err))
+	}
+	tokenProvider := domain.NewTokenProvider(config.P2pNetwork, domain.WithTokenRegistry(tokenRegistry))
 
 	// create a metrics instance
 	logger.Info("initializing metrics instance...")
 	metric, err := metric.New(rootCtx, db.Database, influxCli, config.InfluxOrganization, config.InfluxBucketInfinite,
-		config.InfluxBucket30Days, config.InfluxBucket24Hours, notionalCache, metrics, tokenResolver.GetTransferredTokenByVaa, tokenProvider, logger)
+		config.InfluxBucket30Days, config.InfluxBucket24Hours, notionalCache, newHistoricalPriceFunc(config, logger),
+		metrics, tokenResolver.GetTransferredTokenByVaa, tokenProvider, logger)
 	if err != nil {
 		logger.Fatal("failed to create metrics instance", zap.Error(err))
 	}
@@ -155,37 +163,51 @@ func Run() {
 	logger.Info("terminated successfully")
 }
 
-// Creates a callbacks depending on whether the execution is local (memory queue) or not (SQS queue)
+// Creates a callbacks depending on the message bus backend (SQS queue or Redis stream)
 func newVAAConsumeFunc(appCtx context.Context, config *config.Configuration, logger *zap.Logger) queue.ConsumeFunc {
-	sqsConsumer, err := newSQSConsumer(appCtx, config, config.PipelineSQSUrl)
+	consumer, err := newConsumer(appCtx, config, config.PipelineSQSUrl, config.PipelineStream)
 	if err != nil {
-		logger.Fatal("failed to create sqs consumer", zap.Error(err))
+		logger.Fatal("failed to create pipeline consumer", zap.Error(err))
 	}
 
-	vaaQueue := queue.NewEventSqs(sqsConsumer, queue.NewVaaConverter(logger), logger)
+	vaaQueue := queue.NewEventQueue(consumer, queue.NewVaaConverter(logger), logger)
 	return vaaQueue.Consume
 }
 
 func newNotificationConsumeFunc(ctx context.Context, cfg *config.Configuration, logger *zap.Logger) queue.ConsumeFunc {
 
-	sqsConsumer, err := newSQSConsumer(ctx, cfg, cfg.NotificationsSQSUrl)
+	consumer, err := newConsumer(ctx, cfg, cfg.NotificationsSQSUrl, cfg.NotificationsStream)
 	if err != nil {
-		logger.Fatal("failed to create sqs consumer", zap.Error(err))
+		logger.Fatal("failed to create notifications consumer", zap.Error(err))
 	}
 
-	vaaQueue := queue.NewEventSqs(sqsConsumer, queue.NewNotificationEvent(logger), logger)
+	vaaQueue := queue.NewEventQueue(consumer, queue.NewNotificationEvent(logger), logger)
 	return vaaQueue.Consume
 }
 
-func newSQSConsumer(appCtx context.Context, config *config.Configuration, sqsUrl string) (*sqs_client.Consumer, error) {
+func newConsumer(appCtx context.Context, config *config.Configuration, sqsUrl, stream string) (messagebus.Consumer, error) {
+	if config.MessageBus == messagebus.BackendRedis {
+		return messagebus.NewRedisStreamConsumer(appCtx, newMessageBusRedisClient(config), stream, config.MessageBusConsumerGroup,
+			messagebus.WithRedisMaxMessages(10),
+			messagebus.WithRedisVisibilityTimeout(120*time.Second))
+	}
+
 	awsconfig, err := newAwsConfig(appCtx, config)
 	if err != nil {
 		return nil, err
 	}
 
-	return sqs_client.NewConsumer(awsconfig, sqsUrl,
+	sqsConsumer, err := sqs_client.NewConsumer(awsconfig, sqsUrl,
 		sqs_client.WithMaxMessages(10),
 		sqs_client.WithVisibilityTimeout(120))
+	if err != nil {
+		return nil, err
+	}
+	return messagebus.NewSQSConsumer(sqsConsumer, messagebus.WithSNSEnvelope()), nil
+}
+
+func newMessageBusRedisClient(config *config.Configuration) *redis.Client {
+	return redis.NewClient(&redis.Options{Addr: config.MessageBusRedisURI})
 }
 
 func newAwsConfig(appCtx context.Context, cfg *config.Configuration) (aws.Config, error) {
@@ -226,6 +248,14 @@ func newHealthChecks(
 	db *mongo.Database,
 ) ([]health.Check, error) {
 
+	if config.MessageBus == messagebus.BackendRedis {
+		return []health.Check{
+			health.Redis(newMessageBusRedisClient(config)),
+			health.Influx(influxCli),
+			health.Mongo(db),
+		}, nil
+	}
+
 	awsConfig, err := newAwsConfig(ctx, config)
 	if err != nil {
 		return nil, err
@@ -258,3 +288,14 @@ func newNotionalCache(
 
 	return notionalCache, nil
 }
+
+// newHistoricalPriceFunc returns the function to get the token prices at the time of the VAAs.
+// Without the notional service url, only the current prices of the notional cache are used.
+func newHistoricalPriceFunc(cfg *config.Configuration, logger *zap.Logger) metric.HistoricalPriceFunc {
+	if cfg.NotionalURL == "" {
+		logger.Warn("notional url is not set, the volume will be computed with current prices")
+		return nil
+	}
+	api := apiPrices.NewPricesApi(cfg.NotionalURL, time.Duration(cfg.NotionalTimeout)*time.Second, logger)
+	return metric.NewCachedHistoricalPriceFunc(api.GetPriceByTime)
+}
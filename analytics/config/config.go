@@ -5,6 +5,7 @@ import (
 
 	"github.com/joho/godotenv"
 	"github.com/sethvargo/go-envconfig"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
 )
 
 // Configuration represents the application configuration with the default values.
@@ -19,6 +20,11 @@ type Configuration struct {
 	AwsRegion               string `env:"AWS_REGION"`
 	PipelineSQSUrl          string `env:"PIPELINE_SQS_URL"`
 	NotificationsSQSUrl     string `env:"NOTIFICATIONS_SQS_URL"`
+	MessageBus              string `env:"MESSAGE_BUS,default=sqs"`
+	MessageBusRedisURI      string `env:"MESSAGE_BUS_REDIS_URI"`
+	MessageBusConsumerGroup string `env:"MESSAGE_BUS_CONSUMER_GROUP,default=analytics"`
+	PipelineStream          string `env:"PIPELINE_STREAM,default=pipeline"`
+	NotificationsStream     string `env:"NOTIFICATIONS_STREAM,default=notifications"`
 	InfluxUrl               string `env:"INFLUX_URL"`
 	InfluxToken             string `env:"INFLUX_TOKEN"`
 	InfluxOrganization      string `env:"INFLUX_ORGANIZATION"`
@@ -34,6 +40,10 @@ type Configuration struct {
 	CacheChannel            string `env:"CACHE_CHANNEL,required"`
 	VaaPayloadParserURL     string `env:"VAA_PAYLOAD_PARSER_URL, required"`
 	VaaPayloadParserTimeout int64  `env:"VAA_PAYLOAD_PARSER_TIMEOUT, required"`
+	// NotionalURL is the url of the notional service used to get the historical prices.
+	NotionalURL string `env:"NOTIONAL_URL"`
+	// NotionalTimeout is the timeout in seconds of the requests to the notional service.
+	NotionalTimeout int64 `env:"NOTIONAL_TIMEOUT,default=10"`
 }
 
 // New creates a configuration with the values from .env file and environment variables.
@@ -44,6 +54,9 @@ func New(ctx context.Context) (*Configuration, error) {
 	if err := envconfig.Process(ctx, &configuration); err != nil {
 		return nil, err
 	}
+	if err := messagebus.ValidateBackend(configuration.MessageBus); err != nil {
+		return nil, err
+	}
 
 	return &configuration, nil
 }
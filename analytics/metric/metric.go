@@ -36,6 +36,7 @@ type Metric struct {
 	apiBucket30Days          api.WriteAPIBlocking
 	apiBucket24Hours         api.WriteAPIBlocking
 	notionalCache            wormscanNotionalCache.NotionalLocalCacheReadable
+	historicalPriceFunc      HistoricalPriceFunc
 	metrics                  metrics.Metrics
 	getTransferredTokenByVaa token.GetTransferredTokenByVaa
 	tokenProvider            *domain.TokenProvider
@@ -52,6 +53,7 @@ func New(
 	bucket30Days string,
 	bucket24Hours string,
 	notionalCache wormscanNotionalCache.NotionalLocalCacheReadable,
+	historicalPriceFunc HistoricalPriceFunc,
 	metrics metrics.Metrics,
 	getTransferredTokenByVaa token.GetTransferredTokenByVaa,
 	tokenProvider *domain.TokenProvider,
@@ -72,6 +74,7 @@ func New(
 		apiBucket30Days:          apiBucket30Days,
 		logger:                   logger,
 		notionalCache:            notionalCache,
+		historicalPriceFunc:      historicalPriceFunc,
 		metrics:                  metrics,
 		getTransferredTokenByVaa: getTransferredTokenByVaa,
 		tokenProvider:            tokenProvider,
@@ -107,8 +110,11 @@ func (m *Metric) Push(ctx context.Context, params *Params) error {
 
 		if transferredToken != nil {
 
+			// the price is shared by the volume metric and the transfer prices collection.
+			tokenPriceFunc := m.newTokenPriceFunc(ctx, params)
+
 			if isVaaSigned {
-				err3 = m.volumeMeasurement(ctx, params, transferredToken.Clone())
+				err3 = m.volumeMeasurement(ctx, params, transferredToken.Clone(), tokenPriceFunc)
 			}
 
 			err4 = UpsertTransferPrices(
@@ -116,14 +122,7 @@ func (m *Metric) Push(ctx context.Context, params *Params) error {
 				m.logger,
 				params.Vaa,
 				m.transferPrices,
-				func(tokenID, _ string, timestamp time.Time) (decimal.Decimal, error) {
-
-					priceData, err := m.notionalCache.Get(tokenID)
-					if err != nil {
-						return decimal.NewFromInt(0), err
-					}
-					return priceData.NotionalUsd, nil
-				},
+				tokenPriceFunc,
 				transferredToken.Clone(),
 				m.tokenProvider,
 			)
@@ -241,20 +240,24 @@ func (m *Metric) vaaCountAllMessagesMeasurement(ctx context.Context, params *Par
 }
 
 // volumeMeasurement creates a new point for the `vaa_volume_v2` measurement.
-func (m *Metric) volumeMeasurement(ctx context.Context, params *Params, token *token.TransferredToken) error {
+func (m *Metric) volumeMeasurement(ctx context.Context, params *Params, token *token.TransferredToken, tokenPriceFunc TokenPriceFunc) error {
+
+	// the coingecko id is needed to look up the historical price of the token.
+	var coingeckoID string
+	if tokenMeta, ok := m.tokenProvider.GetTokenByAddress(token.TokenChain, token.TokenAddress.String()); ok {
+		coingeckoID = tokenMeta.CoingeckoID
+	}
 
 	// Generate a data point for the volume metric
 	p := MakePointForVaaVolumeParams{
 		Logger: m.logger,
 		Vaa:    params.Vaa,
 		TokenPriceFunc: func(tokenID string, timestamp time.Time) (decimal.Decimal, error) {
-
-			priceData, err := m.notionalCache.Get(tokenID)
+			price, err := tokenPriceFunc(tokenID, coingeckoID, timestamp)
 			if err != nil {
 				return decimal.NewFromInt(0), err
 			}
-
-			return priceData.NotionalUsd, nil
+			return price.Price, nil
 		},
 		Metrics:          m.metrics,
 		TransferredToken: token,
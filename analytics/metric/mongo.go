@@ -35,6 +35,8 @@ type TransferPriceDoc struct {
 	TokenAddress string `bson:"tokenAddress"`
 	// CoinGeckoID is the CoinGecko ID of the token being transferred.
 	CoinGeckoID string `bson:"coinGeckoId"`
+	// PriceSource is the source of SymbolPriceUsd, one of PriceSourceHistorical or PriceSourceCurrent.
+	PriceSource string `bson:"priceSource"`
 	// UpdatedAt is the timestamp the document was updated.
 	UpdatedAt time.Time `bson:"updatedAt"`
 }
@@ -44,7 +46,7 @@ func UpsertTransferPrices(
 	logger *zap.Logger,
 	vaa *sdk.VAA,
 	transferPrices *mongo.Collection,
-	tokenPriceFunc func(tokenID, coinGeckoID string, timestamp time.Time) (decimal.Decimal, error),
+	tokenPriceFunc TokenPriceFunc,
 	transferredToken *token.TransferredToken,
 	tokenProvider *domain.TokenProvider,
 ) error {
@@ -68,7 +70,7 @@ func UpsertTransferPrices(
 	}
 
 	// Try to obtain the token notional value from the cache
-	notionalUSD, err := tokenPriceFunc(tokenMeta.GetTokenID(), tokenMeta.CoingeckoID, vaa.Timestamp)
+	tokenPrice, err := tokenPriceFunc(tokenMeta.GetTokenID(), tokenMeta.CoingeckoID, vaa.Timestamp)
 	if err != nil {
 		logger.Warn("failed to obtain notional for this token",
 			zap.String("vaaId", vaa.MessageID()),
@@ -80,6 +82,7 @@ func UpsertTransferPrices(
 		)
 		return nil
 	}
+	notionalUSD := tokenPrice.Price
 
 	// Compute the amount with decimals
 	var exp int32
@@ -105,6 +108,7 @@ func UpsertTransferPrices(
 			TokenChain:     uint16(transferredToken.TokenChain),
 			TokenAddress:   transferredToken.TokenAddress.String(),
 			CoinGeckoID:    tokenMeta.CoingeckoID,
+			PriceSource:    tokenPrice.Source,
 			UpdatedAt:      time.Now(),
 		},
 	}
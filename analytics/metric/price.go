@@ -0,0 +1,113 @@
+package metric
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// Sources of the token prices.
+const (
+	// PriceSourceHistorical is the price of the token at the time of the VAA.
+	PriceSourceHistorical = "historical"
+	// PriceSourceCurrent is the latest price of the token in the notional cache.
+	PriceSourceCurrent = "current"
+)
+
+// TokenPrice is the USD price of a token and the source it was obtained from.
+type TokenPrice struct {
+	Price  decimal.Decimal
+	Source string
+}
+
+// TokenPriceFunc returns the price of the given token at the specified timestamp.
+type TokenPriceFunc func(tokenID, coingeckoID string, timestamp time.Time) (*TokenPrice, error)
+
+// HistoricalPriceFunc returns the price of a token at the given time.
+type HistoricalPriceFunc func(ctx context.Context, coingeckoID string, dateTime time.Time) (decimal.Decimal, error)
+
+// historicalPriceCacheSize is the maximum number of prices kept by the cache of the historical prices.
+const historicalPriceCacheSize = 10_000
+
+type historicalPriceKey struct {
+	coingeckoID string
+	minute      int64
+}
+
+// NewCachedHistoricalPriceFunc caches the prices returned by f per token and minute, so that the
+// VAAs of the same token and minute only request the price once. The errors are not cached.
+func NewCachedHistoricalPriceFunc(f HistoricalPriceFunc) HistoricalPriceFunc {
+	var mu sync.Mutex
+	prices := make(map[historicalPriceKey]decimal.Decimal)
+	return func(ctx context.Context, coingeckoID string, dateTime time.Time) (decimal.Decimal, error) {
+		minute := dateTime.Truncate(time.Minute)
+		key := historicalPriceKey{coingeckoID: coingeckoID, minute: minute.Unix()}
+
+		mu.Lock()
+		price, ok := prices[key]
+		mu.Unlock()
+		if ok {
+			return price, nil
+		}
+
+		price, err := f(ctx, coingeckoID, minute)
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		mu.Lock()
+		// the cache is cleared when it is full, the recent prices are requested again.
+		if len(prices) >= historicalPriceCacheSize {
+			prices = make(map[historicalPriceKey]decimal.Decimal)
+		}
+		prices[key] = price
+		mu.Unlock()
+		return price, nil
+	}
+}
+
+// newTokenPriceFunc returns a TokenPriceFunc that resolves each token price once per VAA.
+//
+// The historical price at the VAA timestamp is used, so that delayed or reprocessed VAAs
+// are valued at the right price. The current price of the notional cache is only used
+// when the historical price is not available.
+func (m *Metric) newTokenPriceFunc(ctx context.Context, params *Params) TokenPriceFunc {
+	prices := make(map[string]*TokenPrice)
+	return func(tokenID, coingeckoID string, timestamp time.Time) (*TokenPrice, error) {
+		if p, ok := prices[tokenID]; ok {
+			return p, nil
+		}
+
+		p, err := m.getTokenPrice(ctx, params, tokenID, coingeckoID, timestamp)
+		if err != nil {
+			return nil, err
+		}
+		prices[tokenID] = p
+		return p, nil
+	}
+}
+
+func (m *Metric) getTokenPrice(ctx context.Context, params *Params, tokenID, coingeckoID string, timestamp time.Time) (*TokenPrice, error) {
+	if m.historicalPriceFunc != nil && coingeckoID != "" {
+		price, err := m.historicalPriceFunc(ctx, coingeckoID, timestamp)
+		if err == nil {
+			return &TokenPrice{Price: price, Source: PriceSourceHistorical}, nil
+		}
+		m.logger.Warn("Failed to obtain historical price, using current price",
+			zap.String("trackId", params.TrackID),
+			zap.String("vaaId", params.Vaa.MessageID()),
+			zap.String("coingeckoId", coingeckoID),
+			zap.Time("timestamp", timestamp),
+			zap.Error(err))
+	}
+
+	priceData, err := m.notionalCache.Get(tokenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain current price: %w", err)
+	}
+	return &TokenPrice{Price: priceData.NotionalUsd, Source: PriceSourceCurrent}, nil
+}
@@ -0,0 +1,125 @@
+package metric
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// fakeNotionalCache returns the current price of the tokens it contains.
+type fakeNotionalCache map[string]decimal.Decimal
+
+func (c fakeNotionalCache) Get(tokenID string) (notional.PriceData, error) {
+	price, ok := c[tokenID]
+	if !ok {
+		return notional.PriceData{}, errors.New("not found")
+	}
+	return notional.PriceData{NotionalUsd: price}, nil
+}
+
+func (c fakeNotionalCache) Close() error {
+	return nil
+}
+
+func TestGetTokenPrice(t *testing.T) {
+	timestamp := time.Date(2024, 5, 1, 10, 30, 15, 0, time.UTC)
+	params := &Params{TrackID: "test", Vaa: &sdk.VAA{EmitterChain: sdk.ChainIDEthereum, Sequence: 1}}
+	notionalCache := fakeNotionalCache{"ethereum": decimal.NewFromInt(3000)}
+
+	historical := func(_ context.Context, coingeckoID string, dateTime time.Time) (decimal.Decimal, error) {
+		if coingeckoID == "unavailable" {
+			return decimal.Zero, errors.New("timeout")
+		}
+		if !dateTime.Equal(timestamp) {
+			t.Errorf("unexpected price time %s", dateTime)
+		}
+		return decimal.NewFromInt(2500), nil
+	}
+
+	tests := []struct {
+		name        string
+		historical  HistoricalPriceFunc
+		tokenID     string
+		coingeckoID string
+		price       int64
+		source      string
+		err         bool
+	}{
+		{"historical", historical, "ethereum", "ethereum", 2500, PriceSourceHistorical, false},
+		{"historical unavailable", historical, "ethereum", "unavailable", 3000, PriceSourceCurrent, false},
+		{"without coingecko id", historical, "ethereum", "", 3000, PriceSourceCurrent, false},
+		{"without historical prices", nil, "ethereum", "ethereum", 3000, PriceSourceCurrent, false},
+		{"without prices", historical, "unknown", "unavailable", 0, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Metric{notionalCache: notionalCache, historicalPriceFunc: tt.historical, logger: zap.NewNop()}
+			p, err := m.getTokenPrice(context.Background(), params, tt.tokenID, tt.coingeckoID, timestamp)
+			if tt.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !p.Price.Equal(decimal.NewFromInt(tt.price)) || p.Source != tt.source {
+				t.Errorf("expected %d from %s, got %s from %s", tt.price, tt.source, p.Price, p.Source)
+			}
+		})
+	}
+}
+
+func TestNewCachedHistoricalPriceFunc(t *testing.T) {
+	var calls []time.Time
+	fail := true
+	f := NewCachedHistoricalPriceFunc(func(_ context.Context, coingeckoID string, dateTime time.Time) (decimal.Decimal, error) {
+		calls = append(calls, dateTime)
+		if fail {
+			return decimal.Zero, errors.New("timeout")
+		}
+		return decimal.NewFromInt(int64(len(calls))), nil
+	})
+
+	ctx := context.Background()
+	minute := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+
+	// the errors are not cached.
+	if _, err := f(ctx, "ethereum", minute.Add(10*time.Second)); err == nil {
+		t.Fatal("expected an error")
+	}
+	fail = false
+
+	expected := []struct {
+		coingeckoID string
+		dateTime    time.Time
+		price       int64
+	}{
+		{"ethereum", minute.Add(20 * time.Second), 2},
+		{"ethereum", minute.Add(59 * time.Second), 2},
+		{"solana", minute.Add(30 * time.Second), 3},
+		{"ethereum", minute.Add(time.Minute), 4},
+	}
+	for _, e := range expected {
+		price, err := f(ctx, e.coingeckoID, e.dateTime)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !price.Equal(decimal.NewFromInt(e.price)) {
+			t.Errorf("%s at %s: expected %d, got %s", e.coingeckoID, e.dateTime, e.price, price)
+		}
+	}
+	if len(calls) != 4 {
+		t.Fatalf("expected 4 calls, got %d", len(calls))
+	}
+	if !calls[1].Equal(minute) {
+		t.Errorf("expected the price of the minute, got %s", calls[1])
+	}
+}
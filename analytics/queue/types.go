@@ -5,11 +5,6 @@ import (
 	"time"
 )
 
-type sqsEvent struct {
-	MessageID string `json:"MessageId"`
-	Message   string `json:"Message"`
-}
-
 // Event represents a event data to be handle.
 type Event struct {
 	Source         string
@@ -11,6 +11,11 @@ import (
 )
 
 // GetOrLoad is a function that tries to get the result from the cache, if it is not found or it is expired, then it loads the result.
+//
+// When cacheClient is a *Store, the result is also kept in its L1, the concurrent loads of the key are
+// coalesced into one, a hit older than the refresh ahead threshold triggers a background refresh and the
+// result expires when any of its tags is invalidated. The result is stored in the cache until it has been
+// stale for the stale TTL of the store.
 func GetOrLoad[T any](
 	ctx context.Context,
 	logger *zap.Logger,
@@ -18,35 +23,45 @@ func GetOrLoad[T any](
 	expirations time.Duration,
 	key string,
 	metrics metrics.Metrics,
-	load func() (T, error),
+	load func(context.Context) (T, error),
+	tags ...string,
 ) (T, error) {
 	log := logger.With(zap.String("key", key))
 
-	// Try to get the result from the cache.
-	value, err := cacheClient.Get(ctx, key)
-	foundCache := true
-
-	//If the result is not found in the cache or fails, then load the result.
-	if err != nil {
-		foundCache = false
-		if err != cache.ErrNotFound {
-			log.Warn("getting result from cache", zap.Error(err))
-		}
+	s, ok := cacheClient.(*Store)
+	if !ok {
+		s = newPassThroughStore(cacheClient, logger)
 	}
 
-	var cached CachedResult[T]
+	// Try to get the result from the L1 and then from the cache.
+	cached, foundCache := getEntry[T](ctx, s, log, key)
+
 	//If the result is found in the cache and it is not expired, then return the result.
 	if foundCache {
-		err = json.Unmarshal([]byte(value), &cached)
-		if err != nil {
-			log.Warn("unmarshal cache", zap.Error(err))
-		} else if cached.Timestamp.Add(expirations).After(time.Now()) {
+		switch s.state(cached.Timestamp, expirations, tags) {
+		case entryFresh:
+			return cached.Result, nil
+		case entryRefresh:
+			s.loads.DoChan(key, func() (interface{}, error) {
+				refreshed, err := loadEntry(ctx, s, log, key, expirations, load)
+				if err != nil {
+					log.Warn("refreshing the result in background", zap.Error(err))
+				}
+				return refreshed, err
+			})
 			return cached.Result, nil
 		}
 	}
 
 	//If the result is not found in the cache or it is expired, then load the result.
-	result, err := load()
+	//The concurrent requests of the same key wait for a single load.
+	value, err, _ := s.loads.Do(key, func() (interface{}, error) {
+		// another instance may have refreshed the result since it was read from the L1.
+		if c, ok := getCacheEntry[T](ctx, s, log, key); ok && s.state(c.Timestamp, expirations, tags) != entryExpired {
+			return c, nil
+		}
+		return loadEntry(ctx, s, log, key, expirations, load)
+	})
 	if err != nil {
 		//If the load function fails and the cache was found and is expired, the cache value is returned anyway.
 		if foundCache {
@@ -55,18 +70,71 @@ func GetOrLoad[T any](
 				zap.Error(err), zap.String("cacheTime", cached.Timestamp.String()))
 			return cached.Result, nil
 		}
-		return result, err
+		//Otherwise the result of the load function is returned with its error, as loaded.
+		result, _ := value.(CachedResult[T])
+		return result.Result, err
+	}
+
+	//Returns the result of the execution of the function load
+	return value.(CachedResult[T]).Result, nil
+}
+
+// getEntry gets a cached result from the L1 of the store or from its cache.
+func getEntry[T any](ctx context.Context, s *Store, log *zap.Logger, key string) (CachedResult[T], bool) {
+	if value, ok := s.l1.get(key); ok {
+		if cached, ok := value.(CachedResult[T]); ok {
+			return cached, true
+		}
+	}
+	return getCacheEntry[T](ctx, s, log, key)
+}
+
+// getCacheEntry gets a cached result from the cache of the store and keeps it in its L1.
+func getCacheEntry[T any](ctx context.Context, s *Store, log *zap.Logger, key string) (CachedResult[T], bool) {
+	var cached CachedResult[T]
+	value, err := s.cache.Get(ctx, key)
+	if err != nil {
+		if err != cache.ErrNotFound {
+			log.Warn("getting result from cache", zap.Error(err))
+		}
+		return cached, false
+	}
+	err = json.Unmarshal([]byte(value), &cached)
+	if err != nil {
+		log.Warn("unmarshal cache", zap.Error(err))
+		return cached, false
+	}
+	s.l1.add(key, cached)
+	return cached, true
+}
+
+// loadEntry executes the load function and saves its result in the L1 and in the cache of the store.
+func loadEntry[T any](
+	ctx context.Context,
+	s *Store,
+	log *zap.Logger,
+	key string,
+	expirations time.Duration,
+	load func(context.Context) (T, error),
+) (CachedResult[T], error) {
+	ctx, cancel := s.loadContext(ctx)
+	defer cancel()
+
+	// The result is timestamped before the load, so that a tag invalidated while loading also expires it.
+	start := time.Now()
+	result, err := load(ctx)
+	if err != nil {
+		return CachedResult[T]{Result: result}, err
 	}
 
 	//Saves the result of the execution of the load function in cache.
-	newValue := CachedResult[T]{Timestamp: time.Now(), Result: result}
-	err = cacheClient.Set(ctx, key, newValue, 0)
+	newValue := CachedResult[T]{Timestamp: start, Result: result}
+	s.l1.add(key, newValue)
+	err = s.cache.Set(ctx, key, newValue, expirations+s.opts.StaleTTL)
 	if err != nil {
 		log.Warn("saving the result in the cache", zap.Error(err))
 	}
-
-	//Returns the result of the execution of the function load
-	return result, nil
+	return newValue, nil
 }
 
 type CachedResult[T any] struct {
@@ -0,0 +1,187 @@
+package cacheable
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	"go.uber.org/zap"
+)
+
+// memoryCache is an in-memory cache.Cache that records the ttl of the values.
+type memoryCache struct {
+	mu     sync.Mutex
+	values map[string]string
+	ttls   map[string]time.Duration
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{values: make(map[string]string), ttls: make(map[string]time.Duration)}
+}
+
+func (c *memoryCache) Get(_ context.Context, key string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, ok := c.values[key]
+	if !ok {
+		return "", cache.ErrNotFound
+	}
+	return value, nil
+}
+
+func (c *memoryCache) Set(_ context.Context, key string, value interface{}, expirations time.Duration) error {
+	b, err := value.(CachedResult[int]).MarshalBinary()
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[key] = string(b)
+	c.ttls[key] = expirations
+	return nil
+}
+
+func (c *memoryCache) Close() error {
+	return nil
+}
+
+func (c *memoryCache) seed(t *testing.T, key string, result int, timestamp time.Time) {
+	assert.NoError(t, c.Set(context.Background(), key, CachedResult[int]{Timestamp: timestamp, Result: result}, 0))
+}
+
+func getOrLoad(s *Store, key string, load func(context.Context) (int, error), tags ...string) (int, error) {
+	return GetOrLoad(context.Background(), zap.NewNop(), s, time.Minute, key, metrics.NewNoOpMetrics(), load, tags...)
+}
+
+func TestGetOrLoad_CoalescesConcurrentLoads(t *testing.T) {
+	s := NewStore(newMemoryCache(), StoreOptions{L1Size: 10}, zap.NewNop())
+
+	var loads int32
+	release := make(chan struct{})
+	load := func(context.Context) (int, error) {
+		atomic.AddInt32(&loads, 1)
+		<-release
+		return 7, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]int, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = getOrLoad(s, "scorecards", load)
+		}(i)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&loads))
+	for _, result := range results {
+		assert.Equal(t, 7, result)
+	}
+}
+
+func TestGetOrLoad_StoresWithTTL(t *testing.T) {
+	c := newMemoryCache()
+	s := NewStore(c, StoreOptions{StaleTTL: time.Hour}, zap.NewNop())
+
+	result, err := getOrLoad(s, "top-assets", func(context.Context) (int, error) { return 3, nil })
+	assert.NoError(t, err)
+	assert.Equal(t, 3, result)
+	assert.Equal(t, time.Minute+time.Hour, c.ttls["top-assets"])
+}
+
+func TestGetOrLoad_ReturnsStaleResultWhenLoadFails(t *testing.T) {
+	c := newMemoryCache()
+	c.seed(t, "top-assets", 5, time.Now().Add(-2*time.Minute))
+	s := NewStore(c, StoreOptions{L1Size: 10}, zap.NewNop())
+
+	result, err := getOrLoad(s, "top-assets", func(context.Context) (int, error) { return 0, errors.New("influx is down") })
+	assert.NoError(t, err)
+	assert.Equal(t, 5, result)
+
+	_, err = getOrLoad(s, "chain-activity", func(context.Context) (int, error) { return 0, errors.New("influx is down") })
+	assert.Error(t, err)
+}
+
+func TestGetOrLoad_RefreshesInBackground(t *testing.T) {
+	c := newMemoryCache()
+	c.seed(t, "scorecards", 1, time.Now().Add(-50*time.Second))
+	s := NewStore(c, StoreOptions{L1Size: 10, RefreshAhead: 0.5}, zap.NewNop())
+
+	var loads int32
+	load := func(context.Context) (int, error) {
+		atomic.AddInt32(&loads, 1)
+		return 2, nil
+	}
+
+	// the result is about to expire, so it is returned while it is refreshed in background.
+	result, err := getOrLoad(s, "scorecards", load)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result)
+
+	assert.Eventually(t, func() bool {
+		result, _ := getOrLoad(s, "scorecards", load)
+		return result == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&loads))
+}
+
+func TestGetOrLoad_InvalidatesByTag(t *testing.T) {
+	s := NewStore(newMemoryCache(), StoreOptions{L1Size: 10}, zap.NewNop())
+
+	var loads int32
+	load := func(context.Context) (int, error) {
+		return int(atomic.AddInt32(&loads, 1)), nil
+	}
+
+	result, _ := getOrLoad(s, "scorecards", load, TagNotional)
+	assert.Equal(t, 1, result)
+	result, _ = getOrLoad(s, "top-corridors", load)
+	assert.Equal(t, 2, result)
+
+	s.Invalidate(TagNotional)
+
+	result, _ = getOrLoad(s, "scorecards", load, TagNotional)
+	assert.Equal(t, 3, result)
+	result, _ = getOrLoad(s, "top-corridors", load)
+	assert.Equal(t, 2, result)
+}
+
+func TestGetOrLoad_PassThroughCache(t *testing.T) {
+	c := newMemoryCache()
+	c.seed(t, "guardian-set", 9, time.Now())
+
+	result, err := GetOrLoad(context.Background(), zap.NewNop(), c, time.Minute, "guardian-set", metrics.NewNoOpMetrics(),
+		func(context.Context) (int, error) { return 0, errors.New("not expected") })
+	assert.NoError(t, err)
+	assert.Equal(t, 9, result)
+}
+
+func TestLRU(t *testing.T) {
+	c := newLRU(2)
+	c.add("a", 1)
+	c.add("b", 2)
+	_, _ = c.get("a")
+	c.add("c", 3)
+
+	assert.Equal(t, 2, c.len())
+	_, ok := c.get("b")
+	assert.False(t, ok)
+	value, ok := c.get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	disabled := newLRU(0)
+	disabled.add("a", 1)
+	_, ok = disabled.get("a")
+	assert.False(t, ok)
+}
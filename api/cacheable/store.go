@@ -0,0 +1,169 @@
+package cacheable
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+// TagNotional is the tag of the cached results computed with the notional value of the assets.
+// They are invalidated every time the notional job publishes new prices.
+const TagNotional = "notional"
+
+// DefaultStaleTTL is the time an expired result is kept in redis to be returned when its load fails.
+const DefaultStaleTTL = 24 * time.Hour
+
+// StoreOptions defines the behavior of a Store.
+type StoreOptions struct {
+	// L1Size is the maximum number of results kept in memory in front of redis. Zero disables it.
+	L1Size int
+	// RefreshAhead is the fraction of the expiration after which a hit triggers a background
+	// refresh of the result, so that hot keys never expire. Zero disables it.
+	RefreshAhead float64
+	// StaleTTL is the time an expired result is kept in redis to be returned when its load fails.
+	StaleTTL time.Duration
+	// LoadTimeout bounds the loads, which are detached from the request that triggered them.
+	LoadTimeout time.Duration
+}
+
+// Store is a two level cache, a bounded in-process L1 in front of a distributed cache, used by GetOrLoad.
+// It coalesces the concurrent loads of a key, refreshes the hot keys in background before they
+// expire and invalidates the results by tag.
+//
+// Store implements cache.Cache by delegating to the distributed cache.
+type Store struct {
+	cache         cache.Cache
+	l1            *lru
+	loads         singleflight.Group
+	opts          StoreOptions
+	mu            sync.RWMutex
+	invalidations map[string]time.Time
+	pubSubs       []*redis.PubSub
+	passThrough   bool
+	logger        *zap.Logger
+}
+
+// NewStore creates a new Store on top of a cache client.
+func NewStore(cacheClient cache.Cache, opts StoreOptions, logger *zap.Logger) *Store {
+	return &Store{
+		cache:         cacheClient,
+		l1:            newLRU(opts.L1Size),
+		opts:          opts,
+		invalidations: make(map[string]time.Time),
+		logger:        logger.With(zap.String("module", "CacheStore")),
+	}
+}
+
+// newPassThroughStore wraps a cache client that is not a Store, without L1 nor background refresh.
+// It is not shared between requests, so its loads run in the context of the request.
+func newPassThroughStore(cacheClient cache.Cache, logger *zap.Logger) *Store {
+	s := NewStore(cacheClient, StoreOptions{StaleTTL: DefaultStaleTTL}, logger)
+	s.passThrough = true
+	return s
+}
+
+// Get gets a value from the distributed cache.
+func (s *Store) Get(ctx context.Context, key string) (string, error) {
+	return s.cache.Get(ctx, key)
+}
+
+// Set sets a value in the distributed cache.
+func (s *Store) Set(ctx context.Context, key string, value interface{}, expirations time.Duration) error {
+	return s.cache.Set(ctx, key, value, expirations)
+}
+
+// Close closes the invalidation subscriptions and the distributed cache.
+func (s *Store) Close() error {
+	for _, pubSub := range s.pubSubs {
+		if err := pubSub.Close(); err != nil {
+			s.logger.Warn("closing invalidation subscription", zap.Error(err))
+		}
+	}
+	return s.cache.Close()
+}
+
+// Invalidate expires the results of the tags loaded until now.
+// The next request of each of them is loaded again, unless another instance already refreshed it.
+func (s *Store) Invalidate(tags ...string) {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, tag := range tags {
+		s.invalidations[tag] = now
+	}
+}
+
+// Subscribe invalidates the tags every time a message is published in a redis channel.
+// Every instance of the api receives the message, so all their L1s are invalidated.
+func (s *Store) Subscribe(ctx context.Context, redisClient *redis.Client, channel string, tags ...string) {
+	pubSub := redisClient.Subscribe(ctx, channel)
+	s.pubSubs = append(s.pubSubs, pubSub)
+
+	ch := pubSub.Channel()
+	go func() {
+		for msg := range ch {
+			s.logger.Info("invalidating cached results",
+				zap.String("channel", msg.Channel),
+				zap.String("payload", msg.Payload),
+				zap.Strings("tags", tags))
+			s.Invalidate(tags...)
+		}
+	}()
+}
+
+// entryState is the state of a cached result.
+type entryState int
+
+const (
+	entryFresh entryState = iota
+	entryRefresh
+	entryExpired
+)
+
+// state returns the state of a result cached at timestamp.
+func (s *Store) state(timestamp time.Time, expirations time.Duration, tags []string) entryState {
+	if s.invalidated(timestamp, tags) {
+		return entryExpired
+	}
+	age := time.Since(timestamp)
+	if age >= expirations {
+		return entryExpired
+	}
+	if s.opts.RefreshAhead > 0 && age >= time.Duration(float64(expirations)*s.opts.RefreshAhead) {
+		return entryRefresh
+	}
+	return entryFresh
+}
+
+// invalidated returns true if any of the tags was invalidated after timestamp.
+func (s *Store) invalidated(timestamp time.Time, tags []string) bool {
+	if len(tags) == 0 {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, tag := range tags {
+		if at, ok := s.invalidations[tag]; ok && timestamp.Before(at) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadContext returns the context of a load. Loads are shared by several requests and may run
+// in background, so they must not be cancelled when the request that started them finishes.
+func (s *Store) loadContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.passThrough {
+		return ctx, func() {}
+	}
+	ctx = context.WithoutCancel(ctx)
+	if s.opts.LoadTimeout > 0 {
+		return context.WithTimeout(ctx, s.opts.LoadTimeout)
+	}
+	return ctx, func() {}
+}
@@ -1,7 +1,71 @@
 package address
 
-import "github.com/wormhole-foundation/wormhole-explorer/api/handlers/vaa"
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/vaa"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
 
 type AddressOverview struct {
 	Vaas []*vaa.VaaDoc `json:"vaas"`
 }
+
+// AddressPortfolio is the summary of the operations sent and received by an address across all chains.
+type AddressPortfolio struct {
+	Address             string           `json:"address"`
+	NormalizedAddresses []string         `json:"normalizedAddresses"`
+	FirstActivity       *time.Time       `json:"firstActivity,omitempty"`
+	LastActivity        *time.Time       `json:"lastActivity,omitempty"`
+	SentOperations      uint64           `json:"sentOperations"`
+	ReceivedOperations  uint64           `json:"receivedOperations"`
+	Tokens              []*TokenTotals   `json:"tokens"`
+	Counterparties      []*Counterparty  `json:"counterparties"`
+	Protocols           []*ProtocolUsage `json:"protocols"`
+}
+
+// TokenTotals are the amounts of a token sent and received by an address, with their USD value at transfer time.
+type TokenTotals struct {
+	TokenChain         sdk.ChainID     `json:"tokenChain"`
+	TokenAddress       string          `json:"tokenAddress"`
+	Symbol             string          `json:"symbol,omitempty"`
+	AmountSent         decimal.Decimal `json:"amountSent"`
+	AmountReceived     decimal.Decimal `json:"amountReceived"`
+	UsdSent            decimal.Decimal `json:"usdSent"`
+	UsdReceived        decimal.Decimal `json:"usdReceived"`
+	SentOperations     uint64          `json:"sentOperations"`
+	ReceivedOperations uint64          `json:"receivedOperations"`
+}
+
+// Counterparty is an address that sent operations to or received operations from an address.
+type Counterparty struct {
+	Chain        sdk.ChainID `bson:"chain" json:"chain"`
+	Address      string      `bson:"address" json:"address"`
+	Operations   uint64      `bson:"operations" json:"operations"`
+	LastActivity time.Time   `bson:"lastActivity" json:"lastActivity"`
+}
+
+// ProtocolUsage is the number of operations of an address that used a protocol.
+type ProtocolUsage struct {
+	AppID      string `bson:"appId" json:"appId"`
+	Operations uint64 `bson:"operations" json:"operations"`
+}
+
+// AddressActivity is an operation sent or received by an address.
+type AddressActivity struct {
+	VaaID               string      `bson:"vaaId" json:"vaaId"`
+	Direction           string      `bson:"direction" json:"direction"`
+	Chain               sdk.ChainID `bson:"chain" json:"chain"`
+	Address             string      `bson:"nativeAddress" json:"address"`
+	CounterpartyChain   sdk.ChainID `bson:"counterpartyChain" json:"counterpartyChain"`
+	CounterpartyAddress string      `bson:"counterpartyAddress" json:"counterpartyAddress,omitempty"`
+	TokenChain          sdk.ChainID `bson:"tokenChain" json:"tokenChain"`
+	TokenAddress        string      `bson:"tokenAddress" json:"tokenAddress,omitempty"`
+	Symbol              string      `bson:"symbol" json:"symbol,omitempty"`
+	TokenAmount         string      `bson:"tokenAmount" json:"tokenAmount,omitempty"`
+	UsdAmount           string      `bson:"usdAmount" json:"usdAmount,omitempty"`
+	AppIDs              []string    `bson:"appIds" json:"appIds"`
+	TxHash              string      `bson:"txHash" json:"txHash,omitempty"`
+	Timestamp           time.Time   `bson:"timestamp" json:"timestamp"`
+}
@@ -3,11 +3,18 @@ package address
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/common"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/vaa"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap"
 )
 
@@ -16,7 +23,8 @@ type Repository struct {
 	logger *zap.Logger
 
 	collections struct {
-		parsedVaa *mongo.Collection
+		parsedVaa       *mongo.Collection
+		addressActivity *mongo.Collection
 	}
 }
 
@@ -24,9 +32,11 @@ func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
 	return &Repository{db: db,
 		logger: logger.With(zap.String("module", "AddressRepository")),
 		collections: struct {
-			parsedVaa *mongo.Collection
+			parsedVaa       *mongo.Collection
+			addressActivity *mongo.Collection
 		}{
-			parsedVaa: db.Collection("parsedVaa"),
+			parsedVaa:       db.Collection("parsedVaa"),
+			addressActivity: db.Collection(repository.AddressActivity),
 		},
 	}
 }
@@ -126,3 +136,197 @@ func (r *Repository) GetAddressOverview(ctx context.Context, params *GetAddressO
 	}
 	return &AddressOverview{Vaas: vaas}, nil
 }
+
+// maxCounterparties is the maximum number of counterparties returned in the portfolio of an address.
+const maxCounterparties = 50
+
+// toDecimal converts a string field of the addressActivity collection to decimal, using 0 for empty or invalid values.
+func toDecimal(field string) bson.M {
+	return bson.M{"$convert": bson.M{"input": field, "to": "decimal", "onError": 0, "onNull": 0}}
+}
+
+// sumIf sums value for the activities of the given direction.
+func sumIf(direction string, value interface{}) bson.M {
+	return bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$direction", direction}}, value, 0}}}
+}
+
+// GetAddressPortfolio returns the summary of the activity of the normalized addresses.
+func (r *Repository) GetAddressPortfolio(ctx context.Context, addresses []string) (*AddressPortfolio, error) {
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"address": bson.M{"$in": addresses}}}},
+		{{Key: "$facet", Value: bson.D{
+			{Key: "summary", Value: bson.A{
+				bson.M{"$group": bson.M{
+					"_id":                nil,
+					"firstActivity":      bson.M{"$min": "$timestamp"},
+					"lastActivity":       bson.M{"$max": "$timestamp"},
+					"sentOperations":     sumIf("sent", 1),
+					"receivedOperations": sumIf("received", 1),
+				}},
+			}},
+			{Key: "tokens", Value: bson.A{
+				bson.M{"$match": bson.M{"tokenAddress": bson.M{"$nin": bson.A{"", nil}}}},
+				bson.M{"$group": bson.M{
+					"_id":                bson.M{"tokenChain": "$tokenChain", "tokenAddress": "$tokenAddress"},
+					"symbol":             bson.M{"$max": "$symbol"},
+					"amountSent":         sumIf("sent", toDecimal("$tokenAmount")),
+					"amountReceived":     sumIf("received", toDecimal("$tokenAmount")),
+					"usdSent":            sumIf("sent", toDecimal("$usdAmount")),
+					"usdReceived":        sumIf("received", toDecimal("$usdAmount")),
+					"sentOperations":     sumIf("sent", 1),
+					"receivedOperations": sumIf("received", 1),
+				}},
+				bson.M{"$addFields": bson.M{"usdTotal": bson.M{"$add": bson.A{"$usdSent", "$usdReceived"}}}},
+				bson.M{"$sort": bson.D{{Key: "usdTotal", Value: -1}, {Key: "_id", Value: 1}}},
+			}},
+			{Key: "counterparties", Value: bson.A{
+				bson.M{"$match": bson.M{"counterpartyAddress": bson.M{"$nin": bson.A{"", nil}}}},
+				bson.M{"$group": bson.M{
+					"_id":          bson.M{"chain": "$counterpartyChain", "address": "$counterpartyAddress"},
+					"operations":   bson.M{"$sum": 1},
+					"lastActivity": bson.M{"$max": "$timestamp"},
+				}},
+				bson.M{"$sort": bson.D{{Key: "operations", Value: -1}, {Key: "lastActivity", Value: -1}}},
+				bson.M{"$limit": maxCounterparties},
+				bson.M{"$project": bson.M{"_id": 0, "chain": "$_id.chain", "address": "$_id.address", "operations": 1, "lastActivity": 1}},
+			}},
+			{Key: "protocols", Value: bson.A{
+				bson.M{"$unwind": "$appIds"},
+				bson.M{"$group": bson.M{"_id": "$appIds", "operations": bson.M{"$sum": 1}}},
+				bson.M{"$sort": bson.D{{Key: "operations", Value: -1}, {Key: "_id", Value: 1}}},
+				bson.M{"$project": bson.M{"_id": 0, "appId": "$_id", "operations": 1}},
+			}},
+		}}},
+	}
+
+	cur, err := r.collections.addressActivity.Aggregate(ctx, pipeline)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute Aggregate command to get address portfolio",
+			zap.Error(err),
+			zap.Strings("addresses", addresses),
+			zap.String("requestID", requestID),
+		)
+		return nil, errors.WithStack(err)
+	}
+
+	var documents []struct {
+		Summary []struct {
+			FirstActivity      time.Time `bson:"firstActivity"`
+			LastActivity       time.Time `bson:"lastActivity"`
+			SentOperations     uint64    `bson:"sentOperations"`
+			ReceivedOperations uint64    `bson:"receivedOperations"`
+		} `bson:"summary"`
+		Tokens []struct {
+			ID struct {
+				TokenChain   sdk.ChainID `bson:"tokenChain"`
+				TokenAddress string      `bson:"tokenAddress"`
+			} `bson:"_id"`
+			Symbol             string               `bson:"symbol"`
+			AmountSent         primitive.Decimal128 `bson:"amountSent"`
+			AmountReceived     primitive.Decimal128 `bson:"amountReceived"`
+			UsdSent            primitive.Decimal128 `bson:"usdSent"`
+			UsdReceived        primitive.Decimal128 `bson:"usdReceived"`
+			SentOperations     uint64               `bson:"sentOperations"`
+			ReceivedOperations uint64               `bson:"receivedOperations"`
+		} `bson:"tokens"`
+		Counterparties []*Counterparty  `bson:"counterparties"`
+		Protocols      []*ProtocolUsage `bson:"protocols"`
+	}
+	if err := cur.All(ctx, &documents); err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed to decode cursor for address portfolio",
+			zap.Error(err),
+			zap.Strings("addresses", addresses),
+			zap.String("requestID", requestID),
+		)
+		return nil, errors.WithStack(err)
+	}
+
+	portfolio := AddressPortfolio{
+		NormalizedAddresses: addresses,
+		Tokens:              []*TokenTotals{},
+		Counterparties:      []*Counterparty{},
+		Protocols:           []*ProtocolUsage{},
+	}
+	if len(documents) == 0 || len(documents[0].Summary) == 0 {
+		return &portfolio, nil
+	}
+	doc := documents[0]
+
+	summary := doc.Summary[0]
+	portfolio.FirstActivity = &summary.FirstActivity
+	portfolio.LastActivity = &summary.LastActivity
+	portfolio.SentOperations = summary.SentOperations
+	portfolio.ReceivedOperations = summary.ReceivedOperations
+
+	for _, t := range doc.Tokens {
+		portfolio.Tokens = append(portfolio.Tokens, &TokenTotals{
+			TokenChain:         t.ID.TokenChain,
+			TokenAddress:       t.ID.TokenAddress,
+			Symbol:             t.Symbol,
+			AmountSent:         decimalFromDecimal128(t.AmountSent),
+			AmountReceived:     decimalFromDecimal128(t.AmountReceived),
+			UsdSent:            decimalFromDecimal128(t.UsdSent),
+			UsdReceived:        decimalFromDecimal128(t.UsdReceived),
+			SentOperations:     t.SentOperations,
+			ReceivedOperations: t.ReceivedOperations,
+		})
+	}
+	if doc.Counterparties != nil {
+		portfolio.Counterparties = doc.Counterparties
+	}
+	if doc.Protocols != nil {
+		portfolio.Protocols = doc.Protocols
+	}
+	return &portfolio, nil
+}
+
+// decimalFromDecimal128 converts a mongo decimal to decimal.Decimal, returning zero if it can't be parsed.
+func decimalFromDecimal128(d primitive.Decimal128) decimal.Decimal {
+	result, err := decimal.NewFromString(d.String())
+	if err != nil {
+		return decimal.Zero
+	}
+	return result
+}
+
+type GetAddressActivityParams struct {
+	Addresses []string
+	Skip      int64
+	Limit     int64
+}
+
+// GetAddressActivity returns the operations sent or received by the normalized addresses, sorted by descending timestamp.
+func (r *Repository) GetAddressActivity(ctx context.Context, params *GetAddressActivityParams) ([]*AddressActivity, error) {
+
+	filter := bson.M{"address": bson.M{"$in": params.Addresses}}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(params.Skip).
+		SetLimit(params.Limit)
+
+	cur, err := r.collections.addressActivity.Find(ctx, filter, opts)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute Find command to get address activity",
+			zap.Error(err),
+			zap.Any("params", params),
+			zap.String("requestID", requestID),
+		)
+		return nil, errors.WithStack(err)
+	}
+
+	activities := []*AddressActivity{}
+	if err := cur.All(ctx, &activities); err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed to decode cursor for address activity",
+			zap.Error(err),
+			zap.Any("params", params),
+			zap.String("requestID", requestID),
+		)
+		return nil, errors.WithStack(err)
+	}
+	return activities, nil
+}
@@ -3,11 +3,26 @@ package address
 import (
 	"context"
 
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
 
+// candidateChains are the chains used to normalize an address when its chain is unknown.
+// They cover every native address encoding supported by domain.DecodeNativeAddressToHex.
+var candidateChains = []sdk.ChainID{
+	sdk.ChainIDEthereum,
+	sdk.ChainIDSolana,
+	sdk.ChainIDTerra,
+	sdk.ChainIDInjective,
+	sdk.ChainIDXpla,
+	sdk.ChainIDSei,
+	sdk.ChainIDAlgorand,
+}
+
 type Service struct {
 	repo   *Repository
 	logger *zap.Logger
@@ -44,3 +59,62 @@ func (s *Service) GetAddressOverview(
 	response.Data = overview
 	return response, nil
 }
+
+// normalizeAddress returns the normalized representations of an address.
+// If chain is nil, the address is decoded with the encodings of all the candidate chains.
+func normalizeAddress(address string, chain *sdk.ChainID) []string {
+	chains := candidateChains
+	if chain != nil {
+		chains = []sdk.ChainID{*chain}
+	}
+
+	var addresses []string
+	seen := make(map[string]bool)
+	for _, c := range chains {
+		normalized, err := domain.NormalizeNativeAddress(c, address)
+		if err != nil || seen[normalized] {
+			continue
+		}
+		seen[normalized] = true
+		addresses = append(addresses, normalized)
+	}
+	return addresses
+}
+
+// GetAddressPortfolio returns the summary of the operations sent and received by an address across all chains.
+func (s *Service) GetAddressPortfolio(ctx context.Context, address string, chain *sdk.ChainID) (*AddressPortfolio, error) {
+	addresses := normalizeAddress(address, chain)
+	if len(addresses) == 0 {
+		return nil, errs.ErrMalformedQuery
+	}
+
+	portfolio, err := s.repo.GetAddressPortfolio(ctx, addresses)
+	if err != nil {
+		return nil, err
+	}
+	if portfolio.LastActivity == nil {
+		return nil, errs.ErrNotFound
+	}
+	portfolio.Address = address
+	return portfolio, nil
+}
+
+// GetAddressActivity returns the operations sent or received by an address across all chains.
+func (s *Service) GetAddressActivity(
+	ctx context.Context,
+	address string,
+	chain *sdk.ChainID,
+	pagination *pagination.Pagination,
+) ([]*AddressActivity, error) {
+	addresses := normalizeAddress(address, chain)
+	if len(addresses) == 0 {
+		return nil, errs.ErrMalformedQuery
+	}
+
+	p := GetAddressActivityParams{
+		Addresses: addresses,
+		Skip:      pagination.Skip,
+		Limit:     pagination.Limit,
+	}
+	return s.repo.GetAddressActivity(ctx, &p)
+}
@@ -0,0 +1,22 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestNormalizeAddress(t *testing.T) {
+	evm := "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585"
+	solana := "23ac499437a8e6533b790d5578af5d39b349883188eca535b957d82a0e77eb03"
+
+	assert.Equal(t, []string{evm}, normalizeAddress("0x3Ee18B2214AFF97000D974cf647E7C347E8fa585", nil))
+	assert.Equal(t, []string{solana}, normalizeAddress("3QFeCHsG9WDXzMozWyck8RUxmw59jyj7MPnQd4w2mbDL", nil))
+	assert.Equal(t, []string{evm}, normalizeAddress(evm, nil))
+
+	chain := sdk.ChainIDEthereum
+	assert.Equal(t, []string{evm}, normalizeAddress("0x3Ee18B2214AFF97000D974cf647E7C347E8fa585", &chain))
+	assert.Empty(t, normalizeAddress("3QFeCHsG9WDXzMozWyck8RUxmw59jyj7MPnQd4w2mbDL", &chain))
+	assert.Empty(t, normalizeAddress("not an address", nil))
+}
@@ -0,0 +1,67 @@
+package exports
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/export"
+)
+
+// ExportRequest is the body of a request of an export. From and To are RFC3339 times.
+type ExportRequest struct {
+	Format       string  `json:"format"`
+	From         string  `json:"from"`
+	To           string  `json:"to"`
+	SourceChain  *uint16 `json:"sourceChain"`
+	TargetChain  *uint16 `json:"targetChain"`
+	AppID        string  `json:"appId"`
+	Address      string  `json:"address"`
+	TokenChain   *uint16 `json:"tokenChain"`
+	TokenAddress string  `json:"tokenAddress"`
+}
+
+// ExportDto is the status of an export.
+type ExportDto struct {
+	ID          string        `json:"id"`
+	Format      export.Format `json:"format"`
+	Filter      export.Filter `json:"filter"`
+	Status      export.Status `json:"status"`
+	Rows        int64         `json:"rows"`
+	Size        int64         `json:"size"`
+	Error       string        `json:"error,omitempty"`
+	CreatedAt   time.Time     `json:"createdAt"`
+	StartedAt   *time.Time    `json:"startedAt,omitempty"`
+	CompletedAt *time.Time    `json:"completedAt,omitempty"`
+	// DownloadURL is the path of the download of the file, set when the export is completed.
+	DownloadURL string `json:"downloadUrl,omitempty"`
+}
+
+func toExportDto(e *export.Export) *ExportDto {
+	dto := &ExportDto{
+		ID:          e.ID,
+		Format:      e.Format,
+		Filter:      e.Filter,
+		Status:      e.Status,
+		Rows:        e.Rows,
+		Size:        e.Size,
+		Error:       e.Error,
+		CreatedAt:   e.CreatedAt,
+		StartedAt:   e.StartedAt,
+		CompletedAt: e.CompletedAt,
+	}
+	if e.Status == export.StatusCompleted {
+		dto.DownloadURL = fmt.Sprintf("/api/v1/exports/%s/download", e.ID)
+	}
+	return dto
+}
+
+// Download is the file of a completed export. Either URL is set, to redirect to a storage that
+// serves the file, or Body is set with the content of the file.
+type Download struct {
+	URL         string
+	Body        io.ReadCloser
+	Size        int64
+	FileName    string
+	ContentType string
+}
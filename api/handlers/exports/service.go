@@ -0,0 +1,189 @@
+// Package exports handle the requests of exports of operations, written asynchronously by the export job.
+package exports
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/common/export"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// presignExpiration is the time the download urls of the storages that serve the files are valid.
+const presignExpiration = 15 * time.Minute
+
+// clientWindow is the time window of the exports requested by a client that are limited.
+const clientWindow = time.Hour
+
+// Service definition.
+type Service struct {
+	repo         *export.Repository
+	storage      export.Storage
+	maxRange     time.Duration
+	maxPending   int
+	maxPerClient int
+	logger       *zap.Logger
+}
+
+// NewService create a new Service. The exports are limited to a time range of maxRange. New exports are
+// rejected while there are maxPending exports pending or running, or when the client requested maxPerClient
+// exports in the last hour. A limit of zero disables it.
+func NewService(repo *export.Repository, storage export.Storage, maxRange time.Duration, maxPending, maxPerClient int, logger *zap.Logger) *Service {
+	return &Service{
+		repo:         repo,
+		storage:      storage,
+		maxRange:     maxRange,
+		maxPending:   maxPending,
+		maxPerClient: maxPerClient,
+		logger:       logger.With(zap.String("module", "ExportsService")),
+	}
+}
+
+// Create validates the request and stores a pending export, to be run by the export job.
+// The client is the address of the client that requested the export.
+func (s *Service) Create(ctx context.Context, req *ExportRequest, client string) (*ExportDto, error) {
+	now := time.Now()
+	e, err := newExport(req, s.maxRange, now)
+	if err != nil {
+		return nil, err
+	}
+	e.Client = clientID(client)
+	if err := s.checkLimits(ctx, e.Client, now); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, e); err != nil {
+		s.logger.Error("failed to create export", zap.Error(err))
+		return nil, err
+	}
+	return toExportDto(e), nil
+}
+
+// FindByID get the status of an export.
+func (s *Service) FindByID(ctx context.Context, id string) (*ExportDto, error) {
+	e, err := s.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toExportDto(e), nil
+}
+
+// Download get the file of a completed export. If the storage can serve the file, it returns a
+// temporary url to download it from the storage.
+func (s *Service) Download(ctx context.Context, id string) (*Download, error) {
+	e, err := s.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if e.Status != export.StatusCompleted {
+		return nil, errs.New(errs.CodeConflict, fmt.Sprintf("export is %s, it can be downloaded when completed", e.Status))
+	}
+
+	d := &Download{Size: e.Size, FileName: e.FileName(), ContentType: e.Format.ContentType()}
+	if p, ok := s.storage.(export.Presigner); ok {
+		d.URL, err = p.PresignGet(ctx, e.Key, presignExpiration)
+		return d, err
+	}
+	d.Body, d.Size, err = s.storage.Get(ctx, e.Key)
+	if errors.Is(err, export.ErrFileNotFound) {
+		s.logger.Error("file of completed export not found", zap.String("exportId", id), zap.String("key", e.Key))
+		return nil, errs.ErrNotFound
+	}
+	return d, err
+}
+
+// checkLimits checks the exports requested by the client and the exports pending are below their limits.
+// The exports are counted before the new one is stored, so concurrent requests can exceed the limits slightly.
+func (s *Service) checkLimits(ctx context.Context, client string, now time.Time) error {
+	if s.maxPerClient > 0 {
+		count, err := s.repo.CountByClient(ctx, client, now.Add(-clientWindow))
+		if err != nil {
+			s.logger.Error("failed to count the exports of the client", zap.Error(err))
+			return err
+		}
+		if count >= int64(s.maxPerClient) {
+			return errs.New(errs.CodeRateLimited, fmt.Sprintf("at most %d exports can be requested per hour, retry later", s.maxPerClient))
+		}
+	}
+	if s.maxPending > 0 {
+		count, err := s.repo.CountPending(ctx)
+		if err != nil {
+			s.logger.Error("failed to count the pending exports", zap.Error(err))
+			return err
+		}
+		if count >= int64(s.maxPending) {
+			return errs.New(errs.CodeRateLimited, "too many exports pending, retry later")
+		}
+	}
+	return nil
+}
+
+// clientID returns the id of a client stored in its exports, a hash of its address so the address is not stored.
+func clientID(client string) string {
+	hash := sha256.Sum256([]byte(client))
+	return hex.EncodeToString(hash[:])
+}
+
+func (s *Service) find(ctx context.Context, id string) (*export.Export, error) {
+	e, err := s.repo.FindByID(ctx, id)
+	if errors.Is(err, export.ErrNotFound) {
+		return nil, errs.ErrNotFound
+	}
+	return e, err
+}
+
+// newExport validates an export request and creates a pending export.
+func newExport(req *ExportRequest, maxRange time.Duration, now time.Time) (*export.Export, error) {
+	format, err := export.ParseFormat(req.Format)
+	if err != nil {
+		return nil, errs.NewFieldError(errs.CodeInvalidParam, "INVALID FORMAT", err, "format", req.Format, "must be one of csv, ndjson, parquet")
+	}
+
+	from, err := time.Parse(time.RFC3339, req.From)
+	if err != nil {
+		return nil, errs.NewFieldError(errs.CodeInvalidParam, "INVALID FROM", err, "from", req.From, "must be a RFC3339 time")
+	}
+	to, err := time.Parse(time.RFC3339, req.To)
+	if err != nil {
+		return nil, errs.NewFieldError(errs.CodeInvalidParam, "INVALID TO", err, "to", req.To, "must be a RFC3339 time")
+	}
+	if !from.Before(to) {
+		return nil, errs.NewFieldError(errs.CodeInvalidTimeSpan, "INVALID TIME RANGE", nil, "to", req.To, "must be after from")
+	}
+	if to.Sub(from) > maxRange {
+		return nil, errs.NewFieldError(errs.CodeInvalidTimeSpan, "INVALID TIME RANGE", nil, "to", req.To,
+			fmt.Sprintf("the time range must be at most %s", maxRange))
+	}
+
+	return &export.Export{
+		ID:     uuid.NewString(),
+		Format: format,
+		Filter: export.Filter{
+			From:         from.UTC(),
+			To:           to.UTC(),
+			SourceChain:  toChainID(req.SourceChain),
+			TargetChain:  toChainID(req.TargetChain),
+			AppID:        req.AppID,
+			Address:      req.Address,
+			TokenChain:   toChainID(req.TokenChain),
+			TokenAddress: req.TokenAddress,
+		},
+		Status:    export.StatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func toChainID(chain *uint16) *sdk.ChainID {
+	if chain == nil {
+		return nil
+	}
+	id := sdk.ChainID(*chain)
+	return &id
+}
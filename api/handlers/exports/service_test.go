@@ -0,0 +1,109 @@
+package exports
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/common/export"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.uber.org/zap"
+)
+
+func TestNewExport(t *testing.T) {
+	now := time.Now()
+	ethereum := uint16(sdk.ChainIDEthereum)
+	req := &ExportRequest{
+		Format:      "Parquet",
+		From:        "2024-01-01T00:00:00Z",
+		To:          "2024-01-02T00:00:00+01:00",
+		SourceChain: &ethereum,
+		AppID:       "PORTAL_TOKEN_BRIDGE",
+		Address:     "0x1111",
+	}
+
+	e, err := newExport(req, 24*time.Hour, now)
+	require.NoError(t, err)
+	assert.NotEmpty(t, e.ID)
+	assert.Equal(t, export.FormatParquet, e.Format)
+	assert.Equal(t, export.StatusPending, e.Status)
+	assert.Equal(t, time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC), e.Filter.To)
+	assert.Equal(t, sdk.ChainIDEthereum, *e.Filter.SourceChain)
+	assert.Nil(t, e.Filter.TargetChain)
+	assert.Equal(t, "0x1111", e.Filter.Address)
+	assert.Equal(t, now, e.CreatedAt)
+}
+
+func TestNewExport_Invalid(t *testing.T) {
+	testCases := []struct {
+		name  string
+		req   ExportRequest
+		code  errs.Code
+		field string
+	}{
+		{"format", ExportRequest{Format: "xlsx", From: "2024-01-01T00:00:00Z", To: "2024-01-02T00:00:00Z"}, errs.CodeInvalidParam, "format"},
+		{"from", ExportRequest{Format: "csv", From: "2024-01-01", To: "2024-01-02T00:00:00Z"}, errs.CodeInvalidParam, "from"},
+		{"to", ExportRequest{Format: "csv", From: "2024-01-01T00:00:00Z"}, errs.CodeInvalidParam, "to"},
+		{"empty range", ExportRequest{Format: "csv", From: "2024-01-01T00:00:00Z", To: "2024-01-01T00:00:00Z"}, errs.CodeInvalidTimeSpan, "to"},
+		{"range too long", ExportRequest{Format: "csv", From: "2024-01-01T00:00:00Z", To: "2024-01-03T00:00:00Z"}, errs.CodeInvalidTimeSpan, "to"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := newExport(&testCase.req, 24*time.Hour, time.Now())
+			e := errs.As(err)
+			assert.Equal(t, testCase.code, e.Code)
+			require.Len(t, e.Fields, 1)
+			assert.Equal(t, testCase.field, e.Fields[0].Field)
+		})
+	}
+}
+
+func TestService_Create_Limits(t *testing.T) {
+	m := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer m.Close()
+
+	req := ExportRequest{Format: "csv", From: "2024-01-01T00:00:00Z", To: "2024-01-02T00:00:00Z"}
+	count := func(mt *mtest.T, n int32) bson.D {
+		return mtest.CreateCursorResponse(0, mt.DB.Name()+".exports", mtest.FirstBatch, bson.D{{Key: "n", Value: n}})
+	}
+
+	m.Run("created", func(mt *mtest.T) {
+		mt.AddMockResponses(count(mt, 9), count(mt, 99), mtest.CreateSuccessResponse())
+		srv := NewService(export.NewRepository(mt.DB), nil, 24*time.Hour, 100, 10, zap.NewNop())
+		e, err := srv.Create(context.Background(), &req, "10.0.0.1")
+		require.NoError(t, err)
+		assert.Equal(t, export.StatusPending, e.Status)
+	})
+
+	m.Run("too many by client", func(mt *mtest.T) {
+		mt.AddMockResponses(count(mt, 10))
+		srv := NewService(export.NewRepository(mt.DB), nil, 24*time.Hour, 100, 10, zap.NewNop())
+		_, err := srv.Create(context.Background(), &req, "10.0.0.1")
+		assert.Equal(t, errs.CodeRateLimited, errs.As(err).Code)
+	})
+
+	m.Run("too many pending", func(mt *mtest.T) {
+		mt.AddMockResponses(count(mt, 0), count(mt, 100))
+		srv := NewService(export.NewRepository(mt.DB), nil, 24*time.Hour, 100, 10, zap.NewNop())
+		_, err := srv.Create(context.Background(), &req, "10.0.0.1")
+		assert.Equal(t, errs.CodeRateLimited, errs.As(err).Code)
+	})
+
+	m.Run("no limits", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		srv := NewService(export.NewRepository(mt.DB), nil, 24*time.Hour, 0, 0, zap.NewNop())
+		_, err := srv.Create(context.Background(), &req, "10.0.0.1")
+		require.NoError(t, err)
+	})
+}
+
+func TestClientID(t *testing.T) {
+	assert.Equal(t, clientID("10.0.0.1"), clientID("10.0.0.1"))
+	assert.NotEqual(t, clientID("10.0.0.1"), clientID("10.0.0.2"))
+	assert.NotContains(t, clientID("10.0.0.1"), "10.0.0.1")
+}
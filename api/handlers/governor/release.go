@@ -0,0 +1,227 @@
+package governor
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// releaseWindow is the time window used by the governor to compute the available notional.
+const releaseWindow = 24 * time.Hour
+
+// Governor delay reasons.
+const (
+	// DelayReasonBigTransaction is the reason of a vaa delayed for exceeding the big transaction size.
+	DelayReasonBigTransaction = "big_transaction"
+	// DelayReasonNotionalLimit is the reason of a vaa delayed for exceeding the available notional.
+	DelayReasonNotionalLimit = "notional_limit"
+)
+
+// GovernorVaaStatus represents the governor status of an enqueued vaa.
+type GovernorVaaStatus struct {
+	VaaID          string      `json:"vaaId"`
+	ChainID        vaa.ChainID `json:"chainId"`
+	EmitterAddress string      `json:"emitterAddress"`
+	Sequence       string      `json:"sequence"`
+	TxHash         string      `json:"txHash"`
+	Amount         uint64      `json:"amount"`
+	Status         string      `json:"status"`
+	// DelayReason is the reason the governor enqueued the vaa.
+	DelayReason string `json:"delayReason"`
+	// PredictedReleaseTime is the time a quorum of guardians will have released the vaa, or nil if
+	// there are not enough guardians reporting the chain to reach a quorum.
+	PredictedReleaseTime *time.Time `json:"predictedReleaseTime"`
+	// Quorum is the number of guardians that must release the vaa.
+	Quorum int `json:"quorum"`
+	// Released is the number of guardians that no longer have the vaa enqueued.
+	Released int `json:"released"`
+	// Guardians is the status of the vaa in the governor of each guardian.
+	Guardians []*GuardianEnqueuedVaa `json:"guardians"`
+	// AvailableNotional is the available notional of the chain agreed by a quorum of guardians.
+	AvailableNotional uint64 `json:"availableNotional"`
+	// BigTransactionSize is the big transaction size of the chain agreed by a quorum of guardians.
+	BigTransactionSize uint64 `json:"bigTransactionSize"`
+	// ProjectedNotional is the projection of the available notional over the governor window.
+	ProjectedNotional []*ProjectedNotional `json:"projectedNotional"`
+}
+
+// GuardianEnqueuedVaa represents the status of a vaa in the governor of a guardian.
+type GuardianEnqueuedVaa struct {
+	NodeName    string     `json:"nodeName"`
+	NodeAddress string     `json:"nodeAddress,omitempty"`
+	Enqueued    bool       `json:"enqueued"`
+	ReleaseTime *time.Time `json:"releaseTime,omitempty"`
+}
+
+// ProjectedNotional represents the available notional of a chain at a point in time.
+type ProjectedNotional struct {
+	Time              time.Time `json:"time"`
+	AvailableNotional uint64    `json:"availableNotional"`
+}
+
+// quorumValue returns the value agreed by a quorum of guardians, that is the highest value that at
+// least minGuardianNum guardians reached, and false if there are not enough values.
+func quorumValue(values []uint64) (uint64, bool) {
+	if len(values) < minGuardianNum {
+		return 0, false
+	}
+	sorted := append([]uint64(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+	return sorted[minGuardianNum-1], true
+}
+
+// findEnqueuedVaa returns the vaa enqueued in the governor of a guardian, if any.
+func findEnqueuedVaa(emitters []Emitter, emitterAddress, sequence string) *EnqueuedVAA {
+	address := fmt.Sprintf("0x%s", emitterAddress)
+	for _, e := range emitters {
+		if e.Address != address {
+			continue
+		}
+		for i := range e.EnqueuedVaas {
+			if e.EnqueuedVaas[i].Sequence == sequence {
+				return &e.EnqueuedVaas[i]
+			}
+		}
+	}
+	return nil
+}
+
+// guardianVaaStatus returns the status of the vaa in the governor of each guardian reporting the chain.
+// The guardians tracked as holding the vaa without reporting it in their status are considered enqueued
+// until the release time of the vaa.
+func guardianVaaStatus(doc *GovernorVaaDoc, status []*MaxNotionalAvailableRecord, nodes []*NodeGovernorVaaDoc) []*GuardianEnqueuedVaa {
+	addresses := make(map[string]string, len(nodes))
+	for _, n := range nodes {
+		addresses[n.NodeName] = n.NodeAddress
+	}
+
+	guardians := make([]*GuardianEnqueuedVaa, 0, len(status))
+	for _, s := range status {
+		g := &GuardianEnqueuedVaa{NodeName: s.NodeName, NodeAddress: addresses[s.NodeName]}
+		if v := findEnqueuedVaa(s.Emitters, doc.EmitterAddress, doc.Sequence); v != nil {
+			g.Enqueued = true
+			g.ReleaseTime = v.ReleaseTime
+		}
+		delete(addresses, s.NodeName)
+		guardians = append(guardians, g)
+	}
+	for _, n := range nodes {
+		if _, ok := addresses[n.NodeName]; !ok {
+			continue
+		}
+		releaseTime := doc.ReleaseTime
+		guardians = append(guardians, &GuardianEnqueuedVaa{
+			NodeName:    n.NodeName,
+			NodeAddress: n.NodeAddress,
+			Enqueued:    true,
+			ReleaseTime: &releaseTime,
+		})
+	}
+
+	sort.SliceStable(guardians, func(i, j int) bool { return guardians[i].NodeName < guardians[j].NodeName })
+	return guardians
+}
+
+// predictReleaseTime returns the time a quorum of guardians will have released the vaa and the number of
+// guardians that already released it. The vaa is released by a guardian at its release time, so the
+// quorum is reached at the release time of the guardian completing the guardians that already released it.
+func predictReleaseTime(now time.Time, guardians []*GuardianEnqueuedVaa) (*time.Time, int) {
+	released := 0
+	var releaseTimes []time.Time
+	for _, g := range guardians {
+		if !g.Enqueued || g.ReleaseTime == nil {
+			released++
+			continue
+		}
+		releaseTimes = append(releaseTimes, *g.ReleaseTime)
+	}
+
+	if released+len(releaseTimes) < minGuardianNum {
+		return nil, released
+	}
+	if released >= minGuardianNum {
+		return &now, released
+	}
+	sort.Slice(releaseTimes, func(i, j int) bool { return releaseTimes[i].Before(releaseTimes[j]) })
+	releaseTime := releaseTimes[minGuardianNum-released-1]
+	if releaseTime.Before(now) {
+		releaseTime = now
+	}
+	return &releaseTime, released
+}
+
+// delayReason returns the reason the governor enqueued a vaa with the given notional value.
+func delayReason(amount, bigTransactionSize uint64) string {
+	if bigTransactionSize > 0 && amount >= bigTransactionSize {
+		return DelayReasonBigTransaction
+	}
+	return DelayReasonNotionalLimit
+}
+
+// projectNotional returns the available notional of the chain agreed by a quorum of guardians now and
+// at the release time of each vaa enqueued in the next 24 hours.
+//
+// Each released vaa below the big transaction size consumes notional of the guardian that releases it.
+// The notional freed by the transfers leaving the 24h window is not reported by the guardians, so the
+// projection is a lower bound of the available notional.
+func projectNotional(now time.Time, status []*MaxNotionalAvailableRecord, bigTransactionSize uint64) []*ProjectedNotional {
+	end := now.Add(releaseWindow)
+
+	// collect the points in time where the available notional changes.
+	points := map[time.Time]bool{now: true, end: true}
+	for _, s := range status {
+		for _, e := range s.Emitters {
+			for _, v := range e.EnqueuedVaas {
+				if v.ReleaseTime != nil && v.ReleaseTime.After(now) && v.ReleaseTime.Before(end) {
+					points[*v.ReleaseTime] = true
+				}
+			}
+		}
+	}
+	times := make([]time.Time, 0, len(points))
+	for t := range points {
+		times = append(times, t)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	projection := make([]*ProjectedNotional, 0, len(times))
+	for _, t := range times {
+		available := make([]uint64, 0, len(status))
+		for _, s := range status {
+			available = append(available, guardianNotionalAt(t, s, bigTransactionSize))
+		}
+		value, ok := quorumValue(available)
+		if !ok {
+			return nil
+		}
+		projection = append(projection, &ProjectedNotional{Time: t, AvailableNotional: value})
+	}
+	return projection
+}
+
+// guardianNotionalAt returns the available notional of a guardian at time t after releasing the
+// enqueued vaas below the big transaction size with a release time before t.
+func guardianNotionalAt(t time.Time, s *MaxNotionalAvailableRecord, bigTransactionSize uint64) uint64 {
+	var available uint64
+	if s.NotionalAvailable != nil {
+		available = uint64(*s.NotionalAvailable)
+	}
+	for _, e := range s.Emitters {
+		for _, v := range e.EnqueuedVaas {
+			if v.ReleaseTime == nil || v.ReleaseTime.After(t) || v.Notional == nil {
+				continue
+			}
+			notional := uint64(*v.Notional)
+			if delayReason(notional, bigTransactionSize) == DelayReasonBigTransaction {
+				continue
+			}
+			if notional >= available {
+				return 0
+			}
+			available -= notional
+		}
+	}
+	return available
+}
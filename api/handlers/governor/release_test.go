@@ -0,0 +1,124 @@
+package governor
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/mongo"
+)
+
+func newGuardians(enqueued int, released int, start time.Time) []*GuardianEnqueuedVaa {
+	var guardians []*GuardianEnqueuedVaa
+	for i := 0; i < enqueued; i++ {
+		releaseTime := start.Add(time.Duration(i) * time.Minute)
+		guardians = append(guardians, &GuardianEnqueuedVaa{
+			NodeName:    fmt.Sprintf("enqueued-%02d", i),
+			Enqueued:    true,
+			ReleaseTime: &releaseTime,
+		})
+	}
+	for i := 0; i < released; i++ {
+		guardians = append(guardians, &GuardianEnqueuedVaa{NodeName: fmt.Sprintf("released-%02d", i)})
+	}
+	return guardians
+}
+
+func TestPredictReleaseTime(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	start := now.Add(time.Hour)
+
+	// no guardian released the vaa, the quorum is reached by the 13th earliest release time.
+	releaseTime, released := predictReleaseTime(now, newGuardians(19, 0, start))
+	assert.Equal(t, 0, released)
+	assert.Equal(t, start.Add(12*time.Minute), *releaseTime)
+
+	// guardians that released the vaa count towards the quorum.
+	releaseTime, released = predictReleaseTime(now, newGuardians(15, 4, start))
+	assert.Equal(t, 4, released)
+	assert.Equal(t, start.Add(8*time.Minute), *releaseTime)
+
+	// a quorum already released the vaa.
+	releaseTime, released = predictReleaseTime(now, newGuardians(6, 13, start))
+	assert.Equal(t, 13, released)
+	assert.Equal(t, now, *releaseTime)
+
+	// release times in the past are predicted as now.
+	releaseTime, _ = predictReleaseTime(now, newGuardians(19, 0, now.Add(-time.Hour)))
+	assert.Equal(t, now, *releaseTime)
+
+	// not enough guardians to reach a quorum.
+	releaseTime, _ = predictReleaseTime(now, newGuardians(10, 2, start))
+	assert.Nil(t, releaseTime)
+}
+
+func TestQuorumValue(t *testing.T) {
+	values := make([]uint64, 19)
+	for i := range values {
+		values[i] = uint64(i + 1)
+	}
+	value, ok := quorumValue(values)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(7), value)
+
+	_, ok = quorumValue(values[:12])
+	assert.False(t, ok)
+}
+
+func TestDelayReason(t *testing.T) {
+	assert.Equal(t, DelayReasonBigTransaction, delayReason(100, 100))
+	assert.Equal(t, DelayReasonNotionalLimit, delayReason(99, 100))
+	assert.Equal(t, DelayReasonNotionalLimit, delayReason(100, 0))
+}
+
+func TestGuardianVaaStatus(t *testing.T) {
+	releaseTime := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	doc := &GovernorVaaDoc{EmitterAddress: "ec7372995d5cc8732397fb0ad35c0121e0eaa90d26f828a534cab54391b3a4f5", Sequence: "10", ReleaseTime: releaseTime}
+	status := []*MaxNotionalAvailableRecord{
+		{NodeName: "a", Emitters: []Emitter{{
+			Address:      "0xec7372995d5cc8732397fb0ad35c0121e0eaa90d26f828a534cab54391b3a4f5",
+			EnqueuedVaas: []EnqueuedVAA{{Sequence: "9"}, {Sequence: "10", ReleaseTime: &releaseTime}},
+		}}},
+		{NodeName: "b"},
+	}
+	nodes := []*NodeGovernorVaaDoc{{NodeName: "a", NodeAddress: "0xa"}, {NodeName: "c", NodeAddress: "0xc"}}
+
+	guardians := guardianVaaStatus(doc, status, nodes)
+	assert.Equal(t, []*GuardianEnqueuedVaa{
+		{NodeName: "a", NodeAddress: "0xa", Enqueued: true, ReleaseTime: &releaseTime},
+		{NodeName: "b"},
+		{NodeName: "c", NodeAddress: "0xc", Enqueued: true, ReleaseTime: &releaseTime},
+	}, guardians)
+}
+
+func TestProjectNotional(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	first := now.Add(time.Hour)
+	second := now.Add(2 * time.Hour)
+	small := mongo.Uint64(100)
+	big := mongo.Uint64(1000)
+
+	var status []*MaxNotionalAvailableRecord
+	for i := 0; i < 13; i++ {
+		available := mongo.Uint64(500)
+		status = append(status, &MaxNotionalAvailableRecord{
+			NodeName:          fmt.Sprintf("guardian-%02d", i),
+			NotionalAvailable: &available,
+			Emitters: []Emitter{{EnqueuedVaas: []EnqueuedVAA{
+				{Sequence: "1", ReleaseTime: &first, Notional: &small},
+				{Sequence: "2", ReleaseTime: &second, Notional: &big},
+			}}},
+		})
+	}
+
+	projection := projectNotional(now, status, 1000)
+	assert.Equal(t, []*ProjectedNotional{
+		{Time: now, AvailableNotional: 500},
+		{Time: first, AvailableNotional: 400},
+		{Time: second, AvailableNotional: 400},
+		{Time: now.Add(releaseWindow), AvailableNotional: 400},
+	}, projection)
+
+	assert.Nil(t, projectNotional(now, status[:12], 1000))
+}
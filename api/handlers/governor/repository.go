@@ -26,9 +26,10 @@ type Repository struct {
 	db          *mongo.Database
 	logger      *zap.Logger
 	collections struct {
-		governorConfig *mongo.Collection
-		governorStatus *mongo.Collection
-		governorVaas   *mongo.Collection
+		governorConfig   *mongo.Collection
+		governorStatus   *mongo.Collection
+		governorVaas     *mongo.Collection
+		nodeGovernorVaas *mongo.Collection
 	}
 }
 
@@ -37,13 +38,15 @@ func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
 	return &Repository{db: db,
 		logger: logger.With(zap.String("module", "GovernorRepository")),
 		collections: struct {
-			governorConfig *mongo.Collection
-			governorStatus *mongo.Collection
-			governorVaas   *mongo.Collection
+			governorConfig   *mongo.Collection
+			governorStatus   *mongo.Collection
+			governorVaas     *mongo.Collection
+			nodeGovernorVaas *mongo.Collection
 		}{
-			governorConfig: db.Collection("governorConfig"),
-			governorStatus: db.Collection("governorStatus"),
-			governorVaas:   db.Collection("governorVaas"),
+			governorConfig:   db.Collection("governorConfig"),
+			governorStatus:   db.Collection("governorStatus"),
+			governorVaas:     db.Collection("governorVaas"),
+			nodeGovernorVaas: db.Collection("nodeGovernorVaas"),
 		},
 	}
 }
@@ -675,6 +678,30 @@ func (r *Repository) GetMaxNotionalAvailableByChainID(
 	q *NotionalLimitQuery,
 ) (*MaxNotionalAvailableRecord, error) {
 
+	rows, err := r.GetGovernorStatusByChainID(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	// check exists records
+	if len(rows) == 0 {
+		return nil, errs.ErrNotFound
+	}
+
+	if len(rows) < minGuardianNum {
+		return nil, errs.ErrNotFound
+	}
+
+	maxNotionalLimit := rows[minGuardianNum-1]
+	return maxNotionalLimit, nil
+}
+
+// GetGovernorStatusByChainID get the governor status of a chainID for each guardian, sorted by descending available notional.
+func (r *Repository) GetGovernorStatusByChainID(
+	ctx context.Context,
+	q *NotionalLimitQuery,
+) ([]*MaxNotionalAvailableRecord, error) {
+
 	// stage definitions.
 	matchStage1 := bson.D{{Key: "$match", Value: bson.D{}}}
 
@@ -742,7 +769,7 @@ func (r *Repository) GetMaxNotionalAvailableByChainID(
 	cur, err := r.collections.governorStatus.Aggregate(ctx, pipeLine)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to execute Aggregate command to get maximun available notional by chainID",
+		r.logger.Error("failed to execute Aggregate command to get governor status by chainID",
 			zap.Error(err),
 			zap.Any("q", q),
 			zap.String("requestID", requestID),
@@ -763,17 +790,7 @@ func (r *Repository) GetMaxNotionalAvailableByChainID(
 		return nil, errors.WithStack(err)
 	}
 
-	// check exists records
-	if len(rows) == 0 {
-		return nil, errs.ErrNotFound
-	}
-
-	if len(rows) < minGuardianNum {
-		return nil, errs.ErrNotFound
-	}
-
-	maxNotionalLimit := rows[minGuardianNum-1]
-	return maxNotionalLimit, nil
+	return rows, nil
 }
 
 // EnqueuedVaaQuery respresent a query for enqueuedVaa queries.
@@ -1772,3 +1789,75 @@ func (r *Repository) GetGovernorVaas(ctx context.Context) ([]GovernorVaaDoc, err
 	}
 	return result, nil
 }
+
+// FindGovernorVaa get a vaa tracked by the governor by id.
+func (r *Repository) FindGovernorVaa(ctx context.Context, id string) (*GovernorVaaDoc, error) {
+	// left outer join on the `vaas` collection
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "_id", Value: id}}}},
+		{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: "vaas"},
+			{Key: "localField", Value: "_id"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "pipeline", Value: bson.A{bson.M{"$project": bson.M{"_id": 1}}}},
+			{Key: "as", Value: "vaas"},
+		}}},
+	}
+
+	cur, err := r.collections.governorVaas.Aggregate(ctx, pipeline)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute aggregate command to get governor vaa",
+			zap.Error(err), zap.String("id", id), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+
+	var result []GovernorVaaDoc
+	err = cur.All(ctx, &result)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed decoding cursor to []GovernorVaaDoc",
+			zap.Error(err),
+			zap.String("id", id),
+			zap.String("requestID", requestID),
+		)
+		return nil, errors.WithStack(err)
+	}
+
+	if len(result) == 0 {
+		return nil, errs.ErrNotFound
+	}
+	return &result[0], nil
+}
+
+// NodeGovernorVaaDoc represents a vaa enqueued in the governor of a guardian.
+type NodeGovernorVaaDoc struct {
+	ID          string `bson:"_id"`
+	NodeName    string `bson:"nodeName"`
+	NodeAddress string `bson:"nodeAddress"`
+	VaaID       string `bson:"vaaId"`
+}
+
+// FindNodeGovernorVaas get the guardians that have a vaa enqueued in their governor.
+func (r *Repository) FindNodeGovernorVaas(ctx context.Context, vaaID string) ([]*NodeGovernorVaaDoc, error) {
+	cur, err := r.collections.nodeGovernorVaas.Find(ctx, bson.D{{Key: "vaaId", Value: vaaID}})
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed to execute Find command to get node governor vaas",
+			zap.Error(err), zap.String("vaaId", vaaID), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+
+	var result []*NodeGovernorVaaDoc
+	err = cur.All(ctx, &result)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed decoding cursor to []*NodeGovernorVaaDoc",
+			zap.Error(err),
+			zap.String("vaaId", vaaID),
+			zap.String("requestID", requestID),
+		)
+		return nil, errors.WithStack(err)
+	}
+	return result, nil
+}
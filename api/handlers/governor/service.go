@@ -186,7 +186,7 @@ func (s *Service) GetGovernorLimit(ctx context.Context, p *pagination.Pagination
 func (s *Service) GetAvailNotionByChain(ctx context.Context) ([]*AvailableNotionalByChain, error) {
 	key := availableNotionByChain
 	return cacheable.GetOrLoad(ctx, s.logger, s.cache, 1*time.Minute, key, s.metrics,
-		func() ([]*AvailableNotionalByChain, error) {
+		func(ctx context.Context) ([]*AvailableNotionalByChain, error) {
 			return s.repo.GetAvailNotionByChain(ctx)
 		})
 }
@@ -196,7 +196,7 @@ func (s *Service) GetAvailNotionByChain(ctx context.Context) ([]*AvailableNotion
 func (s *Service) GetTokenList(ctx context.Context) ([]*TokenList, error) {
 	key := tokenList
 	return cacheable.GetOrLoad(ctx, s.logger, s.cache, 1*time.Minute, key, s.metrics,
-		func() ([]*TokenList, error) {
+		func(ctx context.Context) ([]*TokenList, error) {
 			return s.repo.GetTokenList(ctx)
 		})
 
@@ -238,3 +238,69 @@ func (s *Service) GetGovernorVaas(ctx context.Context) ([]GovernorVaaDoc, error)
 	}
 	return result, nil
 }
+
+// GetGovernorVaaStatus get the governor status of an enqueued vaa, with the predicted release time,
+// the guardians that have it enqueued and the projected available notional of the chain.
+func (s *Service) GetGovernorVaaStatus(ctx context.Context, chainID vaa.ChainID, emitter *types.Address, seq string) (*GovernorVaaStatus, error) {
+	id := fmt.Sprintf("%d/%s/%s", chainID, emitter.Hex(), seq)
+	doc, err := s.repo.FindGovernorVaa(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := s.repo.FindNodeGovernorVaas(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	query := QueryNotionalLimit().SetChain(chainID)
+	status, err := s.repo.GetGovernorStatusByChainID(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := s.repo.GetNotionalLimitByChainID(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	// ignore the guardians that do not report the chain.
+	reporting := make([]*MaxNotionalAvailableRecord, 0, len(status))
+	available := make([]uint64, 0, len(status))
+	for _, st := range status {
+		if st.NotionalAvailable != nil {
+			reporting = append(reporting, st)
+			available = append(available, uint64(*st.NotionalAvailable))
+		}
+	}
+	bigTransactionSizes := make([]uint64, 0, len(limits))
+	for _, l := range limits {
+		if l.MaxTrasactionSize != nil {
+			bigTransactionSizes = append(bigTransactionSizes, uint64(*l.MaxTrasactionSize))
+		}
+	}
+	availableNotional, _ := quorumValue(available)
+	bigTransactionSize, _ := quorumValue(bigTransactionSizes)
+
+	vaaStatus := "pending"
+	if len(doc.Vaas) > 0 {
+		vaaStatus = "issued"
+	}
+	now := time.Now()
+	guardians := guardianVaaStatus(doc, reporting, nodes)
+	releaseTime, released := predictReleaseTime(now, guardians)
+	return &GovernorVaaStatus{
+		VaaID:                doc.ID,
+		ChainID:              doc.ChainID,
+		EmitterAddress:       doc.EmitterAddress,
+		Sequence:             doc.Sequence,
+		TxHash:               doc.TxHash,
+		Amount:               uint64(doc.Amount),
+		Status:               vaaStatus,
+		DelayReason:          delayReason(uint64(doc.Amount), bigTransactionSize),
+		PredictedReleaseTime: releaseTime,
+		Quorum:               minGuardianNum,
+		Released:             released,
+		Guardians:            guardians,
+		AvailableNotional:    availableNotional,
+		BigTransactionSize:   bigTransactionSize,
+		ProjectedNotional:    projectNotional(now, reporting, bigTransactionSize),
+	}, nil
+}
@@ -37,6 +37,16 @@ func (gs GuardianSet) GetLatest() common.GuardianSet {
 	return gs.GstByIndex[len(gs.GstByIndex)-1]
 }
 
+// GetByIndex get the guardianset with the given index.
+func (gs GuardianSet) GetByIndex(index uint32) (common.GuardianSet, bool) {
+	for _, g := range gs.GstByIndex {
+		if g.Index == index {
+			return g, true
+		}
+	}
+	return common.GuardianSet{}, false
+}
+
 func getTestnetGuardianSet() GuardianSet {
 	gstByIndex, expirationTimeByIndex := domain.GetTestnetGuardianSet()
 	return GuardianSet{
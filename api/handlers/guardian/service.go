@@ -38,7 +38,7 @@ func NewService(repo *repository.GuardianSetRepository, p2pNetwork string, cache
 
 func (s *Service) GetGuardianSet(ctx context.Context) (*GuardianSet, error) {
 	return cacheable.GetOrLoad(ctx, s.logger, s.cache, 1*time.Minute, currentGuardianSetKey, s.metrics,
-		func() (*GuardianSet, error) {
+		func(ctx context.Context) (*GuardianSet, error) {
 			return s.getGuardianSet(ctx)
 		})
 }
@@ -57,8 +57,9 @@ func (s *Service) getGuardianSet(ctx context.Context) (*GuardianSet, error) {
 		s.logger.Error("guardian set not fetched from chain yet")
 		return gs, nil
 	}
+	// sort by index so that the guardian sets and their expiration times are built in the same order.
 	sort.Slice(docs, func(i, j int) bool {
-		return docs[i].GuardianSetIndex > docs[j].GuardianSetIndex
+		return docs[i].GuardianSetIndex < docs[j].GuardianSetIndex
 	})
 	var gstByIndex []common.GuardianSet
 	var expirationTimeByIndex []time.Time
@@ -80,12 +81,6 @@ func (s *Service) getGuardianSet(ctx context.Context) (*GuardianSet, error) {
 		}
 		expirationTimeByIndex = append(expirationTimeByIndex, expirationTime)
 	}
-	sort.Slice(gstByIndex, func(i, j int) bool {
-		return gstByIndex[i].Index < gstByIndex[j].Index
-	})
-	sort.Slice(expirationTimeByIndex, func(i, j int) bool {
-		return gstByIndex[i].Index < gstByIndex[j].Index
-	})
 	return &GuardianSet{
 		GstByIndex:            gstByIndex,
 		ExpirationTimeByIndex: expirationTimeByIndex,
@@ -0,0 +1,149 @@
+package heartbeats
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/zap"
+)
+
+// historyBucketMinutes is the size of the time buckets used to compute uptime and height lag.
+// A guardian is considered online in a bucket when at least one heartbeat was sampled in it.
+const historyBucketMinutes = 5
+
+// HistoryBucket is the size of the time buckets of the heartbeat history queries.
+const HistoryBucket = historyBucketMinutes * time.Minute
+
+// guardianBucketsDoc is the number of buckets with heartbeats of a guardian in a time range.
+type guardianBucketsDoc struct {
+	GuardianAddr  string `bson:"_id"`
+	NodeName      string `bson:"nodeName"`
+	Version       string `bson:"version"`
+	OnlineBuckets int64  `bson:"onlineBuckets"`
+	Restarts      int64  `bson:"restarts"`
+}
+
+// guardianHeightDoc is the highest block height reported by a guardian for a chain in a bucket.
+type guardianHeightDoc struct {
+	Bucket       time.Time `bson:"bucket"`
+	GuardianAddr string    `bson:"guardianAddr"`
+	NodeName     string    `bson:"nodeName"`
+	Height       int64     `bson:"height"`
+}
+
+// GuardianEventDoc is a restart or version change of a guardian.
+type GuardianEventDoc struct {
+	Timestamp     time.Time `bson:"timestamp" json:"timestamp"`
+	GuardianAddr  string    `bson:"guardianAddr" json:"guardianAddr"`
+	NodeName      string    `bson:"nodeName" json:"nodeName"`
+	Event         string    `bson:"event" json:"event"`
+	Version       string    `bson:"version" json:"version"`
+	BootTimestamp time.Time `bson:"bootTimestamp" json:"bootTimestamp"`
+}
+
+func bucketExpr(field string) bson.M {
+	return bson.M{"$dateTrunc": bson.M{"date": field, "unit": "minute", "binSize": historyBucketMinutes}}
+}
+
+// findGuardianBuckets returns, by guardian, the number of buckets with heartbeats in a time range.
+func (r *Repository) findGuardianBuckets(ctx context.Context, guardianAddrs []string, from, to time.Time) ([]guardianBucketsDoc, error) {
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{
+			"guardianAddr": bson.M{"$in": guardianAddrs},
+			"timestamp":    bson.M{"$gte": from, "$lt": to},
+		}},
+		bson.M{"$sort": bson.M{"timestamp": 1}},
+		bson.M{"$group": bson.M{
+			"_id": bson.M{
+				"guardianAddr": "$guardianAddr",
+				"bucket":       bucketExpr("$timestamp"),
+			},
+			"nodeName": bson.M{"$last": "$nodeName"},
+			"version":  bson.M{"$last": "$version"},
+			"restarts": bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$event", "boot"}}, 1, 0}}},
+		}},
+		bson.M{"$sort": bson.M{"_id.bucket": 1}},
+		bson.M{"$group": bson.M{
+			"_id":           "$_id.guardianAddr",
+			"nodeName":      bson.M{"$last": "$nodeName"},
+			"version":       bson.M{"$last": "$version"},
+			"onlineBuckets": bson.M{"$sum": 1},
+			"restarts":      bson.M{"$sum": "$restarts"},
+		}},
+	}
+	var docs []guardianBucketsDoc
+	if err := r.aggregateHistory(ctx, pipeline, &docs); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// findGuardianHeights returns the highest block height reported by each guardian for a chain in every bucket of a time range.
+func (r *Repository) findGuardianHeights(ctx context.Context, guardianAddrs []string, chainID uint32, from, to time.Time) ([]guardianHeightDoc, error) {
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{
+			"guardianAddr": bson.M{"$in": guardianAddrs},
+			"timestamp":    bson.M{"$gte": from, "$lt": to},
+			"networks.id":  chainID,
+		}},
+		bson.M{"$unwind": "$networks"},
+		bson.M{"$match": bson.M{"networks.id": chainID, "networks.height": bson.M{"$gt": 0}}},
+		bson.M{"$group": bson.M{
+			"_id": bson.M{
+				"guardianAddr": "$guardianAddr",
+				"bucket":       bucketExpr("$timestamp"),
+			},
+			"nodeName": bson.M{"$last": "$nodeName"},
+			"height":   bson.M{"$max": "$networks.height"},
+		}},
+		bson.M{"$project": bson.M{
+			"_id":          0,
+			"guardianAddr": "$_id.guardianAddr",
+			"bucket":       "$_id.bucket",
+			"nodeName":     1,
+			"height":       1,
+		}},
+		bson.M{"$sort": bson.M{"bucket": 1}},
+	}
+	var docs []guardianHeightDoc
+	if err := r.aggregateHistory(ctx, pipeline, &docs); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// FindGuardianEvents returns the restarts and version changes of a guardian in a time range.
+func (r *Repository) FindGuardianEvents(ctx context.Context, guardianAddr string, from, to time.Time) ([]*GuardianEventDoc, error) {
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{
+			"guardianAddr": guardianAddr,
+			"timestamp":    bson.M{"$gte": from, "$lt": to},
+			"event":        bson.M{"$in": bson.A{"boot", "version"}},
+		}},
+		bson.M{"$sort": bson.M{"timestamp": -1}},
+	}
+	docs := []*GuardianEventDoc{}
+	if err := r.aggregateHistory(ctx, pipeline, &docs); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+func (r *Repository) aggregateHistory(ctx context.Context, pipeline bson.A, result any) error {
+	cur, err := r.collections.heartbeatHistory.Aggregate(ctx, pipeline)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute Aggregate command to get heartbeat history",
+			zap.Error(err), zap.String("requestID", requestID))
+		return errors.WithStack(err)
+	}
+	if err := cur.All(ctx, result); err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed decoding cursor of heartbeat history", zap.Error(err), zap.String("requestID", requestID))
+		return errors.WithStack(err)
+	}
+	return nil
+}
@@ -23,4 +23,6 @@ type HeartbeatNetwork struct {
 	Height          int64  `bson:"height" json:"height"`
 	ContractAddress string `bson:"contractaddress" json:"contractAddress"`
 	ErrorCount      int64  `bson:"errorcount" json:"errorCount"`
+	SafeHeight      int64  `bson:"safeheight" json:"safeHeight"`
+	FinalizedHeight int64  `bson:"finalizedheight" json:"finalizedHeight"`
 }
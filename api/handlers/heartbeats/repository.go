@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
@@ -15,15 +16,22 @@ type Repository struct {
 	db          *mongo.Database
 	logger      *zap.Logger
 	collections struct {
-		heartbeats *mongo.Collection
+		heartbeats       *mongo.Collection
+		heartbeatHistory *mongo.Collection
 	}
 }
 
 // NewRepository create a new Repository.
 func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
 	return &Repository{db: db,
-		logger:      logger.With(zap.String("module", "HeartbeatsRepository")),
-		collections: struct{ heartbeats *mongo.Collection }{heartbeats: db.Collection("heartbeats")},
+		logger: logger.With(zap.String("module", "HeartbeatsRepository")),
+		collections: struct {
+			heartbeats       *mongo.Collection
+			heartbeatHistory *mongo.Collection
+		}{
+			heartbeats:       db.Collection("heartbeats"),
+			heartbeatHistory: db.Collection(repository.HeartbeatHistory),
+		},
 	}
 }
 
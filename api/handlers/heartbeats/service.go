@@ -2,7 +2,9 @@ package heartbeats
 
 import (
 	"context"
+	"time"
 
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
 
@@ -21,3 +23,125 @@ func NewService(dao *Repository, logger *zap.Logger) *Service {
 func (s *Service) GetHeartbeatsByIds(ctx context.Context, heartbeatsIDs []string) ([]*HeartbeatDoc, error) {
 	return s.repo.FindByIDs(ctx, heartbeatsIDs)
 }
+
+// GuardianUptime is the uptime of a guardian in a time range.
+type GuardianUptime struct {
+	GuardianAddr  string  `json:"guardianAddr"`
+	NodeName      string  `json:"nodeName"`
+	Version       string  `json:"version"`
+	Uptime        float64 `json:"uptime"`
+	OnlineBuckets int64   `json:"onlineBuckets"`
+	TotalBuckets  int64   `json:"totalBuckets"`
+	Restarts      int64   `json:"restarts"`
+}
+
+// GuardianHeightLag is the lag of the block height reported by a guardian for a chain
+// with respect to the highest height reported by any guardian at the same time.
+type GuardianHeightLag struct {
+	GuardianAddr string  `json:"guardianAddr"`
+	NodeName     string  `json:"nodeName"`
+	CurrentLag   int64   `json:"currentLag"`
+	AverageLag   float64 `json:"averageLag"`
+	MaxLag       int64   `json:"maxLag"`
+	Samples      int64   `json:"samples"`
+}
+
+// GetGuardiansUptime returns the uptime of the guardians in a time range.
+// Guardians without heartbeats in the time range have an uptime of zero.
+func (s *Service) GetGuardiansUptime(ctx context.Context, guardianAddrs []string, from, to time.Time) ([]*GuardianUptime, error) {
+	docs, err := s.repo.findGuardianBuckets(ctx, guardianAddrs, from, to)
+	if err != nil {
+		return nil, err
+	}
+	docByGuardian := make(map[string]guardianBucketsDoc, len(docs))
+	for _, doc := range docs {
+		docByGuardian[doc.GuardianAddr] = doc
+	}
+
+	totalBuckets := countBuckets(from, to)
+	result := make([]*GuardianUptime, 0, len(guardianAddrs))
+	for _, addr := range guardianAddrs {
+		doc := docByGuardian[addr]
+		uptime := float64(doc.OnlineBuckets) / float64(totalBuckets)
+		if uptime > 1 {
+			uptime = 1
+		}
+		result = append(result, &GuardianUptime{
+			GuardianAddr:  addr,
+			NodeName:      doc.NodeName,
+			Version:       doc.Version,
+			Uptime:        uptime,
+			OnlineBuckets: doc.OnlineBuckets,
+			TotalBuckets:  totalBuckets,
+			Restarts:      doc.Restarts,
+		})
+	}
+	return result, nil
+}
+
+// GetGuardiansHeightLag returns the height lag of the guardians for a chain in a time range.
+func (s *Service) GetGuardiansHeightLag(ctx context.Context, guardianAddrs []string, chainID sdk.ChainID, from, to time.Time) ([]*GuardianHeightLag, error) {
+	docs, err := s.repo.findGuardianHeights(ctx, guardianAddrs, uint32(chainID), from, to)
+	if err != nil {
+		return nil, err
+	}
+	return computeHeightLag(guardianAddrs, docs), nil
+}
+
+// GetGuardianEvents returns the restarts and version changes of a guardian in a time range.
+func (s *Service) GetGuardianEvents(ctx context.Context, guardianAddr string, from, to time.Time) ([]*GuardianEventDoc, error) {
+	return s.repo.FindGuardianEvents(ctx, guardianAddr, from, to)
+}
+
+// computeHeightLag computes the lag of every guardian in each bucket against the highest
+// height of the bucket. The docs must be sorted by bucket.
+func computeHeightLag(guardianAddrs []string, docs []guardianHeightDoc) []*GuardianHeightLag {
+	maxHeightByBucket := make(map[time.Time]int64)
+	for _, doc := range docs {
+		if doc.Height > maxHeightByBucket[doc.Bucket] {
+			maxHeightByBucket[doc.Bucket] = doc.Height
+		}
+	}
+
+	lagByGuardian := make(map[string]*GuardianHeightLag, len(guardianAddrs))
+	totalLag := make(map[string]int64, len(guardianAddrs))
+	for _, addr := range guardianAddrs {
+		lagByGuardian[addr] = &GuardianHeightLag{GuardianAddr: addr}
+	}
+	for _, doc := range docs {
+		lag, ok := lagByGuardian[doc.GuardianAddr]
+		if !ok {
+			continue
+		}
+		current := maxHeightByBucket[doc.Bucket] - doc.Height
+		lag.NodeName = doc.NodeName
+		lag.CurrentLag = current
+		lag.Samples++
+		if current > lag.MaxLag {
+			lag.MaxLag = current
+		}
+		totalLag[doc.GuardianAddr] += current
+	}
+
+	result := make([]*GuardianHeightLag, 0, len(guardianAddrs))
+	for _, addr := range guardianAddrs {
+		lag := lagByGuardian[addr]
+		if lag.Samples > 0 {
+			lag.AverageLag = float64(totalLag[addr]) / float64(lag.Samples)
+		}
+		result = append(result, lag)
+	}
+	return result
+}
+
+// countBuckets returns the number of history buckets in a time range.
+func countBuckets(from, to time.Time) int64 {
+	buckets := int64(to.Sub(from) / HistoryBucket)
+	if to.Sub(from)%HistoryBucket != 0 {
+		buckets++
+	}
+	if buckets < 1 {
+		return 1
+	}
+	return buckets
+}
@@ -0,0 +1,43 @@
+package heartbeats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeHeightLag(t *testing.T) {
+	b1 := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	b2 := b1.Add(HistoryBucket)
+	docs := []guardianHeightDoc{
+		{Bucket: b1, GuardianAddr: "g1", NodeName: "node1", Height: 100},
+		{Bucket: b1, GuardianAddr: "g2", NodeName: "node2", Height: 90},
+		{Bucket: b2, GuardianAddr: "g1", NodeName: "node1", Height: 110},
+		{Bucket: b2, GuardianAddr: "g2", NodeName: "node2", Height: 108},
+	}
+
+	lags := computeHeightLag([]string{"g1", "g2", "g3"}, docs)
+	assert.Len(t, lags, 3)
+
+	assert.Equal(t, "node1", lags[0].NodeName)
+	assert.Equal(t, int64(0), lags[0].CurrentLag)
+	assert.Equal(t, int64(0), lags[0].MaxLag)
+
+	assert.Equal(t, "node2", lags[1].NodeName)
+	assert.Equal(t, int64(2), lags[1].CurrentLag)
+	assert.Equal(t, int64(10), lags[1].MaxLag)
+	assert.Equal(t, float64(6), lags[1].AverageLag)
+	assert.Equal(t, int64(2), lags[1].Samples)
+
+	// guardians without heartbeats have no samples.
+	assert.Equal(t, "g3", lags[2].GuardianAddr)
+	assert.Equal(t, int64(0), lags[2].Samples)
+}
+
+func TestCountBuckets(t *testing.T) {
+	from := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, int64(288), countBuckets(from, from.Add(24*time.Hour)))
+	assert.Equal(t, int64(2), countBuckets(from, from.Add(6*time.Minute)))
+	assert.Equal(t, int64(1), countBuckets(from, from))
+}
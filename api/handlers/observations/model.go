@@ -47,3 +47,43 @@ type FindAllParams struct {
 	Pagination *pagination.Pagination
 	TxHash     *types.TxHash
 }
+
+// SigningProgressDoc represents the signing progress of a message, built by fly from the observations.
+type SigningProgressDoc struct {
+	ID                 string      `bson:"_id"`
+	EmitterChain       vaa.ChainID `bson:"emitterChain"`
+	EmitterAddr        string      `bson:"emitterAddr"`
+	Sequence           string      `bson:"sequence"`
+	GuardianSetIndex   uint32      `bson:"guardianSetIndex"`
+	Quorum             int         `bson:"quorum"`
+	Guardians          []string    `bson:"guardians"`
+	FirstObservationAt *time.Time  `bson:"firstObservationAt"`
+	QuorumAt           *time.Time  `bson:"quorumAt"`
+	TimeToQuorumMs     *int64      `bson:"timeToQuorumMs"`
+	VaaAt              *time.Time  `bson:"vaaAt"`
+	UpdatedAt          *time.Time  `bson:"updatedAt"`
+}
+
+// SignedGuardian represents a guardian that signed a message.
+type SignedGuardian struct {
+	Address    string     `json:"address"`
+	ObservedAt *time.Time `json:"observedAt,omitempty"`
+}
+
+// SigningProgress represents the signing progress of a message against the quorum of its guardian set.
+type SigningProgress struct {
+	ID                 string           `json:"id"`
+	EmitterChain       vaa.ChainID      `json:"emitterChain"`
+	EmitterAddr        string           `json:"emitterAddr"`
+	Sequence           uint64           `json:"sequence"`
+	GuardianSetIndex   uint32           `json:"guardianSetIndex"`
+	Quorum             int              `json:"quorum"`
+	Signatures         int              `json:"signatures"`
+	QuorumReached      bool             `json:"quorumReached"`
+	Signed             []SignedGuardian `json:"signed"`
+	Missing            []string         `json:"missing"`
+	FirstObservationAt *time.Time       `json:"firstObservationAt,omitempty"`
+	QuorumAt           *time.Time       `json:"quorumAt,omitempty"`
+	TimeToQuorumMs     *int64           `json:"timeToQuorumMs,omitempty"`
+	VaaAt              *time.Time       `json:"vaaAt,omitempty"`
+}
@@ -4,10 +4,12 @@ package observations
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"github.com/wormhole-foundation/wormhole-explorer/common/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.mongodb.org/mongo-driver/bson"
@@ -21,15 +23,22 @@ type Repository struct {
 	db          *mongo.Database
 	logger      *zap.Logger
 	collections struct {
-		observations *mongo.Collection
+		observations    *mongo.Collection
+		signingProgress *mongo.Collection
 	}
 }
 
 // NewRepository create a new Repository.
 func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
 	return &Repository{db: db,
-		logger:      logger.With(zap.String("module", "ObservationsRepository")),
-		collections: struct{ observations *mongo.Collection }{observations: db.Collection("observations")},
+		logger: logger.With(zap.String("module", "ObservationsRepository")),
+		collections: struct {
+			observations    *mongo.Collection
+			signingProgress *mongo.Collection
+		}{
+			observations:    db.Collection("observations"),
+			signingProgress: db.Collection(repository.SigningProgress),
+		},
 	}
 }
 
@@ -38,9 +47,14 @@ func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
 func (r *Repository) Find(ctx context.Context, q *ObservationQuery) ([]*ObservationDoc, error) {
 
 	// Sort observations in descending timestamp order
-	sort := bson.D{{"indexedAt", -1}}
+	sort := bson.D{{"indexedAt", -1}, {"_id", -1}}
 
-	cur, err := r.collections.observations.Find(ctx, q.toBSON(), options.Find().SetLimit(q.Limit).SetSkip(q.Skip).SetSort(sort))
+	filter := q.toBSON()
+	if q.Cursor != nil {
+		*filter = append(*filter, q.CursorFilter("indexedAt", -1)...)
+	}
+
+	cur, err := r.collections.observations.Find(ctx, filter, options.Find().SetLimit(q.Limit).SetSkip(q.Skip).SetSort(sort))
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed execute Find command to get observations",
@@ -82,6 +96,66 @@ func (r *Repository) FindOne(ctx context.Context, q *ObservationQuery) (*Observa
 	return &obs, err
 }
 
+// FindSigningProgress get the signing progress of a message by its id.
+func (r *Repository) FindSigningProgress(ctx context.Context, id string) (*SigningProgressDoc, error) {
+	var doc SigningProgressDoc
+	err := r.collections.signingProgress.FindOne(ctx, bson.M{"_id": id}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errs.ErrNotFound
+		}
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute FindOne command to get signing progress",
+			zap.Error(err), zap.String("id", id), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+	return &doc, nil
+}
+
+// FindPendingVaas get the signing progress of the messages observed since [from] without a VAA,
+// sorted by the time of the first observation in descending order.
+func (r *Repository) FindPendingVaas(ctx context.Context, from time.Time, p *pagination.Pagination) ([]*SigningProgressDoc, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "vaaAt", Value: bson.M{"$exists": false}},
+			{Key: "firstObservationAt", Value: bson.M{"$gte": from}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "firstObservationAt", Value: -1}, {Key: "_id", Value: -1}}}},
+		// the VAA may have been stored before the first observation was processed.
+		{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: repository.Vaas},
+			{Key: "localField", Value: "_id"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: "vaas"},
+		}}},
+		{{Key: "$match", Value: bson.D{{Key: "vaas", Value: bson.M{"$size": 0}}}}},
+		{{Key: "$project", Value: bson.D{{Key: "vaas", Value: 0}}}},
+		{{Key: "$skip", Value: p.Skip}},
+		{{Key: "$limit", Value: p.Limit}},
+	}
+
+	cur, err := r.collections.signingProgress.Aggregate(ctx, pipeline)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute Aggregate command to get pending vaas",
+			zap.Error(err), zap.Time("from", from), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+
+	var docs []*SigningProgressDoc
+	err = cur.All(ctx, &docs)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed decoding cursor to []*SigningProgressDoc", zap.Error(err),
+			zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+	if docs == nil {
+		docs = make([]*SigningProgressDoc, 0)
+	}
+	return docs, nil
+}
+
 // ObservationQuery respresent a query for the observation mongodb document.
 type ObservationQuery struct {
 	pagination.Pagination
@@ -3,7 +3,15 @@ package observations
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/pkg/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/guardian"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/common/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -85,3 +93,131 @@ func (s *Service) FindOne(
 
 	return s.repo.FindOne(ctx, query)
 }
+
+// GetSigningProgress get the guardians that signed a message and the ones that are missing
+// to reach the quorum of its guardian set.
+func (s *Service) GetSigningProgress(
+	ctx context.Context,
+	chainID vaa.ChainID,
+	emitter *types.Address,
+	seq uint64,
+	gs *guardian.GuardianSet,
+) (*SigningProgress, error) {
+
+	id := fmt.Sprintf("%d/%s/%d", chainID, emitter.Hex(), seq)
+	doc, err := s.repo.FindSigningProgress(ctx, id)
+	if err != nil && !errors.Is(err, errs.ErrNotFound) {
+		return nil, err
+	}
+
+	// the observations are used to get the time when each guardian signed the message.
+	query := Query().
+		SetChain(chainID).
+		SetEmitter(emitter.Hex()).
+		SetSequence(strconv.FormatUint(seq, 10)).
+		SetPagination(pagination.Default().SetLimit(1000))
+	obs, err := s.repo.Find(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	// messages observed before the signing progress was tracked only have observations.
+	if doc == nil {
+		if len(obs) == 0 {
+			return nil, errs.ErrNotFound
+		}
+		doc = &SigningProgressDoc{
+			ID:               id,
+			EmitterChain:     chainID,
+			EmitterAddr:      emitter.Hex(),
+			Sequence:         strconv.FormatUint(seq, 10),
+			GuardianSetIndex: uint32(len(gs.GstByIndex) - 1),
+		}
+	}
+
+	return newSigningProgress(doc, obs, guardianAddrs(gs, doc.GuardianSetIndex)), nil
+}
+
+// FindPendingVaas get the signing progress of the messages observed since [from] that do not have a VAA yet.
+func (s *Service) FindPendingVaas(
+	ctx context.Context,
+	from time.Time,
+	gs *guardian.GuardianSet,
+	p *pagination.Pagination,
+) ([]*SigningProgress, error) {
+
+	docs, err := s.repo.FindPendingVaas(ctx, from, p)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]*SigningProgress, 0, len(docs))
+	for _, doc := range docs {
+		pending = append(pending, newSigningProgress(doc, nil, guardianAddrs(gs, doc.GuardianSetIndex)))
+	}
+	return pending, nil
+}
+
+// guardianAddrs returns the addresses of the guardians of a guardian set, or the ones
+// of the latest guardian set if the index is unknown.
+func guardianAddrs(gs *guardian.GuardianSet, index uint32) []string {
+	if int(index) < len(gs.GstByIndex) {
+		return gs.GstByIndex[index].KeysAsHexStrings()
+	}
+	latest := gs.GetLatest()
+	return latest.KeysAsHexStrings()
+}
+
+// newSigningProgress builds the signing progress of a message from the guardians of the
+// document and the observations, against the guardians of its guardian set.
+func newSigningProgress(doc *SigningProgressDoc, obs []*ObservationDoc, guardianAddrs []string) *SigningProgress {
+
+	// guardian addresses are compared case-insensitively, observations store the checksummed address.
+	observedAt := make(map[string]*time.Time)
+	for _, addr := range doc.Guardians {
+		observedAt[strings.ToLower(addr)] = nil
+	}
+	for _, o := range obs {
+		addr := strings.ToLower(o.GuardianAddr)
+		t, ok := observedAt[addr]
+		if !ok || t == nil || (o.IndexedAt != nil && o.IndexedAt.Before(*t)) {
+			observedAt[addr] = o.IndexedAt
+		}
+	}
+
+	signed := make([]SignedGuardian, 0, len(observedAt))
+	missing := make([]string, 0)
+	for _, addr := range guardianAddrs {
+		t, ok := observedAt[strings.ToLower(addr)]
+		if !ok {
+			missing = append(missing, addr)
+			continue
+		}
+		signed = append(signed, SignedGuardian{Address: addr, ObservedAt: t})
+	}
+	sort.SliceStable(signed, func(i, j int) bool {
+		if signed[i].ObservedAt == nil || signed[j].ObservedAt == nil {
+			return signed[j].ObservedAt == nil && signed[i].ObservedAt != nil
+		}
+		return signed[i].ObservedAt.Before(*signed[j].ObservedAt)
+	})
+
+	quorum := vaa.CalculateQuorum(len(guardianAddrs))
+	sequence, _ := strconv.ParseUint(doc.Sequence, 10, 64)
+	return &SigningProgress{
+		ID:                 doc.ID,
+		EmitterChain:       doc.EmitterChain,
+		EmitterAddr:        doc.EmitterAddr,
+		Sequence:           sequence,
+		GuardianSetIndex:   doc.GuardianSetIndex,
+		Quorum:             quorum,
+		Signatures:         len(signed),
+		QuorumReached:      len(signed) >= quorum,
+		Signed:             signed,
+		Missing:            missing,
+		FirstObservationAt: doc.FirstObservationAt,
+		QuorumAt:           doc.QuorumAt,
+		TimeToQuorumMs:     doc.TimeToQuorumMs,
+		VaaAt:              doc.VaaAt,
+	}
+}
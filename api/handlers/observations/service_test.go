@@ -0,0 +1,39 @@
+package observations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSigningProgress(t *testing.T) {
+	t1 := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Second)
+	doc := &SigningProgressDoc{
+		ID:               "2/000000000000000000000000abcdef/7",
+		EmitterChain:     2,
+		EmitterAddr:      "000000000000000000000000abcdef",
+		Sequence:         "7",
+		GuardianSetIndex: 4,
+		Guardians:        []string{"0xAA", "0xBB", "0xCC"},
+	}
+	obs := []*ObservationDoc{
+		{GuardianAddr: "0xBB", IndexedAt: &t2},
+		{GuardianAddr: "0xaa", IndexedAt: &t1},
+	}
+
+	progress := newSigningProgress(doc, obs, []string{"0xAA", "0xBB", "0xCC", "0xDD", "0xEE"})
+	assert.Equal(t, uint64(7), progress.Sequence)
+	assert.Equal(t, 4, progress.Quorum)
+	assert.Equal(t, 3, progress.Signatures)
+	assert.False(t, progress.QuorumReached)
+
+	// signed guardians are sorted by observation time, the ones without observations go last.
+	assert.Equal(t, []SignedGuardian{
+		{Address: "0xAA", ObservedAt: &t1},
+		{Address: "0xBB", ObservedAt: &t2},
+		{Address: "0xCC"},
+	}, progress.Signed)
+	assert.Equal(t, []string{"0xDD", "0xEE"}, progress.Missing)
+}
@@ -3,6 +3,7 @@ package operations
 import (
 	"time"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/lifecycle"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
@@ -18,6 +19,9 @@ type OperationDto struct {
 	DestinationTx          *DestinationTx          `bson:"destinationTx" json:"destinationTx"`
 	Payload                map[string]any          `bson:"payload"`
 	StandardizedProperties *StandardizedProperties `bson:"standardizedProperties"`
+	Lifecycle              *lifecycle.Lifecycle    `bson:"lifecycle"`
+	// Timestamp is the value the operations are sorted by, used to build the keyset pagination cursor.
+	Timestamp *time.Time `bson:"timestamp"`
 }
 
 // StandardizedProperties represents the standardized properties of a operation.
@@ -9,6 +9,8 @@ import (
 
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/common/lifecycle"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -24,6 +26,7 @@ type Repository struct {
 		vaas               *mongo.Collection
 		parsedVaa          *mongo.Collection
 		globalTransactions *mongo.Collection
+		operationLifecycle *mongo.Collection
 	}
 }
 
@@ -35,10 +38,12 @@ func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
 			vaas               *mongo.Collection
 			parsedVaa          *mongo.Collection
 			globalTransactions *mongo.Collection
+			operationLifecycle *mongo.Collection
 		}{
 			vaas:               db.Collection("vaas"),
 			parsedVaa:          db.Collection("parsedVaa"),
 			globalTransactions: db.Collection("globalTransactions"),
+			operationLifecycle: db.Collection(repository.OperationLifecycle),
 		},
 	}
 }
@@ -63,6 +68,9 @@ func (r *Repository) FindById(ctx context.Context, id string) (*OperationDto, er
 	// lookup parsedVaa
 	pipeline = append(pipeline, bson.D{{Key: "$lookup", Value: bson.D{{Key: "from", Value: "parsedVaa"}, {Key: "localField", Value: "_id"}, {Key: "foreignField", Value: "_id"}, {Key: "as", Value: "parsedVaa"}}}})
 
+	// lookup operationLifecycle
+	pipeline = append(pipeline, bson.D{{Key: "$lookup", Value: bson.D{{Key: "from", Value: repository.OperationLifecycle}, {Key: "localField", Value: "_id"}, {Key: "foreignField", Value: "_id"}, {Key: "as", Value: "operationLifecycle"}}}})
+
 	// add fields
 	pipeline = append(pipeline, bson.D{{Key: "$addFields", Value: bson.D{
 		{Key: "payload", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$parsedVaa.parsedPayload", 0}}}},
@@ -71,10 +79,11 @@ func (r *Repository) FindById(ctx context.Context, id string) (*OperationDto, er
 		{Key: "symbol", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$transferPrices.symbol", 0}}}},
 		{Key: "usdAmount", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$transferPrices.usdAmount", 0}}}},
 		{Key: "tokenAmount", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$transferPrices.tokenAmount", 0}}}},
+		{Key: "lifecycle", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$operationLifecycle", 0}}}},
 	}}})
 
 	// unset
-	pipeline = append(pipeline, bson.D{{Key: "$unset", Value: bson.A{"transferPrices", "parsedVaa"}}})
+	pipeline = append(pipeline, bson.D{{Key: "$unset", Value: bson.A{"transferPrices", "parsedVaa", "operationLifecycle"}}})
 
 	// Execute the aggregation pipeline
 	cur, err := r.collections.globalTransactions.Aggregate(ctx, pipeline)
@@ -117,6 +126,7 @@ type OperationQuery struct {
 	AppIDs         []string
 	ExclusiveAppId bool
 	PayloadType    []int
+	Status         lifecycle.Status
 }
 
 func buildQueryOperationsByChain(sourceChainIDs, targetChainIDs []vaa.ChainID) bson.D {
@@ -274,6 +284,11 @@ func BuildPipelineSearchFromParsedVaa(query OperationQuery) mongo.Pipeline {
 		bson.E{Key: "_id", Value: -1},
 	}}})
 
+	// resume from the keyset pagination cursor
+	if query.Pagination.Cursor != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: query.Pagination.CursorFilter("timestamp", query.Pagination.GetSortInt())}})
+	}
+
 	// Skip initial results
 	pipeline = append(pipeline, bson.D{{Key: "$skip", Value: query.Pagination.Skip}})
 
@@ -306,7 +321,7 @@ func BuildPipelineSearchFromParsedVaa(query OperationQuery) mongo.Pipeline {
 // FindAll returns all operations filtered by q.
 func (r *Repository) FindAll(ctx context.Context, query OperationQuery) ([]*OperationDto, error) {
 
-	var pipeline mongo.Pipeline
+	var match []bson.D
 
 	// filter operations by address or txHash
 	if query.Address != "" {
@@ -318,16 +333,151 @@ func (r *Repository) FindAll(ctx context.Context, query OperationQuery) ([]*Oper
 		if len(ids) == 0 {
 			return []*OperationDto{}, nil
 		}
-		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}}}})
+		match = append(match, bson.D{{Key: "$match", Value: bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}}}})
 	} else if query.TxHash != "" {
 		// match operation by txHash (source tx and destination tx)
 		matchByTxHash := r.matchOperationByTxHash(ctx, query.TxHash)
-		pipeline = append(pipeline, matchByTxHash)
+		match = append(match, matchByTxHash)
+	}
+
+	// sort and paginate
+	pipeline := buildPipelineSortByTimestamp(match, query.Pagination)
+
+	// lookup vaas
+	pipeline = append(pipeline, bson.D{{Key: "$lookup", Value: bson.D{{Key: "from", Value: "vaas"}, {Key: "localField", Value: "_id"}, {Key: "foreignField", Value: "_id"}, {Key: "as", Value: "vaas"}}}})
+
+	// lookup globalTransactions
+	pipeline = append(pipeline, bson.D{{Key: "$lookup", Value: bson.D{{Key: "from", Value: "globalTransactions"}, {Key: "localField", Value: "_id"}, {Key: "foreignField", Value: "_id"}, {Key: "as", Value: "globalTransactions"}}}})
+
+	// lookup transferPrices
+	pipeline = append(pipeline, bson.D{{Key: "$lookup", Value: bson.D{{Key: "from", Value: "transferPrices"}, {Key: "localField", Value: "_id"}, {Key: "foreignField", Value: "_id"}, {Key: "as", Value: "transferPrices"}}}})
+
+	// lookup parsedVaa
+	pipeline = append(pipeline, bson.D{{Key: "$lookup", Value: bson.D{{Key: "from", Value: "parsedVaa"}, {Key: "localField", Value: "_id"}, {Key: "foreignField", Value: "_id"}, {Key: "as", Value: "parsedVaa"}}}})
+
+	// add fields
+	pipeline = append(pipeline, bson.D{{Key: "$addFields", Value: bson.D{
+		{Key: "payload", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$parsedVaa.parsedPayload", 0}}}},
+		{Key: "vaa", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$vaas", 0}}}},
+		{Key: "standardizedProperties", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$parsedVaa.standardizedProperties", 0}}}},
+		{Key: "symbol", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$transferPrices.symbol", 0}}}},
+		{Key: "usdAmount", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$transferPrices.usdAmount", 0}}}},
+		{Key: "tokenAmount", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$transferPrices.tokenAmount", 0}}}},
+	}}})
+
+	// unset
+	pipeline = append(pipeline, bson.D{{Key: "$unset", Value: bson.A{"transferPrices", "parsedVaa"}}})
+
+	// Execute the aggregation pipeline
+	cur, err := r.collections.globalTransactions.Aggregate(ctx, pipeline)
+	if err != nil {
+		r.logger.Error("failed execute aggregation pipeline", zap.Error(err))
+		return nil, err
+	}
+
+	// Read results from cursor
+	var operations []*OperationDto
+	err = cur.All(ctx, &operations)
+	if err != nil {
+		r.logger.Error("failed to decode cursor", zap.Error(err))
+		return nil, err
+	}
+
+	return operations, nil
+}
+
+// buildPipelineSortByTimestamp returns the pipeline that sorts and paginates the globalTransactions that pass the
+// match stages by the timestamp of their origin tx, or by the timestamp of their VAA when the origin tx is not known.
+//
+// The operations with an origin tx are sorted using the index by originTx.timestamp. When the match stages
+// bound the operations, by id or by tx hash, they are merged with the operations without origin tx, sorted in
+// memory by the timestamp of their VAA. The unbounded pages only return the operations with an origin tx,
+// because the operations without origin tx can't be sorted using an index.
+func buildPipelineSortByTimestamp(match []bson.D, p pagination.Pagination) mongo.Pipeline {
+	sort := bson.D{{Key: "$sort", Value: bson.D{
+		bson.E{Key: "timestamp", Value: p.GetSortInt()},
+		bson.E{Key: "_id", Value: -1},
+	}}}
+	limit := bson.D{{Key: "$limit", Value: p.Skip + p.Limit}}
+
+	// operations with origin tx, sorted by its timestamp.
+	var pipeline mongo.Pipeline
+	pipeline = append(pipeline, match...)
+	pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.D{{Key: "originTx.timestamp", Value: bson.D{{Key: "$ne", Value: nil}}}}}})
+	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{
+		bson.E{Key: "originTx.timestamp", Value: p.GetSortInt()},
+		bson.E{Key: "_id", Value: -1},
+	}}})
+	if p.Cursor != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: p.CursorFilter("originTx.timestamp", p.GetSortInt())}})
+	}
+	pipeline = append(pipeline, limit)
+	pipeline = append(pipeline, bson.D{{Key: "$addFields", Value: bson.D{{Key: "timestamp", Value: "$originTx.timestamp"}}}})
+
+	// operations without origin tx, sorted by the timestamp of their VAA.
+	if len(match) > 0 {
+		pipeline = append(pipeline, buildStageUnionWithoutOriginTx(match, p))
+	}
+
+	// merge both sorted by timestamp
+	pipeline = append(pipeline, sort)
+
+	// Skip initial results
+	pipeline = append(pipeline, bson.D{{Key: "$skip", Value: p.Skip}})
+
+	// Limit size of results
+	pipeline = append(pipeline, bson.D{{Key: "$limit", Value: p.Limit}})
+
+	return pipeline
+}
+
+// buildStageUnionWithoutOriginTx returns the stage that adds the globalTransactions without origin tx that pass
+// the match stages, sorted and paginated by the timestamp of their VAA.
+func buildStageUnionWithoutOriginTx(match []bson.D, p pagination.Pagination) bson.D {
+	sort := bson.D{{Key: "$sort", Value: bson.D{
+		bson.E{Key: "timestamp", Value: p.GetSortInt()},
+		bson.E{Key: "_id", Value: -1},
+	}}}
+	withoutOriginTx := bson.A{}
+	for _, stage := range match {
+		withoutOriginTx = append(withoutOriginTx, stage)
+	}
+	withoutOriginTx = append(withoutOriginTx,
+		bson.D{{Key: "$match", Value: bson.D{{Key: "originTx.timestamp", Value: nil}}}},
+		bson.D{{Key: "$lookup", Value: bson.D{{Key: "from", Value: "vaas"}, {Key: "localField", Value: "_id"}, {Key: "foreignField", Value: "_id"}, {Key: "as", Value: "vaas"}}}},
+		bson.D{{Key: "$addFields", Value: bson.D{
+			{Key: "timestamp", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$originTx.timestamp", bson.D{{Key: "$arrayElemAt", Value: bson.A{"$vaas.timestamp", 0}}}}}}},
+		}}},
+		bson.D{{Key: "$unset", Value: bson.A{"vaas"}}},
+		sort,
+	)
+	if p.Cursor != nil {
+		withoutOriginTx = append(withoutOriginTx, bson.D{{Key: "$match", Value: p.CursorFilter("timestamp", p.GetSortInt())}})
+	}
+	withoutOriginTx = append(withoutOriginTx, bson.D{{Key: "$limit", Value: p.Skip + p.Limit}})
+	return bson.D{{Key: "$unionWith", Value: bson.D{{Key: "coll", Value: "globalTransactions"}, {Key: "pipeline", Value: withoutOriginTx}}}}
+}
+
+// FindByLifecycleStatus returns the operations in the given lifecycle status, sorted by the time they reached the status.
+//
+// The time a status is reached never changes, unlike the last update of the lifecycle, so the keyset
+// pagination doesn't skip or repeat operations updated between two pages.
+func (r *Repository) FindByLifecycleStatus(ctx context.Context, query OperationQuery) ([]*OperationDto, error) {
+
+	var pipeline mongo.Pipeline
+	timeField := query.Status.TimeField()
+
+	// filter operations by lifecycle status
+	pipeline = append(pipeline, bson.D{{Key: "$match", Value: query.Status.Filter()}})
+
+	// resume from the keyset pagination cursor
+	if query.Pagination.Cursor != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: query.Pagination.CursorFilter(timeField, query.Pagination.GetSortInt())}})
 	}
 
 	// sort
 	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{
-		bson.E{Key: "originTx.timestamp", Value: query.Pagination.GetSortInt()},
+		bson.E{Key: timeField, Value: query.Pagination.GetSortInt()},
 		bson.E{Key: "_id", Value: -1},
 	}}})
 
@@ -337,6 +487,12 @@ func (r *Repository) FindAll(ctx context.Context, query OperationQuery) ([]*Oper
 	// Limit size of results
 	pipeline = append(pipeline, bson.D{{Key: "$limit", Value: query.Pagination.Limit}})
 
+	// keep the lifecycle document before adding the other collections
+	pipeline = append(pipeline, bson.D{{Key: "$project", Value: bson.D{
+		{Key: "lifecycle", Value: "$$ROOT"},
+		{Key: "timestamp", Value: "$" + timeField},
+	}}})
+
 	// lookup vaas
 	pipeline = append(pipeline, bson.D{{Key: "$lookup", Value: bson.D{{Key: "from", Value: "vaas"}, {Key: "localField", Value: "_id"}, {Key: "foreignField", Value: "_id"}, {Key: "as", Value: "vaas"}}}})
 
@@ -357,13 +513,15 @@ func (r *Repository) FindAll(ctx context.Context, query OperationQuery) ([]*Oper
 		{Key: "symbol", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$transferPrices.symbol", 0}}}},
 		{Key: "usdAmount", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$transferPrices.usdAmount", 0}}}},
 		{Key: "tokenAmount", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$transferPrices.tokenAmount", 0}}}},
+		{Key: "originTx", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$globalTransactions.originTx", 0}}}},
+		{Key: "destinationTx", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$globalTransactions.destinationTx", 0}}}},
 	}}})
 
 	// unset
-	pipeline = append(pipeline, bson.D{{Key: "$unset", Value: bson.A{"transferPrices", "parsedVaa"}}})
+	pipeline = append(pipeline, bson.D{{Key: "$unset", Value: bson.A{"transferPrices", "parsedVaa", "globalTransactions"}}})
 
 	// Execute the aggregation pipeline
-	cur, err := r.collections.globalTransactions.Aggregate(ctx, pipeline)
+	cur, err := r.collections.operationLifecycle.Aggregate(ctx, pipeline)
 	if err != nil {
 		r.logger.Error("failed execute aggregation pipeline", zap.Error(err))
 		return nil, err
@@ -0,0 +1,31 @@
+package operations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// hasStage returns whether the pipeline has a stage of the operator.
+func hasStage(pipeline mongo.Pipeline, operator string) bool {
+	for _, stage := range pipeline {
+		if len(stage) > 0 && stage[0].Key == operator {
+			return true
+		}
+	}
+	return false
+}
+
+func TestBuildPipelineSortByTimestamp(t *testing.T) {
+	p := *pagination.Default()
+
+	// the unbounded pages only sort the operations with origin tx, using the index.
+	assert.False(t, hasStage(buildPipelineSortByTimestamp(nil, p), "$unionWith"))
+
+	// the bounded pages merge the operations without origin tx.
+	match := []bson.D{{{Key: "$match", Value: bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: bson.A{"2/0001/1"}}}}}}}}
+	assert.True(t, hasStage(buildPipelineSortByTimestamp(match, p), "$unionWith"))
+}
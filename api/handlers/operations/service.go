@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/common/lifecycle"
 	"github.com/wormhole-foundation/wormhole-explorer/common/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
@@ -40,6 +41,7 @@ type OperationFilter struct {
 	ExclusiveAppId bool
 	Pagination     pagination.Pagination
 	PayloadType    []int
+	Status         lifecycle.Status
 }
 
 // FindAll returns all operations filtered by q.
@@ -58,6 +60,11 @@ func (s *Service) FindAll(ctx context.Context, filter OperationFilter) ([]*Opera
 		AppIDs:         filter.AppIDs,
 		ExclusiveAppId: filter.ExclusiveAppId,
 		PayloadType:    filter.PayloadType,
+		Status:         filter.Status,
+	}
+
+	if operationQuery.Status != "" {
+		return s.repo.FindByLifecycleStatus(ctx, operationQuery)
 	}
 
 	if len(operationQuery.AppIDs) != 0 || len(operationQuery.SourceChainIDs) > 0 || len(operationQuery.TargetChainIDs) > 0 || len(operationQuery.PayloadType) > 0 {
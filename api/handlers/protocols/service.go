@@ -111,9 +111,10 @@ func (s *Service) fetchProtocolValues(ctx context.Context, wg *sync.WaitGroup, p
 		time.Duration(s.cacheTTL)*time.Minute,
 		s.cacheKeyPrefix+":"+strings.ToUpper(protocol),
 		s.metrics,
-		func() (ProtocolStats, error) {
+		func(ctx context.Context) (ProtocolStats, error) {
 			return fetch(ctx, protocol)
 		},
+		cacheable.TagNotional,
 	)
 
 	res := ProtocolTotalValuesDTO{
@@ -0,0 +1,34 @@
+// Package sourcemessages handle the request of the messages published on the source chains
+// and their reconciliation with the signed VAAs.
+package sourcemessages
+
+import (
+	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// StatusUnsigned is the status of a message without a VAA after the finality time of its chain.
+const StatusUnsigned = "unsigned"
+
+// SourceMessageDoc definition.
+type SourceMessageDoc struct {
+	ID           string      `bson:"_id" json:"id"`
+	EmitterChain sdk.ChainID `bson:"emitterChain" json:"emitterChain"`
+	EmitterAddr  string      `bson:"emitterAddr" json:"emitterAddr"`
+	Sequence     uint64      `bson:"sequence" json:"sequence"`
+	TxHash       string      `bson:"txHash" json:"txHash"`
+	BlockHeight  string      `bson:"blockHeight" json:"blockHeight"`
+	BlockTime    time.Time   `bson:"blockTime" json:"blockTime"`
+	FinalizedAt  time.Time   `bson:"finalizedAt" json:"finalizedAt"`
+	Status       string      `bson:"status" json:"status"`
+	ReconciledAt *time.Time  `bson:"reconciledAt" json:"reconciledAt,omitempty"`
+}
+
+// SequenceGap is a range of sequences of an emitter that were not published on the source chain,
+// between two published sequences.
+type SequenceGap struct {
+	From  uint64 `json:"from"`
+	To    uint64 `json:"to"`
+	Count uint64 `json:"count"`
+}
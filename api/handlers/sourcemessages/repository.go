@@ -0,0 +1,106 @@
+package sourcemessages
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// Repository definition.
+type Repository struct {
+	db          *mongo.Database
+	logger      *zap.Logger
+	collections struct {
+		sourceMessages *mongo.Collection
+	}
+}
+
+// NewRepository create a new Repository.
+func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
+	return &Repository{db: db,
+		logger: logger.With(zap.String("module", "SourceMessagesRepository")),
+		collections: struct {
+			sourceMessages *mongo.Collection
+		}{
+			sourceMessages: db.Collection(repository.SourceMessages),
+		},
+	}
+}
+
+// FindUnsigned get the messages without a VAA after the finality time of their chain,
+// sorted by the finality time in descending order.
+func (r *Repository) FindUnsigned(ctx context.Context, chainID *sdk.ChainID, p *pagination.Pagination) ([]*SourceMessageDoc, error) {
+	filter := bson.D{{Key: "status", Value: StatusUnsigned}}
+	if chainID != nil {
+		filter = append(filter, bson.E{Key: "emitterChain", Value: *chainID})
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "finalizedAt", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(p.Skip).
+		SetLimit(p.Limit)
+
+	cur, err := r.collections.sourceMessages.Find(ctx, filter, opts)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute Find command to get unsigned source messages",
+			zap.Error(err), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+
+	var docs []*SourceMessageDoc
+	err = cur.All(ctx, &docs)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed decoding cursor to []*SourceMessageDoc", zap.Error(err),
+			zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+	if docs == nil {
+		docs = make([]*SourceMessageDoc, 0)
+	}
+	return docs, nil
+}
+
+// FindSequences get the sequences published by an emitter in the range [from, to], in ascending order.
+func (r *Repository) FindSequences(ctx context.Context, chainID sdk.ChainID, emitter string, from, to uint64) ([]uint64, error) {
+	filter := bson.D{
+		{Key: "emitterChain", Value: chainID},
+		{Key: "emitterAddr", Value: emitter},
+		{Key: "sequence", Value: bson.M{"$gte": from, "$lte": to}},
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "sequence", Value: 1}}).
+		SetProjection(bson.D{{Key: "_id", Value: 0}, {Key: "sequence", Value: 1}})
+
+	cur, err := r.collections.sourceMessages.Find(ctx, filter, opts)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute Find command to get source message sequences",
+			zap.Error(err), zap.String("emitter", emitter), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+	defer cur.Close(ctx)
+
+	var sequences []uint64
+	for cur.Next(ctx) {
+		var doc struct {
+			Sequence uint64 `bson:"sequence"`
+		}
+		if err := cur.Decode(&doc); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		sequences = append(sequences, doc.Sequence)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return sequences, nil
+}
@@ -0,0 +1,68 @@
+package sourcemessages
+
+import (
+	"context"
+
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/common/types"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// Service definition.
+type Service struct {
+	repo   *Repository
+	logger *zap.Logger
+}
+
+// NewService create a new Service.
+func NewService(repo *Repository, logger *zap.Logger) *Service {
+	return &Service{repo: repo, logger: logger.With(zap.String("module", "SourceMessagesService"))}
+}
+
+// FindUnsigned get the messages published on the source chains without a VAA after the finality time.
+func (s *Service) FindUnsigned(ctx context.Context, chainID *sdk.ChainID, p *pagination.Pagination) ([]*SourceMessageDoc, error) {
+	return s.repo.FindUnsigned(ctx, chainID, p)
+}
+
+// FindSequenceGaps get the gaps between the sequences published by an emitter in the range [from, to].
+// Only the messages published after the reconciler started are known, so the sequences before
+// the first known one are not reported as a gap.
+func (s *Service) FindSequenceGaps(
+	ctx context.Context,
+	chainID sdk.ChainID,
+	emitter *types.Address,
+	from, to uint64,
+	p *pagination.Pagination,
+) ([]*SequenceGap, error) {
+
+	sequences, err := s.repo.FindSequences(ctx, chainID, emitter.Hex(), from, to)
+	if err != nil {
+		return nil, err
+	}
+	return paginate(sequenceGaps(sequences), p), nil
+}
+
+// sequenceGaps returns the ranges of missing sequences between the given sorted sequences.
+func sequenceGaps(sequences []uint64) []*SequenceGap {
+	gaps := make([]*SequenceGap, 0)
+	for i := 1; i < len(sequences); i++ {
+		prev, curr := sequences[i-1], sequences[i]
+		if curr <= prev+1 {
+			continue
+		}
+		gaps = append(gaps, &SequenceGap{From: prev + 1, To: curr - 1, Count: curr - prev - 1})
+	}
+	return gaps
+}
+
+func paginate(gaps []*SequenceGap, p *pagination.Pagination) []*SequenceGap {
+	if p.Skip >= int64(len(gaps)) {
+		return make([]*SequenceGap, 0)
+	}
+	end := p.Skip + p.Limit
+	if end > int64(len(gaps)) {
+		end = int64(len(gaps))
+	}
+	return gaps[p.Skip:end]
+}
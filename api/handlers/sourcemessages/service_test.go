@@ -0,0 +1,25 @@
+package sourcemessages
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+)
+
+func TestSequenceGaps(t *testing.T) {
+	gaps := sequenceGaps([]uint64{3, 4, 5, 8, 9, 15})
+	assert.Equal(t, []*SequenceGap{
+		{From: 6, To: 7, Count: 2},
+		{From: 10, To: 14, Count: 5},
+	}, gaps)
+
+	// duplicated and consecutive sequences.
+	assert.Empty(t, sequenceGaps([]uint64{1, 1, 2, 3}))
+	assert.Empty(t, sequenceGaps(nil))
+
+	p := &pagination.Pagination{Skip: 1, Limit: 10}
+	assert.Equal(t, []*SequenceGap{{From: 10, To: 14, Count: 5}}, paginate(gaps, p))
+	p = &pagination.Pagination{Skip: 2, Limit: 10}
+	assert.Empty(t, paginate(gaps, p))
+}
@@ -50,16 +50,16 @@ func (s *Service) GetSymbolWithAssets(ctx context.Context, ts SymbolWithAssetsTi
 	key := topSymbolsByVolumeKey
 	key = fmt.Sprintf("%s:%s", key, ts)
 	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.expiration, key, s.metrics,
-		func() ([]SymbolWithAssetDTO, error) {
+		func(ctx context.Context) ([]SymbolWithAssetDTO, error) {
 			return s.repo.GetSymbolWithAssets(ctx, ts)
-		})
+		}, cacheable.TagNotional)
 }
 
 func (s *Service) GetTopCorridors(ctx context.Context, ts TopCorridorsTimeSpan) ([]TopCorridorsDTO, error) {
 	key := topCorridorsByCountKey
 	key = fmt.Sprintf("%s:%s", key, ts)
 	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.expiration, key, s.metrics,
-		func() ([]TopCorridorsDTO, error) {
+		func(ctx context.Context) ([]TopCorridorsDTO, error) {
 			return s.repo.GetTopCorridores(ctx, ts)
 		})
 }
@@ -70,9 +70,9 @@ func (s *Service) GetNativeTokenTransferSummary(ctx context.Context, symbol stri
 	}
 
 	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.expiration, nttSummary, s.metrics,
-		func() (*NativeTokenTransferSummary, error) {
+		func(ctx context.Context) (*NativeTokenTransferSummary, error) {
 			return s.repo.GetNativeTokenTransferSummary(ctx, symbol)
-		})
+		}, cacheable.TagNotional)
 }
 
 func (s *Service) GetNativeTokenTransferActivity(ctx context.Context, isNotional bool, symbol string) ([]NativeTokenTransferActivity, error) {
@@ -81,7 +81,7 @@ func (s *Service) GetNativeTokenTransferActivity(ctx context.Context, isNotional
 	}
 	key := fmt.Sprintf("%s:%s:%t", nttChainActivity, symbol, isNotional)
 	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.expiration, key, s.metrics,
-		func() ([]NativeTokenTransferActivity, error) {
+		func(ctx context.Context) ([]NativeTokenTransferActivity, error) {
 			return s.repo.GetNativeTokenTransferActivity(ctx, isNotional, symbol)
 		})
 }
@@ -131,7 +131,7 @@ func (s *Service) GetNativeTokenTransferByTime(ctx context.Context, timespan Ntt
 	key := fmt.Sprintf("%s:%s:%s:%t:%s:%s", nttTransferByTime, timespan, symbol, isNotional, fromStr, toStr)
 
 	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.expiration, key, s.metrics,
-		func() ([]NativeTokenTransferByTime, error) {
+		func(ctx context.Context) ([]NativeTokenTransferByTime, error) {
 			return s.repo.GetNativeTokenTransferByTime(ctx, timespan, symbol, isNotional, from, to)
 		})
 
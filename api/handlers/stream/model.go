@@ -0,0 +1,120 @@
+package stream
+
+import (
+	"strings"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// Event types sent through the stream.
+const (
+	// EventTypeVaa is sent when a new signed VAA is stored.
+	EventTypeVaa = "vaa"
+	// EventTypeOperation is sent when the payload of a VAA is parsed.
+	EventTypeOperation = "operation"
+	// EventTypeTargetTx is sent when the target transaction of a VAA is found or updated.
+	EventTypeTargetTx = "target-tx"
+)
+
+// Event is a change in the wormscan database that is sent to the stream clients.
+type Event struct {
+	// Type is one of EventTypeVaa, EventTypeOperation or EventTypeTargetTx.
+	Type string `json:"type"`
+	// Cursor identifies the position of the event in the stream.
+	// Clients send it back to resume the stream after a disconnection.
+	Cursor       string      `json:"cursor"`
+	ID           string      `json:"id"`
+	EmitterChain vaa.ChainID `json:"emitterChain"`
+	EmitterAddr  string      `json:"emitterAddr"`
+	Sequence     string      `json:"sequence"`
+	Data         any         `json:"data"`
+
+	// appIDs and addresses are only used to filter the events.
+	appIDs    []string
+	addresses []string
+}
+
+// VaaData is the data of an EventTypeVaa event.
+type VaaData struct {
+	GuardianSetIndex uint32     `json:"guardianSetIndex"`
+	Vaa              []byte     `json:"vaa"`
+	Timestamp        *time.Time `json:"timestamp"`
+	TxHash           *string    `json:"txHash,omitempty"`
+}
+
+// OperationData is the data of an EventTypeOperation event.
+type OperationData struct {
+	Timestamp              *time.Time     `json:"timestamp"`
+	Payload                map[string]any `json:"payload,omitempty"`
+	StandardizedProperties map[string]any `json:"standardizedProperties,omitempty"`
+}
+
+// TargetTxData is the data of an EventTypeTargetTx event.
+type TargetTxData struct {
+	ChainID     vaa.ChainID `json:"chainId"`
+	Status      string      `json:"status"`
+	Method      string      `json:"method"`
+	TxHash      string      `json:"txHash"`
+	From        string      `json:"from"`
+	To          string      `json:"to"`
+	BlockNumber string      `json:"blockNumber"`
+	Timestamp   *time.Time  `json:"timestamp"`
+}
+
+// Filter defines the events a client is subscribed to.
+//
+// Empty fields match every event. The appId and address filters only match
+// events that carry that information, i.e. operations and target transactions.
+type Filter struct {
+	ChainIDs    []vaa.ChainID
+	EmitterAddr string
+	AppID       string
+	Address     string
+}
+
+// Match returns true when the event passes the filter.
+func (f *Filter) Match(e *Event) bool {
+	if len(f.ChainIDs) > 0 && !containsChain(f.ChainIDs, e.EmitterChain) {
+		return false
+	}
+	if f.EmitterAddr != "" && !strings.EqualFold(f.EmitterAddr, e.EmitterAddr) {
+		return false
+	}
+	if f.AppID != "" && !containsFold(e.appIDs, f.AppID) {
+		return false
+	}
+	if f.Address != "" && !containsAddress(e.addresses, f.Address) {
+		return false
+	}
+	return true
+}
+
+func containsChain(chainIDs []vaa.ChainID, chainID vaa.ChainID) bool {
+	for _, c := range chainIDs {
+		if c == chainID {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsAddress compares hex addresses ignoring case, and any other
+// format (e.g. base58) as is, since it is case sensitive.
+func containsAddress(addresses []string, address string) bool {
+	for _, a := range addresses {
+		if a == address || (strings.HasPrefix(a, "0x") && strings.EqualFold(a, address)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,266 @@
+package stream
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// ErrInvalidCursor is returned when a stream cursor cannot be decoded.
+var ErrInvalidCursor = errors.New("invalid stream cursor")
+
+// Collections watched by the stream.
+const (
+	collectionVaas               = "vaas"
+	collectionParsedVaa          = "parsedVaa"
+	collectionGlobalTransactions = "globalTransactions"
+)
+
+// Repository watches the wormscan database for new VAAs, operations and target transactions.
+type Repository struct {
+	db     *mongo.Database
+	logger *zap.Logger
+}
+
+// NewRepository create a new Repository.
+func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
+	return &Repository{db: db, logger: logger.With(zap.String("module", "StreamRepository"))}
+}
+
+// changeEvent is a mongodb change stream event.
+type changeEvent struct {
+	Ns struct {
+		Coll string `bson:"coll"`
+	} `bson:"ns"`
+	FullDocument bson.Raw `bson:"fullDocument"`
+}
+
+type vaaDoc struct {
+	ID               string     `bson:"_id"`
+	EmitterChain     uint16     `bson:"emitterChain"`
+	EmitterAddr      string     `bson:"emitterAddr"`
+	Sequence         string     `bson:"sequence"`
+	GuardianSetIndex uint32     `bson:"guardianSetIndex"`
+	Vaa              []byte     `bson:"vaas"`
+	Timestamp        *time.Time `bson:"timestamp"`
+	TxHash           *string    `bson:"txHash"`
+}
+
+type parsedVaaDoc struct {
+	ID                     string         `bson:"_id"`
+	Timestamp              *time.Time     `bson:"timestamp"`
+	ParsedPayload          map[string]any `bson:"parsedPayload"`
+	StandardizedProperties map[string]any `bson:"standardizedProperties"`
+}
+
+type globalTransactionDoc struct {
+	ID       string `bson:"_id"`
+	OriginTx *struct {
+		From string `bson:"from"`
+	} `bson:"originTx"`
+	DestinationTx *struct {
+		ChainID     uint16     `bson:"chainId"`
+		Status      string     `bson:"status"`
+		Method      string     `bson:"method"`
+		TxHash      string     `bson:"txHash"`
+		From        string     `bson:"from"`
+		To          string     `bson:"to"`
+		BlockNumber string     `bson:"blockNumber"`
+		Timestamp   *time.Time `bson:"timestamp"`
+	} `bson:"destinationTx"`
+}
+
+// Watch sends the database changes to the handler until the context is cancelled
+// or the change stream fails.
+//
+// When cursor is not empty, the stream resumes right after the event identified by it.
+func (r *Repository) Watch(ctx context.Context, cursor string, handler func(*Event)) error {
+
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if cursor != "" {
+		token, err := decodeCursor(cursor)
+		if err != nil {
+			return err
+		}
+		opts.SetResumeAfter(token)
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "$or", Value: bson.A{
+			// new signed vaas
+			bson.D{
+				{Key: "ns.coll", Value: collectionVaas},
+				{Key: "operationType", Value: "insert"},
+			},
+			// new or reprocessed operations
+			bson.D{
+				{Key: "ns.coll", Value: collectionParsedVaa},
+				{Key: "operationType", Value: bson.D{{Key: "$in", Value: bson.A{"insert", "update", "replace"}}}},
+			},
+			// target transactions
+			bson.D{
+				{Key: "ns.coll", Value: collectionGlobalTransactions},
+				{Key: "operationType", Value: bson.D{{Key: "$in", Value: bson.A{"insert", "update", "replace"}}}},
+				{Key: "fullDocument.destinationTx", Value: bson.D{{Key: "$exists", Value: true}}},
+			},
+		}}}}},
+	}
+
+	stream, err := r.db.Watch(ctx, pipeline, opts)
+	if err != nil {
+		r.logger.Error("failed to open change stream", zap.Error(err))
+		return errors.WithStack(err)
+	}
+	defer stream.Close(context.Background())
+
+	for stream.Next(ctx) {
+		var change changeEvent
+		if err := stream.Decode(&change); err != nil {
+			r.logger.Error("failed to decode change stream event", zap.Error(err))
+			continue
+		}
+
+		event, err := toEvent(&change)
+		if err != nil {
+			r.logger.Error("failed to convert change stream event",
+				zap.String("collection", change.Ns.Coll), zap.Error(err))
+			continue
+		}
+		event.Cursor = encodeCursor(stream.ResumeToken())
+		handler(event)
+	}
+
+	if err := stream.Err(); err != nil && ctx.Err() == nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// toEvent converts a change stream event into a stream Event.
+func toEvent(change *changeEvent) (*Event, error) {
+	switch change.Ns.Coll {
+	case collectionVaas:
+		var doc vaaDoc
+		if err := bson.Unmarshal(change.FullDocument, &doc); err != nil {
+			return nil, err
+		}
+		return &Event{
+			Type:         EventTypeVaa,
+			ID:           doc.ID,
+			EmitterChain: vaa.ChainID(doc.EmitterChain),
+			EmitterAddr:  doc.EmitterAddr,
+			Sequence:     doc.Sequence,
+			Data: VaaData{
+				GuardianSetIndex: doc.GuardianSetIndex,
+				Vaa:              doc.Vaa,
+				Timestamp:        doc.Timestamp,
+				TxHash:           doc.TxHash,
+			},
+		}, nil
+
+	case collectionParsedVaa:
+		var doc parsedVaaDoc
+		if err := bson.Unmarshal(change.FullDocument, &doc); err != nil {
+			return nil, err
+		}
+		event, err := newEventFromID(EventTypeOperation, doc.ID)
+		if err != nil {
+			return nil, err
+		}
+		event.Data = OperationData{
+			Timestamp:              doc.Timestamp,
+			Payload:                doc.ParsedPayload,
+			StandardizedProperties: doc.StandardizedProperties,
+		}
+		if appIDs, ok := doc.StandardizedProperties["appIds"].(bson.A); ok {
+			for _, appID := range appIDs {
+				if s, ok := appID.(string); ok {
+					event.appIDs = append(event.appIDs, s)
+				}
+			}
+		}
+		for _, key := range []string{"fromAddress", "toAddress"} {
+			if s, ok := doc.StandardizedProperties[key].(string); ok && s != "" {
+				event.addresses = append(event.addresses, s)
+			}
+		}
+		return event, nil
+
+	case collectionGlobalTransactions:
+		var doc globalTransactionDoc
+		if err := bson.Unmarshal(change.FullDocument, &doc); err != nil {
+			return nil, err
+		}
+		if doc.DestinationTx == nil {
+			return nil, errors.New("global transaction without destination tx")
+		}
+		event, err := newEventFromID(EventTypeTargetTx, doc.ID)
+		if err != nil {
+			return nil, err
+		}
+		event.Data = TargetTxData{
+			ChainID:     vaa.ChainID(doc.DestinationTx.ChainID),
+			Status:      doc.DestinationTx.Status,
+			Method:      doc.DestinationTx.Method,
+			TxHash:      doc.DestinationTx.TxHash,
+			From:        doc.DestinationTx.From,
+			To:          doc.DestinationTx.To,
+			BlockNumber: doc.DestinationTx.BlockNumber,
+			Timestamp:   doc.DestinationTx.Timestamp,
+		}
+		event.addresses = append(event.addresses, doc.DestinationTx.From, doc.DestinationTx.To)
+		if doc.OriginTx != nil {
+			event.addresses = append(event.addresses, doc.OriginTx.From)
+		}
+		return event, nil
+
+	default:
+		return nil, errors.New("unexpected collection")
+	}
+}
+
+// newEventFromID builds an event from a VAA ID (chain/emitter/sequence).
+func newEventFromID(eventType, id string) (*Event, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 {
+		return nil, errors.New("invalid vaa id " + id)
+	}
+	chainID, err := strconv.ParseUint(parts[0], 10, 16)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid vaa id %s", id)
+	}
+	return &Event{
+		Type:         eventType,
+		ID:           id,
+		EmitterChain: vaa.ChainID(chainID),
+		EmitterAddr:  parts[1],
+		Sequence:     parts[2],
+	}, nil
+}
+
+// encodeCursor returns the opaque representation of a change stream resume token.
+func encodeCursor(token bson.Raw) string {
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+// decodeCursor parses an opaque cursor into a change stream resume token.
+func decodeCursor(cursor string) (bson.Raw, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	token := bson.Raw(b)
+	if err := token.Validate(); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return token, nil
+}
@@ -0,0 +1,205 @@
+// Package stream streams new VAAs, operations and target transactions to the API clients.
+package stream
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+)
+
+// subscriptionBufferSize is the number of events buffered for each subscription.
+// Subscriptions that fall behind this buffer are closed, clients can reconnect using the last cursor.
+const subscriptionBufferSize = 256
+
+// Subscription receives the events that match its filter.
+type Subscription struct {
+	filter Filter
+	events chan *Event
+	once   sync.Once
+	cancel context.CancelFunc
+}
+
+// Events returns the channel of events. It is closed when the subscription ends.
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+// send delivers the event without blocking, returns false if the subscription buffer is full.
+func (s *Subscription) send(e *Event) bool {
+	if !s.filter.Match(e) {
+		return true
+	}
+	select {
+	case s.events <- e:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Subscription) close() {
+	s.once.Do(func() {
+		if s.cancel != nil {
+			s.cancel()
+		}
+		close(s.events)
+	})
+}
+
+// errCodeChangeStreamHistoryLost is the code of the error of resuming a change stream from a token
+// that is no longer in the oplog.
+const errCodeChangeStreamHistoryLost = 286
+
+// Service definition.
+type Service struct {
+	repo       *Repository
+	mu         sync.Mutex
+	subs       map[*Subscription]struct{}
+	resumed    int
+	maxResumed int
+	ctx        context.Context
+	logger     *zap.Logger
+}
+
+// NewService create a new Service. At most maxResumed subscriptions with cursor, that open
+// their own change stream, are served at the same time.
+func NewService(repo *Repository, maxResumed int, logger *zap.Logger) *Service {
+	return &Service{
+		repo:       repo,
+		subs:       make(map[*Subscription]struct{}),
+		maxResumed: maxResumed,
+		ctx:        context.Background(),
+		logger:     logger.With(zap.String("module", "StreamService")),
+	}
+}
+
+// Start watches the database in a separate goroutine and broadcasts the events
+// to the live subscriptions until the context is cancelled.
+//
+// When the change stream fails, it is resumed after the last broadcasted event, so that no event is lost.
+func (s *Service) Start(ctx context.Context) {
+	s.ctx = ctx
+	go func() {
+		var cursor string
+		for {
+			err := s.repo.Watch(ctx, cursor, func(e *Event) {
+				cursor = e.Cursor
+				s.broadcast(e)
+			})
+			if ctx.Err() != nil {
+				s.closeAll()
+				return
+			}
+			if isHistoryLost(err) {
+				s.logger.Error("change stream can not be resumed, restarting it from now", zap.String("cursor", cursor), zap.Error(err))
+				cursor = ""
+			} else {
+				s.logger.Error("change stream closed, retrying", zap.Error(err))
+			}
+			select {
+			case <-ctx.Done():
+				s.closeAll()
+				return
+			case <-time.After(5 * time.Second):
+			}
+		}
+	}()
+}
+
+// Subscribe registers a new subscription.
+//
+// Without cursor, the subscription receives the events of the shared change stream.
+// With a cursor, a dedicated change stream is opened to replay the events after it,
+// unless there are already maxResumed of them.
+func (s *Service) Subscribe(filter Filter, cursor string) (*Subscription, error) {
+	sub := &Subscription{
+		filter: filter,
+		events: make(chan *Event, subscriptionBufferSize),
+	}
+
+	if cursor == "" {
+		s.mu.Lock()
+		s.subs[sub] = struct{}{}
+		s.mu.Unlock()
+		return sub, nil
+	}
+
+	// validate the cursor before opening the change stream.
+	if _, err := decodeCursor(cursor); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	if s.maxResumed > 0 && s.resumed >= s.maxResumed {
+		s.mu.Unlock()
+		return nil, errs.New(errs.CodeRateLimited, "too many streams resumed from a cursor, retry later")
+	}
+	s.resumed++
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	sub.cancel = cancel
+	go func() {
+		defer func() {
+			s.mu.Lock()
+			s.resumed--
+			s.mu.Unlock()
+			sub.close()
+		}()
+		err := s.repo.Watch(ctx, cursor, func(e *Event) {
+			if !sub.send(e) {
+				s.logger.Warn("stream subscription is too slow, closing it")
+				cancel()
+			}
+		})
+		if err != nil {
+			s.logger.Error("failed to resume change stream", zap.Error(err))
+		}
+	}()
+	return sub, nil
+}
+
+// Unsubscribe removes the subscription and closes its channel.
+func (s *Service) Unsubscribe(sub *Subscription) {
+	// dedicated subscriptions are closed by their own goroutine once the change stream ends.
+	if sub.cancel != nil {
+		sub.cancel()
+		return
+	}
+	s.mu.Lock()
+	delete(s.subs, sub)
+	s.mu.Unlock()
+	sub.close()
+}
+
+func (s *Service) broadcast(e *Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subs {
+		if !sub.send(e) {
+			s.logger.Warn("stream subscription is too slow, closing it")
+			delete(s.subs, sub)
+			sub.close()
+		}
+	}
+}
+
+func (s *Service) closeAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subs {
+		delete(s.subs, sub)
+		sub.close()
+	}
+}
+
+// isHistoryLost returns true when the change stream can not be resumed because its cursor is too old.
+func isHistoryLost(err error) bool {
+	var serverErr mongo.ServerError
+	return errors.As(err, &serverErr) && serverErr.HasErrorCode(errCodeChangeStreamHistoryLost)
+}
@@ -919,6 +919,13 @@ func (r *Repository) FindTransactions(
 			})
 		}
 
+		// Resume from the keyset pagination cursor
+		if input.pagination != nil && input.pagination.Cursor != nil {
+			pipeline = append(pipeline, bson.D{
+				{"$match", input.pagination.CursorFilter("timestamp", input.pagination.GetSortInt())},
+			})
+		}
+
 		// Filter by ID
 		if input.id != "" {
 			pipeline = append(pipeline, bson.D{
@@ -1048,8 +1055,16 @@ func (r *Repository) ListTransactionsByAddress(
 	}}})
 	pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.D{{Key: "parsedVaa", Value: bson.D{{Key: "$ne", Value: []any{}}}}}}})
 
-	// sort by timestamp
-	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{bson.E{Key: "timestamp", Value: pagination.GetSortInt()}}}})
+	// sort by timestamp and ID
+	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{
+		bson.E{Key: "timestamp", Value: pagination.GetSortInt()},
+		bson.E{Key: "_id", Value: -1},
+	}}})
+
+	// resume from the keyset pagination cursor
+	if pagination.Cursor != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: pagination.CursorFilter("timestamp", pagination.GetSortInt())}})
+	}
 
 	// Skip initial results
 	pipeline = append(pipeline, bson.D{{Key: "$skip", Value: pagination.Skip}})
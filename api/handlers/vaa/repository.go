@@ -147,8 +147,15 @@ func (r *Repository) FindVaasByEmitterAndToChain(
 			{"$match", bson.D{bson.E{"rawStandardizedProperties.toChain", toChain}}},
 		})
 
-		// specify sorting criteria
-		pipeline = append(pipeline, bson.D{{"$sort", bson.D{bson.E{"indexedAt", query.GetSortInt()}}}})
+		// specify sorting criteria, the same of the vaas collection so that both can be paginated with the same cursor.
+		pipeline = append(pipeline, bson.D{{"$sort", bson.D{query.getSortPredicate(), {"_id", -1}}}})
+
+		// resume from the keyset pagination cursor
+		if query.Pagination.Cursor != nil {
+			pipeline = append(pipeline, bson.D{
+				{"$match", query.Pagination.CursorFilter("timestamp", query.GetSortInt())},
+			})
+		}
 
 		// skip initial results
 		if query.Pagination.Skip != 0 {
@@ -193,8 +200,10 @@ func (r *Repository) FindVaasByEmitterAndToChain(
 		return make([]*VaaDoc, 0), nil
 	}
 
-	// call FindVaas with the IDs we've found
+	// call FindVaas with the IDs we've found, they are already paginated.
 	q := *query // make a copy to avoid modifying the struct passed by the caller
+	q.Pagination.Skip = 0
+	q.Pagination.Cursor = nil
 	for _, vaa := range vaas {
 		q.ids = append(q.ids, vaa.ID)
 	}
@@ -216,9 +225,16 @@ func (r *Repository) FindVaas(
 	{
 		// specify sorting criteria
 		pipeline = append(pipeline, bson.D{
-			{"$sort", bson.D{q.getSortPredicate()}},
+			{"$sort", bson.D{q.getSortPredicate(), {"_id", -1}}},
 		})
 
+		// resume from the keyset pagination cursor
+		if q.Pagination.Cursor != nil {
+			pipeline = append(pipeline, bson.D{
+				{"$match", q.Pagination.CursorFilter("timestamp", q.GetSortInt())},
+			})
+		}
+
 		// filter by VAA ids (potentially more than one)
 		if len(q.ids) > 0 {
 			var array bson.A
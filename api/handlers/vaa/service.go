@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 
 	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
@@ -82,8 +83,7 @@ func (s *Service) FindAll(
 	}
 
 	// Return the matching documents
-	res := response.Response[[]*VaaDoc]{Data: vaas}
-	return &res, nil
+	return newVaasResponse(vaas, query.Pagination), nil
 }
 
 // FindByChain get all the vaa by chainID.
@@ -99,9 +99,11 @@ func (s *Service) FindByChain(
 		IncludeParsedPayload(false)
 
 	vaas, err := s.repo.FindVaas(ctx, query)
+	if err != nil {
+		return &response.Response[[]*VaaDoc]{Data: vaas}, err
+	}
 
-	res := response.Response[[]*VaaDoc]{Data: vaas}
-	return &res, err
+	return newVaasResponse(vaas, query.Pagination), nil
 }
 
 // FindByEmitterParams contains the input parameters for the function `FindByEmitter`.
@@ -128,17 +130,30 @@ func (s *Service) FindByEmitter(
 	// In most cases, the data is obtained from the VAA collection.
 	//
 	// The special case of filtering VAAs by `toChain` requires querying
-	// the data from a different collection.
-	var vaas []*VaaDoc
-	var err error
+	// the data from a different collection, sorted in the same order.
 	if params.ToChain != nil {
-		vaas, err = s.repo.FindVaasByEmitterAndToChain(ctx, query, *params.ToChain)
-	} else {
-		vaas, err = s.repo.FindVaas(ctx, query)
+		vaas, err := s.repo.FindVaasByEmitterAndToChain(ctx, query, *params.ToChain)
+		if err != nil {
+			return &response.Response[[]*VaaDoc]{Data: vaas}, err
+		}
+		return newVaasResponse(vaas, query.Pagination), nil
 	}
 
+	vaas, err := s.repo.FindVaas(ctx, query)
+	if err != nil {
+		return &response.Response[[]*VaaDoc]{Data: vaas}, err
+	}
+	return newVaasResponse(vaas, query.Pagination), nil
+}
+
+// newVaasResponse builds a paginated response, including the cursor of the next page.
+func newVaasResponse(vaas []*VaaDoc, p pagination.Pagination) *response.Response[[]*VaaDoc] {
 	res := response.Response[[]*VaaDoc]{Data: vaas}
-	return &res, err
+	if len(vaas) > 0 {
+		last := vaas[len(vaas)-1]
+		res.Pagination.Next = p.NextCursor(len(vaas), last.Timestamp, last.ID)
+	}
+	return &res
 }
 
 // If the parameter [payload] is true, the parse payload is added in the response.
@@ -300,3 +315,57 @@ func (s *Service) FindDuplicatedById(
 	resp := response.Response[[]*VaaDoc]{Data: vaas}
 	return &resp, err
 }
+
+// maxBatchSize is the maximum number of VAAs read from a transaction to build a batch.
+const maxBatchSize = 1000
+
+// FindBatch gets the signed VAAs of the messages emitted with the same nonce by a transaction.
+// Batch VAAs are not signed by the guardians, so the batch is built from the VAAs of its messages,
+// sorted by sequence.
+func (s *Service) FindBatch(
+	ctx context.Context,
+	chain sdk.ChainID,
+	txHash *types.TxHash,
+	nonce uint32,
+) ([]*VaaDoc, error) {
+
+	query := Query().
+		SetChain(chain).
+		SetTxHash(txHash.String()).
+		SetPagination(pagination.Default().SetLimit(maxBatchSize)).
+		IncludeParsedPayload(false)
+
+	// the transaction hashes of some chains are stored in the globalTransactions collection.
+	vaas, err := s.repo.FindVaasByTxHashWorkaround(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	// keep the VAAs emitted with the nonce of the batch.
+	type batchVaa struct {
+		doc      *VaaDoc
+		sequence uint64
+	}
+	var batch []batchVaa
+	for _, doc := range vaas {
+		v, err := sdk.Unmarshal(doc.Vaa)
+		if err != nil {
+			requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+			s.logger.Error("error unmarshal vaa", zap.Error(err), zap.String("id", doc.ID), zap.String("requestID", requestID))
+			return nil, errs.ErrInternalError
+		}
+		if v.Nonce == nonce {
+			batch = append(batch, batchVaa{doc: doc, sequence: v.Sequence})
+		}
+	}
+	if len(batch) == 0 {
+		return nil, errs.ErrNotFound
+	}
+
+	sort.Slice(batch, func(i, j int) bool { return batch[i].sequence < batch[j].sequence })
+	result := make([]*VaaDoc, 0, len(batch))
+	for _, b := range batch {
+		result = append(result, b.doc)
+	}
+	return result, nil
+}
@@ -40,6 +40,10 @@ type AppConfig struct {
 		Prefix                   string
 		ProtocolsStatsKey        string
 		ProtocolsStatsExpiration int
+		// L1Size is the number of results kept in memory in front of redis.
+		L1Size int
+		// NotionalChannel is the redis channel where the notional job publishes new prices.
+		NotionalChannel string
 	}
 	PORT         int
 	LogLevel     string
@@ -75,6 +79,33 @@ type AppConfig struct {
 		Tokens string
 	}
 	Protocols []string
+	Stream    struct {
+		// Enabled starts the /api/v1/stream endpoint, it requires mongodb change streams.
+		Enabled bool
+		// MaxResumed is the maximum number of streams resumed from a cursor at the same time,
+		// each of them opens a change stream.
+		MaxResumed int
+	}
+	Export struct {
+		// Enabled starts the /api/v1/exports endpoints, the exports are run by the exports job.
+		Enabled bool
+		// Storage is the storage of the export files shared with the exports job, local or s3.
+		Storage           string
+		Path              string
+		S3Endpoint        string
+		S3Region          string
+		S3Bucket          string
+		S3Prefix          string
+		S3AccessKeyID     string
+		S3SecretAccessKey string
+		S3PathStyle       bool
+		// MaxRangeDays is the maximum time range of an export.
+		MaxRangeDays int
+		// MaxPending is the maximum number of exports pending or running, 0 disables the limit.
+		MaxPending int
+		// MaxPerClientHour is the maximum number of exports a client can request per hour, 0 disables the limit.
+		MaxPerClientHour int
+	}
 }
 
 // GetLogLevel get zapcore.Level define in the configuraion.
@@ -93,8 +124,11 @@ func defaulConfig() *AppConfig {
 			Prefix                   string
 			ProtocolsStatsKey        string
 			ProtocolsStatsExpiration int
+			L1Size                   int
+			NotionalChannel          string
 		}{
 			MetricExpiration: 10,
+			L1Size:           1000,
 		},
 	}
 }
@@ -106,6 +140,10 @@ func init() {
 	viper.SetDefault("p2pnetwork", P2pMainNet)
 	viper.SetDefault("PprofEnabled", false)
 	viper.SetDefault("RateLimit_Enabled", true)
+	viper.SetDefault("Stream_MaxResumed", 100)
+	viper.SetDefault("Export_MaxRangeDays", 366)
+	viper.SetDefault("Export_MaxPending", 100)
+	viper.SetDefault("Export_MaxPerClientHour", 10)
 
 	// Consider environment variables in unmarshall doesn't work unless doing this: https://github.com/spf13/viper/issues/188#issuecomment-1168898503
 	b, err := json.Marshal(defaulConfig())
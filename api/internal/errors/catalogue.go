@@ -0,0 +1,166 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Code is a stable machine-readable error code of the public API.
+// Codes are part of the API contract: new codes can be added, but existing ones are never renamed.
+type Code string
+
+// Error codes of the public API.
+const (
+	CodeInvalidParam      Code = "INVALID_PARAM"
+	CodeInvalidChainID    Code = "INVALID_CHAIN_ID"
+	CodeInvalidAddress    Code = "INVALID_ADDRESS"
+	CodeInvalidSequence   Code = "INVALID_SEQUENCE"
+	CodeInvalidHash       Code = "INVALID_HASH"
+	CodeInvalidTimeSpan   Code = "INVALID_TIME_SPAN"
+	CodeInvalidPagination Code = "INVALID_PAGINATION"
+	CodeInvalidBody       Code = "INVALID_BODY"
+	CodeMalformedQuery    Code = "MALFORMED_QUERY"
+	CodeNotFound          Code = "NOT_FOUND"
+	CodeConflict          Code = "CONFLICT"
+	CodeRateLimited       Code = "RATE_LIMITED"
+	CodeNotImplemented    Code = "NOT_IMPLEMENTED"
+	CodeTimeout           Code = "TIMEOUT"
+	CodeUnavailable       Code = "UNAVAILABLE"
+	CodeInternal          Code = "INTERNAL_ERROR"
+)
+
+// codeSpec is the transport mapping and the default message of a code.
+type codeSpec struct {
+	httpStatus int
+	grpcCode   codes.Code
+	message    string
+}
+
+var catalogue = map[Code]codeSpec{
+	CodeInvalidParam:      {http.StatusBadRequest, codes.InvalidArgument, "INVALID PARAM"},
+	CodeInvalidChainID:    {http.StatusBadRequest, codes.InvalidArgument, "INVALID CHAIN ID"},
+	CodeInvalidAddress:    {http.StatusBadRequest, codes.InvalidArgument, "INVALID ADDRESS"},
+	CodeInvalidSequence:   {http.StatusBadRequest, codes.InvalidArgument, "INVALID SEQUENCE"},
+	CodeInvalidHash:       {http.StatusBadRequest, codes.InvalidArgument, "INVALID HASH"},
+	CodeInvalidTimeSpan:   {http.StatusBadRequest, codes.InvalidArgument, "INVALID TIME SPAN"},
+	CodeInvalidPagination: {http.StatusBadRequest, codes.InvalidArgument, "INVALID PAGINATION"},
+	CodeInvalidBody:       {http.StatusBadRequest, codes.InvalidArgument, "INVALID BODY"},
+	CodeMalformedQuery:    {http.StatusBadRequest, codes.InvalidArgument, "MALFORMED QUERY"},
+	CodeNotFound:          {http.StatusNotFound, codes.NotFound, "NOT FOUND"},
+	CodeConflict:          {http.StatusConflict, codes.FailedPrecondition, "CONFLICT"},
+	CodeRateLimited:       {http.StatusTooManyRequests, codes.ResourceExhausted, "TOO MANY REQUESTS"},
+	CodeNotImplemented:    {http.StatusNotImplemented, codes.Unimplemented, "NOT IMPLEMENTED"},
+	CodeTimeout:           {http.StatusGatewayTimeout, codes.DeadlineExceeded, "TIMEOUT"},
+	CodeUnavailable:       {http.StatusServiceUnavailable, codes.Unavailable, "UNAVAILABLE"},
+	CodeInternal:          {http.StatusInternalServerError, codes.Internal, "INTERNAL ERROR"},
+}
+
+func (c Code) spec() codeSpec {
+	if spec, ok := catalogue[c]; ok {
+		return spec
+	}
+	return catalogue[CodeInternal]
+}
+
+// HTTPStatus returns the http status of the code.
+func (c Code) HTTPStatus() int {
+	return c.spec().httpStatus
+}
+
+// GRPCCode returns the gRPC status code of the code.
+// The numeric value is also the `code` field of the http error response, aligned with the guardian API.
+func (c Code) GRPCCode() codes.Code {
+	return c.spec().grpcCode
+}
+
+// Message returns the default message of the code.
+func (c Code) Message() string {
+	return c.spec().message
+}
+
+// FieldError describes why a field of the request is invalid.
+type FieldError struct {
+	// Field is the name of the path param, query param or body field.
+	Field string `json:"field"`
+	// Value is the value received for the field.
+	Value string `json:"value,omitempty"`
+	// Reason describes the expected value of the field.
+	Reason string `json:"reason"`
+}
+
+// Error is an error of the catalogue, it can be returned by any layer of the api.
+type Error struct {
+	Code    Code
+	Message string
+	Fields  []FieldError
+	Err     error
+}
+
+// New creates an Error. If message is empty the default message of the code is used.
+func New(code Code, message string, fields ...FieldError) *Error {
+	return Wrap(code, message, nil, fields...)
+}
+
+// Wrap creates an Error caused by err. If message is empty the default message of the code is used.
+func Wrap(code Code, message string, err error, fields ...FieldError) *Error {
+	if message == "" {
+		message = code.Message()
+	}
+	return &Error{Code: code, Message: message, Fields: fields, Err: err}
+}
+
+// NewFieldError creates an Error for an invalid field of the request.
+func NewFieldError(code Code, message string, err error, field, value, reason string) *Error {
+	return Wrap(code, message, err, FieldError{Field: field, Value: value, Reason: reason})
+}
+
+// Error interface implementation.
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s: %v", e.Code, e.Message, e.Err)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the cause of the error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// As returns err as an Error of the catalogue.
+// The errors that are not of the catalogue are classified by their cause:
+// not found, malformed query, timeouts, including the network timeouts of the Influx client, and internal errors.
+func As(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	return Wrap(CodeOf(err), "", err)
+}
+
+// CodeOf returns the code of an error.
+func CodeOf(err error) Code {
+	var e *Error
+	var netErr net.Error
+	switch {
+	case err == nil:
+		return ""
+	case errors.As(err, &e):
+		return e.Code
+	case errors.Is(err, ErrNotFound):
+		return CodeNotFound
+	case errors.Is(err, ErrMalformedQuery):
+		return CodeMalformedQuery
+	case errors.Is(err, context.DeadlineExceeded):
+		return CodeTimeout
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return CodeTimeout
+	default:
+		return CodeInternal
+	}
+}
@@ -0,0 +1,59 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestCatalogue_EveryCodeHasSpec(t *testing.T) {
+	for code, spec := range catalogue {
+		assert.NotZero(t, spec.httpStatus, code)
+		assert.NotEmpty(t, spec.message, code)
+	}
+	assert.Equal(t, http.StatusInternalServerError, Code("UNKNOWN_CODE").HTTPStatus())
+	assert.Equal(t, codes.Internal, Code("UNKNOWN_CODE").GRPCCode())
+}
+
+func TestCodeOf(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected Code
+	}{
+		{"nil", nil, ""},
+		{"catalogue", New(CodeInvalidAddress, ""), CodeInvalidAddress},
+		{"wrapped catalogue", fmt.Errorf("finding address: %w", New(CodeInvalidHash, "")), CodeInvalidHash},
+		{"not found", ErrNotFound, CodeNotFound},
+		{"malformed query", ErrMalformedQuery, CodeMalformedQuery},
+		{"deadline exceeded", fmt.Errorf("query: %w", context.DeadlineExceeded), CodeTimeout},
+		{"network timeout", fmt.Errorf("influx: %w", timeoutError{}), CodeTimeout},
+		{"other", fmt.Errorf("unexpected"), CodeInternal},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, CodeOf(testCase.err))
+		})
+	}
+}
+
+func TestAs(t *testing.T) {
+	e := As(ErrNotFound)
+	assert.Equal(t, CodeNotFound, e.Code)
+	assert.Equal(t, "NOT FOUND", e.Message)
+	assert.ErrorIs(t, e, ErrNotFound)
+
+	fieldErr := NewFieldError(CodeInvalidChainID, "INVALID CHAIN ID", nil, "chain_id", "abc", "must be a wormhole chain id")
+	assert.Same(t, fieldErr, As(fmt.Errorf("parsing: %w", fieldErr)))
+	assert.Equal(t, []FieldError{{Field: "chain_id", Value: "abc", Reason: "must be a wormhole chain id"}}, fieldErr.Fields)
+}
@@ -0,0 +1,81 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// ErrInvalidCursor is returned when a cursor token cannot be decoded.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is the position of the last element of a page, used for keyset pagination.
+//
+// Results are sorted by a timestamp field and then by `_id`, so the pair
+// (timestamp, _id) identifies a unique position in the result set.
+// Clients receive it as an opaque token and send it back to get the next page.
+type Cursor struct {
+	Timestamp time.Time `json:"t"`
+	ID        string    `json:"i"`
+}
+
+// Encode returns the opaque token representation of the cursor.
+func (c *Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor parses an opaque cursor token.
+func DecodeCursor(token string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if c.ID == "" || c.Timestamp.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// SetCursor sets the cursor to resume the pagination from.
+func (p *Pagination) SetCursor(c *Cursor) *Pagination {
+	p.Cursor = c
+	return p
+}
+
+// CursorFilter returns a mongo filter that matches the documents placed after the cursor.
+//
+// It assumes the results are sorted by [timestampField] in the [sort] direction
+// (1 or -1, see GetSortInt) and then by `_id` in descending order.
+func (p *Pagination) CursorFilter(timestampField string, sort int) bson.D {
+	op := "$lt"
+	if sort == 1 {
+		op = "$gt"
+	}
+	return bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: timestampField, Value: bson.D{{Key: op, Value: p.Cursor.Timestamp}}}},
+		bson.D{
+			{Key: timestampField, Value: p.Cursor.Timestamp},
+			{Key: "_id", Value: bson.D{{Key: "$lt", Value: p.Cursor.ID}}},
+		},
+	}}}
+}
+
+// NextCursor returns the token of the next page given the number of results in
+// the current page and the position of its last element.
+//
+// An empty string is returned when the current page is the last one.
+func (p *Pagination) NextCursor(count int, timestamp *time.Time, id string) string {
+	if count == 0 || int64(count) < p.Limit || timestamp == nil {
+		return ""
+	}
+	c := Cursor{Timestamp: *timestamp, ID: id}
+	return c.Encode()
+}
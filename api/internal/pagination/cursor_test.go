@@ -0,0 +1,38 @@
+package pagination
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursorEncodeDecode(t *testing.T) {
+	ts := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	c := Cursor{Timestamp: ts, ID: "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/123"}
+
+	decoded, err := DecodeCursor(c.Encode())
+	assert.NoError(t, err)
+	assert.True(t, ts.Equal(decoded.Timestamp))
+	assert.Equal(t, c.ID, decoded.ID)
+}
+
+func TestDecodeInvalidCursor(t *testing.T) {
+	for _, token := range []string{"", "not-base64!", "e30"} {
+		_, err := DecodeCursor(token)
+		assert.ErrorIs(t, err, ErrInvalidCursor, token)
+	}
+}
+
+func TestNextCursor(t *testing.T) {
+	ts := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	p := Default().SetLimit(2)
+
+	// the page is not full, so there are no more results.
+	assert.Equal(t, "", p.NextCursor(1, &ts, "id"))
+
+	next := p.NextCursor(2, &ts, "id")
+	c, err := DecodeCursor(next)
+	assert.NoError(t, err)
+	assert.Equal(t, "id", c.ID)
+}
@@ -5,6 +5,8 @@ type Pagination struct {
 	Skip      int64
 	Limit     int64
 	SortOrder string
+	// Cursor is set when the client requested keyset pagination instead of skip/limit.
+	Cursor *Cursor
 }
 
 // Default returns a `*Pagination` with default values.
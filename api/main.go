@@ -26,7 +26,9 @@ import (
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/cacheable"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/address"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/exports"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/governor"
 	guardianHandlers "github.com/wormhole-foundation/wormhole-explorer/api/handlers/guardian"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/heartbeats"
@@ -34,10 +36,13 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/observations"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/operations"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/relays"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/sourcemessages"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/stats"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/stream"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/transactions"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/vaa"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/config"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/tvl"
 	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
@@ -50,11 +55,13 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/common/coingecko"
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/export"
 	xlogger "github.com/wormhole-foundation/wormhole-explorer/common/logger"
 	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	stats2 "github.com/wormhole-foundation/wormhole-explorer/common/stats"
+	"github.com/wormhole-foundation/wormhole-explorer/common/tokenregistry"
 	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
-	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"github.com/wormhole-foundation/wormhole-explorer/common/vaaparser"
 	"go.uber.org/zap"
 )
 
@@ -92,6 +99,8 @@ func GetSwagger(ctx *fiber.Ctx) error {
 // @description guardian is the legacy namespace backguard compatible with guardian node API. The prefix is /v1.
 // @description This API is public and does not require authentication although some endpoints are rate limited.
 // @description Check each endpoint documentation for more information.
+// @description Errors have a stable machine-readable error_code (e.g. INVALID_CHAIN_ID, INVALID_ADDRESS, NOT_FOUND, TIMEOUT) mapped to the http status,
+// @description the invalid fields of the request and the request id. The gRPC API returns the same error_code in the reason of an ErrorInfo detail.
 // @termsOfService https://wormhole.com/
 // @contact.name API Support
 // @contact.url https://discord.com/invite/wormholecrypto
@@ -147,7 +156,11 @@ func main() {
 	}
 
 	// create token provider
-	tokenProvider := domain.NewTokenProvider(cfg.P2pNetwork)
+	tokenRegistry := tokenregistry.NewRegistry(tokenregistry.NewRepository(db.Database, rootLogger), tokenregistry.DefaultRefreshInterval, rootLogger)
+	if err := tokenRegistry.Start(appCtx); err != nil {
+		rootLogger.Fatal("failed to load token registry", zap.Error(err))
+	}
+	tokenProvider := domain.NewTokenProvider(cfg.P2pNetwork, domain.WithTokenRegistry(tokenRegistry))
 
 	// Set up repositories
 	rootLogger.Info("initializing repositories")
@@ -157,6 +170,7 @@ func main() {
 	governorRepo := governor.NewRepository(db.Database, rootLogger)
 	infrastructureRepo := infrastructure.NewRepository(db.Database, rootLogger)
 	heartbeatsRepo := heartbeats.NewRepository(db.Database, rootLogger)
+	sourceMessagesRepo := sourcemessages.NewRepository(db.Database, rootLogger)
 	transactionsRepo := transactions.NewRepository(
 		tvl,
 		cfg.P2pNetwork,
@@ -212,6 +226,7 @@ func main() {
 	governorService := governor.NewService(governorRepo, cache, metrics, rootLogger)
 	infrastructureService := infrastructure.NewService(infrastructureRepo, rootLogger)
 	heartbeatsService := heartbeats.NewService(heartbeatsRepo, rootLogger)
+	sourceMessagesService := sourcemessages.NewService(sourceMessagesRepo, rootLogger)
 	transactionsService := transactions.NewService(transactionsRepo, cache, expirationTime, tokenProvider, metrics, rootLogger)
 	relaysService := relays.NewService(relaysRepo, rootLogger)
 	operationsService := operations.NewService(operationsRepo, rootLogger)
@@ -219,6 +234,37 @@ func main() {
 	protocolsService := protocols.NewService(cfg.Protocols, []string{protocols.CCTP, protocols.PortalTokenBridge, protocols.NTT}, protocolsRepo, rootLogger, cache, cfg.Cache.ProtocolsStatsKey, cfg.Cache.ProtocolsStatsExpiration, metrics, tvl)
 	guardianService := guardianHandlers.NewService(guardianSetRepository, cfg.P2pNetwork, cache, metrics, rootLogger)
 
+	// Set up the live stream of vaas, operations and target transactions
+	var streamService *stream.Service
+	if cfg.Stream.Enabled {
+		rootLogger.Info("initializing stream")
+		streamService = stream.NewService(stream.NewRepository(db.Database, rootLogger), cfg.Stream.MaxResumed, rootLogger)
+		streamService.Start(appCtx)
+	}
+
+	// Set up the exports of operations
+	var exportsService *exports.Service
+	if cfg.Export.Enabled {
+		rootLogger.Info("initializing exports")
+		storage, err := export.NewStorage(export.StorageConfig{
+			Type:            cfg.Export.Storage,
+			Path:            cfg.Export.Path,
+			Endpoint:        cfg.Export.S3Endpoint,
+			Region:          cfg.Export.S3Region,
+			Bucket:          cfg.Export.S3Bucket,
+			Prefix:          cfg.Export.S3Prefix,
+			AccessKeyID:     cfg.Export.S3AccessKeyID,
+			SecretAccessKey: cfg.Export.S3SecretAccessKey,
+			PathStyle:       cfg.Export.S3PathStyle,
+		})
+		if err != nil {
+			rootLogger.Fatal("failed to initialize export storage", zap.Error(err))
+		}
+		maxRange := time.Duration(cfg.Export.MaxRangeDays) * 24 * time.Hour
+		exportsService = exports.NewService(export.NewRepository(db.Database), storage, maxRange,
+			cfg.Export.MaxPending, cfg.Export.MaxPerClientHour, rootLogger)
+	}
+
 	// Set up a custom error handler
 	response.SetEnableStackTrace(*cfg)
 	app := fiber.New(fiber.Config{
@@ -258,7 +304,7 @@ func main() {
 	notSupportedByEnv := middleware.NotSupportedByTestnetEnv(cfg.P2pNetwork)
 	// Set up route handlers
 	app.Get("/swagger.json", GetSwagger)
-	wormscan.RegisterRoutes(notSupportedByEnv, app, rootLogger, addressService, vaaService, obsService, governorService, infrastructureService, transactionsService, relaysService, operationsService, statsService, protocolsService)
+	wormscan.RegisterRoutes(notSupportedByEnv, app, rootLogger, addressService, vaaService, obsService, governorService, infrastructureService, transactionsService, relaysService, operationsService, statsService, protocolsService, streamService, exportsService, heartbeatsService, guardianService, sourceMessagesService)
 	guardian.RegisterRoutes(cfg, app, rootLogger, vaaService, governorService, heartbeatsService, guardianService)
 
 	// Set up gRPC handlers
@@ -326,7 +372,24 @@ func NewCache(ctx context.Context, cfg *config.AppConfig, logger *zap.Logger) (w
 		return nil, fmt.Errorf("failed to initialize cache client: %w", err)
 	}
 
-	return cacheClient, nil
+	// put an in-process cache in front of redis that coalesces and refreshes the loads of the results.
+	store := cacheable.NewStore(cacheClient, cacheable.StoreOptions{
+		L1Size:       cfg.Cache.L1Size,
+		RefreshAhead: 0.8,
+		StaleTTL:     cacheable.DefaultStaleTTL,
+		LoadTimeout:  30 * time.Second,
+	}, logger)
+
+	// invalidate the results computed with the notional value of the assets when new prices are published.
+	if cfg.Cache.NotionalChannel != "" {
+		channel := cfg.Cache.NotionalChannel
+		if cfg.Cache.Prefix != "" {
+			channel = fmt.Sprintf("%s:%s", cfg.Cache.Prefix, channel)
+		}
+		store.Subscribe(ctx, redisClient, channel, cacheable.TagNotional)
+	}
+
+	return store, nil
 }
 
 func newInfluxClient(url, token string) influxdb2.Client {
@@ -385,7 +448,7 @@ func NewRateLimiter(ctx context.Context, cfg *config.AppConfig, logger *zap.Logg
 			return utils.GetRealIp(c)
 		},
 		LimitReached: func(c *fiber.Ctx) error {
-			return c.SendStatus(fiber.StatusTooManyRequests)
+			return response.NewError(c, errs.New(errs.CodeRateLimited, ""))
 		},
 		Storage: store,
 	})
@@ -395,16 +458,17 @@ func NewRateLimiter(ctx context.Context, cfg *config.AppConfig, logger *zap.Logg
 }
 
 // NewVaaParserFunc returns a function to parse VAA payload.
+//
+// VAAs are parsed in-process, and the vaa-payload-parser service is used as
+// fallback for unsupported protocols when it is enabled.
 func NewVaaParserFunc(cfg *config.AppConfig, logger *zap.Logger) (vaaPayloadParser.ParseVaaFunc, error) {
 	if cfg.RunMode == config.RunModeDevelopmernt && !cfg.VaaPayloadParser.Enabled {
-		return func(vaa *sdk.VAA) (any, error) {
-			return nil, nil
-		}, nil
+		return vaaparser.New(cfg.P2pNetwork, nil, logger).ParseVaa, nil
 	}
 	vaaPayloadParserClient, err := vaaPayloadParser.NewParserVAAAPIClient(cfg.VaaPayloadParser.Timeout,
 		cfg.VaaPayloadParser.URL, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize VAA parser client: %w", err)
 	}
-	return vaaPayloadParserClient.ParseVaa, nil
+	return vaaparser.New(cfg.P2pNetwork, &vaaPayloadParserClient, logger).ParseVaa, nil
 }
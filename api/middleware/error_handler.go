@@ -18,15 +18,37 @@ import (
 // example: fiber.New(fiber.Config{ErrorHandler: errs.APIErrorHandler}
 func ErrorHandler(ctx *fiber.Ctx, err error) error {
 	var apiError response.APIError
+	var fiberError *fiber.Error
 	switch {
 	case errors.As(err, &apiError):
-		ctx.Status(apiError.StatusCode).JSON(apiError)
-	case errors.Is(err, errs.ErrNotFound):
-		apiError = response.NewNotFoundError(ctx)
-		ctx.Status(fiber.StatusNotFound).JSON(apiError)
+	case errors.As(err, &fiberError):
+		apiError = response.NewError(ctx, errs.Wrap(fiberErrorCode(fiberError.Code), fiberError.Message, err))
+		apiError.StatusCode = fiberError.Code
 	default:
-		apiError = response.NewInternalError(ctx, err)
-		ctx.Status(fiber.StatusInternalServerError).JSON(apiError)
+		apiError = response.NewError(ctx, err)
 	}
+	ctx.Status(apiError.StatusCode).JSON(apiError)
 	return nil
 }
+
+// fiberErrorCode returns the code of the error catalogue of the http status of a fiber error.
+func fiberErrorCode(status int) errs.Code {
+	switch status {
+	case fiber.StatusNotFound:
+		return errs.CodeNotFound
+	case fiber.StatusTooManyRequests:
+		return errs.CodeRateLimited
+	case fiber.StatusRequestTimeout, fiber.StatusGatewayTimeout:
+		return errs.CodeTimeout
+	case fiber.StatusNotImplemented:
+		return errs.CodeNotImplemented
+	case fiber.StatusServiceUnavailable:
+		return errs.CodeUnavailable
+	case fiber.StatusRequestEntityTooLarge, fiber.StatusUnsupportedMediaType, fiber.StatusUnprocessableEntity:
+		return errs.CodeInvalidBody
+	}
+	if status >= fiber.StatusBadRequest && status < fiber.StatusInternalServerError {
+		return errs.CodeInvalidParam
+	}
+	return errs.CodeInternal
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
 )
@@ -19,7 +20,7 @@ func ExtractPagination(ctx *fiber.Ctx) (*pagination.Pagination, error) {
 		n, err := strconv.ParseInt(param, 10, 64)
 		if err != nil || n < 0 {
 			msg := `parameter 'page' must be a non-negative integer`
-			return nil, response.NewInvalidParamError(ctx, msg, err)
+			return nil, response.NewFieldError(ctx, errs.CodeInvalidPagination, msg, err, "page", param, "must be a non-negative integer")
 		}
 		pageNumber = &n
 	}
@@ -30,7 +31,7 @@ func ExtractPagination(ctx *fiber.Ctx) (*pagination.Pagination, error) {
 		n, err := strconv.ParseInt(param, 10, 64)
 		if err != nil || n <= 0 {
 			msg := `parameter 'pageSize' must be a positive integer`
-			return nil, response.NewInvalidParamError(ctx, msg, err)
+			return nil, response.NewFieldError(ctx, errs.CodeInvalidPagination, msg, err, "pageSize", param, "must be a positive integer")
 		}
 		pageSize = &n
 	}
@@ -40,11 +41,26 @@ func ExtractPagination(ctx *fiber.Ctx) (*pagination.Pagination, error) {
 	if param := strings.ToUpper(ctx.Query("sortOrder", "DESC")); param != "" {
 		if param != "ASC" && param != "DESC" {
 			msg := `parameter 'sortOrder' must either be 'ASC' or 'DESC'`
-			return nil, response.NewInvalidParamError(ctx, msg, nil)
+			return nil, response.NewFieldError(ctx, errs.CodeInvalidPagination, msg, nil, "sortOrder", param, "must be ASC or DESC")
 		}
 		sortOrder = param
 	}
 
+	// get the keyset pagination cursor from query params
+	var cursor *pagination.Cursor
+	if param := ctx.Query("cursor"); param != "" {
+		if pageNumber != nil {
+			msg := `parameters 'page' and 'cursor' cannot be used at the same time`
+			return nil, response.NewFieldError(ctx, errs.CodeInvalidPagination, msg, nil, "cursor", param, "cannot be used with page")
+		}
+		c, err := pagination.DecodeCursor(param)
+		if err != nil {
+			msg := `parameter 'cursor' is not a valid cursor`
+			return nil, response.NewFieldError(ctx, errs.CodeInvalidPagination, msg, err, "cursor", param, "must be the cursor of a previous response")
+		}
+		cursor = c
+	}
+
 	// build the result and return
 	p := pagination.Default()
 	if sortOrder != "" {
@@ -56,5 +72,8 @@ func ExtractPagination(ctx *fiber.Ctx) (*pagination.Pagination, error) {
 	if pageNumber != nil {
 		p.SetSkip(p.Limit * *pageNumber)
 	}
+	if cursor != nil {
+		p.SetCursor(cursor)
+	}
 	return p, nil
 }
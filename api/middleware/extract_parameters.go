@@ -12,12 +12,19 @@ import (
 	"github.com/pkg/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/stats"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/transactions"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
 	"github.com/wormhole-foundation/wormhole-explorer/common/types"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
 
+// Reasons of the field errors shared by several parameters.
+const (
+	reasonChainID  = "must be a wormhole chain id"
+	reasonTimeSpan = "is not a supported time span"
+)
+
 // ExtractChainID get chain parameter from route path.
 func ExtractChainID(c *fiber.Ctx, l *zap.Logger) (sdk.ChainID, error) {
 
@@ -30,7 +37,7 @@ func ExtractChainID(c *fiber.Ctx, l *zap.Logger) (sdk.ChainID, error) {
 			zap.String("requestID", requestID),
 		)
 
-		return sdk.ChainIDUnset, response.NewInvalidParamError(c, "WRONG CHAIN ID", errors.WithStack(err))
+		return sdk.ChainIDUnset, response.NewFieldError(c, errs.CodeInvalidChainID, "WRONG CHAIN ID", errors.WithStack(err), "chain", c.Params("chain"), reasonChainID)
 	}
 
 	return sdk.ChainID(chain), nil
@@ -54,13 +61,20 @@ func ExtractToChain(c *fiber.Ctx, l *zap.Logger) (*sdk.ChainID, error) {
 			zap.String("requestID", requestID),
 		)
 
-		return nil, response.NewInvalidParamError(c, "INVALID TO_CHAIN VALUE", errors.WithStack(err))
+		return nil, response.NewFieldError(c, errs.CodeInvalidChainID, "INVALID TO_CHAIN VALUE", errors.WithStack(err), "toChain", param, reasonChainID)
 	}
 
 	result := sdk.ChainID(chain)
 	return &result, nil
 }
 
+// ExtractChainQueryParam parses the `chain` query parameter.
+//
+// When the parameter is not present, the function returns: a nil ChainID and a nil error.
+func ExtractChainQueryParam(c *fiber.Ctx, l *zap.Logger) (*sdk.ChainID, error) {
+	return extractChainQueryParam(c, l, "chain")
+}
+
 func ExtractSourceChain(c *fiber.Ctx, l *zap.Logger) ([]sdk.ChainID, error) {
 	param := c.Query("sourceChain")
 	if param == "" {
@@ -75,7 +89,7 @@ func ExtractSourceChain(c *fiber.Ctx, l *zap.Logger) ([]sdk.ChainID, error) {
 				zap.Error(err),
 				zap.String("requestID", requestID),
 			)
-			return nil, response.NewInvalidParamError(c, "INVALID SOURCE_CHAIN VALUE", errors.WithStack(err))
+			return nil, response.NewFieldError(c, errs.CodeInvalidChainID, "INVALID SOURCE_CHAIN VALUE", errors.WithStack(err), "sourceChain", val, reasonChainID)
 		}
 		result = append(result, chain)
 	}
@@ -96,7 +110,31 @@ func ExtractTargetChain(c *fiber.Ctx, l *zap.Logger) ([]sdk.ChainID, error) {
 				zap.Error(err),
 				zap.String("requestID", requestID),
 			)
-			return nil, response.NewInvalidParamError(c, "INVALID TARGET_CHAIN VALUE", errors.WithStack(err))
+			return nil, response.NewFieldError(c, errs.CodeInvalidChainID, "INVALID TARGET_CHAIN VALUE", errors.WithStack(err), "targetChain", val, reasonChainID)
+		}
+		result = append(result, chain)
+	}
+	return result, nil
+}
+
+// ExtractChains parses the comma-separated `chain` query parameter.
+//
+// When the parameter is not present, the function returns: a nil slice and a nil error.
+func ExtractChains(c *fiber.Ctx, l *zap.Logger) ([]sdk.ChainID, error) {
+	param := c.Query("chain")
+	if param == "" {
+		return nil, nil
+	}
+	result := make([]sdk.ChainID, 0, len(param))
+	for _, val := range strings.Split(param, ",") {
+		chain, err := parseChainIDParam(val)
+		if err != nil {
+			requestID := fmt.Sprintf("%v", c.Locals("requestid"))
+			l.Error("failed to parse chain parameter",
+				zap.Error(err),
+				zap.String("requestID", requestID),
+			)
+			return nil, response.NewFieldError(c, errs.CodeInvalidChainID, "INVALID CHAIN VALUE", errors.WithStack(err), "chain", val, reasonChainID)
 		}
 		result = append(result, chain)
 	}
@@ -124,7 +162,7 @@ func extractChainQueryParam(c *fiber.Ctx, l *zap.Logger, queryParam string) (*sd
 			zap.String("requestID", requestID),
 		)
 
-		return nil, response.NewInvalidParamError(c, "INVALID CHAIN VALUE", errors.WithStack(err))
+		return nil, response.NewFieldError(c, errs.CodeInvalidChainID, "INVALID CHAIN VALUE", errors.WithStack(err), queryParam, param, reasonChainID)
 	}
 	result := sdk.ChainID(chain)
 	return &result, nil
@@ -155,7 +193,7 @@ func ExtractEmitterAddr(c *fiber.Ctx, l *zap.Logger, chainIdHint *sdk.ChainID) (
 			zap.String("emitterStr", emitterStr),
 			zap.String("requestID", requestID),
 		)
-		return nil, response.NewInvalidParamError(c, "MALFORMED EMITTER_ADDR", errors.WithStack(err))
+		return nil, response.NewFieldError(c, errs.CodeInvalidAddress, "MALFORMED EMITTER_ADDR", errors.WithStack(err), "emitter", emitterStr, "must be a wormhole address or a native address of the chain")
 	}
 
 	return emitter, nil
@@ -174,7 +212,7 @@ func ExtractSequence(c *fiber.Ctx, l *zap.Logger) (uint64, error) {
 			zap.String("sequence", sequence),
 			zap.String("requestID", requestID),
 		)
-		return 0, response.NewInvalidParamError(c, "MALFORMED SEQUENCE NUMBER", errors.WithStack(err))
+		return 0, response.NewFieldError(c, errs.CodeInvalidSequence, "MALFORMED SEQUENCE NUMBER", errors.WithStack(err), "sequence", sequence, "must be an unsigned integer")
 	}
 
 	return seq, nil
@@ -186,7 +224,7 @@ func ExtractGuardianAddress(c *fiber.Ctx, l *zap.Logger) (*types.Address, error)
 	// read the address from query params
 	tmp := c.Params("guardian_address")
 	if tmp == "" {
-		return nil, response.NewInvalidParamError(c, "MALFORMED GUARDIAN ADDR", nil)
+		return nil, response.NewFieldError(c, errs.CodeInvalidAddress, "MALFORMED GUARDIAN ADDR", nil, "guardian_address", tmp, "is required")
 	}
 
 	// Attempt to parse the address
@@ -197,7 +235,7 @@ func ExtractGuardianAddress(c *fiber.Ctx, l *zap.Logger) (*types.Address, error)
 			zap.Error(err),
 			zap.String("requestID", requestID),
 		)
-		return nil, response.NewInvalidParamError(c, "MALFORMED GUARDIAN ADDR", errors.WithStack(err))
+		return nil, response.NewFieldError(c, errs.CodeInvalidAddress, "MALFORMED GUARDIAN ADDR", errors.WithStack(err), "guardian_address", tmp, "must be a guardian address")
 	}
 
 	return guardianAddress, nil
@@ -253,7 +291,7 @@ func ExtractObservationSigner(c *fiber.Ctx, l *zap.Logger) (*sdk.Address, error)
 			zap.String("signer", signer),
 			zap.String("requestID", requestID),
 		)
-		return nil, response.NewInvalidParamError(c, "MALFORMED SIGNER", errors.WithStack(err))
+		return nil, response.NewFieldError(c, errs.CodeInvalidAddress, "MALFORMED SIGNER", errors.WithStack(err), "signer", signer, "must be a wormhole address")
 	}
 
 	return &signerAddr, nil
@@ -264,7 +302,7 @@ func ExtractObservationHash(c *fiber.Ctx, l *zap.Logger) (string, error) {
 
 	hash := c.Params("hash")
 	if hash == "" {
-		return "", response.NewInvalidParamError(c, "MALFORMED HASH", nil)
+		return "", response.NewFieldError(c, errs.CodeInvalidHash, "MALFORMED HASH", nil, "hash", hash, "is required")
 	}
 
 	return hash, nil
@@ -303,7 +341,7 @@ func GetTxHash(c *fiber.Ctx, l *zap.Logger) (*types.TxHash, error) {
 			zap.String("txHash", value),
 			zap.String("requestID", requestID),
 		)
-		return nil, response.NewInvalidParamError(c, "MALFORMED TX HASH", errors.WithStack(err))
+		return nil, response.NewFieldError(c, errs.CodeInvalidHash, "MALFORMED TX HASH", errors.WithStack(err), "txHash", value, "must be a transaction hash")
 	}
 
 	return txHash, nil
@@ -316,7 +354,7 @@ func ExtractParsedPayload(c *fiber.Ctx, l *zap.Logger) (bool, error) {
 
 	parsedPayload, err := strconv.ParseBool(parsedPayloadStr)
 	if err != nil {
-		return false, response.NewInvalidQueryParamError(c, "INVALID <parsedPayload> QUERY PARAMETER", errors.WithStack(err))
+		return false, response.NewFieldError(c, errs.CodeInvalidParam, "INVALID <parsedPayload> QUERY PARAMETER", errors.WithStack(err), "parsedPayload", parsedPayloadStr, "must be a boolean")
 	}
 
 	return parsedPayload, nil
@@ -340,7 +378,7 @@ func ExtractTimeSpan(c *fiber.Ctx, l *zap.Logger) (string, error) {
 
 	// validate the timeSpan
 	if !isValidTimeSpan(timeSpanStr) {
-		return "", response.NewInvalidQueryParamError(c, "INVALID <timeSpan> QUERY PARAMETER", nil)
+		return "", response.NewFieldError(c, errs.CodeInvalidTimeSpan, "INVALID <timeSpan> QUERY PARAMETER", nil, "timeSpan", timeSpanStr, "must be one of 1d, 1w, 1mo")
 	}
 	return timeSpanStr, nil
 }
@@ -356,7 +394,7 @@ func ExtractSampleRate(c *fiber.Ctx, l *zap.Logger) (string, error) {
 
 	// validate the sampleRate
 	if !isValidSampleRate(sampleRateStr) {
-		return "", response.NewInvalidQueryParamError(c, "INVALID <sampleRate> QUERY PARAMETER", nil)
+		return "", response.NewFieldError(c, errs.CodeInvalidTimeSpan, "INVALID <sampleRate> QUERY PARAMETER", nil, "sampleRate", sampleRateStr, "must be one of 1h, 1d")
 	}
 	return sampleRateStr, nil
 }
@@ -378,15 +416,15 @@ func ExtractTimeSpanAndSampleRate(c *fiber.Ctx, l *zap.Logger) (string, string,
 	switch timeSpan {
 	case "1d":
 		if sampleRate != "1h" {
-			return "", "", response.NewInvalidQueryParamError(c, "INVALID CONFIGURATION <timeSpan>, <sampleRate> QUERY PARAMETERS.", nil)
+			return "", "", response.NewFieldError(c, errs.CodeInvalidTimeSpan, "INVALID CONFIGURATION <timeSpan>, <sampleRate> QUERY PARAMETERS.", nil, "sampleRate", sampleRate, "must be 1h when timeSpan is 1d")
 		}
 	case "1w":
 		if sampleRate != "1d" {
-			return "", "", response.NewInvalidQueryParamError(c, "INVALID CONFIGURATION <timeSpan>, <sampleRate> QUERY PARAMETERS", nil)
+			return "", "", response.NewFieldError(c, errs.CodeInvalidTimeSpan, "INVALID CONFIGURATION <timeSpan>, <sampleRate> QUERY PARAMETERS", nil, "sampleRate", sampleRate, "must be 1d when timeSpan is 1w")
 		}
 	case "1mo":
 		if sampleRate != "1d" {
-			return "", "", response.NewInvalidQueryParamError(c, "INVALID CONFIGURATION <timeSpan>, <sampleRate> QUERY PARAMETERS", nil)
+			return "", "", response.NewFieldError(c, errs.CodeInvalidTimeSpan, "INVALID CONFIGURATION <timeSpan>, <sampleRate> QUERY PARAMETERS", nil, "sampleRate", sampleRate, "must be 1d when timeSpan is 1mo")
 		}
 	}
 
@@ -401,7 +439,7 @@ func ExtractTime(c *fiber.Ctx, timeLayout, queryParam string) (*time.Time, error
 	}
 	t, err := time.Parse(timeLayout, date)
 	if err != nil {
-		return nil, response.NewInvalidQueryParamError(c, fmt.Sprintf("INVALID <%s> QUERY PARAMETER", queryParam), nil)
+		return nil, response.NewFieldError(c, errs.CodeInvalidParam, fmt.Sprintf("INVALID <%s> QUERY PARAMETER", queryParam), nil, queryParam, date, "must have the format "+timeLayout)
 	}
 	return &t, nil
 }
@@ -409,7 +447,7 @@ func ExtractTime(c *fiber.Ctx, timeLayout, queryParam string) (*time.Time, error
 func ExtractSymbol(c *fiber.Ctx) (string, error) {
 	symbol := c.Query("symbol")
 	if symbol == "" {
-		return "", response.NewInvalidQueryParamError(c, "INVALID <symbol> QUERY PARAMETER", nil)
+		return "", response.NewFieldError(c, errs.CodeInvalidParam, "INVALID <symbol> QUERY PARAMETER", nil, "symbol", symbol, "is required")
 	}
 	return symbol, nil
 }
@@ -433,14 +471,14 @@ func ExtractIsNotional(ctx *fiber.Ctx) (bool, error) {
 	if by == "tx" {
 		return false, nil
 	}
-	return false, response.NewInvalidQueryParamError(ctx, "INVALID <by> QUERY PARAMETER", nil)
+	return false, response.NewFieldError(ctx, errs.CodeInvalidParam, "INVALID <by> QUERY PARAMETER", nil, "by", by, "must be one of notional, tx")
 }
 
 func ExtractChainActivityTimeSpan(ctx *fiber.Ctx) (transactions.ChainActivityTimeSpan, error) {
 	s := ctx.Query("timeSpan", string(transactions.ChainActivityTs7Days))
 	timeSpan, err := transactions.ParseChainActivityTimeSpan(s)
 	if err != nil {
-		return "", response.NewInvalidQueryParamError(ctx, "INVALID <timeSpan> QUERY PARAMETER", nil)
+		return "", response.NewFieldError(ctx, errs.CodeInvalidTimeSpan, "INVALID <timeSpan> QUERY PARAMETER", nil, "timeSpan", s, reasonTimeSpan)
 	}
 	return timeSpan, nil
 }
@@ -455,7 +493,7 @@ func ExtractTopStatisticsTimeSpan(ctx *fiber.Ctx) (*transactions.TopStatisticsTi
 	s := ctx.Query("timeSpan")
 	timeSpan, err := transactions.ParseTopStatisticsTimeSpan(s)
 	if err != nil {
-		return nil, response.NewInvalidQueryParamError(ctx, "INVALID <timeSpan> QUERY PARAMETER", nil)
+		return nil, response.NewFieldError(ctx, errs.CodeInvalidTimeSpan, "INVALID <timeSpan> QUERY PARAMETER", nil, "timeSpan", s, reasonTimeSpan)
 	}
 
 	return timeSpan, nil
@@ -472,7 +510,7 @@ func ExtractTokenAddress(c *fiber.Ctx, l *zap.Logger) (*types.Address, error) {
 			zap.String("token_address", strTokenAddress),
 			zap.String("requestID", requestID),
 		)
-		return nil, response.NewInvalidParamError(c, "MALFORMED TOKEN_ADDRESS", errors.WithStack(err))
+		return nil, response.NewFieldError(c, errs.CodeInvalidAddress, "MALFORMED TOKEN_ADDRESS", errors.WithStack(err), "token_address", strTokenAddress, "must be a wormhole address or a native address of the token")
 	}
 	return tokenAddress, nil
 }
@@ -485,7 +523,7 @@ func ExtractSymbolWithAssetsTimeSpan(ctx *fiber.Ctx) (*stats.SymbolWithAssetsTim
 	}
 	timeSpan, err := stats.ParseSymbolsWithAssetsTimeSpan(s)
 	if err != nil {
-		return nil, response.NewInvalidQueryParamError(ctx, "INVALID <timeSpan> QUERY PARAMETER", nil)
+		return nil, response.NewFieldError(ctx, errs.CodeInvalidTimeSpan, "INVALID <timeSpan> QUERY PARAMETER", nil, "timeSpan", s, reasonTimeSpan)
 	}
 
 	return timeSpan, nil
@@ -499,7 +537,7 @@ func ExtractTopCorridorsTimeSpan(ctx *fiber.Ctx) (*stats.TopCorridorsTimeSpan, e
 	}
 	timeSpan, err := stats.ParseTopCorridorsTimeSpan(s)
 	if err != nil {
-		return nil, response.NewInvalidQueryParamError(ctx, "INVALID <timeSpan> QUERY PARAMETER", nil)
+		return nil, response.NewFieldError(ctx, errs.CodeInvalidTimeSpan, "INVALID <timeSpan> QUERY PARAMETER", nil, "timeSpan", s, reasonTimeSpan)
 	}
 
 	return timeSpan, nil
@@ -510,7 +548,7 @@ func ExtractNttTimeSpan(ctx *fiber.Ctx) (*stats.NttTimespan, error) {
 	s := ctx.Query("timeSpan")
 	timeSpan, err := stats.ParseNttTimespan(s)
 	if err != nil {
-		return nil, response.NewInvalidQueryParamError(ctx, "INVALID <timeSpan> QUERY PARAMETER", nil)
+		return nil, response.NewFieldError(ctx, errs.CodeInvalidTimeSpan, "INVALID <timeSpan> QUERY PARAMETER", nil, "timeSpan", s, reasonTimeSpan)
 	}
 
 	return timeSpan, nil
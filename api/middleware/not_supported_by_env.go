@@ -2,15 +2,15 @@ package middleware
 
 import (
 	"github.com/gofiber/fiber/v2"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/response"
 )
 
 // ExtractPagination parses pagination-related query parameters.
 func NotSupportedByTestnetEnv(p2pNetwork string) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		if p2pNetwork == "testnet" {
-			return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-				"error": "Not implemented by the testnet environment",
-			})
+			return response.NewError(c, errs.New(errs.CodeNotImplemented, "Not implemented by the testnet environment"))
 		}
 		return c.Next()
 	}
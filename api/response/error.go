@@ -8,6 +8,7 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/config"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 )
 
 // API error codes. These error code are the same used in guardian API.
@@ -45,11 +46,16 @@ func SetEnableStackTrace(cfg config.AppConfig) {
 
 // APIError api error response.
 // This structure is defined to be aligned with the way the guardian API handles the error response.
+//
+// Code is the numeric gRPC code of the error, as in the guardian API, and ErrorCode is the stable
+// code of the error catalogue that clients should use to tell errors apart.
 type APIError struct {
-	StatusCode int           `json:"-"`
-	Code       int           `json:"code"` // support to guardian-api code.
-	Message    string        `json:"message"`
-	Details    []ErrorDetail `json:"details"`
+	StatusCode int               `json:"-"`
+	Code       int               `json:"code"` // support to guardian-api code.
+	ErrorCode  errs.Code         `json:"error_code"`
+	Message    string            `json:"message"`
+	Fields     []errs.FieldError `json:"fields,omitempty"`
+	Details    []ErrorDetail     `json:"details"`
 }
 
 // ErrorDetail definition.
@@ -61,11 +67,14 @@ type ErrorDetail struct {
 
 // Error interface implementation.
 func (a APIError) Error() string {
-	return fmt.Sprintf("code: %d, message: %s, details: %v", a.Code, a.Message, a.Details)
+	return fmt.Sprintf("code: %d, error_code: %s, message: %s, details: %v", a.Code, a.ErrorCode, a.Message, a.Details)
 }
 
-// NewApiError create a new api response.
-func NewApiError(ctx *fiber.Ctx, statusCode, code int, message string, err error) APIError {
+// newAPIError create an api error response from a code of the error catalogue.
+func newAPIError(ctx *fiber.Ctx, code errs.Code, message string, err error, fields []errs.FieldError) APIError {
+	if message == "" {
+		message = code.Message()
+	}
 	detail := ErrorDetail{
 		RequestID: fmt.Sprintf("%v", ctx.Locals("requestid")),
 	}
@@ -73,58 +82,71 @@ func NewApiError(ctx *fiber.Ctx, statusCode, code int, message string, err error
 		detail.StackTrace = fmt.Sprintf("%+v\n", err)
 	}
 	return APIError{
-		StatusCode: statusCode,
-		Code:       code,
+		StatusCode: code.HTTPStatus(),
+		Code:       int(code.GRPCCode()),
+		ErrorCode:  code,
 		Message:    message,
+		Fields:     fields,
 		Details:    []ErrorDetail{detail},
 	}
 }
 
+// NewError create a new api error response from any error.
+// The errors that are not of the error catalogue are classified by errs.CodeOf.
+func NewError(ctx *fiber.Ctx, err error) APIError {
+	e := errs.As(err)
+	return newAPIError(ctx, e.Code, e.Message, e.Err, e.Fields)
+}
+
+// NewFieldError create a new api error response for an invalid field of the request.
+func NewFieldError(ctx *fiber.Ctx, code errs.Code, message string, err error, field, value, reason string) APIError {
+	return newAPIError(ctx, code, message, err, []errs.FieldError{{Field: field, Value: value, Reason: reason}})
+}
+
+// NewApiError create a new api response.
+func NewApiError(ctx *fiber.Ctx, statusCode, code int, message string, err error) APIError {
+	apiError := newAPIError(ctx, errorCodeOf(code), message, err, nil)
+	apiError.StatusCode = statusCode
+	apiError.Code = code
+	return apiError
+}
+
+// errorCodeOf returns the code of the error catalogue of a guardian-api code.
+func errorCodeOf(code int) errs.Code {
+	switch code {
+	case InvalidParam, OutOfRange:
+		return errs.CodeInvalidParam
+	case NotFound:
+		return errs.CodeNotFound
+	case DeadlineExceeded:
+		return errs.CodeTimeout
+	case ResourceExhausted:
+		return errs.CodeRateLimited
+	case Unimplemented:
+		return errs.CodeNotImplemented
+	case Unavailable:
+		return errs.CodeUnavailable
+	default:
+		return errs.CodeInternal
+	}
+}
+
 // NewInvalidParamError create a invalid param Error.
 func NewInvalidParamError(ctx *fiber.Ctx, message string, err error) APIError {
 	if message == "" {
 		message = "INVALID PARAM"
 	}
-	detail := ErrorDetail{
-		RequestID: fmt.Sprintf("%v", ctx.Locals("requestid")),
-	}
-	if enableStackTrace && err != nil {
-		detail.StackTrace = fmt.Sprintf("%+v\n", err)
-	}
-	return APIError{
-		StatusCode: fiber.StatusBadRequest,
-		Code:       InvalidParam,
-		Message:    message,
-		Details:    []ErrorDetail{detail},
-	}
+	return newAPIError(ctx, errs.CodeInvalidParam, message, err, nil)
 }
 
 // NewInternalError create a new APIError for Internal Errors.
 func NewInternalError(ctx *fiber.Ctx, err error) APIError {
-	detail := ErrorDetail{
-		RequestID: fmt.Sprintf("%v", ctx.Locals("requestid")),
-	}
-	if enableStackTrace && err != nil {
-		detail.StackTrace = fmt.Sprintf("%+v\n", err)
-	}
-	return APIError{
-		StatusCode: fiber.StatusInternalServerError,
-		Code:       Internal,
-		Message:    "INTERNAL ERROR",
-		Details:    []ErrorDetail{detail},
-	}
+	return newAPIError(ctx, errs.CodeInternal, "INTERNAL ERROR", err, nil)
 }
 
 // NewNotFoundError create a new APIError for Not Found errors.
 func NewNotFoundError(ctx *fiber.Ctx) APIError {
-	return APIError{
-		StatusCode: fiber.StatusNotFound,
-		Code:       NotFound,
-		Message:    "NOT FOUND",
-		Details: []ErrorDetail{{
-			RequestID: fmt.Sprintf("%v", ctx.Locals("requestid")),
-		}},
-	}
+	return newAPIError(ctx, errs.CodeNotFound, "NOT FOUND", nil, nil)
 }
 
 // NewInvalidQueryParamError create a query param error
@@ -132,34 +154,12 @@ func NewInvalidQueryParamError(ctx *fiber.Ctx, message string, err error) APIErr
 	if message == "" {
 		message = "INVALID QUERY PARAM"
 	}
-	detail := ErrorDetail{
-		RequestID: fmt.Sprintf("%v", ctx.Locals("requestid")),
-	}
-	if enableStackTrace && err != nil {
-		detail.StackTrace = fmt.Sprintf("%+v\n", err)
-	}
-	return APIError{
-		StatusCode: fiber.StatusBadRequest,
-		Code:       InvalidParam,
-		Message:    message,
-		Details:    []ErrorDetail{detail},
-	}
+	return newAPIError(ctx, errs.CodeInvalidParam, message, err, nil)
 }
 
 func NewRequestBodyError(ctx *fiber.Ctx, message string, err error) APIError {
 	if message == "" {
 		message = "INVALID BODY"
 	}
-	detail := ErrorDetail{
-		RequestID: fmt.Sprintf("%v", ctx.Locals("requestid")),
-	}
-	if enableStackTrace && err != nil {
-		detail.StackTrace = fmt.Sprintf("%+v\n", err)
-	}
-	return APIError{
-		StatusCode: fiber.StatusBadRequest,
-		Code:       InvalidParam,
-		Message:    message,
-		Details:    []ErrorDetail{detail},
-	}
+	return newAPIError(ctx, errs.CodeInvalidBody, message, err, nil)
 }
@@ -44,8 +44,8 @@ type AvailableNotionalItemResponse struct {
 // @Tags Guardian
 // @ID governor-available-notional-by-chain
 // @Success 200 {object} AvailableNotionalResponse
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /v1/governor/available_notional_by_chain [get]
 func (c *Controller) GetAvailNotionByChain(ctx *fiber.Ctx) error {
 	// call service to get available notional by chainID
@@ -79,7 +79,7 @@ type EnqueuedVaaResponse struct {
 type EnqueuedVaaItemResponse struct {
 	EmitterChain   vaa.ChainID `json:"emitterChain"`
 	EmitterAddress string      `json:"emitterAddress"`
-	Sequence       uint64      `json:"sequence"`
+	Sequence       uint64      `json:"sequence,string"`
 	ReleaseTime    int64       `json:"releaseTime"`
 	NotionalValue  string      `json:"notionalValue"`
 	TxHash         string      `json:"txHash"`
@@ -90,8 +90,8 @@ type EnqueuedVaaItemResponse struct {
 // @Tags Guardian
 // @ID guardians-enqueued-vaas
 // @Success 200 {object} EnqueuedVaaResponse
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /v1/governor/enqueued_vaas [get]
 func (c *Controller) GetEnqueuedVaas(ctx *fiber.Ctx) error {
 	enqueuedVaa, err := c.srv.GetEnqueuedVaas(ctx.Context())
@@ -131,8 +131,8 @@ func (c *Controller) GetEnqueuedVaas(ctx *fiber.Ctx) error {
 // @Param emitter path string true "address of the emitter"
 // @Param seq path integer true "sequence of the vaa"
 // @Success 200 {object} EnqueuedVaaResponse
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /v1/governor/is_vaa_enqueued/:chain_id/:emitter/:seq [get]
 func (c *Controller) IsVaaEnqueued(ctx *fiber.Ctx) error {
 	chainID, emitter, seq, err := middleware.ExtractVAAParams(ctx, c.logger)
@@ -162,8 +162,8 @@ func (c *Controller) IsVaaEnqueued(ctx *fiber.Ctx) error {
 // @Tags Guardian
 // @ID guardians-token-list
 // @Success 200 {object} []governor.TokenList
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /v1/governor/token_list [get]
 func (c *Controller) GetTokenList(ctx *fiber.Ctx) error {
 	tokenList, err := c.srv.GetTokenList(ctx.Context())
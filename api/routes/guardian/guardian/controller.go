@@ -1,8 +1,12 @@
 package guardian
 
 import (
+	"strconv"
+
+	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/gofiber/fiber/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/guardian"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
 	"go.uber.org/zap"
 )
@@ -37,37 +41,76 @@ type GuardianSet struct {
 // @Tags Guardian
 // @ID guardian-set
 // @Success 200 {object} GuardianSetResponse
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /v1/guardianset/current [get]
 func (c *Controller) GetGuardianSet(ctx *fiber.Ctx) error {
+	gs, err := c.getGuardianSet(ctx)
+	if err != nil {
+		return err
+	}
+
+	// get lasted guardianSet.
+	return ctx.Status(fiber.StatusOK).JSON(newGuardianSetResponse(gs.GetLatest()))
+}
+
+// GetGuardianSetByIndex godoc
+// @Description Get a guardian set by index.
+// @Tags Guardian
+// @ID guardian-set-by-index
+// @Param index path integer true "index of the guardian set"
+// @Success 200 {object} GuardianSetResponse
+// @Failure 400 {object} response.APIError
+// @Failure 404 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /v1/guardianset/:index [get]
+func (c *Controller) GetGuardianSetByIndex(ctx *fiber.Ctx) error {
+	param := ctx.Params("index")
+	index, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		return response.NewFieldError(ctx, errs.CodeInvalidParam, "INVALID GUARDIAN SET INDEX", err, "index", param, "must be a non-negative integer")
+	}
+
+	gs, err := c.getGuardianSet(ctx)
+	if err != nil {
+		return err
+	}
+
+	guardianSet, ok := gs.GetByIndex(uint32(index))
+	if !ok {
+		return response.NewNotFoundError(ctx)
+	}
+	return ctx.Status(fiber.StatusOK).JSON(newGuardianSetResponse(guardianSet))
+}
+
+// getGuardianSet get the guardian sets, or an api error if they were not fetched from chain yet.
+func (c *Controller) getGuardianSet(ctx *fiber.Ctx) (*guardian.GuardianSet, error) {
 	gs, err := c.gsSrv.GetGuardianSet(ctx.Context())
 	if err != nil {
 		c.logger.Error("failed to get guardian set", zap.Error(err))
-		return response.NewApiError(ctx, fiber.StatusInternalServerError, response.Internal,
+		return nil, response.NewApiError(ctx, fiber.StatusInternalServerError, response.Internal,
 			"failed to get guardian set", err)
 	}
 	// check guardianSet exists.
 	if len(gs.GstByIndex) == 0 {
-		return response.NewApiError(ctx, fiber.StatusServiceUnavailable, response.Unavailable,
+		return nil, response.NewApiError(ctx, fiber.StatusServiceUnavailable, response.Unavailable,
 			"guardian set not fetched from chain yet", nil)
 	}
+	return gs, nil
+}
 
-	// get lasted guardianSet.
-	guardinSet := gs.GetLatest()
-
+// newGuardianSetResponse create a guardian set response compatible with the guardian API.
+func newGuardianSetResponse(gs common.GuardianSet) GuardianSetResponse {
 	// get guardian addresses.
-	addresses := make([]string, len(guardinSet.Keys))
-	for i, v := range guardinSet.Keys {
+	addresses := make([]string, len(gs.Keys))
+	for i, v := range gs.Keys {
 		addresses[i] = v.Hex()
 	}
 
-	// create response.
-	response := GuardianSetResponse{
+	return GuardianSetResponse{
 		GuardianSet: GuardianSet{
-			Index:     guardinSet.Index,
+			Index:     gs.Index,
 			Addresses: addresses,
 		},
 	}
-	return ctx.Status(fiber.StatusOK).JSON(response)
 }
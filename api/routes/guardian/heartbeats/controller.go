@@ -46,6 +46,7 @@ type RawHeartbeat struct {
 	GuardianAddr  string                      `json:"guardianAddr"`
 	BootTimestamp string                      `json:"bootTimestamp"`
 	Features      []string                    `json:"features"`
+	P2PNodeID     string                      `json:"p2pNodeId"` // not exists in heartbeats mongo collection.
 }
 
 // HeartbeatNetwork definition.
@@ -54,6 +55,8 @@ type HeartbeatNetworkResponse struct {
 	Height          string `bson:"height" json:"height"`
 	ContractAddress string `bson:"contractaddress" json:"contractAddress"`
 	ErrorCount      string `bson:"errorcount" json:"errorCount"`
+	SafeHeight      string `bson:"safeheight" json:"safeHeight"`
+	FinalizedHeight string `bson:"finalizedheight" json:"finalizedHeight"`
 }
 
 // GetGuardianSet godoc
@@ -61,8 +64,8 @@ type HeartbeatNetworkResponse struct {
 // @Tags Guardian
 // @ID guardians-hearbeats
 // @Success 200 {object} HeartbeatsResponse
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /v1/heartbeats [get]
 func (c *Controller) GetLastHeartbeats(ctx *fiber.Ctx) error {
 
@@ -101,9 +104,6 @@ func (c *Controller) GetLastHeartbeats(ctx *fiber.Ctx) error {
 }
 
 func buildHeartbeatResponse(heartbeats []*heartbeats.HeartbeatDoc) *HeartbeatsResponse {
-	if heartbeats == nil {
-		return nil
-	}
 	heartbeatResponses := make([]*HeartbeatResponse, 0, len(heartbeats))
 	for _, heartbeat := range heartbeats {
 
@@ -114,10 +114,18 @@ func buildHeartbeatResponse(heartbeats []*heartbeats.HeartbeatDoc) *HeartbeatsRe
 				Height:          strconv.Itoa(int(network.Height)),
 				ContractAddress: network.ContractAddress,
 				ErrorCount:      strconv.Itoa(int(network.ErrorCount)),
+				SafeHeight:      strconv.Itoa(int(network.SafeHeight)),
+				FinalizedHeight: strconv.Itoa(int(network.FinalizedHeight)),
 			}
 			networkResponses = append(networkResponses, networkResponse)
 		}
 
+		// the guardian API renders the empty repeated fields as empty arrays.
+		features := heartbeat.Features
+		if features == nil {
+			features = []string{}
+		}
+
 		hr := HeartbeatResponse{
 			VerifiedGuardianAddr: heartbeat.ID,
 			P2PNodeAddr:          "", // not exists in heartbeats mongo collection.
@@ -129,7 +137,7 @@ func buildHeartbeatResponse(heartbeats []*heartbeats.HeartbeatDoc) *HeartbeatsRe
 				Version:       heartbeat.Version,
 				GuardianAddr:  heartbeat.GuardianAddr,
 				BootTimestamp: strconv.Itoa(int(heartbeat.BootTimestamp)),
-				Features:      heartbeat.Features,
+				Features:      features,
 			},
 		}
 		heartbeatResponses = append(heartbeatResponses, &hr)
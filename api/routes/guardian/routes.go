@@ -43,6 +43,7 @@ func RegisterRoutes(
 	// guardianSet resource
 	guardianSet := apiV1.Group("/guardianset")
 	guardianSet.Get("/current", guardianCtrl.GetGuardianSet)
+	guardianSet.Get("/:index", guardianCtrl.GetGuardianSetByIndex)
 
 	// heartbeats resource
 	heartbeats := apiV1.Group("/heartbeats")
@@ -6,8 +6,10 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/vaa"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/common/types"
 	"go.uber.org/zap"
 )
 
@@ -30,8 +32,8 @@ func NewController(serv *vaa.Service, logger *zap.Logger) *Controller {
 // @Param emitter path string true "address of the emitter"
 // @Param seq path integer true "sequence of the VAA"
 // @Success 200 {object} object{vaaBytes=[]byte}
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /v1/signed_vaa/:chain_id/:emitter/:seq [get]
 func (c *Controller) FindSignedVAAByID(ctx *fiber.Ctx) error {
 
@@ -66,16 +68,43 @@ func (c *Controller) FindSignedVAAByID(ctx *fiber.Ctx) error {
 }
 
 // FindSignedBatchVAAByID godoc
-// @Description get a batch of VAA []byte from a chainID, emitter address and sequence.
+// @Description get the batch of VAA []byte emitted with a nonce by a transaction, from a chainID, transaction ID and nonce.
+// @Description Batch VAAs are not signed by the guardians, so the batch is made of the signed VAAs of its messages, sorted by sequence.
 // @Tags Guardian
 // @ID guardians-find-signed-batch-vaa
 // @Param chain_id path integer true "id of the blockchain"
-// @Param emitter path string true "address of the emitter"
-// @Param seq path integer true "sequence of the VAA"
-// @Success 200 {object} object{vaaBytes=[]byte}
-// @Failure 400
-// @Failure 500
-// @Router /v1/signed_batch_vaa/:chain_id/:emitter/sequence/:seq [get]
+// @Param trx_id path string true "id of the transaction"
+// @Param nonce path integer true "nonce of the batch"
+// @Success 200 {object} object{vaasBytes=[][]byte}
+// @Failure 400 {object} response.APIError
+// @Failure 404 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /v1/signed_batch_vaa/:chain_id/:trx_id/:nonce [get]
 func (c *Controller) FindSignedBatchVAAByID(ctx *fiber.Ctx) error {
-	return response.NewApiError(ctx, fiber.StatusNotImplemented, response.Unimplemented, "not yet implemented", nil)
+	chainID, err := middleware.ExtractChainID(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+	txHash, err := types.ParseTxHash(ctx.Params("trxID"))
+	if err != nil {
+		return response.NewFieldError(ctx, errs.CodeInvalidHash, "MALFORMED TX HASH", err, "trxID", ctx.Params("trxID"), "must be a transaction hash")
+	}
+	nonce, err := strconv.ParseUint(ctx.Params("nonce"), 10, 32)
+	if err != nil {
+		return response.NewFieldError(ctx, errs.CodeInvalidParam, "INVALID NONCE", err, "nonce", ctx.Params("nonce"), "must be a 32 bits unsigned integer")
+	}
+
+	vaas, err := c.srv.FindBatch(ctx.Context(), chainID, txHash, uint32(nonce))
+	if err != nil {
+		return err
+	}
+	response := struct {
+		VaasBytes [][]byte `json:"vaasBytes"`
+	}{
+		VaasBytes: make([][]byte, 0, len(vaas)),
+	}
+	for _, v := range vaas {
+		response.VaasBytes = append(response.VaasBytes, v.Vaa)
+	}
+	return ctx.JSON(response)
 }
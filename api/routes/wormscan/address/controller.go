@@ -1,6 +1,8 @@
 package address
 
 import (
+	stderrors "errors"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/address"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
@@ -33,9 +35,9 @@ func NewController(srv *address.Service, logger *zap.Logger) *Controller {
 // @Param page query integer false "Page number. Starts at 0."
 // @Param pageSize query integer false "Number of elements per page."
 // @Success 200 {object} response.Response[address.AddressOverview]
-// @Failure 400
-// @Failure 404
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 404 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/address/:address [get]
 func (c *Controller) FindById(ctx *fiber.Ctx) error {
 
@@ -48,7 +50,7 @@ func (c *Controller) FindById(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if pagination.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errors.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	response, err := c.srv.GetAddressOverview(ctx.Context(), address, pagination)
@@ -61,3 +63,78 @@ func (c *Controller) FindById(ctx *fiber.Ctx) error {
 
 	return ctx.JSON(response)
 }
+
+// GetPortfolio godoc
+// @Description Returns the portfolio of an address: the operations sent and received across all chains,
+// @Description the totals in and out per token with their USD value at transfer time, the counterparties,
+// @Description the first and last activity and the protocols used.
+// @Tags wormholescan
+// @ID get-address-portfolio
+// @Param address path string true "address, in native or wormhole format"
+// @Param chain query integer false "chain of the address, used to decode the native address. If not set, all the known encodings are tried."
+// @Success 200 {object} response.Response[address.AddressPortfolio]
+// @Failure 400 {object} response.APIError
+// @Failure 404 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /api/v1/address/{address}/portfolio [get]
+func (c *Controller) GetPortfolio(ctx *fiber.Ctx) error {
+
+	addr := middleware.ExtractAddressFromPath(ctx, c.logger)
+
+	chain, err := middleware.ExtractChainQueryParam(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+
+	portfolio, err := c.srv.GetAddressPortfolio(ctx.Context(), addr, chain)
+	if stderrors.Is(err, errors.ErrMalformedQuery) {
+		return response.NewFieldError(ctx, errors.CodeInvalidAddress, "INVALID ADDRESS", err, "address", addr, "must be an address of a supported chain")
+	}
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(response.Response[*address.AddressPortfolio]{Data: portfolio})
+}
+
+// GetActivity godoc
+// @Description Returns the operations sent or received by an address across all chains, sorted by descending timestamp.
+// @Tags wormholescan
+// @ID get-address-activity
+// @Param address path string true "address, in native or wormhole format"
+// @Param chain query integer false "chain of the address, used to decode the native address. If not set, all the known encodings are tried."
+// @Param page query integer false "Page number. Starts at 0."
+// @Param pageSize query integer false "Number of elements per page."
+// @Success 200 {object} response.Response[[]address.AddressActivity]
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /api/v1/address/{address}/activity [get]
+func (c *Controller) GetActivity(ctx *fiber.Ctx) error {
+
+	addr := middleware.ExtractAddressFromPath(ctx, c.logger)
+
+	chain, err := middleware.ExtractChainQueryParam(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+
+	pagination, err := middleware.ExtractPagination(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Check pagination max limit
+	if pagination.Limit > 1000 {
+		return response.NewFieldError(ctx, errors.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
+	}
+
+	activity, err := c.srv.GetAddressActivity(ctx.Context(), addr, chain, pagination)
+	if stderrors.Is(err, errors.ErrMalformedQuery) {
+		return response.NewFieldError(ctx, errors.CodeInvalidAddress, "INVALID ADDRESS", err, "address", addr, "must be an address of a supported chain")
+	}
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(response.Response[[]*address.AddressActivity]{Data: activity})
+}
@@ -0,0 +1,96 @@
+// Package exports handle the request of exports of operations.
+package exports
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/pkg/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/exports"
+	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
+	"go.uber.org/zap"
+)
+
+// Controller definition.
+type Controller struct {
+	srv    *exports.Service
+	logger *zap.Logger
+}
+
+// NewController create a new controler.
+func NewController(srv *exports.Service, logger *zap.Logger) *Controller {
+	return &Controller{
+		srv:    srv,
+		logger: logger.With(zap.String("module", "ExportsController")),
+	}
+}
+
+// Create godoc
+// @Description Request an export of the operations in the time range [from, to) and the prices of their tokens
+// @Description at transfer time, in the csv, ndjson or parquet format. The export runs asynchronously,
+// @Description its status is returned by /api/v1/exports/{id} and the file is downloaded from /api/v1/exports/{id}/download.
+// @Description The address matches the sender or the receiver of the operations.
+// @Description The exports requested by each client per hour and the exports pending are limited. The exports and
+// @Description their files are deleted some time after they are completed.
+// @Tags wormholescan
+// @ID create-export
+// @Accept json
+// @Param request body exports.ExportRequest true "format, time range and filters of the export"
+// @Success 202 {object} exports.ExportDto
+// @Failure 400 {object} response.APIError
+// @Failure 429 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /api/v1/exports [post]
+func (c *Controller) Create(ctx *fiber.Ctx) error {
+	var req exports.ExportRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return response.NewRequestBodyError(ctx, "invalid export request, unable to parse", errors.WithStack(err))
+	}
+
+	e, err := c.srv.Create(ctx.Context(), &req, utils.GetRealIp(ctx))
+	if err != nil {
+		return err
+	}
+	return ctx.Status(fiber.StatusAccepted).JSON(e)
+}
+
+// FindById godoc
+// @Description Returns the status of an export.
+// @Tags wormholescan
+// @ID find-export-by-id
+// @Param id path string true "id of the export"
+// @Success 200 {object} exports.ExportDto
+// @Failure 404 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /api/v1/exports/{id} [get]
+func (c *Controller) FindById(ctx *fiber.Ctx) error {
+	e, err := c.srv.FindByID(ctx.Context(), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(e)
+}
+
+// Download godoc
+// @Description Downloads the file of a completed export. When the file is stored in an S3-compatible store,
+// @Description the response redirects to a temporary url of the file.
+// @Tags wormholescan
+// @ID download-export
+// @Param id path string true "id of the export"
+// @Success 200 {file} file
+// @Success 302
+// @Failure 404 {object} response.APIError
+// @Failure 409 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /api/v1/exports/{id}/download [get]
+func (c *Controller) Download(ctx *fiber.Ctx) error {
+	d, err := c.srv.Download(ctx.Context(), ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	if d.URL != "" {
+		return ctx.Redirect(d.URL, fiber.StatusFound)
+	}
+	ctx.Attachment(d.FileName)
+	ctx.Set(fiber.HeaderContentType, d.ContentType)
+	return ctx.SendStream(d.Body, int(d.Size))
+}
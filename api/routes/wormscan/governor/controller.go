@@ -3,9 +3,11 @@ package governor
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/governor"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
 	_ "github.com/wormhole-foundation/wormhole-explorer/api/response" // needed by swaggo docs
@@ -30,8 +32,8 @@ func NewController(serv *governor.Service, logger *zap.Logger) *Controller {
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
 // @Success 200 {object} response.Response[governor.GovConfig]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/governor/config [get]
 func (c *Controller) FindGovernorConfigurations(ctx *fiber.Ctx) error {
 
@@ -42,7 +44,7 @@ func (c *Controller) FindGovernorConfigurations(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	governorConfigs, err := c.srv.FindGovernorConfig(ctx.Context(), p)
@@ -58,8 +60,8 @@ func (c *Controller) FindGovernorConfigurations(ctx *fiber.Ctx) error {
 // @Tags wormholescan
 // @ID governor-config-by-guardian-address
 // @Success 200 {object} response.Response[governor.GovConfig]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/governor/config/:guardian_address [get]
 func (c *Controller) FindGovernorConfigurationByGuardianAddress(ctx *fiber.Ctx) error {
 
@@ -92,8 +94,8 @@ func (c *Controller) FindGovernorConfigurationByGuardianAddress(ctx *fiber.Ctx)
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
 // @Success 200 {object} response.Response[[]governor.GovStatus]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/governor/status [get]
 func (c *Controller) FindGovernorStatus(ctx *fiber.Ctx) error {
 
@@ -104,7 +106,7 @@ func (c *Controller) FindGovernorStatus(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	governorStatus, err := c.srv.FindGovernorStatus(ctx.Context(), p)
@@ -122,8 +124,8 @@ func (c *Controller) FindGovernorStatus(ctx *fiber.Ctx) error {
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
 // @Success 200 {object} response.Response[governor.GovStatus]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/governor/status/:guardian_address [get]
 func (c *Controller) FindGovernorStatusByGuardianAddress(ctx *fiber.Ctx) error {
 
@@ -134,7 +136,7 @@ func (c *Controller) FindGovernorStatusByGuardianAddress(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	guardianAddress, err := middleware.ExtractGuardianAddress(ctx, c.logger)
@@ -157,8 +159,8 @@ func (c *Controller) FindGovernorStatusByGuardianAddress(ctx *fiber.Ctx) error {
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
 // @Success 200 {object} response.Response[[]governor.GovernorLimit]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/governor/limit [get]
 func (c *Controller) GetGovernorLimit(ctx *fiber.Ctx) error {
 
@@ -169,7 +171,7 @@ func (c *Controller) GetGovernorLimit(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	governorLimit, err := c.srv.GetGovernorLimit(ctx.Context(), p)
@@ -187,8 +189,8 @@ func (c *Controller) GetGovernorLimit(ctx *fiber.Ctx) error {
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
 // @Success 200 {object} response.Response[[]governor.NotionalLimitDetail]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/governor/notional/limit [get]
 func (c *Controller) FindNotionalLimit(ctx *fiber.Ctx) error {
 
@@ -199,7 +201,7 @@ func (c *Controller) FindNotionalLimit(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	notionalLimit, err := c.srv.FindNotionalLimit(ctx.Context(), p)
@@ -217,8 +219,8 @@ func (c *Controller) FindNotionalLimit(ctx *fiber.Ctx) error {
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
 // @Success 200 {object} response.Response[[]governor.NotionalLimitDetail]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/governor/notional/limit/:chain [get]
 func (c *Controller) GetNotionalLimitByChainID(ctx *fiber.Ctx) error {
 
@@ -229,7 +231,7 @@ func (c *Controller) GetNotionalLimitByChainID(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	chainID, err := middleware.ExtractChainID(ctx, c.logger)
@@ -253,8 +255,8 @@ func (c *Controller) GetNotionalLimitByChainID(ctx *fiber.Ctx) error {
 // @Param pageSize query integer false "Number of elements per page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
 // @Success 200 {object} response.Response[[]governor.NotionalAvailable]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/governor/notional/available [get]
 func (c *Controller) GetAvailableNotional(ctx *fiber.Ctx) error {
 
@@ -265,7 +267,7 @@ func (c *Controller) GetAvailableNotional(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	notionalAvaialabilies, err := c.srv.GetAvailableNotional(ctx.Context(), p)
@@ -283,8 +285,8 @@ func (c *Controller) GetAvailableNotional(ctx *fiber.Ctx) error {
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
 // @Success 200 {object} response.Response[[]governor.NotionalAvailableDetail]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/governor/notional/available/:chain [get]
 func (c *Controller) GetAvailableNotionalByChainID(ctx *fiber.Ctx) error {
 
@@ -295,7 +297,7 @@ func (c *Controller) GetAvailableNotionalByChainID(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	chainID, err := middleware.ExtractChainID(ctx, c.logger)
@@ -316,8 +318,8 @@ func (c *Controller) GetAvailableNotionalByChainID(ctx *fiber.Ctx) error {
 // @Tags wormholescan
 // @ID governor-max-notional-available-by-chain
 // @Success 200 {object} response.Response[governor.MaxNotionalAvailableRecord]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/governor/notional/max_available/:chain [get]
 func (c *Controller) GetMaxNotionalAvailableByChainID(ctx *fiber.Ctx) error {
 
@@ -342,8 +344,8 @@ func (c *Controller) GetMaxNotionalAvailableByChainID(ctx *fiber.Ctx) error {
 // @Param pageSize query integer false "Number of elements per page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
 // @Success 200 {object} response.Response[[]governor.EnqueuedVaas]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/governor/enqueued_vaas/ [get]
 func (c *Controller) GetEnqueuedVaas(ctx *fiber.Ctx) error {
 
@@ -354,7 +356,7 @@ func (c *Controller) GetEnqueuedVaas(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	enqueuedVaas, err := c.srv.GetEnqueueVass(ctx.Context(), p)
@@ -373,8 +375,8 @@ func (c *Controller) GetEnqueuedVaas(ctx *fiber.Ctx) error {
 // @Param pageSize query integer false "Number of elements per page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
 // @Success 200 {object} response.Response[[]governor.EnqueuedVaaDetail]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/governor/enqueued_vaas/:chain [get]
 func (c *Controller) GetEnqueuedVaasByChainID(ctx *fiber.Ctx) error {
 
@@ -385,7 +387,7 @@ func (c *Controller) GetEnqueuedVaasByChainID(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 	chainID, err := middleware.ExtractChainID(ctx, c.logger)
 	if err != nil {
@@ -405,8 +407,8 @@ func (c *Controller) GetEnqueuedVaasByChainID(ctx *fiber.Ctx) error {
 // @Tags wormholescan
 // @ID governor-vaas
 // @Success 200 {object} response.Response[[]governor.GovernorVaasResponse]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/governor/vaas [get]
 func (c *Controller) GetGovernorVaas(ctx *fiber.Ctx) error {
 	enqueuedVaas, err := c.srv.GetGovernorVaas(ctx.Context())
@@ -434,3 +436,30 @@ func (c *Controller) GetGovernorVaas(ctx *fiber.Ctx) error {
 
 	return ctx.JSON(result)
 }
+
+// GetGovernorVaaStatus godoc
+// @Description Returns the governor status of an enqueued vaa, with the predicted release time,
+// @Description the guardians that have it enqueued and the projected available notional of the chain over the next 24 hours.
+// @Tags wormholescan
+// @ID governor-vaa-status
+// @Param chain_id path integer true "id of the blockchain"
+// @Param emitter path string true "address of the emitter"
+// @Param seq path integer true "sequence of the vaa"
+// @Success 200 {object} governor.GovernorVaaStatus
+// @Failure 400 {object} response.APIError
+// @Failure 404 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /api/v1/governor/vaas/{chain_id}/{emitter}/{seq} [get]
+func (c *Controller) GetGovernorVaaStatus(ctx *fiber.Ctx) error {
+	chainID, emitter, seq, err := middleware.ExtractVAAParams(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+
+	status, err := c.srv.GetGovernorVaaStatus(ctx.Context(), chainID, emitter, strconv.FormatUint(seq, 10))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(status)
+}
@@ -0,0 +1,218 @@
+package guardians
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/guardian"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/heartbeats"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultTimeRange is the time range of the queries when the `from` query parameter is not set.
+	defaultTimeRange = 24 * time.Hour
+	// maxTimeRange is the maximum time range of the queries.
+	maxTimeRange = 31 * 24 * time.Hour
+)
+
+// Controller definition.
+type Controller struct {
+	srv             *heartbeats.Service
+	guardianService *guardian.Service
+	logger          *zap.Logger
+}
+
+// NewController create a new controler.
+func NewController(srv *heartbeats.Service, guardianService *guardian.Service, logger *zap.Logger) *Controller {
+	return &Controller{
+		srv:             srv,
+		guardianService: guardianService,
+		logger:          logger.With(zap.String("module", "GuardiansController")),
+	}
+}
+
+// GuardiansUptimeResponse response.
+type GuardiansUptimeResponse struct {
+	From      time.Time                    `json:"from"`
+	To        time.Time                    `json:"to"`
+	Bucket    string                       `json:"bucket"`
+	Guardians []*heartbeats.GuardianUptime `json:"guardians"`
+}
+
+// GuardiansHeightLagResponse response.
+type GuardiansHeightLagResponse struct {
+	From      time.Time                       `json:"from"`
+	To        time.Time                       `json:"to"`
+	Bucket    string                          `json:"bucket"`
+	ChainID   uint16                          `json:"chainId"`
+	Guardians []*heartbeats.GuardianHeightLag `json:"guardians"`
+}
+
+// GuardianEventsResponse response.
+type GuardianEventsResponse struct {
+	From   time.Time                      `json:"from"`
+	To     time.Time                      `json:"to"`
+	Events []*heartbeats.GuardianEventDoc `json:"events"`
+}
+
+// GetUptime godoc
+// @Description Returns the uptime of the guardians of the current guardian set.
+// @Description A guardian is online in a 5 minutes bucket when at least one of its heartbeats was sampled in it.
+// @Tags wormholescan
+// @ID get-guardians-uptime
+// @Param from query string false "From date, supported format 2006-01-02T15:04:05Z07:00. Defaults to 24 hours ago"
+// @Param to query string false "To date, supported format 2006-01-02T15:04:05Z07:00. Defaults to now"
+// @Success 200 {object} GuardiansUptimeResponse
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /api/v1/guardians/uptime [get]
+func (c *Controller) GetUptime(ctx *fiber.Ctx) error {
+	from, to, err := extractTimeRange(ctx)
+	if err != nil {
+		return err
+	}
+	guardianAddrs, err := c.currentGuardianAddrs(ctx)
+	if err != nil {
+		return err
+	}
+
+	uptime, err := c.srv.GetGuardiansUptime(ctx.Context(), guardianAddrs, from, to)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(GuardiansUptimeResponse{
+		From:      from,
+		To:        to,
+		Bucket:    heartbeats.HistoryBucket.String(),
+		Guardians: uptime,
+	})
+}
+
+// GetHeightLag godoc
+// @Description Returns the lag of the block height reported by the guardians of the current guardian set for a chain,
+// @Description with respect to the highest height reported by any guardian in the same 5 minutes bucket.
+// @Tags wormholescan
+// @ID get-guardians-height-lag
+// @Param chain path integer true "chain id"
+// @Param from query string false "From date, supported format 2006-01-02T15:04:05Z07:00. Defaults to 24 hours ago"
+// @Param to query string false "To date, supported format 2006-01-02T15:04:05Z07:00. Defaults to now"
+// @Success 200 {object} GuardiansHeightLagResponse
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /api/v1/guardians/height-lag/{chain} [get]
+func (c *Controller) GetHeightLag(ctx *fiber.Ctx) error {
+	chainID, err := middleware.ExtractChainID(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+	from, to, err := extractTimeRange(ctx)
+	if err != nil {
+		return err
+	}
+	guardianAddrs, err := c.currentGuardianAddrs(ctx)
+	if err != nil {
+		return err
+	}
+
+	lag, err := c.srv.GetGuardiansHeightLag(ctx.Context(), guardianAddrs, chainID, from, to)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(GuardiansHeightLagResponse{
+		From:      from,
+		To:        to,
+		Bucket:    heartbeats.HistoryBucket.String(),
+		ChainID:   uint16(chainID),
+		Guardians: lag,
+	})
+}
+
+// GetEvents godoc
+// @Description Returns the restarts and version changes of a guardian.
+// @Tags wormholescan
+// @ID get-guardian-events
+// @Param guardian_address path string true "guardian address"
+// @Param from query string false "From date, supported format 2006-01-02T15:04:05Z07:00. Defaults to 24 hours ago"
+// @Param to query string false "To date, supported format 2006-01-02T15:04:05Z07:00. Defaults to now"
+// @Success 200 {object} GuardianEventsResponse
+// @Failure 400 {object} response.APIError
+// @Failure 404 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /api/v1/guardians/{guardian_address}/events [get]
+func (c *Controller) GetEvents(ctx *fiber.Ctx) error {
+	from, to, err := extractTimeRange(ctx)
+	if err != nil {
+		return err
+	}
+	guardianAddrs, err := c.currentGuardianAddrs(ctx)
+	if err != nil {
+		return err
+	}
+
+	// heartbeats are stored with the checksummed address of the guardian.
+	var guardianAddr string
+	for _, addr := range guardianAddrs {
+		if strings.EqualFold(addr, ctx.Params("guardian_address")) {
+			guardianAddr = addr
+			break
+		}
+	}
+	if guardianAddr == "" {
+		return response.NewNotFoundError(ctx)
+	}
+
+	events, err := c.srv.GetGuardianEvents(ctx.Context(), guardianAddr, from, to)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(GuardianEventsResponse{From: from, To: to, Events: events})
+}
+
+// currentGuardianAddrs returns the addresses of the guardians of the current guardian set.
+func (c *Controller) currentGuardianAddrs(ctx *fiber.Ctx) ([]string, error) {
+	gs, err := c.guardianService.GetGuardianSet(ctx.Context())
+	if err != nil {
+		c.logger.Error("failed to get guardian set", zap.Error(err))
+		return nil, response.NewApiError(ctx, fiber.StatusInternalServerError, response.Internal,
+			"failed to get guardian set", err)
+	}
+	if len(gs.GstByIndex) == 0 {
+		return nil, response.NewApiError(ctx, fiber.StatusServiceUnavailable, response.Unavailable,
+			"guardian set not fetched from chain yet", nil)
+	}
+	guardianSet := gs.GetLatest()
+	return guardianSet.KeysAsHexStrings(), nil
+}
+
+// extractTimeRange returns the time range of the request.
+func extractTimeRange(ctx *fiber.Ctx) (time.Time, time.Time, error) {
+	from, err := middleware.ExtractTime(ctx, time.RFC3339, "from")
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	to, err := middleware.ExtractTime(ctx, time.RFC3339, "to")
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	end := time.Now().UTC()
+	if to != nil {
+		end = to.UTC()
+	}
+	start := end.Add(-defaultTimeRange)
+	if from != nil {
+		start = from.UTC()
+	}
+
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, response.NewInvalidQueryParamError(ctx, "INVALID <from> QUERY PARAMETER, MUST BE BEFORE <to>", nil)
+	}
+	if end.Sub(start) > maxTimeRange {
+		return time.Time{}, time.Time{}, response.NewInvalidQueryParamError(ctx, "INVALID TIME RANGE, MUST BE LESS THAN 31 DAYS", nil)
+	}
+	return start, end, nil
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/infrastructure"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/build"
+	_ "github.com/wormhole-foundation/wormhole-explorer/api/response" // required by swaggo
 )
 
 // Controller definition.
@@ -22,8 +23,8 @@ func NewController(serv *infrastructure.Service) *Controller {
 // @Tags wormholescan
 // @ID health-check
 // @Success 200 {object} object{status=string}
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/health [get]
 func (c *Controller) HealthCheck(ctx *fiber.Ctx) error {
 	return ctx.JSON(struct {
@@ -37,8 +38,8 @@ func (c *Controller) HealthCheck(ctx *fiber.Ctx) error {
 // @Tags wormholescan
 // @ID ready-check
 // @Success 200 {object} object{ready=string}
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/ready [get]
 func (c *Controller) ReadyCheck(ctx *fiber.Ctx) error {
 	ready, _ := c.srv.CheckMongoServerStatus(ctx.Context())
@@ -67,8 +68,8 @@ type VersionResponse struct {
 // @Tags wormholescan
 // @ID get-version
 // @Success 200 {object} VersionResponse
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/version [get]
 func (c *Controller) Version(ctx *fiber.Ctx) error {
 	return ctx.JSON(VersionResponse{
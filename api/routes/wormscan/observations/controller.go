@@ -3,25 +3,38 @@ package observations
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/guardian"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/observations"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
 	"go.uber.org/zap"
 )
 
+const (
+	// nextCursorHeader is the response header that holds the keyset pagination cursor of the next page.
+	nextCursorHeader = "X-Next-Cursor"
+	// defaultPendingVaasTimeRange is the time range of the pending VAAs when the `from` query parameter is not set.
+	defaultPendingVaasTimeRange = 24 * time.Hour
+)
+
 // Controller definition.
 type Controller struct {
-	srv    *observations.Service
-	logger *zap.Logger
+	srv             *observations.Service
+	guardianService *guardian.Service
+	logger          *zap.Logger
 }
 
 // NewController create a new controler.
-func NewController(srv *observations.Service, logger *zap.Logger) *Controller {
+func NewController(srv *observations.Service, guardianService *guardian.Service, logger *zap.Logger) *Controller {
 	return &Controller{
-		srv:    srv,
-		logger: logger.With(zap.String("module", "ObservationsController")),
+		srv:             srv,
+		guardianService: guardianService,
+		logger:          logger.With(zap.String("module", "ObservationsController")),
 	}
 }
 
@@ -31,11 +44,12 @@ func NewController(srv *observations.Service, logger *zap.Logger) *Controller {
 // @ID find-observations
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
+// @Param cursor query string false "Cursor returned in the X-Next-Cursor header of the previous page."
 // @Param txHash query string false "Transaction hash of the Observations"
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
 // @Success 200 {object} []observations.ObservationDoc
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/observations [get]
 func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 
@@ -46,7 +60,7 @@ func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	txHash, err := middleware.GetTxHash(ctx, c.logger)
@@ -64,6 +78,7 @@ func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 		return err
 	}
 
+	setNextCursor(ctx, p, obs)
 	return ctx.JSON(obs)
 }
 
@@ -73,10 +88,11 @@ func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 // @ID find-observations-by-chain
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
+// @Param cursor query string false "Cursor returned in the X-Next-Cursor header of the previous page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
 // @Success 200 {object} []observations.ObservationDoc
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/observations/:chain [get]
 func (c *Controller) FindAllByChain(ctx *fiber.Ctx) error {
 
@@ -87,7 +103,7 @@ func (c *Controller) FindAllByChain(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	chainID, err := middleware.ExtractChainID(ctx, c.logger)
@@ -100,6 +116,7 @@ func (c *Controller) FindAllByChain(ctx *fiber.Ctx) error {
 		return err
 	}
 
+	setNextCursor(ctx, p, obs)
 	return ctx.JSON(obs)
 }
 
@@ -109,10 +126,11 @@ func (c *Controller) FindAllByChain(ctx *fiber.Ctx) error {
 // @ID find-observations-by-emitter
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
+// @Param cursor query string false "Cursor returned in the X-Next-Cursor header of the previous page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
 // @Success 200 {object} []observations.ObservationDoc
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/observations/:chain/:emitter [get]
 func (c *Controller) FindAllByEmitter(ctx *fiber.Ctx) error {
 
@@ -123,7 +141,7 @@ func (c *Controller) FindAllByEmitter(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	chainID, addr, err := middleware.ExtractVAAChainIDEmitter(ctx, c.logger)
@@ -136,6 +154,7 @@ func (c *Controller) FindAllByEmitter(ctx *fiber.Ctx) error {
 		return err
 	}
 
+	setNextCursor(ctx, p, obs)
 	return ctx.JSON(obs)
 }
 
@@ -145,10 +164,11 @@ func (c *Controller) FindAllByEmitter(ctx *fiber.Ctx) error {
 // @ID find-observations-by-sequence
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
+// @Param cursor query string false "Cursor returned in the X-Next-Cursor header of the previous page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
 // @Success 200 {object} []observations.ObservationDoc
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/observations/:chain/:emitter/:sequence [get]
 func (c *Controller) FindAllByVAA(ctx *fiber.Ctx) error {
 
@@ -159,7 +179,7 @@ func (c *Controller) FindAllByVAA(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	chainID, addr, seq, err := middleware.ExtractVAAParams(ctx, c.logger)
@@ -172,6 +192,7 @@ func (c *Controller) FindAllByVAA(ctx *fiber.Ctx) error {
 		return err
 	}
 
+	setNextCursor(ctx, p, obs)
 	return ctx.JSON(obs)
 }
 
@@ -183,8 +204,8 @@ func (c *Controller) FindAllByVAA(ctx *fiber.Ctx) error {
 // @Param pageSize query integer false "Number of elements per page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
 // @Success 200 {object} []observations.ObservationDoc
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/observations/:chain/:emitter/:sequence/:signer/:hash [get]
 func (c *Controller) FindOne(ctx *fiber.Ctx) error {
 	chainID, addr, seq, err := middleware.ExtractVAAParams(ctx, c.logger)
@@ -205,3 +226,103 @@ func (c *Controller) FindOne(ctx *fiber.Ctx) error {
 	}
 	return ctx.JSON(obs)
 }
+
+// GetSigningProgress godoc
+// @Description Returns the signing progress of a VAA: the guardians that signed the message, the ones that are missing
+// @Description to reach the quorum of its guardian set and the time it took to reach the quorum.
+// @Tags wormholescan
+// @ID get-vaa-signing-progress
+// @Param chain_id path integer true "id of the blockchain"
+// @Param emitter path string true "address of the emitter"
+// @Param seq path integer true "sequence of the VAA"
+// @Success 200 {object} observations.SigningProgress
+// @Failure 400 {object} response.APIError
+// @Failure 404 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /api/v1/vaas/:chain_id/:emitter/:seq/signing-progress [get]
+func (c *Controller) GetSigningProgress(ctx *fiber.Ctx) error {
+	chainID, addr, seq, err := middleware.ExtractVAAParams(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+	gs, err := c.getGuardianSet(ctx)
+	if err != nil {
+		return err
+	}
+
+	progress, err := c.srv.GetSigningProgress(ctx.Context(), chainID, addr, seq, gs)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(progress)
+}
+
+// FindPendingVaas godoc
+// @Description Returns the messages observed by the guardians that do not have a VAA yet,
+// @Description sorted by the time of the first observation in descending order.
+// @Tags wormholescan
+// @ID find-pending-vaas
+// @Param from query string false "Messages first observed since this date, supported format 2006-01-02T15:04:05Z07:00. Defaults to 24 hours ago"
+// @Param page query integer false "Page number."
+// @Param pageSize query integer false "Number of elements per page."
+// @Success 200 {object} []observations.SigningProgress
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /api/v1/pending-vaas [get]
+func (c *Controller) FindPendingVaas(ctx *fiber.Ctx) error {
+	p, err := middleware.ExtractPagination(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Check pagination max limit
+	if p.Limit > 1000 {
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
+	}
+
+	from, err := middleware.ExtractTime(ctx, time.RFC3339, "from")
+	if err != nil {
+		return err
+	}
+	since := time.Now().Add(-defaultPendingVaasTimeRange)
+	if from != nil {
+		since = *from
+	}
+
+	gs, err := c.getGuardianSet(ctx)
+	if err != nil {
+		return err
+	}
+
+	pending, err := c.srv.FindPendingVaas(ctx.Context(), since, gs, p)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(pending)
+}
+
+// getGuardianSet returns the guardian sets, failing if they were not fetched yet.
+func (c *Controller) getGuardianSet(ctx *fiber.Ctx) (*guardian.GuardianSet, error) {
+	gs, err := c.guardianService.GetGuardianSet(ctx.Context())
+	if err != nil {
+		c.logger.Error("failed to get guardian set", zap.Error(err))
+		return nil, response.NewApiError(ctx, fiber.StatusInternalServerError, response.Internal,
+			"failed to get guardian set", err)
+	}
+	if len(gs.GstByIndex) == 0 {
+		return nil, response.NewApiError(ctx, fiber.StatusServiceUnavailable, response.Unavailable,
+			"guardian set not fetched from chain yet", nil)
+	}
+	return gs, nil
+}
+
+// setNextCursor sets the X-Next-Cursor response header with the cursor of the next page, if any.
+func setNextCursor(ctx *fiber.Ctx, p *pagination.Pagination, obs []*observations.ObservationDoc) {
+	if len(obs) == 0 {
+		return
+	}
+	last := obs[len(obs)-1]
+	if next := p.NextCursor(len(obs), last.IndexedAt, last.ID); next != "" {
+		ctx.Set(nextCursorHeader, next)
+	}
+}
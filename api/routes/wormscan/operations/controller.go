@@ -4,8 +4,10 @@ import (
 	"context"
 	"github.com/gofiber/fiber/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/operations"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/common/lifecycle"
 	"github.com/wormhole-foundation/wormhole-explorer/common/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
@@ -41,13 +43,16 @@ func NewController(operationService operationService, logger *zap.Logger) *Contr
 // @Param txHash query string false "hash of the transaction"
 // @Param page query integer false "page number"
 // @Param pageSize query integer false "pageSize". Maximum value is 100.
+// @Param cursor query string false "cursor returned in the pagination of the previous page".
 // @Param sourceChain query string false "source chains of the operation, separated by comma".
 // @Param targetChain query string false "target chains of the operation, separated by comma".
 // @Param appId query string false "appID of the operation".
 // @Param exclusiveAppId query boolean false "single appId of the operation".
+// @Param payloadType query string false "payload types of the operation, separated by comma".
+// @Param status query string false "lifecycle status of the operation: emitted, governed, signed, redeemed or stuck".
 // @Success 200 {object} []OperationResponse
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/operations [get]
 func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 	// Extract query parameters
@@ -58,7 +63,7 @@ func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if pagination.Limit > 100 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 100", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 100", nil, "pageSize", ctx.Query("pageSize"), "must be at most 100")
 	}
 
 	address := middleware.ExtractAddressFromQueryParams(ctx, c.logger)
@@ -115,6 +120,18 @@ func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 		}
 	}
 
+	var status lifecycle.Status
+	if statusParam := ctx.Query("status"); statusParam != "" {
+		var ok bool
+		status, ok = lifecycle.ParseStatus(statusParam)
+		if !ok {
+			return response.NewInvalidParamError(ctx, "invalid status", nil)
+		}
+		if searchByAddress || searchByTxHash || searchBySourceTargetChain || searchByAppId || len(payloadType) > 0 {
+			return response.NewInvalidParamError(ctx, "status cannot be combined with other query filters", nil)
+		}
+	}
+
 	filter := operations.OperationFilter{
 		TxHash:         txHash,
 		Address:        address,
@@ -123,6 +140,7 @@ func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 		AppIDs:         appIDs,
 		ExclusiveAppId: exclusiveAppId,
 		PayloadType:    payloadType,
+		Status:         status,
 		Pagination:     *pagination,
 	}
 
@@ -133,7 +151,7 @@ func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 	}
 
 	// build response
-	resp := toListOperationResponse(ops, c.logger)
+	resp := toListOperationResponse(ops, pagination, c.logger)
 	return ctx.JSON(resp)
 }
 
@@ -145,8 +163,8 @@ func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 // @Param emitter path string true "address of the emitter"
 // @Param seq path integer true "sequence of the VAA"
 // @Success 200 {object} OperationResponse
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/operations/{chain_id}/{emitter}/{seq} [get]
 func (c *Controller) FindById(ctx *fiber.Ctx) error {
 	// Extract query params
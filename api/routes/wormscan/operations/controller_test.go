@@ -7,6 +7,7 @@ import (
 	ops "github.com/wormhole-foundation/wormhole-explorer/api/handlers/operations"
 	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/operations"
+	"github.com/wormhole-foundation/wormhole-explorer/common/lifecycle"
 	"github.com/wormhole-foundation/wormhole-explorer/common/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
@@ -53,9 +54,58 @@ func Test_FindAll(t *testing.T) {
 			name:               "Test_FindAll_InvalidPayloadType",
 			requestURL:         "/api/v1/operations?payloadType=1,thisShouldBeANumber,3",
 			expectedStatusCode: http.StatusBadRequest,
-			expectedResponse:   `{"code":3,"message":"invalid payloadType","details":[{"request_id":"\u003cnil\u003e"}]}`,
+			expectedResponse:   `{"code":3,"error_code":"INVALID_PARAM","message":"invalid payloadType","details":[{"request_id":"\u003cnil\u003e"}]}`,
 			setupServiceMock:   func(mockService *mockOpsService) {},
 		},
+		{
+			name:               "Test_FindAll_StuckStatus",
+			requestURL:         "/api/v1/operations?status=stuck",
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"operations":[]}`,
+			setupServiceMock: func(mockService *mockOpsService) {
+				statusMatcher := mock.MatchedBy(func(filter ops.OperationFilter) bool {
+					return filter.Status == lifecycle.StatusStuck
+				})
+				mockService.On("FindAll", mock.Anything, statusMatcher).Return([]*ops.OperationDto{}, nil)
+			},
+		},
+		{
+			name:               "Test_FindAll_InvalidStatus",
+			requestURL:         "/api/v1/operations?status=lost",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"code":3,"error_code":"INVALID_PARAM","message":"invalid status","details":[{"request_id":"\u003cnil\u003e"}]}`,
+			setupServiceMock:   func(mockService *mockOpsService) {},
+		},
+		{
+			name:               "Test_FindAll_StatusWithOtherFilters",
+			requestURL:         "/api/v1/operations?status=stuck&appId=PORTAL_TOKEN_BRIDGE",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"code":3,"error_code":"INVALID_PARAM","message":"status cannot be combined with other query filters","details":[{"request_id":"\u003cnil\u003e"}]}`,
+			setupServiceMock:   func(mockService *mockOpsService) {},
+		},
+		{
+			name:               "Test_FindAll_InvalidSourceChain",
+			requestURL:         "/api/v1/operations?sourceChain=ethereum",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"code":3,"error_code":"INVALID_CHAIN_ID","message":"INVALID SOURCE_CHAIN VALUE","fields":[{"field":"sourceChain","value":"ethereum","reason":"must be a wormhole chain id"}],"details":[{"request_id":"\u003cnil\u003e"}]}`,
+			setupServiceMock:   func(mockService *mockOpsService) {},
+		},
+		{
+			name:               "Test_FindAll_PageSizeTooLarge",
+			requestURL:         "/api/v1/operations?pageSize=101",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"code":3,"error_code":"INVALID_PAGINATION","message":"pageSize cannot be greater than 100","fields":[{"field":"pageSize","value":"101","reason":"must be at most 100"}],"details":[{"request_id":"\u003cnil\u003e"}]}`,
+			setupServiceMock:   func(mockService *mockOpsService) {},
+		},
+		{
+			name:               "Test_FindAll_Timeout",
+			requestURL:         "/api/v1/operations",
+			expectedStatusCode: http.StatusGatewayTimeout,
+			expectedResponse:   `{"code":4,"error_code":"TIMEOUT","message":"TIMEOUT","details":[{"request_id":"\u003cnil\u003e"}]}`,
+			setupServiceMock: func(mockService *mockOpsService) {
+				mockService.On("FindAll", mock.Anything, mock.Anything).Return([]*ops.OperationDto(nil), context.DeadlineExceeded)
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -7,7 +7,10 @@ import (
 
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/operations"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/api/response"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/lifecycle"
 
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
@@ -15,15 +18,16 @@ import (
 
 // OperationResponse definition.
 type OperationResponse struct {
-	ID             string         `json:"id"`
-	EmitterChain   sdk.ChainID    `json:"emitterChain"`
-	EmitterAddress EmitterAddress `json:"emitterAddress"`
-	Sequence       string         `json:"sequence"`
-	Vaa            *Vaa           `json:"vaa,omitempty"`
-	Content        *Content       `json:"content,omitempty"`
-	SourceChain    *SourceChain   `json:"sourceChain,omitempty"`
-	TargetChain    *TargetChain   `json:"targetChain,omitempty"`
-	Data           map[string]any `json:"data,omitempty"`
+	ID             string               `json:"id"`
+	EmitterChain   sdk.ChainID          `json:"emitterChain"`
+	EmitterAddress EmitterAddress       `json:"emitterAddress"`
+	Sequence       string               `json:"sequence"`
+	Vaa            *Vaa                 `json:"vaa,omitempty"`
+	Content        *Content             `json:"content,omitempty"`
+	SourceChain    *SourceChain         `json:"sourceChain,omitempty"`
+	TargetChain    *TargetChain         `json:"targetChain,omitempty"`
+	Data           map[string]any       `json:"data,omitempty"`
+	Lifecycle      *lifecycle.Lifecycle `json:"lifecycle,omitempty"`
 }
 
 // EmitterAddress definition.
@@ -83,7 +87,8 @@ type Data struct {
 }
 
 type ListOperationResponse struct {
-	Operations []*OperationResponse `json:"operations"`
+	Operations []*OperationResponse         `json:"operations"`
+	Pagination *response.ResponsePagination `json:"pagination,omitempty"`
 }
 
 // toOperationResponse converts an operations.OperationDto to an OperationResponse.
@@ -141,6 +146,7 @@ func toOperationResponse(operation *operations.OperationDto, log *zap.Logger) (*
 		Data:        getAdditionalData(operation),
 		SourceChain: sourceChain,
 		TargetChain: targetChain,
+		Lifecycle:   operation.Lifecycle,
 	}
 
 	return &r, nil
@@ -258,17 +264,25 @@ func getChainEvents(chainID sdk.ChainID, operation *operations.OperationDto) (*S
 	return sourceChain, targetChain
 }
 
-func toListOperationResponse(operations []*operations.OperationDto, log *zap.Logger) ListOperationResponse {
-	response := ListOperationResponse{
+func toListOperationResponse(operations []*operations.OperationDto, p *pagination.Pagination, log *zap.Logger) ListOperationResponse {
+	resp := ListOperationResponse{
 		Operations: make([]*OperationResponse, 0, len(operations)),
 	}
 
 	for i := range operations {
 		r, err := toOperationResponse(operations[i], log)
 		if err == nil {
-			response.Operations = append(response.Operations, r)
+			resp.Operations = append(resp.Operations, r)
 		}
 	}
 
-	return response
+	// build the keyset pagination cursor of the next page
+	if len(operations) > 0 {
+		last := operations[len(operations)-1]
+		if next := p.NextCursor(len(operations), last.Timestamp, last.ID); next != "" {
+			resp.Pagination = &response.ResponsePagination{Next: next}
+		}
+	}
+
+	return resp
 }
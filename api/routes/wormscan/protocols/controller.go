@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/gofiber/fiber/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/protocols"
+	_ "github.com/wormhole-foundation/wormhole-explorer/api/response" // required by swaggo
 	"go.uber.org/zap"
 )
 
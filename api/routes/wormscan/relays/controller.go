@@ -7,6 +7,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/relays"
 	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	_ "github.com/wormhole-foundation/wormhole-explorer/api/response" // required by swaggo
 	"go.uber.org/zap"
 )
 
@@ -29,8 +30,8 @@ func NewController(srv *relays.Service, logger *zap.Logger) *Controller {
 // @Tags wormholescan
 // @ID find-relay-by-vaa-id
 // @Success 200 {object} relays.RelayResponse
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/relays/:chain/:emitter/:sequence [get]
 func (c *Controller) FindOne(ctx *fiber.Ctx) error {
 	chainID, addr, seq, err := middleware.ExtractVAAParams(ctx, c.logger)
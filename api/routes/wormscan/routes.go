@@ -7,23 +7,32 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/compress"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	addrsvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/address"
+	exportssvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/exports"
 	govsvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/governor"
+	guardiansvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/guardian"
+	heartbeatssvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/heartbeats"
 	infrasvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/infrastructure"
 	obssvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/observations"
 	opsvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/operations"
 	protocolssvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/protocols"
 	relayssvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/relays"
+	sourcemessagessvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/sourcemessages"
 	statssvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/stats"
+	streamsvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/stream"
 	trxsvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/transactions"
 	vaasvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/vaa"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/address"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/exports"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/governor"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/guardians"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/infrastructure"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/observations"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/operations"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/protocols"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/relays"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/sourcemessages"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/stats"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/stream"
 
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/transactions"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/vaa"
@@ -46,12 +55,17 @@ func RegisterRoutes(
 	operationsService *opsvc.Service,
 	statsService *statssvc.Service,
 	protocolsService *protocolssvc.Service,
+	streamService *streamsvc.Service,
+	exportsService *exportssvc.Service,
+	heartbeatsService *heartbeatssvc.Service,
+	guardianService *guardiansvc.Service,
+	sourceMessagesService *sourcemessagessvc.Service,
 ) {
 
 	// Set up controllers
 	addressCtrl := address.NewController(addressService, rootLogger)
 	vaaCtrl := vaa.NewController(vaaService, rootLogger)
-	observationsCtrl := observations.NewController(obsService, rootLogger)
+	observationsCtrl := observations.NewController(obsService, guardianService, rootLogger)
 	governorCtrl := governor.NewController(governorService, rootLogger)
 	infrastructureCtrl := infrastructure.NewController(infrastructureService)
 	transactionCtrl := transactions.NewController(transactionsService, rootLogger)
@@ -59,6 +73,8 @@ func RegisterRoutes(
 	opsCtrl := operations.NewController(operationsService, rootLogger)
 	statsCtrl := stats.NewController(statsService, rootLogger)
 	contributorsCtrl := protocols.NewController(rootLogger, protocolsService)
+	guardiansCtrl := guardians.NewController(heartbeatsService, guardianService, rootLogger)
+	sourceMessagesCtrl := sourcemessages.NewController(sourceMessagesService, rootLogger)
 
 	// Set up route handlers
 	api := app.Group("/api/v1")
@@ -84,6 +100,8 @@ func RegisterRoutes(
 
 	// accounts resource
 	api.Get("/address/:id", addressCtrl.FindById)
+	api.Get("/address/:id/portfolio", addressCtrl.GetPortfolio)
+	api.Get("/address/:id/activity", addressCtrl.GetActivity)
 
 	// analytics, transactions, custom endpoints
 	api.Get("/global-tx/:chain/:emitter/:sequence", transactionCtrl.FindGlobalTransactionByID)
@@ -123,6 +141,7 @@ func RegisterRoutes(
 	vaas.Get("/:chain/:emitter", vaaCtrl.FindByEmitter)
 	vaas.Get("/:chain/:emitter/:sequence", vaaCtrl.FindById)
 	vaas.Get("/:chain/:emitter/:sequence/duplicated", vaaCtrl.FindDuplicatedById)
+	vaas.Get("/:chain/:emitter/:sequence/signing-progress", observationsCtrl.GetSigningProgress)
 	vaas.Post("/parse", vaaCtrl.ParseVaa)
 
 	// oservations resource
@@ -133,6 +152,14 @@ func RegisterRoutes(
 	observations.Get("/:chain/:emitter/:sequence", observationsCtrl.FindAllByVAA)
 	observations.Get("/:chain/:emitter/:sequence/:signer/:hash", observationsCtrl.FindOne)
 
+	// pending vaas resource
+	api.Get("/pending-vaas", observationsCtrl.FindPendingVaas)
+
+	// source messages resource
+	sourceMessages := api.Group("/source-messages")
+	sourceMessages.Get("/unsigned", sourceMessagesCtrl.FindUnsigned)
+	sourceMessages.Get("/:chain/:emitter/gaps", sourceMessagesCtrl.FindSequenceGaps)
+
 	// governor resources
 	governor := api.Group("/governor")
 	governorLimit := governor.Group("/limit")
@@ -157,7 +184,29 @@ func RegisterRoutes(
 	enqueueVaas.Get("/", governorCtrl.GetEnqueuedVaas)
 	enqueueVaas.Get("/:chain", governorCtrl.GetEnqueuedVaasByChainID)
 	governor.Get("/vaas", governorCtrl.GetGovernorVaas)
+	governor.Get("/vaas/:chain/:emitter/:sequence", governorCtrl.GetGovernorVaaStatus)
+
+	// guardians resource
+	guardiansGroup := api.Group("/guardians")
+	guardiansGroup.Get("/uptime", guardiansCtrl.GetUptime)
+	guardiansGroup.Get("/height-lag/:chain", guardiansCtrl.GetHeightLag)
+	guardiansGroup.Get("/:guardian_address/events", guardiansCtrl.GetEvents)
 
 	relays := api.Group("/relays")
 	relays.Get("/:chain/:emitter/:sequence", relaysCtrl.FindOne)
+
+	// live stream, only available when enabled by configuration
+	if streamService != nil {
+		streamCtrl := stream.NewController(streamService, rootLogger)
+		api.Get("/stream", streamCtrl.Stream)
+	}
+
+	// exports of operations, only available when enabled by configuration
+	if exportsService != nil {
+		exportsCtrl := exports.NewController(exportsService, rootLogger)
+		exportsGroup := api.Group("/exports")
+		exportsGroup.Post("/", exportsCtrl.Create)
+		exportsGroup.Get("/:id", exportsCtrl.FindById)
+		exportsGroup.Get("/:id/download", exportsCtrl.Download)
+	}
 }
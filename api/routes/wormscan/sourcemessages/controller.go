@@ -0,0 +1,157 @@
+// Package sourcemessages handle the request of the messages published on the source chains.
+package sourcemessages
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/sourcemessages"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// maxSequenceRange is the maximum number of sequences of a request of sequence gaps.
+const maxSequenceRange uint64 = 100_000
+
+// Controller definition.
+type Controller struct {
+	srv    *sourcemessages.Service
+	logger *zap.Logger
+}
+
+// NewController create a new controler.
+func NewController(srv *sourcemessages.Service, logger *zap.Logger) *Controller {
+	return &Controller{
+		srv:    srv,
+		logger: logger.With(zap.String("module", "SourceMessagesController")),
+	}
+}
+
+// FindUnsigned godoc
+// @Description Returns the messages published on the source chains that do not have a VAA
+// @Description after the finality time of the chain, sorted by the finality time in descending order.
+// @Tags wormholescan
+// @ID find-unsigned-source-messages
+// @Param chain query integer false "id of the blockchain"
+// @Param page query integer false "Page number."
+// @Param pageSize query integer false "Number of elements per page."
+// @Success 200 {object} []sourcemessages.SourceMessageDoc
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /api/v1/source-messages/unsigned [get]
+func (c *Controller) FindUnsigned(ctx *fiber.Ctx) error {
+	p, err := middleware.ExtractPagination(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Check pagination max limit
+	if p.Limit > 1000 {
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
+	}
+
+	var chainID *sdk.ChainID
+	if param := ctx.Query("chain"); param != "" {
+		chain, err := strconv.ParseUint(param, 10, 16)
+		if err != nil {
+			return response.NewFieldError(ctx, errs.CodeInvalidChainID, "INVALID <chain> QUERY PARAMETER", err, "chain", param, "must be a wormhole chain id")
+		}
+		id := sdk.ChainID(chain)
+		chainID = &id
+	}
+
+	msgs, err := c.srv.FindUnsigned(ctx.Context(), chainID, p)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(msgs)
+}
+
+// FindSequenceGaps godoc
+// @Description Returns the ranges of sequences missing between the messages published by an emitter on the source chain.
+// @Tags wormholescan
+// @ID find-source-message-sequence-gaps
+// @Param chain_id path integer true "id of the blockchain"
+// @Param emitter path string true "address of the emitter"
+// @Description The range of sequences is limited to 100000 sequences. When one of the bounds is not set, it is
+// @Description set to the other bound plus or minus 100000 sequences, and when both are not set the range starts at 0.
+// @Param fromSequence query integer false "Lower bound of the sequences, inclusive."
+// @Param toSequence query integer false "Upper bound of the sequences, inclusive."
+// @Param page query integer false "Page number."
+// @Param pageSize query integer false "Number of elements per page."
+// @Success 200 {object} []sourcemessages.SequenceGap
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /api/v1/source-messages/:chain_id/:emitter/gaps [get]
+func (c *Controller) FindSequenceGaps(ctx *fiber.Ctx) error {
+	p, err := middleware.ExtractPagination(ctx)
+	if err != nil {
+		return err
+	}
+
+	chainID, emitter, err := middleware.ExtractVAAChainIDEmitter(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+
+	from, to, err := extractSequenceRange(ctx)
+	if err != nil {
+		return err
+	}
+
+	gaps, err := c.srv.FindSequenceGaps(ctx.Context(), chainID, emitter, from, to, p)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(gaps)
+}
+
+// extractSequenceRange parses the range of sequences of the request, limited to maxSequenceRange sequences.
+func extractSequenceRange(ctx *fiber.Ctx) (uint64, uint64, error) {
+	from, err := extractSequenceQueryParam(ctx, "fromSequence", math.MaxUint64)
+	if err != nil {
+		return 0, 0, err
+	}
+	to, err := extractSequenceQueryParam(ctx, "toSequence", math.MaxUint64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	switch {
+	case from == math.MaxUint64 && to == math.MaxUint64:
+		from, to = 0, maxSequenceRange-1
+	case from == math.MaxUint64:
+		from = 0
+		if to >= maxSequenceRange {
+			from = to - maxSequenceRange + 1
+		}
+	case to == math.MaxUint64:
+		to = from + maxSequenceRange - 1
+	}
+
+	if from > to {
+		return 0, 0, response.NewInvalidQueryParamError(ctx, "fromSequence cannot be greater than toSequence", nil)
+	}
+	if to-from >= maxSequenceRange {
+		return 0, 0, response.NewInvalidQueryParamError(ctx, fmt.Sprintf("the range of sequences cannot be greater than %d", maxSequenceRange), nil)
+	}
+	return from, to, nil
+}
+
+// extractSequenceQueryParam parses a sequence query parameter, returning the default value when it is not set.
+func extractSequenceQueryParam(ctx *fiber.Ctx, queryParam string, defaultValue uint64) (uint64, error) {
+	param := ctx.Query(queryParam)
+	if param == "" {
+		return defaultValue, nil
+	}
+	seq, err := strconv.ParseUint(param, 10, 63)
+	if err != nil {
+		return 0, response.NewInvalidQueryParamError(ctx, fmt.Sprintf("INVALID <%s> QUERY PARAMETER", queryParam), nil)
+	}
+	return seq, nil
+}
@@ -0,0 +1,50 @@
+package sourcemessages
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractSequenceRange(t *testing.T) {
+	testCases := []struct {
+		name  string
+		query string
+		from  uint64
+		to    uint64
+		err   bool
+	}{
+		{"no bounds", "", 0, 99_999, false},
+		{"from", "?fromSequence=500000", 500_000, 599_999, false},
+		{"to", "?toSequence=500000", 400_001, 500_000, false},
+		{"to lower than the range", "?toSequence=10", 0, 10, false},
+		{"both", "?fromSequence=10&toSequence=20", 10, 20, false},
+		{"range too long", "?fromSequence=0&toSequence=100000", 0, 0, true},
+		{"from greater than to", "?fromSequence=20&toSequence=10", 0, 0, true},
+		{"invalid", "?fromSequence=abc", 0, 0, true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var from, to uint64
+			var err error
+			app := fiber.New()
+			app.Get("/gaps", func(ctx *fiber.Ctx) error {
+				from, to, err = extractSequenceRange(ctx)
+				return nil
+			})
+			_, testErr := app.Test(httptest.NewRequest("GET", "/gaps"+testCase.query, nil))
+			require.NoError(t, testErr)
+
+			if testCase.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.from, from)
+			assert.Equal(t, testCase.to, to)
+		})
+	}
+}
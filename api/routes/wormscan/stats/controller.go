@@ -34,8 +34,8 @@ func NewController(statsService *stats.Service, logger *zap.Logger) *Controller
 // @ID top-symbols-by-volume
 // @Param timeSpan query string false "Time span, supported values: 7d, 15d and 30d (default is 7d)."
 // @Success 200 {object} stats.TopSymbolByVolumeResult
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/top-symbols-by-volume [get]
 func (c *Controller) GetTopSymbolsByVolume(ctx *fiber.Ctx) error {
 	timeSpan, err := middleware.ExtractSymbolWithAssetsTimeSpan(ctx)
@@ -103,8 +103,8 @@ func (c *Controller) createTopSymbolsByVolumeResult(assets []stats.SymbolWithAss
 // @ID /api/v1/top-100-corridors
 // @Param timeSpan query string false "Time span, supported values: 2d and 7d (default is 2d)."
 // @Success 200 {object} stats.TopCorridorsResult
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/top-100-corridors [get]
 func (c *Controller) GetTopCorridors(ctx *fiber.Ctx) error {
 	timeSpan, err := middleware.ExtractTopCorridorsTimeSpan(ctx)
@@ -152,8 +152,8 @@ func createTop100CorridorsResult(corridors []stats.TopCorridorsDTO) []*TopCorrid
 // @ID /api/v1/native-token-transfer/summary
 // @Param symbol query string true "Symbol of the token. Currently only supports W."
 // @Success 200 {object} stats.NativeTokenTransferSummary
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/native-token-transfer/summary [get]
 func (c *Controller) GetNativeTokenTransferSummary(ctx *fiber.Ctx) error {
 	symbolParam, err := middleware.ExtractSymbol(ctx)
@@ -177,8 +177,8 @@ func (c *Controller) GetNativeTokenTransferSummary(ctx *fiber.Ctx) error {
 // @Param symbol query string true "Symbol of the token. Currently only supports W."
 // @Param by query string false "Renders the results using notional or tx count (default is notional)."
 // @Success 200 {object} []stats.NativeTokenTransferActivity
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/native-token-transfer/activity [get]
 func (c *Controller) GetNativeTokenTransferActivity(ctx *fiber.Ctx) error {
 	symbolParam, err := middleware.ExtractSymbol(ctx)
@@ -209,8 +209,8 @@ func (c *Controller) GetNativeTokenTransferActivity(ctx *fiber.Ctx) error {
 // @Param by query string false "Renders the results using notional or tx count (default is notional)."
 // @Param timeSpan query string true "Time Span, supported values: [1h, 1d, 1mo, 1y]."
 // @Success 200 {object} []stats.NativeTokenTransferByTime
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/native-token-transfer/transfer-by-time [get]
 func (c *Controller) GetNativeTokenTransferByTime(ctx *fiber.Ctx) error {
 	from, err := middleware.ExtractTime(ctx, time.RFC3339, "from")
@@ -252,8 +252,8 @@ func (c *Controller) GetNativeTokenTransferByTime(ctx *fiber.Ctx) error {
 // @Param symbol query string true "Symbol of the token. Currently only supports W."
 // @Param by query string false "Renders the results using notional or tx count (default is notional)."
 // @Success 200 {object} []stats.NativeTokenTransferTopAddress
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/native-token-transfer/top-address [get]
 func (c *Controller) GetNativeTokenTransferAddressTop(ctx *fiber.Ctx) error {
 	symbolParam, err := middleware.ExtractSymbol(ctx)
@@ -279,8 +279,8 @@ func (c *Controller) GetNativeTokenTransferAddressTop(ctx *fiber.Ctx) error {
 // @ID /api/v1/native-token-transfer/top-holder
 // @Param symbol query string true "Symbol of the token. Currently only supports W."
 // @Success 200 {object} []stats.NativeTokenTransferTopHolder
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/native-token-transfer/top-holder [get]
 func (c *Controller) GetNativeTokenTransferTopHolder(ctx *fiber.Ctx) error {
 	symbolParam, err := middleware.ExtractSymbol(ctx)
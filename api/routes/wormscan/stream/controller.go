@@ -0,0 +1,119 @@
+// Package stream handle the request of the live stream of VAAs, operations and target transactions.
+package stream
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/pkg/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/stream"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/common/types"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// keepAliveInterval is the interval between keep-alive comments sent to idle clients.
+const keepAliveInterval = 15 * time.Second
+
+// Controller definition.
+type Controller struct {
+	srv    *stream.Service
+	logger *zap.Logger
+}
+
+// NewController create a new controler.
+func NewController(srv *stream.Service, logger *zap.Logger) *Controller {
+	return &Controller{
+		srv:    srv,
+		logger: logger.With(zap.String("module", "StreamController")),
+	}
+}
+
+// Stream godoc
+// @Description Stream new signed VAAs, parsed operations and target transaction updates as server-sent events.
+// @Description Each event has the type `vaa`, `operation` or `target-tx` and its id is the cursor to resume the stream.
+// @Tags wormholescan
+// @ID stream
+// @Param chain query string false "emitter chains of the events, separated by comma"
+// @Param emitter query string false "emitter address of the events"
+// @Param appId query string false "appId of the operations"
+// @Param address query string false "origin or destination address of the operations and target transactions"
+// @Param cursor query string false "cursor of the last received event, the Last-Event-ID header is also accepted"
+// @Success 200 {object} stream.Event
+// @Failure 400 {object} response.APIError
+// @Failure 429 {object} response.APIError
+// @Failure 500 {object} response.APIError
+// @Router /api/v1/stream [get]
+func (c *Controller) Stream(ctx *fiber.Ctx) error {
+
+	chainIDs, err := middleware.ExtractChains(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+
+	filter := stream.Filter{
+		ChainIDs: chainIDs,
+		AppID:    middleware.ExtractAppId(ctx, c.logger),
+		Address:  middleware.ExtractAddressFromQueryParams(ctx, c.logger),
+	}
+
+	if param := ctx.Query("emitter"); param != "" {
+		acceptSolanaFormat := len(chainIDs) == 1 && chainIDs[0] == sdk.ChainIDSolana
+		emitter, err := types.StringToAddress(param, acceptSolanaFormat)
+		if err != nil {
+			return response.NewFieldError(ctx, errs.CodeInvalidAddress, "MALFORMED EMITTER_ADDR", errors.WithStack(err), "emitter", param, "must be a wormhole address or a native address of the chain")
+		}
+		filter.EmitterAddr = emitter.Hex()
+	}
+
+	cursor := ctx.Query("cursor", ctx.Get("Last-Event-ID"))
+	sub, err := c.srv.Subscribe(filter, cursor)
+	if err != nil {
+		if errors.Is(err, stream.ErrInvalidCursor) {
+			return response.NewInvalidParamError(ctx, "parameter 'cursor' is not a valid cursor", err)
+		}
+		return err
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+	ctx.Set("X-Accel-Buffering", "no")
+
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer c.srv.Unsubscribe(sub)
+
+		ticker := time.NewTicker(keepAliveInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case e, ok := <-sub.Events():
+				if !ok {
+					return
+				}
+				data, err := json.Marshal(e)
+				if err != nil {
+					c.logger.Error("failed to encode stream event", zap.String("id", e.ID), zap.Error(err))
+					continue
+				}
+				fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", e.Cursor, e.Type, data)
+			case <-ticker.C:
+				fmt.Fprint(w, ": keep-alive\n\n")
+			}
+
+			// the client is gone when the flush fails.
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+
+	return nil
+}
@@ -37,8 +37,8 @@ func NewController(transactionsService *transactions.Service, logger *zap.Logger
 // @Param timeSpan query string false "Time Span, default: 1d, supported values: [1d, 1w, 1mo]. 1mo ​​is 30 days."
 // @Param sampleRate query string false "Sample Rate, default: 1h, supported values: [1h, 1d]. Valid configurations with timeSpan: 1d/1h, 1w/1d, 1mo/1d"
 // @Success 200 {object} []transactions.TransactionCountResult
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/last-txs [get]
 func (c *Controller) GetLastTransactions(ctx *fiber.Ctx) error {
 	timeSpan, sampleRate, err := middleware.ExtractTimeSpanAndSampleRate(ctx, c.logger)
@@ -71,7 +71,7 @@ func (c *Controller) GetLastTransactions(ctx *fiber.Ctx) error {
 // @Tags wormholescan
 // @ID get-scorecards
 // @Success 200 {object} ScorecardsResponse
-// @Failure 500
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/scorecards [get]
 func (c *Controller) GetScorecards(ctx *fiber.Ctx) error {
 
@@ -102,7 +102,7 @@ func (c *Controller) GetScorecards(ctx *fiber.Ctx) error {
 // @ID get-top-chain-pairs-by-num-transfers
 // @Param timeSpan query string true "Time span, supported values: 7d, 15d, 30d."
 // @Success 200 {object} TopChainPairsResponse
-// @Failure 500
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/top-chain-pairs-by-num-transfers [get]
 func (c *Controller) GetTopChainPairs(ctx *fiber.Ctx) error {
 
@@ -142,7 +142,7 @@ func (c *Controller) GetTopChainPairs(ctx *fiber.Ctx) error {
 // @ID get-top-assets-by-volume
 // @Param timeSpan query string true "Time span, supported values: 7d, 15d, 30d."
 // @Success 200 {object} TopAssetsResponse
-// @Failure 500
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/top-assets-by-volume [get]
 func (c *Controller) GetTopAssets(ctx *fiber.Ctx) error {
 
@@ -194,8 +194,8 @@ func (c *Controller) GetTopAssets(ctx *fiber.Ctx) error {
 // @Param to query string true "To date, supported format 2006-01-02T15:04:05Z07:00"
 // @Param appIds query string false "Search by appId"
 // @Success 200 {object} transactions.ChainActivityTopResults
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/application-activity [get]
 func (c *Controller) GetApplicationActivity(ctx *fiber.Ctx) error {
 
@@ -272,8 +272,8 @@ func (c *Controller) GetApplicationActivity(ctx *fiber.Ctx) error {
 // @Param sourceChain query string false "Search by sourceChain"
 // @Param targetChain query string false "Search by targetChain"
 // @Success 200 {object} transactions.ChainActivityTopResults
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/x-chain-activity/tops [get]
 func (c *Controller) GetChainActivityTops(ctx *fiber.Ctx) error {
 
@@ -339,8 +339,8 @@ func (c *Controller) GetChainActivityTops(ctx *fiber.Ctx) error {
 // @Param by query string false "Renders the results using notional or tx count (default is notional)."
 // @Param apps query string false "List of apps separated by comma (default is all apps)."
 // @Success 200 {object} transactions.ChainActivity
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/x-chain-activity [get]
 func (c *Controller) GetChainActivity(ctx *fiber.Ctx) error {
 
@@ -446,8 +446,8 @@ func (c *Controller) createChainActivityResponse(activity []transactions.ChainAc
 // @Param emitter path string true "address of the emitter"
 // @Param seq path integer true "sequence of the VAA"
 // @Success 200 {object} Tx
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/global-tx/:chain_id/:emitter/:seq [get]
 func (c *Controller) FindGlobalTransactionByID(ctx *fiber.Ctx) error {
 	chainID, emitter, seq, err := middleware.ExtractVAAParams(ctx, c.logger)
@@ -475,8 +475,8 @@ func convertToDecimal(amount decimal.Decimal) decimal.Decimal {
 // @Param chain_id path integer true "id of the blockchain"
 // @Param token_address path string true "token address"
 // @Success 200 {object} transactions.Token
-// @Failure 400
-// @Failure 404
+// @Failure 400 {object} response.APIError
+// @Failure 404 {object} response.APIError
 // @Router /api/v1/token/:chain_id/:token_address [get]
 func (c *Controller) GetTokenByChainAndAddress(ctx *fiber.Ctx) error {
 	chain, err := middleware.ExtractChainID(ctx, c.logger)
@@ -504,10 +504,11 @@ func (c *Controller) GetTokenByChainAndAddress(ctx *fiber.Ctx) error {
 // @Param page query integer false "Page number. Starts at 0."
 // @Param pageSize query integer false "Number of elements per page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
+// @Param cursor query string false "Cursor returned in the pagination of the previous page."
 // @Param address query string false "Filter transactions by Address."
 // @Success 200 {object} ListTransactionsResponse
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/transactions/ [get]
 func (c *Controller) ListTransactions(ctx *fiber.Ctx) error {
 
@@ -520,7 +521,7 @@ func (c *Controller) ListTransactions(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if pagination.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errors.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	// Query transactions from the database
@@ -535,8 +536,17 @@ func (c *Controller) ListTransactions(ctx *fiber.Ctx) error {
 	}
 
 	// Populate the response struct and return
-	response := c.makeTransactionsResponse(dtos)
-	return ctx.JSON(response)
+	resp := c.makeTransactionsResponse(dtos)
+
+	// Build the keyset pagination cursor of the next page
+	if len(dtos) > 0 {
+		last := dtos[len(dtos)-1]
+		if next := pagination.NextCursor(len(dtos), &last.Timestamp, last.ID); next != "" {
+			resp.Pagination = &response.ResponsePagination{Next: next}
+		}
+	}
+
+	return ctx.JSON(resp)
 }
 
 func (c *Controller) makeTransactionsResponse(dtos []transactions.TransactionDto) ListTransactionsResponse {
@@ -606,8 +616,8 @@ func (c *Controller) makeTransactionDetail(input *transactions.TransactionDto) *
 // @Param emitter path string true "address of the emitter"
 // @Param seq path integer true "sequence of the VAA"
 // @Success 200 {object} TransactionDetail
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/transactions/:chain_id/:emitter/:seq [get]
 func (c *Controller) GetTransactionByID(ctx *fiber.Ctx) error {
 
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/transactions"
+	"github.com/wormhole-foundation/wormhole-explorer/api/response"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
@@ -27,5 +28,6 @@ type TransactionDetail struct {
 
 // ListTransactionsResponse is the "200 OK" response model for `GET /api/v1/transactions`.
 type ListTransactionsResponse struct {
-	Transactions []*TransactionDetail `json:"transactions"`
+	Transactions []*TransactionDetail         `json:"transactions"`
+	Pagination   *response.ResponsePagination `json:"pagination,omitempty"`
 }
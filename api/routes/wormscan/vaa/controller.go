@@ -8,6 +8,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/pkg/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/vaa"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
 	_ "github.com/wormhole-foundation/wormhole-explorer/api/response" // required by swaggo
@@ -31,13 +32,14 @@ func NewController(serv *vaa.Service, logger *zap.Logger) *Controller {
 // @ID find-all-vaas
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
+// @Param cursor query string false "Cursor returned in the pagination of the previous page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
 // @Param txHash query string false "Transaction hash of the VAA"
 // @Param parsedPayload query bool false "include the parsed contents of the VAA, if available"
 // @Param appId query string false "filter by application ID"
 // @Success 200 {object} response.Response[[]vaa.VaaDoc]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/vaas/ [get]
 func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 
@@ -48,7 +50,7 @@ func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if pagination.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	txHash, err := middleware.GetTxHash(ctx, c.logger)
@@ -86,10 +88,11 @@ func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 // @Param chain_id path integer true "id of the blockchain"
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
+// @Param cursor query string false "Cursor returned in the pagination of the previous page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
 // @Success 200 {object} response.Response[[]vaa.VaaDoc]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/vaas/:chain_id [get]
 func (c *Controller) FindByChain(ctx *fiber.Ctx) error {
 
@@ -100,7 +103,7 @@ func (c *Controller) FindByChain(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	chainID, err := middleware.ExtractChainID(ctx, c.logger)
@@ -125,10 +128,11 @@ func (c *Controller) FindByChain(ctx *fiber.Ctx) error {
 // @Param toChain query integer false "destination chain"
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
+// @Param cursor query string false "Cursor returned in the pagination of the previous page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
 // @Success 200 {object} response.Response[[]vaa.VaaDoc]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/vaas/:chain_id/:emitter [get]
 func (c *Controller) FindByEmitter(ctx *fiber.Ctx) error {
 
@@ -140,7 +144,7 @@ func (c *Controller) FindByEmitter(ctx *fiber.Ctx) error {
 
 	// Check pagination max limit
 	if pagination.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+		return response.NewFieldError(ctx, errs.CodeInvalidPagination, "pageSize cannot be greater than 1000", nil, "pageSize", ctx.Query("pageSize"), "must be at most 1000")
 	}
 
 	chainID, emitter, err := middleware.ExtractVAAChainIDEmitter(ctx, c.logger)
@@ -181,8 +185,8 @@ func (c *Controller) FindByEmitter(ctx *fiber.Ctx) error {
 // @Param seq path integer true "sequence of the VAA"
 // @Param parsedPayload query bool false "include the parsed contents of the VAA, if available"
 // @Success 200 {object} response.Response[[]vaa.VaaDoc]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/vaas/:chain_id/:emitter/:seq [get]
 func (c *Controller) FindById(ctx *fiber.Ctx) error {
 
@@ -214,8 +218,8 @@ func (c *Controller) FindById(ctx *fiber.Ctx) error {
 // @Tags wormholescan
 // @ID get-vaa-counts
 // @Success 200 {object} response.Response[[]vaa.VaaStats]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/vaas/vaa-counts [get]
 func (c *Controller) GetVaaCount(ctx *fiber.Ctx) error {
 
@@ -232,9 +236,9 @@ func (c *Controller) GetVaaCount(ctx *fiber.Ctx) error {
 // @Tags wormholescan
 // @ID parse-vaa
 // @Success 200 {object} parser.ParseVaaWithStandarizedPropertiesdResponse
-// @Failure 400
-// @Failure 404
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 404 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/vaas/parse [post]
 func (c *Controller) ParseVaa(ctx *fiber.Ctx) error {
 
@@ -279,8 +283,8 @@ func (c *Controller) ParseVaa(ctx *fiber.Ctx) error {
 // @Param emitter path string true "address of the emitter"
 // @Param seq path integer true "sequence of the VAA"
 // @Success 200 {object} response.Response[[]vaa.VaaDoc]
-// @Failure 400
-// @Failure 500
+// @Failure 400 {object} response.APIError
+// @Failure 500 {object} response.APIError
 // @Router /api/v1/vaas/:chain_id/:emitter/:seq/duplicated [get]
 func (c *Controller) FindDuplicatedById(ctx *fiber.Ctx) error {
 
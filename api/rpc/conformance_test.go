@@ -0,0 +1,362 @@
+package rpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/governor"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/guardian"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/heartbeats"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/vaa"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/config"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	guardianRoutes "github.com/wormhole-foundation/wormhole-explorer/api/routes/guardian"
+	"github.com/wormhole-foundation/wormhole-explorer/api/rpc"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const emitterAddress = "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585"
+
+// gatewayMarshaler renders the gRPC responses the same way the grpc-gateway of the guardian public RPC does.
+var gatewayMarshaler = protojson.MarshalOptions{EmitUnpopulated: true}
+
+// Test_GatewayConformance checks that the endpoints of the guardian API covered here respond the same json the
+// grpc-gateway of the guardian public RPC renders for the response of the same gRPC method.
+// The explorer can add fields to the responses, but every field rendered by the grpc-gateway must be present with the same value.
+func Test_GatewayConformance(t *testing.T) {
+
+	m := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer m.Close()
+
+	guardianSetDoc := bson.D{
+		{Key: "_id", Value: int32(4)},
+		{Key: "keys", Value: bson.A{
+			bson.D{{Key: "index", Value: int32(1)}, {Key: "address", Value: []byte{0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff, 0x00, 0x11, 0x22, 0x33, 0x44}}},
+			bson.D{{Key: "index", Value: int32(0)}, {Key: "address", Value: []byte{0x58, 0xcc, 0x3a, 0xe5, 0xc0, 0x97, 0xb2, 0x13, 0xce, 0x3c, 0x81, 0x97, 0x9e, 0x1b, 0x9f, 0x95, 0x70, 0x74, 0x6a, 0xa5}}},
+		}},
+	}
+	heartbeatDoc := bson.D{
+		{Key: "_id", Value: "0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5"},
+		{Key: "boottimestamp", Value: int64(1713000000000000000)},
+		{Key: "counter", Value: int64(12345)},
+		{Key: "guardianaddr", Value: "0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5"},
+		{Key: "nodename", Value: "RockawayX"},
+		{Key: "timestamp", Value: int64(1713300000000000000)},
+		{Key: "version", Value: "v2.24.0"},
+		{Key: "networks", Value: bson.A{
+			bson.D{{Key: "id", Value: int64(2)}, {Key: "height", Value: int64(19670000)}, {Key: "contractaddress", Value: "0x98f3c9e6E3fAce36bAAd05FE09d375Ef1464288B"}, {Key: "errorcount", Value: int64(3)}, {Key: "safeheight", Value: int64(19669990)}, {Key: "finalizedheight", Value: int64(19669940)}},
+		}},
+	}
+	vaaDoc := bson.D{
+		{Key: "_id", Value: "2/" + emitterAddress + "/1"},
+		{Key: "emitterChain", Value: int32(2)},
+		{Key: "emitterAddr", Value: emitterAddress},
+		{Key: "sequence", Value: "1"},
+		{Key: "guardianSetIndex", Value: int32(4)},
+		{Key: "vaas", Value: []byte{0x01, 0x00, 0x00, 0x00, 0x04, 0x0d}},
+	}
+	availableNotionalDoc := bson.D{
+		{Key: "chainId", Value: int32(2)},
+		{Key: "availableNotional", Value: decimal(t, "49000000")},
+		{Key: "notionalLimit", Value: decimal(t, "50000000")},
+		{Key: "maxTransactionSize", Value: decimal(t, "5000000")},
+	}
+	enqueuedVaaDoc := bson.D{
+		{Key: "chainid", Value: int32(2)},
+		{Key: "emitteraddress", Value: emitterAddress},
+		{Key: "sequence", Value: "98765"},
+		{Key: "releasetime", Value: int64(1713400000)},
+		{Key: "notionalvalue", Value: decimal(t, "6000000")},
+		{Key: "txhash", Value: "0x6d0c3c4bbe5bc6a1d5c7fdf8d0d64b0ae5e1c5e1a0d2b4f3c5e6a7b8c9d0e1f2"},
+	}
+	tokenDoc := bson.D{
+		{Key: "originchainid", Value: int32(2)},
+		{Key: "originaddress", Value: "0x000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2"},
+		{Key: "price", Value: 3000.5},
+	}
+
+	messageID := &publicrpcv1.MessageID{EmitterChain: publicrpcv1.ChainID(2), EmitterAddress: emitterAddress, Sequence: 1}
+
+	testCases := []struct {
+		name string
+		// path is the path of the guardian API endpoint.
+		path string
+		// results are the results of the mongo commands executed to serve the request.
+		results [][]bson.D
+		// call calls the gRPC method of the endpoint.
+		call func(context.Context, *rpc.Handler) (proto.Message, error)
+	}{
+		{
+			name:    "GetCurrentGuardianSet",
+			path:    "/v1/guardianset/current",
+			results: [][]bson.D{{guardianSetDoc}},
+			call: func(ctx context.Context, h *rpc.Handler) (proto.Message, error) {
+				return h.GetCurrentGuardianSet(ctx, &publicrpcv1.GetCurrentGuardianSetRequest{})
+			},
+		},
+		{
+			name:    "GetLastHeartbeats",
+			path:    "/v1/heartbeats",
+			results: [][]bson.D{{guardianSetDoc}, {heartbeatDoc}},
+			call: func(ctx context.Context, h *rpc.Handler) (proto.Message, error) {
+				return h.GetLastHeartbeats(ctx, &publicrpcv1.GetLastHeartbeatsRequest{})
+			},
+		},
+		{
+			name:    "GetSignedVAA",
+			path:    "/v1/signed_vaa/2/" + emitterAddress + "/1",
+			results: [][]bson.D{{vaaDoc}},
+			call: func(ctx context.Context, h *rpc.Handler) (proto.Message, error) {
+				return h.GetSignedVAA(ctx, &publicrpcv1.GetSignedVAARequest{MessageId: messageID})
+			},
+		},
+		{
+			name:    "GetSignedVAA_NotFound",
+			path:    "/v1/signed_vaa/2/" + emitterAddress + "/1",
+			results: [][]bson.D{{}},
+			call: func(ctx context.Context, h *rpc.Handler) (proto.Message, error) {
+				return h.GetSignedVAA(ctx, &publicrpcv1.GetSignedVAARequest{MessageId: messageID})
+			},
+		},
+		{
+			name:    "GovernorGetAvailableNotionalByChain",
+			path:    "/v1/governor/available_notional_by_chain",
+			results: [][]bson.D{{availableNotionalDoc}},
+			call: func(ctx context.Context, h *rpc.Handler) (proto.Message, error) {
+				return h.GovernorGetAvailableNotionalByChain(ctx, &publicrpcv1.GovernorGetAvailableNotionalByChainRequest{})
+			},
+		},
+		{
+			name:    "GovernorGetEnqueuedVAAs",
+			path:    "/v1/governor/enqueued_vaas",
+			results: [][]bson.D{{enqueuedVaaDoc}},
+			call: func(ctx context.Context, h *rpc.Handler) (proto.Message, error) {
+				return h.GovernorGetEnqueuedVAAs(ctx, &publicrpcv1.GovernorGetEnqueuedVAAsRequest{})
+			},
+		},
+		{
+			name:    "GovernorIsVAAEnqueued",
+			path:    "/v1/governor/is_vaa_enqueued/2/" + emitterAddress + "/1",
+			results: [][]bson.D{{{{Key: "_id", Value: "2/" + emitterAddress + "/1"}}}},
+			call: func(ctx context.Context, h *rpc.Handler) (proto.Message, error) {
+				return h.GovernorIsVAAEnqueued(ctx, &publicrpcv1.GovernorIsVAAEnqueuedRequest{MessageId: messageID})
+			},
+		},
+		{
+			name:    "GovernorGetTokenList",
+			path:    "/v1/governor/token_list",
+			results: [][]bson.D{{tokenDoc}},
+			call: func(ctx context.Context, h *rpc.Handler) (proto.Message, error) {
+				return h.GovernorGetTokenList(ctx, &publicrpcv1.GovernorGetTokenListRequest{})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		m.Run(tc.name, func(mt *mtest.T) {
+			app, handler := newGuardianAPI(mt)
+
+			// the REST endpoint is served first and then the gRPC method, both of them from the same results.
+			addCursorResponses(mt, tc.results)
+			addCursorResponses(mt, tc.results)
+
+			req, err := http.NewRequest(http.MethodGet, tc.path, nil)
+			assert.NoError(mt.T, err)
+			resp, err := app.Test(req, 1000)
+			assert.NoError(mt.T, err)
+			defer resp.Body.Close()
+			restBody, err := io.ReadAll(resp.Body)
+			assert.NoError(mt.T, err)
+
+			msg, err := tc.call(context.Background(), handler)
+			if err != nil {
+				// errors are compared by the gRPC code, which is the code of the error response of the REST endpoint.
+				var restErr struct {
+					Code int `json:"code"`
+				}
+				assert.NoError(mt.T, json.Unmarshal(restBody, &restErr))
+				assert.NotEqual(mt.T, http.StatusOK, resp.StatusCode)
+				assert.Equal(mt.T, int(status.Code(err)), restErr.Code)
+				return
+			}
+			assert.Equal(mt.T, http.StatusOK, resp.StatusCode, string(restBody))
+
+			gatewayBody, err := gatewayMarshaler.Marshal(msg)
+			assert.NoError(mt.T, err)
+
+			var gateway, rest interface{}
+			assert.NoError(mt.T, json.Unmarshal(gatewayBody, &gateway))
+			assert.NoError(mt.T, json.Unmarshal(restBody, &rest))
+			assertConforms(mt.T, "$", gateway, rest)
+		})
+	}
+}
+
+// newGuardianAPI creates the REST guardian API and the gRPC handler on top of the mocked mongo database.
+func newGuardianAPI(mt *mtest.T) (*fiber.App, *rpc.Handler) {
+	logger := zap.NewNop()
+	dummyCache := cache.NewDummyCacheClient()
+	noOpMetrics := metrics.NewNoOpMetrics()
+
+	vaaService := vaa.NewService(vaa.NewRepository(mt.DB, logger), dummyCache.Get, nil, logger)
+	governorService := governor.NewService(governor.NewRepository(mt.DB, logger), dummyCache, noOpMetrics, logger)
+	heartbeatsService := heartbeats.NewService(heartbeats.NewRepository(mt.DB, logger), logger)
+	guardianService := guardian.NewService(repository.NewGuardianSetRepository(mt.DB, logger), config.P2pMainNet, dummyCache, noOpMetrics, logger)
+
+	app := fiber.New(fiber.Config{
+		ErrorHandler:          middleware.ErrorHandler,
+		DisableStartupMessage: true,
+	})
+	guardianRoutes.RegisterRoutes(&config.AppConfig{}, app, logger, vaaService, governorService, heartbeatsService, guardianService)
+
+	return app, rpc.NewHandler(vaaService, heartbeatsService, governorService, guardianService, logger)
+}
+
+// addCursorResponses adds a mocked response with the documents of each result.
+func addCursorResponses(mt *mtest.T, results [][]bson.D) {
+	for _, docs := range results {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "wormhole.test", mtest.FirstBatch, docs...))
+	}
+}
+
+// assertConforms asserts that every field of the grpc-gateway json is present in the REST json with the same value.
+func assertConforms(t *testing.T, path string, gateway, rest interface{}) {
+	switch expected := gateway.(type) {
+	case map[string]interface{}:
+		actual, ok := rest.(map[string]interface{})
+		if !assert.True(t, ok, "%s: expected an object, got %v", path, rest) {
+			return
+		}
+		for key, value := range expected {
+			actualValue, ok := actual[key]
+			if !assert.True(t, ok, "%s.%s: missing field", path, key) {
+				continue
+			}
+			assertConforms(t, path+"."+key, value, actualValue)
+		}
+	case []interface{}:
+		actual, ok := rest.([]interface{})
+		if !assert.True(t, ok, "%s: expected an array, got %v", path, rest) || !assert.Len(t, actual, len(expected), path) {
+			return
+		}
+		for i := range expected {
+			assertConforms(t, path, expected[i], actual[i])
+		}
+	default:
+		assert.Equal(t, expected, rest, path)
+	}
+}
+
+func decimal(t *testing.T, value string) primitive.Decimal128 {
+	d, err := primitive.ParseDecimal128(value)
+	assert.NoError(t, err)
+	return d
+}
+
+// Test_GetSignedBatchVAA checks that the batch is made of the VAAs emitted with the nonce by the transaction,
+// sorted by sequence, both in the REST endpoint and in the gRPC method.
+func Test_GetSignedBatchVAA(t *testing.T) {
+
+	m := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer m.Close()
+
+	const txHash = "6d0c3c4bbe5bc6a1d5c7fdf8d0d64b0ae5e1c5e1a0d2b4f3c5e6a7b8c9d0e1f2"
+	emitter, err := sdk.StringToAddress(emitterAddress)
+	assert.NoError(t, err)
+	newVaaDoc := func(sequence uint64, nonce uint32) ([]byte, bson.D) {
+		v := &sdk.VAA{
+			Version:          1,
+			GuardianSetIndex: 4,
+			Timestamp:        time.Unix(1713300000, 0),
+			Nonce:            nonce,
+			Sequence:         sequence,
+			EmitterChain:     sdk.ChainIDEthereum,
+			EmitterAddress:   emitter,
+			Payload:          []byte{0x01},
+		}
+		data, err := v.Marshal()
+		assert.NoError(t, err)
+		return data, bson.D{
+			{Key: "_id", Value: v.MessageID()},
+			{Key: "emitterChain", Value: int32(2)},
+			{Key: "emitterAddr", Value: emitterAddress},
+			{Key: "sequence", Value: strconv.FormatUint(sequence, 10)},
+			{Key: "guardianSetIndex", Value: int32(4)},
+			{Key: "txHash", Value: txHash},
+			{Key: "vaas", Value: data},
+		}
+	}
+	vaa3, doc3 := newVaaDoc(3, 7)
+	_, doc2 := newVaaDoc(2, 8)
+	vaa1, doc1 := newVaaDoc(1, 7)
+
+	testCases := []struct {
+		name string
+		// results are the results of the mongo commands executed to serve the request:
+		// the globalTransactions of the transaction and the vaas of the transaction.
+		results  [][]bson.D
+		expected [][]byte
+		code     codes.Code
+	}{
+		{
+			name:     "Found",
+			results:  [][]bson.D{{}, {doc3, doc2, doc1}},
+			expected: [][]byte{vaa1, vaa3},
+		},
+		{
+			name:    "NotFound",
+			results: [][]bson.D{{}, {doc2}},
+			code:    codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		m.Run(tc.name, func(mt *mtest.T) {
+			app, handler := newGuardianAPI(mt)
+			addCursorResponses(mt, tc.results)
+			addCursorResponses(mt, tc.results)
+
+			req, err := http.NewRequest(http.MethodGet, "/v1/signed_batch_vaa/2/"+txHash+"/7", nil)
+			assert.NoError(mt.T, err)
+			resp, err := app.Test(req, 1000)
+			assert.NoError(mt.T, err)
+			defer resp.Body.Close()
+
+			batch, err := handler.GetSignedBatchVAA(context.Background(),
+				&rpc.GetSignedBatchVAARequest{EmitterChain: sdk.ChainIDEthereum, TxId: txHash, Nonce: 7})
+
+			if tc.code != codes.OK {
+				assert.Equal(mt.T, http.StatusNotFound, resp.StatusCode)
+				assert.Equal(mt.T, tc.code, status.Code(err))
+				return
+			}
+			var rest struct {
+				VaasBytes [][]byte `json:"vaasBytes"`
+			}
+			assert.Equal(mt.T, http.StatusOK, resp.StatusCode)
+			assert.NoError(mt.T, json.NewDecoder(resp.Body).Decode(&rest))
+			assert.Equal(mt.T, tc.expected, rest.VaasBytes)
+			assert.NoError(mt.T, err)
+			assert.Equal(mt.T, tc.expected, batch.VaasBytes)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package rpc
+
+import (
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain is the domain of the ErrorInfo detail of the gRPC errors.
+const errorDomain = "wormholescan.io"
+
+// internalErrorMessage is the message of the internal errors, the same used by the guardian public RPC.
+const internalErrorMessage = "internal server error"
+
+// statusError converts an error of the api to a gRPC status error.
+// The errors that are not of the error catalogue are classified by errs.CodeOf.
+func statusError(err error) error {
+	e := errs.As(err)
+	message := e.Message
+	if e.Code == errs.CodeInternal {
+		message = internalErrorMessage
+	}
+	return newStatusError(e.Code, message, e.Fields...)
+}
+
+// newStatusError creates a gRPC status error with the status code mapped from the code of the error catalogue.
+// The code is sent in the reason of an ErrorInfo detail, and the invalid fields in a BadRequest detail,
+// so that gRPC clients can tell errors apart the same way as http clients.
+func newStatusError(code errs.Code, message string, fields ...errs.FieldError) error {
+	st := status.New(code.GRPCCode(), message)
+	info := &errdetails.ErrorInfo{Reason: string(code), Domain: errorDomain}
+
+	var withDetails *status.Status
+	var err error
+	if len(fields) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, f := range fields {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       f.Field,
+				Description: f.Reason,
+			})
+		}
+		withDetails, err = st.WithDetails(info, badRequest)
+	} else {
+		withDetails, err = st.WithDetails(info)
+	}
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// emitterAddressField returns the field error of an invalid emitter address.
+func emitterAddressField(value string) errs.FieldError {
+	return errs.FieldError{Field: "message_id.emitter_address", Value: value, Reason: "must be a 32 bytes hex address"}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/certusone/wormhole/node/pkg/common"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/governor"
@@ -17,14 +18,11 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/common/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // Handler rpc handler.
 type Handler struct {
 	publicrpcv1.UnimplementedPublicRPCServiceServer
-	gs          guardian.GuardianSet
 	vaaSrv      *vaaservice.Service
 	hbSrv       *heartbeats.Service
 	govSrv      *governor.Service
@@ -41,27 +39,27 @@ func NewHandler(vaaSrv *vaaservice.Service, hbSrv *heartbeats.Service, govSrv *g
 func (h *Handler) GetSignedVAA(ctx context.Context, request *publicrpcv1.GetSignedVAARequest) (*publicrpcv1.GetSignedVAAResponse, error) {
 	// check and get chainID/address/sequence
 	if request.MessageId == nil {
-		return nil, status.Error(codes.InvalidArgument, "no message ID specified")
+		return nil, newStatusError(errs.CodeInvalidParam, "no message ID specified", errs.FieldError{Field: "message_id", Reason: "is required"})
 	}
 
 	chainID := vaa.ChainID(request.MessageId.EmitterChain.Number())
 
 	// This interface is not supported for PythNet messages because those VAAs are not stored in the database.
 	if chainID == vaa.ChainIDPythNet {
-		return nil, status.Error(codes.InvalidArgument, "not supported for PythNet")
+		return nil, newStatusError(errs.CodeInvalidChainID, "not supported for PythNet", errs.FieldError{Field: "message_id.emitter_chain", Value: chainID.String(), Reason: "is not supported"})
 	}
 
 	address, err := hex.DecodeString(request.MessageId.EmitterAddress)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode address from hex: %v", err))
+		return nil, newStatusError(errs.CodeInvalidAddress, fmt.Sprintf("failed to decode address from hex: %v", err), emitterAddressField(request.MessageId.EmitterAddress))
 	}
 	if len(address) != 32 {
-		return nil, status.Error(codes.InvalidArgument, "address must be 32 bytes")
+		return nil, newStatusError(errs.CodeInvalidAddress, "address must be 32 bytes", emitterAddressField(request.MessageId.EmitterAddress))
 	}
 
 	addr, err := types.BytesToAddress(address)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode address from bytes: %v", err))
+		return nil, newStatusError(errs.CodeInvalidAddress, fmt.Sprintf("failed to decode address from bytes: %v", err), emitterAddressField(request.MessageId.EmitterAddress))
 	}
 
 	sequence := strconv.FormatUint(request.MessageId.Sequence, 10)
@@ -76,10 +74,10 @@ func (h *Handler) GetSignedVAA(ctx context.Context, request *publicrpcv1.GetSign
 	)
 	if err != nil {
 		if errors.Is(err, errs.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, "requested VAA not found in store")
+			return nil, newStatusError(errs.CodeNotFound, "requested VAA not found in store")
 		}
 		h.logger.Error("failed to fetch VAA", zap.Error(err), zap.Any("request", request))
-		return nil, status.Error(codes.Internal, "internal server error")
+		return nil, statusError(err)
 	}
 
 	// build GetSignedVAAResponse response.
@@ -88,26 +86,74 @@ func (h *Handler) GetSignedVAA(ctx context.Context, request *publicrpcv1.GetSign
 	}, nil
 }
 
-// GetSignedBatchVAA get signed batch VAA.
-func (h *Handler) GetSignedBatchVAA(ctx context.Context, _ any) (any, error) {
-	return nil, status.Error(codes.Unimplemented, "not yet implemented")
+// GetSignedBatchVAARequest is the request of GetSignedBatchVAA.
+// The publicrpc proto of the guardian node does not define the batch messages, so they are defined here.
+type GetSignedBatchVAARequest struct {
+	EmitterChain vaa.ChainID
+	TxId         string
+	Nonce        uint32
 }
 
-// GetLastHeartbeats get last heartbeats.
-func (h *Handler) GetLastHeartbeats(ctx context.Context, request *publicrpcv1.GetLastHeartbeatsRequest) (*publicrpcv1.GetLastHeartbeatsResponse, error) {
+// GetSignedBatchVAAResponse is the response of GetSignedBatchVAA.
+type GetSignedBatchVAAResponse struct {
+	VaasBytes [][]byte
+}
+
+// GetSignedBatchVAA get the signed VAAs emitted with a nonce by a transaction.
+// Batch VAAs are not signed by the guardians, so the batch is made of the signed VAAs of its messages.
+func (h *Handler) GetSignedBatchVAA(ctx context.Context, request *GetSignedBatchVAARequest) (*GetSignedBatchVAAResponse, error) {
+	if request.EmitterChain == vaa.ChainIDUnset {
+		return nil, newStatusError(errs.CodeInvalidChainID, "no emitter chain specified", errs.FieldError{Field: "emitter_chain", Reason: "is required"})
+	}
+	txHash, err := types.ParseTxHash(request.TxId)
+	if err != nil {
+		return nil, newStatusError(errs.CodeInvalidHash, fmt.Sprintf("failed to parse tx id: %v", err), errs.FieldError{Field: "tx_id", Value: request.TxId, Reason: "must be a transaction hash"})
+	}
+
+	vaas, err := h.vaaSrv.FindBatch(ctx, request.EmitterChain, txHash, request.Nonce)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, newStatusError(errs.CodeNotFound, "requested batch VAA not found in store")
+		}
+		h.logger.Error("failed to fetch batch VAA", zap.Error(err), zap.Any("request", request))
+		return nil, statusError(err)
+	}
+
+	response := &GetSignedBatchVAAResponse{VaasBytes: make([][]byte, 0, len(vaas))}
+	for _, v := range vaas {
+		response.VaasBytes = append(response.VaasBytes, v.Vaa)
+	}
+	return response, nil
+}
+
+// getLatestGuardianSet get the latest guardian set, or a status error if it was not fetched from chain yet.
+func (h *Handler) getLatestGuardianSet(ctx context.Context) (*common.GuardianSet, error) {
+	gs, err := h.guardianSrv.GetGuardianSet(ctx)
+	if err != nil {
+		h.logger.Error("failed to get guardian set", zap.Error(err))
+		return nil, statusError(err)
+	}
 	// check guardianSet exists.
-	if len(h.gs.GstByIndex) == 0 {
-		return nil, status.Error(codes.Unavailable, "guardian set not fetched from chain yet")
+	if len(gs.GstByIndex) == 0 {
+		return nil, newStatusError(errs.CodeUnavailable, "guardian set not fetched from chain yet")
 	}
+	guardianSet := gs.GetLatest()
+	return &guardianSet, nil
+}
 
+// GetLastHeartbeats get last heartbeats.
+func (h *Handler) GetLastHeartbeats(ctx context.Context, request *publicrpcv1.GetLastHeartbeatsRequest) (*publicrpcv1.GetLastHeartbeatsResponse, error) {
 	// get lasted guardianSet.
-	guardianSet := h.gs.GetLatest()
+	guardianSet, err := h.getLatestGuardianSet(ctx)
+	if err != nil {
+		return nil, err
+	}
 	guardianAddresses := guardianSet.KeysAsHexStrings()
 
 	// get last heartbeats by ids.
 	heartbeats, err := h.hbSrv.GetHeartbeatsByIds(ctx, guardianAddresses)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "internal server error")
+		return nil, statusError(err)
 	}
 
 	response := &publicrpcv1.GetLastHeartbeatsResponse{
@@ -122,6 +168,8 @@ func (h *Handler) GetLastHeartbeats(ctx context.Context, request *publicrpcv1.Ge
 				Height:          network.Height,
 				ContractAddress: network.ContractAddress,
 				ErrorCount:      uint64(network.ErrorCount), //TODO:check
+				SafeHeight:      network.SafeHeight,
+				FinalizedHeight: network.FinalizedHeight,
 			}
 			networkResponses = append(networkResponses, &networkResponse)
 		}
@@ -148,12 +196,11 @@ func (h *Handler) GetLastHeartbeats(ctx context.Context, request *publicrpcv1.Ge
 
 // GetCurrentGuardianSet get current guardian set.
 func (h *Handler) GetCurrentGuardianSet(ctx context.Context, request *publicrpcv1.GetCurrentGuardianSetRequest) (*publicrpcv1.GetCurrentGuardianSetResponse, error) {
-	// check guardianSet exists.
-	if len(h.gs.GstByIndex) == 0 {
-		return nil, status.Error(codes.Unavailable, "guardian set not fetched from chain yet")
-	}
 	// get lasted guardianSet.
-	guardinSet := h.gs.GetLatest()
+	guardinSet, err := h.getLatestGuardianSet(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	// get guardian addresses.
 	addresses := make([]string, len(guardinSet.Keys))
@@ -173,7 +220,7 @@ func (h *Handler) GetCurrentGuardianSet(ctx context.Context, request *publicrpcv
 func (h *Handler) GovernorGetAvailableNotionalByChain(ctx context.Context, _ *publicrpcv1.GovernorGetAvailableNotionalByChainRequest) (*publicrpcv1.GovernorGetAvailableNotionalByChainResponse, error) {
 	availableNotional, err := h.govSrv.GetAvailNotionByChain(ctx)
 	if err != nil {
-		return nil, err
+		return nil, statusError(err)
 	}
 	entries := make([]*publicrpcv1.GovernorGetAvailableNotionalByChainResponse_Entry, 0)
 	for _, v := range availableNotional {
@@ -195,14 +242,14 @@ func (h *Handler) GovernorGetAvailableNotionalByChain(ctx context.Context, _ *pu
 func (h *Handler) GovernorGetEnqueuedVAAs(ctx context.Context, _ *publicrpcv1.GovernorGetEnqueuedVAAsRequest) (*publicrpcv1.GovernorGetEnqueuedVAAsResponse, error) {
 	enqueuedVaa, err := h.govSrv.GetEnqueuedVaas(ctx)
 	if err != nil {
-		return nil, err
+		return nil, statusError(err)
 	}
 
 	entries := make([]*publicrpcv1.GovernorGetEnqueuedVAAsResponse_Entry, 0, len(enqueuedVaa))
 	for _, v := range enqueuedVaa {
 		seqUint64, err := strconv.ParseUint(v.Sequence, 10, 64)
 		if err != nil {
-			return nil, err
+			return nil, statusError(err)
 		}
 		entry := publicrpcv1.GovernorGetEnqueuedVAAsResponse_Entry{
 			EmitterChain:   uint32(v.EmitterChain),
@@ -224,19 +271,19 @@ func (h *Handler) GovernorGetEnqueuedVAAs(ctx context.Context, _ *publicrpcv1.Go
 func (h *Handler) GovernorIsVAAEnqueued(ctx context.Context, request *publicrpcv1.GovernorIsVAAEnqueuedRequest) (*publicrpcv1.GovernorIsVAAEnqueuedResponse, error) {
 
 	if request.MessageId == nil {
-		return nil, status.Error(codes.InvalidArgument, "Parameters are required")
+		return nil, newStatusError(errs.CodeInvalidParam, "Parameters are required", errs.FieldError{Field: "message_id", Reason: "is required"})
 	}
 
 	chainID := vaa.ChainID(request.MessageId.EmitterChain)
 
 	emitterAddress, err := types.StringToAddress(request.MessageId.EmitterAddress, false /*acceptSolanaFormat*/)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "Invalid emitter address")
+		return nil, newStatusError(errs.CodeInvalidAddress, "Invalid emitter address", emitterAddressField(request.MessageId.EmitterAddress))
 	}
 
 	isEnqueued, err := h.govSrv.IsVaaEnqueued(ctx, chainID, emitterAddress, strconv.FormatUint(request.MessageId.Sequence, 10))
 	if err != nil {
-		return nil, err
+		return nil, statusError(err)
 	}
 
 	return &publicrpcv1.GovernorIsVAAEnqueuedResponse{IsEnqueued: isEnqueued}, nil
@@ -246,7 +293,7 @@ func (h *Handler) GovernorIsVAAEnqueued(ctx context.Context, request *publicrpcv
 func (h *Handler) GovernorGetTokenList(ctx context.Context, _ *publicrpcv1.GovernorGetTokenListRequest) (*publicrpcv1.GovernorGetTokenListResponse, error) {
 	tokenList, err := h.govSrv.GetTokenList(ctx)
 	if err != nil {
-		return nil, err
+		return nil, statusError(err)
 	}
 
 	entries := make([]*publicrpcv1.GovernorGetTokenListResponse_Entry, 0, len(tokenList))
@@ -0,0 +1,263 @@
+// Package backfill provides a resumable engine to run historical backfills.
+//
+// A backfill reads pages of items from a Source and processes each item with a Strategy using a
+// pool of workers. After each page the position of the source is stored as a checkpoint, so an
+// interrupted job can be resumed from the last page processed instead of starting again.
+package backfill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Source reads the items of a backfill in a stable order.
+type Source[T any] interface {
+	// Next returns the page of items following the cursor and the cursor of the last item of the page.
+	// The empty cursor is the start of the source. An empty page means there are no more items.
+	Next(ctx context.Context, cursor string, limit int64) ([]T, string, error)
+	// Key returns the identifier of an item, used to report the failures.
+	Key(item T) string
+}
+
+// QuerySource is implemented by the sources whose items depend on a query. The query is stored in the
+// checkpoint, so a job is never resumed from the cursor of a different query.
+type QuerySource interface {
+	// Query returns a canonical representation of the query of the source.
+	Query() string
+}
+
+// ResumableSource is implemented by the query sources with parameters set when the job is started, like
+// an end time that defaults to now. The parameters are restored from the query of the checkpoint when
+// the job is resumed.
+type ResumableSource interface {
+	QuerySource
+	// Restore restores the parameters of the source from the query of a checkpoint.
+	Restore(query string) error
+}
+
+// ErrQueryMismatch is returned when a job is resumed with a query other than the one of its checkpoint.
+var ErrQueryMismatch = errors.New("the checkpoint of the job was created with a different query")
+
+// Strategy processes the items of a backfill.
+//
+// The items of a page interrupted before the checkpoint are processed again when the job is resumed,
+// so the strategy must be idempotent.
+type Strategy[T any] interface {
+	Process(ctx context.Context, item T) error
+}
+
+// StrategyFunc is an adapter to use a function as a Strategy.
+type StrategyFunc[T any] func(ctx context.Context, item T) error
+
+// Process calls f(ctx, item).
+func (f StrategyFunc[T]) Process(ctx context.Context, item T) error {
+	return f(ctx, item)
+}
+
+// Config is the configuration of a backfill job.
+type Config struct {
+	// Name identifies the job and its checkpoint.
+	Name string
+	// PageSize is the number of items read from the source at a time.
+	PageSize int64
+	// Workers is the number of items processed concurrently.
+	Workers int
+	// RequestsPerMinute is the maximum number of items processed per minute, or 0 for no limit.
+	RequestsPerMinute int64
+	// Resume continues the job from its checkpoint instead of starting from the beginning.
+	Resume bool
+	// DryRun reads the items without processing them or storing checkpoints.
+	DryRun bool
+	// FailuresFile is the path of the file where the items that failed are appended, if any.
+	FailuresFile string
+}
+
+// Job is a backfill job.
+type Job[T any] struct {
+	cfg         Config
+	source      Source[T]
+	strategy    Strategy[T]
+	checkpoints CheckpointStore
+	logger      *zap.Logger
+}
+
+// NewJob creates a new backfill job. The checkpoints are not stored if checkpoints is nil.
+func NewJob[T any](cfg Config, source Source[T], strategy Strategy[T], checkpoints CheckpointStore, logger *zap.Logger) *Job[T] {
+	if cfg.PageSize <= 0 {
+		cfg.PageSize = 100
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = 1
+	}
+	return &Job[T]{
+		cfg:         cfg,
+		source:      source,
+		strategy:    strategy,
+		checkpoints: checkpoints,
+		logger:      logger.With(zap.String("module", "Backfill"), zap.String("job", cfg.Name)),
+	}
+}
+
+// Run runs the job until the source is exhausted or the context is cancelled, and returns the
+// last checkpoint of the job.
+func (j *Job[T]) Run(ctx context.Context) (*Checkpoint, error) {
+	checkpoint, err := j.start(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if checkpoint.Completed {
+		j.logger.Info("Backfill already completed", zap.Uint64("processed", checkpoint.Processed))
+		return checkpoint, nil
+	}
+
+	failures, err := newFailureWriter(j.cfg.FailuresFile)
+	if err != nil {
+		return checkpoint, err
+	}
+	defer failures.Close()
+
+	limiter := newLimiter(j.cfg.RequestsPerMinute)
+	defer limiter.stop()
+
+	j.logger.Info("Starting backfill",
+		zap.String("cursor", checkpoint.Cursor),
+		zap.Bool("dryRun", j.cfg.DryRun))
+
+	for {
+		items, cursor, err := j.source.Next(ctx, checkpoint.Cursor, j.cfg.PageSize)
+		if err != nil {
+			return checkpoint, err
+		}
+		if len(items) == 0 {
+			checkpoint.Completed = true
+			if err := j.save(ctx, checkpoint); err != nil {
+				return checkpoint, err
+			}
+			j.logger.Info("Backfill completed",
+				zap.Uint64("processed", checkpoint.Processed),
+				zap.Uint64("failed", checkpoint.Failed))
+			return checkpoint, nil
+		}
+
+		processed, failed := j.processPage(ctx, items, limiter, failures)
+		// the page is not checkpointed if it was interrupted, so it is processed again on resume.
+		if err := ctx.Err(); err != nil {
+			return checkpoint, err
+		}
+
+		checkpoint.Cursor = cursor
+		checkpoint.Processed += processed
+		checkpoint.Failed += failed
+		if err := j.save(ctx, checkpoint); err != nil {
+			return checkpoint, err
+		}
+		j.logger.Info("Processed page",
+			zap.String("cursor", cursor),
+			zap.Uint64("processed", checkpoint.Processed),
+			zap.Uint64("failed", checkpoint.Failed))
+	}
+}
+
+// start returns the checkpoint to start the job from.
+func (j *Job[T]) start(ctx context.Context) (*Checkpoint, error) {
+	now := time.Now()
+	query := j.query()
+	if j.cfg.Resume && j.checkpoints != nil {
+		checkpoint, err := j.checkpoints.Load(ctx, j.cfg.Name)
+		if err != nil {
+			return nil, err
+		}
+		if checkpoint != nil {
+			if s, ok := j.source.(ResumableSource); ok {
+				if err := s.Restore(checkpoint.Query); err != nil {
+					return nil, err
+				}
+				query = j.query()
+			}
+			if checkpoint.Query != query {
+				return nil, fmt.Errorf("%w: job %s, checkpoint query %q, query %q", ErrQueryMismatch, j.cfg.Name, checkpoint.Query, query)
+			}
+			j.logger.Info("Resuming backfill from checkpoint",
+				zap.String("cursor", checkpoint.Cursor),
+				zap.Time("startedAt", checkpoint.StartedAt))
+			return checkpoint, nil
+		}
+	}
+	return &Checkpoint{ID: j.cfg.Name, Query: query, StartedAt: now, UpdatedAt: now}, nil
+}
+
+// query returns the query of the source, or the empty string if the source has no query.
+func (j *Job[T]) query() string {
+	if s, ok := j.source.(QuerySource); ok {
+		return s.Query()
+	}
+	return ""
+}
+
+// save stores the checkpoint, unless the job is a dry run.
+func (j *Job[T]) save(ctx context.Context, checkpoint *Checkpoint) error {
+	checkpoint.UpdatedAt = time.Now()
+	if j.cfg.DryRun || j.checkpoints == nil {
+		return nil
+	}
+	return j.checkpoints.Save(ctx, checkpoint)
+}
+
+// processPage processes the items of a page concurrently and returns the number of items processed and failed.
+func (j *Job[T]) processPage(ctx context.Context, items []T, limiter *limiter, failures *failureWriter) (uint64, uint64) {
+	var mu sync.Mutex
+	var processed, failed uint64
+
+	queue := make(chan T)
+	var wg sync.WaitGroup
+	wg.Add(j.cfg.Workers)
+	for i := 0; i < j.cfg.Workers; i++ {
+		go func() {
+			defer wg.Done()
+			for item := range queue {
+				err := j.process(ctx, item, limiter)
+				if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+					continue
+				}
+				mu.Lock()
+				if err != nil {
+					failed++
+					j.logger.Error("Failed to process item", zap.String("key", j.source.Key(item)), zap.Error(err))
+					if err := failures.Write(j.source.Key(item), err); err != nil {
+						j.logger.Error("Failed to write failure", zap.String("key", j.source.Key(item)), zap.Error(err))
+					}
+				} else {
+					processed++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, item := range items {
+		if ctx.Err() != nil {
+			break
+		}
+		queue <- item
+	}
+	close(queue)
+	wg.Wait()
+	return processed, failed
+}
+
+// process processes an item, waiting for the rate limit.
+func (j *Job[T]) process(ctx context.Context, item T, limiter *limiter) error {
+	if j.cfg.DryRun {
+		j.logger.Debug("Dry run, skipping item", zap.String("key", j.source.Key(item)))
+		return nil
+	}
+	if err := limiter.wait(ctx); err != nil {
+		return err
+	}
+	return j.strategy.Process(ctx, item)
+}
@@ -0,0 +1,296 @@
+package backfill
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// sliceSource reads the integers of a slice, using the index of the last item as cursor.
+type sliceSource struct {
+	items []int
+}
+
+func (s *sliceSource) Next(_ context.Context, cursor string, limit int64) ([]int, string, error) {
+	start := 0
+	if cursor != "" {
+		last, err := strconv.Atoi(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		start = last + 1
+	}
+	if start >= len(s.items) {
+		return nil, cursor, nil
+	}
+	end := start + int(limit)
+	if end > len(s.items) {
+		end = len(s.items)
+	}
+	return s.items[start:end], strconv.Itoa(end - 1), nil
+}
+
+func (s *sliceSource) Key(item int) string {
+	return strconv.Itoa(item)
+}
+
+type memoryCheckpointStore struct {
+	checkpoints map[string]Checkpoint
+}
+
+func (s *memoryCheckpointStore) Load(_ context.Context, name string) (*Checkpoint, error) {
+	c, ok := s.checkpoints[name]
+	if !ok {
+		return nil, nil
+	}
+	return &c, nil
+}
+
+func (s *memoryCheckpointStore) Save(_ context.Context, checkpoint *Checkpoint) error {
+	s.checkpoints[checkpoint.ID] = *checkpoint
+	return nil
+}
+
+// recorder is a strategy that records the items processed and fails the odd items greater than failFrom.
+type recorder struct {
+	mu       sync.Mutex
+	items    []int
+	failFrom int
+}
+
+func (r *recorder) Process(_ context.Context, item int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.items = append(r.items, item)
+	if item > r.failFrom && item%2 == 1 {
+		return errors.New("odd item")
+	}
+	return nil
+}
+
+func newItems(n int) []int {
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+	return items
+}
+
+func TestJobRun(t *testing.T) {
+	store := &memoryCheckpointStore{checkpoints: map[string]Checkpoint{}}
+	strategy := &recorder{failFrom: 20}
+	failuresFile := filepath.Join(t.TempDir(), "failures.csv")
+	cfg := Config{Name: "test", PageSize: 10, Workers: 3, FailuresFile: failuresFile}
+
+	checkpoint, err := NewJob[int](cfg, &sliceSource{items: newItems(25)}, strategy, store, zap.NewNop()).Run(context.Background())
+	require.NoError(t, err)
+	assert.True(t, checkpoint.Completed)
+	assert.Equal(t, uint64(23), checkpoint.Processed)
+	assert.Equal(t, uint64(2), checkpoint.Failed)
+	assert.Len(t, strategy.items, 25)
+	assert.Equal(t, *checkpoint, store.checkpoints["test"])
+
+	failures, err := os.ReadFile(failuresFile)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"21,odd item", "23,odd item"}, strings.Split(strings.TrimSpace(string(failures)), "\n"))
+}
+
+func TestJobResume(t *testing.T) {
+	startedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := &memoryCheckpointStore{checkpoints: map[string]Checkpoint{
+		"test": {ID: "test", Cursor: "9", Processed: 10, StartedAt: startedAt},
+	}}
+	strategy := &recorder{failFrom: 100}
+	cfg := Config{Name: "test", PageSize: 10, Workers: 2, Resume: true}
+
+	checkpoint, err := NewJob[int](cfg, &sliceSource{items: newItems(25)}, strategy, store, zap.NewNop()).Run(context.Background())
+	require.NoError(t, err)
+	assert.True(t, checkpoint.Completed)
+	assert.Equal(t, uint64(25), checkpoint.Processed)
+	assert.Equal(t, startedAt, checkpoint.StartedAt)
+	assert.ElementsMatch(t, newItems(25)[10:], strategy.items)
+
+	// a completed job is not run again.
+	strategy.items = nil
+	_, err = NewJob[int](cfg, &sliceSource{items: newItems(25)}, strategy, store, zap.NewNop()).Run(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, strategy.items)
+
+	// a job is restarted from the beginning unless it is resumed.
+	cfg.Resume = false
+	checkpoint, err = NewJob[int](cfg, &sliceSource{items: newItems(25)}, strategy, store, zap.NewNop()).Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(25), checkpoint.Processed)
+	assert.Len(t, strategy.items, 25)
+}
+
+func TestJobInterrupted(t *testing.T) {
+	store := &memoryCheckpointStore{checkpoints: map[string]Checkpoint{}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	strategy := StrategyFunc[int](func(_ context.Context, item int) error {
+		if item == 15 {
+			cancel()
+		}
+		return nil
+	})
+	cfg := Config{Name: "test", PageSize: 10, Workers: 1}
+
+	_, err := NewJob[int](cfg, &sliceSource{items: newItems(25)}, strategy, store, zap.NewNop()).Run(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// the interrupted page is not checkpointed.
+	checkpoint := store.checkpoints["test"]
+	assert.False(t, checkpoint.Completed)
+	assert.Equal(t, "9", checkpoint.Cursor)
+	assert.Equal(t, uint64(10), checkpoint.Processed)
+}
+
+func TestJobDryRun(t *testing.T) {
+	store := &memoryCheckpointStore{checkpoints: map[string]Checkpoint{}}
+	strategy := &recorder{}
+	cfg := Config{Name: "test", PageSize: 10, DryRun: true}
+
+	checkpoint, err := NewJob[int](cfg, &sliceSource{items: newItems(25)}, strategy, store, zap.NewNop()).Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(25), checkpoint.Processed)
+	assert.Empty(t, strategy.items)
+	assert.Empty(t, store.checkpoints)
+}
+
+func TestTimeCursor(t *testing.T) {
+	cursor := &TimeCursor{
+		Timestamp: time.Date(2024, 1, 1, 0, 0, 0, int(123*time.Millisecond), time.UTC),
+		ID:        "2/ec7372995d5cc8732397fb0ad35c0121e0eaa90d26f828a534cab54391b3a4f5/10",
+	}
+	decoded, err := ParseTimeCursor(cursor.String())
+	require.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+
+	decoded, err = ParseTimeCursor("")
+	require.NoError(t, err)
+	assert.Nil(t, decoded)
+
+	_, err = ParseTimeCursor("invalid")
+	assert.Error(t, err)
+}
+
+func TestLineSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lines.csv")
+	require.NoError(t, os.WriteFile(path, []byte("a\n\nb\r\nc\nd"), 0o644))
+	source := NewLineSource(path)
+
+	lines, cursor, err := source.Next(context.Background(), "", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, lines)
+
+	lines, cursor, err = source.Next(context.Background(), cursor, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "d"}, lines)
+
+	lines, last, err := source.Next(context.Background(), cursor, 2)
+	require.NoError(t, err)
+	assert.Empty(t, lines)
+	assert.Equal(t, cursor, last)
+}
+
+func TestFileCheckpointStore(t *testing.T) {
+	store := NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoints.json"))
+
+	checkpoint, err := store.Load(context.Background(), "job")
+	require.NoError(t, err)
+	assert.Nil(t, checkpoint)
+
+	saved := &Checkpoint{ID: "job", Cursor: "10", Processed: 5, StartedAt: time.Now().UTC().Truncate(time.Second)}
+	require.NoError(t, store.Save(context.Background(), saved))
+	require.NoError(t, store.Save(context.Background(), &Checkpoint{ID: "other", Cursor: "1"}))
+
+	checkpoint, err = store.Load(context.Background(), "job")
+	require.NoError(t, err)
+	assert.Equal(t, saved.Cursor, checkpoint.Cursor)
+	assert.Equal(t, saved.Processed, checkpoint.Processed)
+	assert.True(t, saved.StartedAt.Equal(checkpoint.StartedAt))
+}
+
+// querySliceSource is a sliceSource with a query.
+type querySliceSource struct {
+	sliceSource
+	query string
+}
+
+func (s *querySliceSource) Query() string {
+	return s.query
+}
+
+func TestJobResumeQueryMismatch(t *testing.T) {
+	store := &memoryCheckpointStore{checkpoints: map[string]Checkpoint{}}
+	cfg := Config{Name: "test", PageSize: 10}
+	source := &querySliceSource{sliceSource: sliceSource{items: newItems(25)}, query: "emitterChain=2"}
+
+	checkpoint, err := NewJob[int](cfg, source, &recorder{failFrom: 100}, store, zap.NewNop()).Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "emitterChain=2", checkpoint.Query)
+
+	cfg.Resume = true
+	source.query = "emitterChain=4"
+	_, err = NewJob[int](cfg, source, &recorder{failFrom: 100}, store, zap.NewNop()).Run(context.Background())
+	assert.ErrorIs(t, err, ErrQueryMismatch)
+
+	source.query = "emitterChain=2"
+	checkpoint, err = NewJob[int](cfg, source, &recorder{failFrom: 100}, store, zap.NewNop()).Run(context.Background())
+	require.NoError(t, err)
+	assert.True(t, checkpoint.Completed)
+}
+
+func TestJobResumeWithoutEndTime(t *testing.T) {
+	store := &memoryCheckpointStore{checkpoints: map[string]Checkpoint{}}
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	chain := sdk.ChainIDEthereum
+	query := repository.VaaQuery{StartTime: &startTime, EmitterChainID: &chain}
+
+	// the job is started without end time and interrupted after its first checkpoint.
+	source := NewVaaSource(nil, query)
+	store.checkpoints["test"] = Checkpoint{ID: "test", Query: source.Query(), Completed: true}
+
+	// the job is resumed later, over the end time of the checkpoint instead of the new now.
+	time.Sleep(time.Millisecond)
+	cfg := Config{Name: "test", Resume: true}
+	resumed := NewVaaSource(nil, query)
+	assert.NotEqual(t, source.Query(), resumed.Query())
+	checkpoint, err := NewJob[*repository.VaaDoc](cfg, resumed, nil, store, zap.NewNop()).Run(context.Background())
+	require.NoError(t, err)
+	assert.True(t, checkpoint.Completed)
+	assert.Equal(t, source.Query(), resumed.Query())
+
+	// a different query is still rejected.
+	other := chain + 1
+	query.EmitterChainID = &other
+	_, err = NewJob[*repository.VaaDoc](cfg, NewVaaSource(nil, query), nil, store, zap.NewNop()).Run(context.Background())
+	assert.ErrorIs(t, err, ErrQueryMismatch)
+}
+
+func TestParseTimeRange(t *testing.T) {
+	start, end, err := ParseTimeRange("2024-01-01T00:00:00Z", "")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Nil(t, end)
+
+	_, end, err = ParseTimeRange("2024-01-01T00:00:00Z", "2024-01-02T00:00:00Z")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), *end)
+
+	_, _, err = ParseTimeRange("2024-01-02T00:00:00Z", "2024-01-01T00:00:00Z")
+	assert.Error(t, err)
+}
@@ -0,0 +1,127 @@
+package backfill
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Checkpoint is the progress of a backfill job.
+type Checkpoint struct {
+	ID        string    `bson:"_id"`
+	Query     string    `bson:"query"`
+	Cursor    string    `bson:"cursor"`
+	Processed uint64    `bson:"processed"`
+	Failed    uint64    `bson:"failed"`
+	Completed bool      `bson:"completed"`
+	StartedAt time.Time `bson:"startedAt"`
+	UpdatedAt time.Time `bson:"updatedAt"`
+}
+
+// CheckpointStore stores the checkpoints of the backfill jobs.
+type CheckpointStore interface {
+	// Load returns the checkpoint of a job, or nil if the job has no checkpoint.
+	Load(ctx context.Context, name string) (*Checkpoint, error)
+	Save(ctx context.Context, checkpoint *Checkpoint) error
+}
+
+// MongoCheckpointStore is a CheckpointStore backed by the backfillCheckpoints collection.
+type MongoCheckpointStore struct {
+	collection *mongo.Collection
+}
+
+// NewMongoCheckpointStore creates a new MongoCheckpointStore.
+func NewMongoCheckpointStore(db *mongo.Database) *MongoCheckpointStore {
+	return &MongoCheckpointStore{collection: db.Collection(repository.BackfillCheckpoints)}
+}
+
+// Load returns the checkpoint of a job, or nil if the job has no checkpoint.
+func (s *MongoCheckpointStore) Load(ctx context.Context, name string) (*Checkpoint, error) {
+	var checkpoint Checkpoint
+	err := s.collection.FindOne(ctx, bson.M{"_id": name}).Decode(&checkpoint)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &checkpoint, nil
+}
+
+// Save stores the checkpoint of a job.
+func (s *MongoCheckpointStore) Save(ctx context.Context, checkpoint *Checkpoint) error {
+	_, err := s.collection.ReplaceOne(ctx, bson.M{"_id": checkpoint.ID}, checkpoint, options.Replace().SetUpsert(true))
+	return err
+}
+
+// FileCheckpointStore is a CheckpointStore backed by a json file, for the jobs that run without a database.
+type FileCheckpointStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileCheckpointStore creates a new FileCheckpointStore.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+// Load returns the checkpoint of a job, or nil if the job has no checkpoint.
+func (s *FileCheckpointStore) Load(_ context.Context, name string) (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	checkpoints, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	checkpoint, ok := checkpoints[name]
+	if !ok {
+		return nil, nil
+	}
+	return &checkpoint, nil
+}
+
+// Save stores the checkpoint of a job.
+func (s *FileCheckpointStore) Save(_ context.Context, checkpoint *Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	checkpoints, err := s.read()
+	if err != nil {
+		return err
+	}
+	checkpoints[checkpoint.ID] = *checkpoint
+	data, err := json.MarshalIndent(checkpoints, "", "  ")
+	if err != nil {
+		return err
+	}
+	// write to a temporary file and rename it, so an interrupted save does not corrupt the checkpoints.
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
+
+// read returns the checkpoints of the file by job name.
+func (s *FileCheckpointStore) read() (map[string]Checkpoint, error) {
+	checkpoints := make(map[string]Checkpoint)
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return checkpoints, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &checkpoints); err != nil {
+		return nil, fmt.Errorf("invalid checkpoints file %s: %w", s.path, err)
+	}
+	return checkpoints, nil
+}
@@ -0,0 +1,35 @@
+package backfill
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeCursor is the position of an item in a source sorted by ascending timestamp and id.
+type TimeCursor struct {
+	Timestamp time.Time
+	ID        string
+}
+
+// String encodes the cursor as the timestamp in milliseconds and the id of the item.
+func (c *TimeCursor) String() string {
+	return fmt.Sprintf("%d/%s", c.Timestamp.UnixMilli(), c.ID)
+}
+
+// ParseTimeCursor decodes a cursor encoded by TimeCursor.String, or returns nil for the empty cursor.
+func ParseTimeCursor(cursor string) (*TimeCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	ts, id, ok := strings.Cut(cursor, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	ms, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor %q: %w", cursor, err)
+	}
+	return &TimeCursor{Timestamp: time.UnixMilli(ms).UTC(), ID: id}, nil
+}
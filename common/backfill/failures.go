@@ -0,0 +1,49 @@
+package backfill
+
+import (
+	"encoding/csv"
+	"os"
+	"sync"
+)
+
+// failureWriter appends the items that failed to a csv file with the key of the item and the error.
+// A nil failureWriter discards the failures.
+type failureWriter struct {
+	mu     sync.Mutex
+	file   *os.File
+	writer *csv.Writer
+}
+
+// newFailureWriter opens the failures file, or returns a nil failureWriter if path is empty.
+func newFailureWriter(path string) (*failureWriter, error) {
+	if path == "" {
+		return nil, nil
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &failureWriter{file: file, writer: csv.NewWriter(file)}, nil
+}
+
+// Write appends a failed item to the file.
+func (w *failureWriter) Write(key string, err error) error {
+	if w == nil {
+		return nil
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := w.writer.Write([]string{key, err.Error()}); err != nil {
+		return err
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// Close closes the file.
+func (w *failureWriter) Close() error {
+	if w == nil {
+		return nil
+	}
+	return w.file.Close()
+}
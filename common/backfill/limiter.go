@@ -0,0 +1,43 @@
+package backfill
+
+import (
+	"context"
+	"time"
+)
+
+// limiter limits the number of items processed per minute. A nil limiter does not limit.
+type limiter struct {
+	ticker *time.Ticker
+}
+
+// newLimiter creates a limiter, or returns a nil limiter if requestsPerMinute is not positive.
+func newLimiter(requestsPerMinute int64) *limiter {
+	if requestsPerMinute <= 0 {
+		return nil
+	}
+	interval := time.Minute / time.Duration(requestsPerMinute)
+	if interval <= 0 {
+		return nil
+	}
+	return &limiter{ticker: time.NewTicker(interval)}
+}
+
+// wait blocks until the next item can be processed or the context is cancelled.
+func (l *limiter) wait(ctx context.Context) error {
+	if l == nil {
+		return ctx.Err()
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-l.ticker.C:
+		return nil
+	}
+}
+
+// stop releases the resources of the limiter.
+func (l *limiter) stop() {
+	if l != nil {
+		l.ticker.Stop()
+	}
+}
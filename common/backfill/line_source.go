@@ -0,0 +1,72 @@
+package backfill
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// LineSource reads the non-empty lines of a file, using the byte offset following the last line as cursor.
+type LineSource struct {
+	path string
+}
+
+// NewLineSource creates a new LineSource.
+func NewLineSource(path string) *LineSource {
+	return &LineSource{path: path}
+}
+
+// Next returns the page of lines following the cursor.
+func (s *LineSource) Next(_ context.Context, cursor string, limit int64) ([]string, string, error) {
+	var offset int64
+	if cursor != "" {
+		var err error
+		offset, err = strconv.ParseInt(cursor, 10, 64)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid cursor %q: %w", cursor, err)
+		}
+	}
+
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, "", err
+	}
+	defer f.Close()
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, "", err
+	}
+
+	r := bufio.NewReader(f)
+	var lines []string
+	for int64(len(lines)) < limit {
+		line, err := r.ReadString('\n')
+		offset += int64(len(line))
+		if text := strings.TrimRight(line, "\r\n"); text != "" {
+			lines = append(lines, text)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	if len(lines) == 0 {
+		return nil, cursor, nil
+	}
+	return lines, strconv.FormatInt(offset, 10), nil
+}
+
+// Query returns the path of the file.
+func (s *LineSource) Query() string {
+	return "file=" + s.path
+}
+
+// Key returns the line.
+func (s *LineSource) Key(line string) string {
+	return line
+}
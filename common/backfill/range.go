@@ -0,0 +1,31 @@
+package backfill
+
+import (
+	"fmt"
+	"time"
+)
+
+// ParseTimeRange parses the RFC3339 start and end times of a backfill. The end time is nil if end is empty,
+// the VaaSource then sets it to the time the job is started and keeps it when the job is resumed.
+func ParseTimeRange(start, end string) (time.Time, *time.Time, error) {
+	startTime, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("failed to parse start time: %w", err)
+	}
+	if end == "" {
+		if startTime.After(time.Now()) {
+			return time.Time{}, nil, fmt.Errorf("start time %s should be in the past", startTime.Format(time.RFC3339))
+		}
+		return startTime, nil, nil
+	}
+
+	endTime, err := time.Parse(time.RFC3339, end)
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("failed to parse end time: %w", err)
+	}
+	if startTime.After(endTime) {
+		return time.Time{}, nil, fmt.Errorf("start time %s should be before end time %s",
+			startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+	}
+	return startTime, &endTime, nil
+}
@@ -0,0 +1,102 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+)
+
+// VaaSource reads the vaas matching a query sorted by ascending timestamp and id.
+type VaaSource struct {
+	repository *repository.VaaRepository
+	query      repository.VaaQuery
+	// openEnd is true if the query has no end time, it is then set to the time the source is created.
+	openEnd bool
+}
+
+// NewVaaSource creates a new VaaSource. If the query has no end time, the vaas are read up to the time
+// the source is created, so the query stored in the checkpoint of the job is bounded.
+func NewVaaSource(vaaRepository *repository.VaaRepository, query repository.VaaQuery) *VaaSource {
+	s := &VaaSource{repository: vaaRepository, query: query}
+	if query.EndTime == nil {
+		now := time.Now()
+		s.query.EndTime = &now
+		s.openEnd = true
+	}
+	return s
+}
+
+// Next returns the page of vaas following the cursor.
+func (s *VaaSource) Next(ctx context.Context, cursor string, limit int64) ([]*repository.VaaDoc, string, error) {
+	c, err := ParseTimeCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	var after *repository.VaaCursor
+	if c != nil {
+		after = &repository.VaaCursor{Timestamp: c.Timestamp, ID: c.ID}
+	}
+	vaas, err := s.repository.FindPageAfter(ctx, s.query, after, limit)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(vaas) == 0 {
+		return nil, cursor, nil
+	}
+	last := vaas[len(vaas)-1]
+	if last.Timestamp == nil {
+		return nil, "", fmt.Errorf("vaa %s has no timestamp", last.ID)
+	}
+	next := TimeCursor{Timestamp: *last.Timestamp, ID: last.ID}
+	return vaas, next.String(), nil
+}
+
+// Query returns the filters of the query of the source.
+func (s *VaaSource) Query() string {
+	var filters []string
+	if s.query.StartTime != nil {
+		filters = append(filters, "startTime="+s.query.StartTime.UTC().Format(time.RFC3339Nano))
+	}
+	if s.query.EndTime != nil {
+		filters = append(filters, "endTime="+s.query.EndTime.UTC().Format(time.RFC3339Nano))
+	}
+	if s.query.EmitterChainID != nil {
+		filters = append(filters, fmt.Sprintf("emitterChain=%d", *s.query.EmitterChainID))
+	}
+	if s.query.EmitterAddress != nil {
+		filters = append(filters, "emitterAddr="+*s.query.EmitterAddress)
+	}
+	if s.query.Sequence != nil {
+		filters = append(filters, "sequence="+*s.query.Sequence)
+	}
+	return strings.Join(filters, ",")
+}
+
+// Restore sets the end time of a query without end time to the end time of the query of the checkpoint,
+// so a job started without end time is resumed over the same vaas.
+func (s *VaaSource) Restore(query string) error {
+	if !s.openEnd {
+		return nil
+	}
+	s.query.EndTime = nil
+	for _, filter := range strings.Split(query, ",") {
+		value, ok := strings.CutPrefix(filter, "endTime=")
+		if !ok {
+			continue
+		}
+		endTime, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return fmt.Errorf("invalid end time in checkpoint query %q: %w", query, err)
+		}
+		s.query.EndTime = &endTime
+	}
+	return nil
+}
+
+// Key returns the id of the vaa.
+func (s *VaaSource) Key(v *repository.VaaDoc) string {
+	return v.ID
+}
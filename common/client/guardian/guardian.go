@@ -12,6 +12,9 @@ import (
 
 const DefaultTimeout = 10
 
+// ErrVaaNotFound is returned when the guardian does not have the requested signed vaa.
+var ErrVaaNotFound = errors.New("signed vaa not found")
+
 // GuardianAPIClient guardian api client.
 type GuardianAPIClient struct {
 	Client  http.Client
@@ -50,6 +53,10 @@ func (c *GuardianAPIClient) GetSignedVAA(vaaID string) (*SignedVaa, error) {
 		c.Logger.Error("failed to call endpoint", zap.String("endpoint", endpointUrl), zap.Error(err))
 		return nil, err
 	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrVaaNotFound
+	}
 	if resp.StatusCode != http.StatusOK {
 		c.Logger.Error("failed to call endpoint", zap.String("endpoint", endpointUrl), zap.Int("status_code", resp.StatusCode))
 		return nil, errors.New("failed to call endpoint, status code is not 200")
@@ -42,6 +42,15 @@ type ParserVAAAPIClient struct {
 // ParseVaaFunc represent a parse vaa function.
 type ParseVaaFunc func(vaa *sdk.VAA) (any, error)
 
+// VaaParser is the interface implemented by the VAA payload parsers.
+//
+// It is implemented by the HTTP client of the vaa-payload-parser service and by
+// the in-process parser of the common/vaaparser package.
+type VaaParser interface {
+	ParseVaaWithStandarizedProperties(vaa *sdk.VAA) (*ParseVaaWithStandarizedPropertiesdResponse, error)
+	ParseVaa(vaa *sdk.VAA) (any, error)
+}
+
 // NewParserVAAAPIClient create new instances of ParserVAAAPIClient.
 func NewParserVAAAPIClient(timeout int64, baseURL string, logger *zap.Logger) (ParserVAAAPIClient, error) {
 	if timeout == 0 {
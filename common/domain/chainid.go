@@ -382,6 +382,28 @@ func DecodeNativeAddressToHex(chainID sdk.ChainID, address string) (string, erro
 	}
 }
 
+// NormalizeNativeAddress returns the 32-byte wormhole representation of a native address,
+// as a lowercase hex string without the 0x prefix.
+//
+// Addresses that are already in wormhole format (hex) are accepted for any chain.
+func NormalizeNativeAddress(chainID sdk.ChainID, address string) (string, error) {
+	if address == "" {
+		return "", fmt.Errorf("empty address")
+	}
+	hexAddress, err := DecodeNativeAddressToHex(chainID, address)
+	if err != nil {
+		hexAddress = address
+	}
+	addr, errAddr := sdk.StringToAddress(hexAddress)
+	if errAddr != nil {
+		if err != nil {
+			return "", err
+		}
+		return "", fmt.Errorf("invalid address %s for chain %d: %w", address, chainID, errAddr)
+	}
+	return addr.String(), nil
+}
+
 // decodeBech32 is a helper function to decode a bech32 addresses.
 func decodeBech32(h, address string) (string, error) {
 
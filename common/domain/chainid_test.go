@@ -229,3 +229,48 @@ func TestTranslateEmitterAddress(t *testing.T) {
 		}
 	}
 }
+
+func TestNormalizeNativeAddress(t *testing.T) {
+	var tests = []struct {
+		chainID sdk.ChainID
+		address string
+		want    string
+		wantErr bool
+	}{
+		{
+			chainID: sdk.ChainIDEthereum,
+			address: "0x3Ee18B2214AFF97000D974cf647E7C347E8fa585",
+			want:    "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585",
+		},
+		{
+			chainID: sdk.ChainIDSolana,
+			address: "3QFeCHsG9WDXzMozWyck8RUxmw59jyj7MPnQd4w2mbDL",
+			want:    "23ac499437a8e6533b790d5578af5d39b349883188eca535b957d82a0e77eb03",
+		},
+		{
+			chainID: sdk.ChainIDNear,
+			address: "23ac499437a8e6533b790d5578af5d39b349883188eca535b957d82a0e77eb03",
+			want:    "23ac499437a8e6533b790d5578af5d39b349883188eca535b957d82a0e77eb03",
+		},
+		{
+			chainID: sdk.ChainIDNear,
+			address: "not-an-address",
+			wantErr: true,
+		},
+		{
+			chainID: sdk.ChainIDEthereum,
+			address: "",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := NormalizeNativeAddress(test.chainID, test.address)
+		if test.wantErr {
+			assert.Error(t, err, "NormalizeNativeAddress(%d, %s)", test.chainID, test.address)
+			continue
+		}
+		assert.NoError(t, err, "NormalizeNativeAddress(%d, %s)", test.chainID, test.address)
+		assert.Equal(t, test.want, got, "NormalizeNativeAddress(%d, %s)", test.chainID, test.address)
+	}
+}
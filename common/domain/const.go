@@ -10,6 +10,9 @@ const (
 const (
 	AppIdUnkonwn           = "UNKONWN"
 	AppIdPortalTokenBridge = "PORTAL_TOKEN_BRIDGE"
+	AppIdCCTPWormhole      = "CCTP_WORMHOLE_INTEGRATION"
+	AppIdNTT               = "NATIVE_TOKEN_TRANSFER"
+	AppIdGenericRelayer    = "GENERIC_RELAYER"
 )
 
 // SourceTxStatus is meant to be a user-facing enum that describes the status of the source transaction.
@@ -25,8 +25,28 @@ type TokenMetadata struct {
 	Decimals    int64
 }
 
+// TokenRegistry resolves tokens that are not part of the static token lists,
+// e.g.: tokens recorded from Token Bridge attestations.
+type TokenRegistry interface {
+	GetTokenByAddress(tokenChain sdk.ChainID, tokenAddress string) (*TokenMetadata, bool)
+	GetTokenByCoingeckoID(coingeckoID string) (*TokenMetadata, bool)
+	GetAllTokens() []TokenMetadata
+}
+
+// TokenProviderOption is an option of the TokenProvider.
+type TokenProviderOption func(*TokenProvider)
+
+// WithTokenRegistry sets a registry that is consulted at runtime for the tokens
+// that are not part of the static token lists.
+func WithTokenRegistry(registry TokenRegistry) TokenProviderOption {
+	return func(t *TokenProvider) {
+		t.registry = registry
+	}
+}
+
 type TokenProvider struct {
 	p2pNetwork                 string
+	registry                   TokenRegistry
 	tokenMetadata              []TokenMetadata
 	tokenMetadataByContractID  map[string]*TokenMetadata
 	tokenMetadataByCoingeckoID map[string]*TokenMetadata
@@ -42,7 +62,7 @@ func makeContractID(tokenChain sdk.ChainID, tokenAddress string) string {
 	return fmt.Sprintf("%d-%s", tokenChain, tokenAddress)
 }
 
-func NewTokenProvider(p2pNetwork string) *TokenProvider {
+func NewTokenProvider(p2pNetwork string, opts ...TokenProviderOption) *TokenProvider {
 	var tokenMetadata []TokenMetadata
 
 	switch p2pNetwork {
@@ -77,7 +97,7 @@ func NewTokenProvider(p2pNetwork string) *TokenProvider {
 		coingeckoIDBySymbol[symbol] = tokenMetadata[i].CoingeckoID
 		tokenMetadataBySymbol[symbol] = append(tokenMetadataBySymbol[symbol], &tokenMetadata[i])
 	}
-	return &TokenProvider{
+	tokenProvider := &TokenProvider{
 		p2pNetwork:                 p2pNetwork,
 		tokenMetadata:              tokenMetadata,
 		tokenMetadataByContractID:  tokenMetadataByContractID,
@@ -85,13 +105,31 @@ func NewTokenProvider(p2pNetwork string) *TokenProvider {
 		tokenMetadataBySymbol:      tokenMetadataBySymbol,
 		coingeckIdBySymbol:         coingeckoIDBySymbol,
 	}
+	for _, opt := range opts {
+		opt(tokenProvider)
+	}
+	return tokenProvider
 }
 
 // GetAllTokens returns a list of all tokens that exist in the database.
+// Tokens of the registry that are not part of the static lists are appended at the end.
 //
 // The caller must not modify the `[]TokenMetadata` returned.
 func (t *TokenProvider) GetAllTokens() []TokenMetadata {
-	return t.tokenMetadata
+	if t.registry == nil {
+		return t.tokenMetadata
+	}
+
+	registryTokens := t.registry.GetAllTokens()
+	tokens := make([]TokenMetadata, 0, len(t.tokenMetadata)+len(registryTokens))
+	tokens = append(tokens, t.tokenMetadata...)
+	for _, token := range registryTokens {
+		if _, ok := t.tokenMetadataByContractID[makeContractID(token.TokenChain, token.TokenAddress)]; ok {
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens
 }
 
 // GetAllCoingeckoIDs returns a list of all coingecko IDs that exist in the database.
@@ -103,6 +141,15 @@ func (t *TokenProvider) GetAllCoingeckoIDs() []string {
 		uniqueIDs[t.tokenMetadata[i].CoingeckoID] = true
 	}
 
+	// add the coingecko IDs mapped by operators in the registry
+	if t.registry != nil {
+		for _, token := range t.registry.GetAllTokens() {
+			if token.CoingeckoID != "" {
+				uniqueIDs[token.CoingeckoID] = true
+			}
+		}
+	}
+
 	// collect keys into a slice
 	ids := make([]string, 0, len(uniqueIDs))
 	for k := range uniqueIDs {
@@ -119,6 +166,9 @@ func (t *TokenProvider) GetTokenByCoingeckoID(coingeckoID string) (*TokenMetadat
 
 	result, ok := t.tokenMetadataByCoingeckoID[coingeckoID]
 	if !ok {
+		if t.registry != nil {
+			return t.registry.GetTokenByCoingeckoID(coingeckoID)
+		}
 		return nil, false
 	}
 
@@ -134,6 +184,9 @@ func (t *TokenProvider) GetTokenByAddress(tokenChain sdk.ChainID, tokenAddress s
 
 	result, ok := t.tokenMetadataByContractID[key]
 	if !ok {
+		if t.registry != nil {
+			return t.registry.GetTokenByAddress(tokenChain, tokenAddress)
+		}
 		return nil, false
 	}
 
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+var (
+	ErrUnknownGuardianSet = errors.New("unknown guardian set")
+	ErrInvalidSignatures  = errors.New("VAA contains invalid signatures")
+	ErrNoQuorum           = errors.New("VAA does not have a quorum of signatures")
+)
+
+// VerifyVaa validates the guardian signatures of a VAA with the guardian sets of the network, sorted by index,
+// and checks the VAA has a quorum of signatures.
+func VerifyVaa(v *sdk.VAA, guardianSetsByIndex []common.GuardianSet) error {
+	if v.GuardianSetIndex >= uint32(len(guardianSetsByIndex)) {
+		return fmt.Errorf("%w: got %d, max is %d", ErrUnknownGuardianSet, v.GuardianSetIndex, len(guardianSetsByIndex))
+	}
+	keys := guardianSetsByIndex[v.GuardianSetIndex].Keys
+	if !v.VerifySignatures(keys) {
+		return ErrInvalidSignatures
+	}
+	if quorum := sdk.CalculateQuorum(len(keys)); len(v.Signatures) < quorum {
+		return fmt.Errorf("%w: got %d, quorum is %d", ErrNoQuorum, len(v.Signatures), quorum)
+	}
+	return nil
+}
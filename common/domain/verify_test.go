@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestVerifyVaa(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 0, 4)
+	addresses := make([]eth_common.Address, 0, 4)
+	for i := 0; i < 4; i++ {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys = append(keys, key)
+		addresses = append(addresses, crypto.PubkeyToAddress(key.PublicKey))
+	}
+	guardianSets := []common.GuardianSet{{Index: 0, Keys: addresses}}
+
+	newVaa := func(guardianSetIndex uint32, signers ...*ecdsa.PrivateKey) *sdk.VAA {
+		v := &sdk.VAA{
+			Version:          sdk.SupportedVAAVersion,
+			GuardianSetIndex: guardianSetIndex,
+			Timestamp:        time.Unix(1700000000, 0),
+			Sequence:         42,
+			EmitterChain:     sdk.ChainIDEthereum,
+			EmitterAddress:   sdk.Address{31: 1},
+			Payload:          []byte{1, 2, 3},
+		}
+		for i, key := range signers {
+			v.AddSignature(key, uint8(i))
+		}
+		return v
+	}
+	tampered := newVaa(0, keys...)
+	tampered.Payload = []byte{3, 2, 1}
+
+	tests := []struct {
+		name string
+		vaa  *sdk.VAA
+		err  error
+	}{
+		{name: "valid", vaa: newVaa(0, keys...)},
+		{name: "quorum", vaa: newVaa(0, keys[:3]...)},
+		{name: "unknown guardian set", vaa: newVaa(1, keys...), err: ErrUnknownGuardianSet},
+		{name: "invalid signatures", vaa: tampered, err: ErrInvalidSignatures},
+		{name: "no quorum", vaa: newVaa(0, keys[:2]...), err: ErrNoQuorum},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyVaa(tt.vaa, guardianSets)
+			if tt.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, tt.err), "got %v", err)
+			}
+		})
+	}
+}
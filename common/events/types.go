@@ -11,6 +11,7 @@ const (
 	EvmTransactionFoundType = "evm-transaction-found"
 	TransferRedeemedType    = "transfer-redeemed"
 	EvmTransferRedeemedName = "transfer-redeemed"
+	SourceTxConfirmedType   = "source-tx-confirmed"
 )
 
 type NotificationEvent struct {
@@ -38,7 +39,7 @@ func NewNotificationEvent[T EventData](trackID, source, _type string, data T) (*
 }
 
 type EventData interface {
-	SignedVaa | LogMessagePublished | EvmTransactionFound | TransferRedeemed
+	SignedVaa | LogMessagePublished | EvmTransactionFound | TransferRedeemed | SourceTxConfirmed
 }
 
 func GetEventData[T EventData](e *NotificationEvent) (T, error) {
@@ -117,3 +118,14 @@ type TransferRedeemedAttributes struct {
 	EffectiveGasPrice *string `json:"effectiveGasPrice"`
 	Fee               *uint64 `json:"fee"`
 }
+
+// SourceTxConfirmed is published by tx-tracker when the source transaction of a VAA is found.
+type SourceTxConfirmed struct {
+	ID             string     `json:"id"`
+	EmitterChain   uint16     `json:"emitterChain"`
+	EmitterAddress string     `json:"emitterAddress"`
+	Sequence       string     `json:"sequence"`
+	TxHash         string     `json:"txHash"`
+	From           string     `json:"from"`
+	Timestamp      *time.Time `json:"timestamp"`
+}
@@ -0,0 +1,102 @@
+// Package export exports the operations and the prices of their tokens at transfer time to CSV,
+// NDJSON or Parquet files.
+//
+// The exports are requested through the api, which stores them in the exports collection as pending.
+// The export job claims the pending exports, writes their files with an Exporter to a Storage, the
+// local disk or an S3-compatible store, and marks them as completed. The api serves the download of
+// the completed exports from the same Storage, until the export job deletes the exports and their
+// files once they expire.
+package export
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// ErrNotFound is returned when an export does not exist.
+var ErrNotFound = errors.New("export not found")
+
+// Format is the file format of an export.
+type Format string
+
+// Formats of the exports.
+const (
+	FormatCSV     Format = "csv"
+	FormatNDJSON  Format = "ndjson"
+	FormatParquet Format = "parquet"
+)
+
+// ParseFormat parses the name of a format, case insensitive.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case FormatCSV, FormatNDJSON, FormatParquet:
+		return f, nil
+	default:
+		return "", fmt.Errorf("invalid export format %q", s)
+	}
+}
+
+// ContentType returns the media type of the files of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv"
+	case FormatNDJSON:
+		return "application/x-ndjson"
+	default:
+		return "application/vnd.apache.parquet"
+	}
+}
+
+// Status is the status of an export.
+type Status string
+
+// Statuses of the exports.
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+)
+
+// Filter selects the operations of an export. The operations are selected by the time of their vaa,
+// in the range [From, To), and by every other field set.
+type Filter struct {
+	From        time.Time    `bson:"from" json:"from"`
+	To          time.Time    `bson:"to" json:"to"`
+	SourceChain *sdk.ChainID `bson:"sourceChain,omitempty" json:"sourceChain,omitempty"`
+	TargetChain *sdk.ChainID `bson:"targetChain,omitempty" json:"targetChain,omitempty"`
+	AppID       string       `bson:"appId,omitempty" json:"appId,omitempty"`
+	// Address is the sender or the receiver of the operations, in the native format of its chain.
+	Address      string       `bson:"address,omitempty" json:"address,omitempty"`
+	TokenChain   *sdk.ChainID `bson:"tokenChain,omitempty" json:"tokenChain,omitempty"`
+	TokenAddress string       `bson:"tokenAddress,omitempty" json:"tokenAddress,omitempty"`
+}
+
+// Export is a document of the exports collection.
+type Export struct {
+	ID     string `bson:"_id"`
+	Format Format `bson:"format"`
+	Filter Filter `bson:"filter"`
+	Status Status `bson:"status"`
+	// Client identifies the client that requested the export, to limit the exports requested by each client.
+	Client string `bson:"client,omitempty"`
+	// Key is the key of the file in the storage, set when the export is completed.
+	Key         string     `bson:"key,omitempty"`
+	Rows        int64      `bson:"rows"`
+	Size        int64      `bson:"size"`
+	Error       string     `bson:"error,omitempty"`
+	CreatedAt   time.Time  `bson:"createdAt"`
+	StartedAt   *time.Time `bson:"startedAt,omitempty"`
+	CompletedAt *time.Time `bson:"completedAt,omitempty"`
+	UpdatedAt   time.Time  `bson:"updatedAt"`
+}
+
+// FileName returns the name of the file of the export, used for the download.
+func (e *Export) FileName() string {
+	return fmt.Sprintf("operations-%s.%s", e.ID, e.Format)
+}
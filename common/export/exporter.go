@@ -0,0 +1,261 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+)
+
+// File is a file of an export stored.
+type File struct {
+	Key  string
+	Rows int64
+	Size int64
+}
+
+// Exporter writes the files of the exports.
+type Exporter struct {
+	parsedVaa *mongo.Collection
+	storage   Storage
+	pageSize  int64
+	logger    *zap.Logger
+}
+
+// NewExporter creates a new exporter that reads the operations by pages of pageSize.
+func NewExporter(db *mongo.Database, storage Storage, pageSize int64, logger *zap.Logger) *Exporter {
+	return &Exporter{
+		parsedVaa: db.Collection(repository.ParsedVaa),
+		storage:   storage,
+		pageSize:  pageSize,
+		logger:    logger.With(zap.String("module", "Exporter")),
+	}
+}
+
+// operationDoc is an operation read to build a row, with the price of its token at transfer time.
+type operationDoc struct {
+	ID             string      `bson:"_id"`
+	Timestamp      time.Time   `bson:"timestamp"`
+	SourceChain    sdk.ChainID `bson:"sourceChain"`
+	EmitterAddress string      `bson:"emitterAddr"`
+	Sequence       string      `bson:"sequence"`
+	SourceTxHash   string      `bson:"sourceTxHash"`
+	SourceAddress  string      `bson:"sourceAddress"`
+	TargetChain    sdk.ChainID `bson:"targetChain"`
+	TargetAddress  string      `bson:"targetAddress"`
+	TargetTxHash   string      `bson:"targetTxHash"`
+	AppIDs         []string    `bson:"appIds"`
+	TokenChain     sdk.ChainID `bson:"tokenChain"`
+	TokenAddress   string      `bson:"tokenAddress"`
+	Amount         string      `bson:"amount"`
+	Fee            string      `bson:"fee"`
+	Symbol         string      `bson:"symbol"`
+	CoingeckoID    string      `bson:"coinGeckoId"`
+	TokenAmount    string      `bson:"tokenAmount"`
+	Price          string      `bson:"price"`
+	UsdAmount      string      `bson:"usdAmount"`
+}
+
+func (d *operationDoc) row() *Row {
+	return &Row{
+		VaaID:          d.ID,
+		Timestamp:      d.Timestamp,
+		SourceChain:    uint16(d.SourceChain),
+		EmitterAddress: d.EmitterAddress,
+		Sequence:       d.Sequence,
+		SourceTxHash:   d.SourceTxHash,
+		SourceAddress:  d.SourceAddress,
+		TargetChain:    uint16(d.TargetChain),
+		TargetAddress:  d.TargetAddress,
+		TargetTxHash:   d.TargetTxHash,
+		AppIDs:         d.AppIDs,
+		TokenChain:     uint16(d.TokenChain),
+		TokenAddress:   d.TokenAddress,
+		Symbol:         d.Symbol,
+		CoingeckoID:    d.CoingeckoID,
+		Amount:         d.Amount,
+		TokenAmount:    d.TokenAmount,
+		PriceUSD:       d.Price,
+		NotionalUSD:    d.UsdAmount,
+		Fee:            d.Fee,
+	}
+}
+
+// Run writes the operations of an export to a temporary file and stores it.
+func (e *Exporter) Run(ctx context.Context, exp *Export) (*File, error) {
+	log := e.logger.With(zap.String("exportId", exp.ID), zap.String("format", string(exp.Format)))
+
+	tmp, err := os.CreateTemp("", "export-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	b := bufio.NewWriter(tmp)
+	w, err := NewWriter(exp.Format, b)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows int64
+	var last *operationDoc
+	for {
+		docs, err := e.findPage(ctx, &exp.Filter, last)
+		if err != nil {
+			return nil, err
+		}
+		for i := range docs {
+			if err := w.Write(docs[i].row()); err != nil {
+				return nil, err
+			}
+		}
+		rows += int64(len(docs))
+		log.Debug("exported page", zap.Int("count", len(docs)), zap.Int64("rows", rows))
+
+		if int64(len(docs)) < e.pageSize {
+			break
+		}
+		last = &docs[len(docs)-1]
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	if err := b.Flush(); err != nil {
+		return nil, err
+	}
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("exports/%s.%s", exp.ID, exp.Format)
+	if err := e.storage.Put(ctx, key, tmp, size, exp.Format.ContentType()); err != nil {
+		return nil, fmt.Errorf("failed to store export file: %w", err)
+	}
+	log.Info("export file stored", zap.String("key", key), zap.Int64("rows", rows), zap.Int64("size", size))
+	return &File{Key: key, Rows: rows, Size: size}, nil
+}
+
+// findPage returns the operations of the filter after the last one returned, sorted by timestamp and id.
+func (e *Exporter) findPage(ctx context.Context, f *Filter, last *operationDoc) ([]operationDoc, error) {
+	cur, err := e.parsedVaa.Aggregate(ctx, buildPipeline(f, last, e.pageSize))
+	if err != nil {
+		e.logger.Error("failed execute aggregation pipeline", zap.Error(err))
+		return nil, err
+	}
+	var docs []operationDoc
+	if err := cur.All(ctx, &docs); err != nil {
+		e.logger.Error("failed to decode cursor", zap.Error(err))
+		return nil, err
+	}
+	return docs, nil
+}
+
+// buildPipeline builds the pipeline of a page of operations. The address is matched after the lookup of
+// the global transaction, because the sender of an operation is only known by its source transaction.
+func buildPipeline(f *Filter, last *operationDoc, limit int64) mongo.Pipeline {
+	match := bson.D{{Key: "timestamp", Value: bson.M{"$gte": f.From, "$lt": f.To}}}
+	if f.SourceChain != nil {
+		match = append(match, bson.E{Key: "rawStandardizedProperties.fromChain", Value: *f.SourceChain})
+	}
+	if f.TargetChain != nil {
+		match = append(match, bson.E{Key: "rawStandardizedProperties.toChain", Value: *f.TargetChain})
+	}
+	if f.AppID != "" {
+		match = append(match, bson.E{Key: "rawStandardizedProperties.appIds", Value: f.AppID})
+	}
+	if f.TokenChain != nil {
+		match = append(match, bson.E{Key: "standardizedProperties.tokenChain", Value: *f.TokenChain})
+	}
+	if f.TokenAddress != "" {
+		match = append(match, bson.E{Key: "standardizedProperties.tokenAddress", Value: bson.M{"$in": addressForms(f.TokenAddress)}})
+	}
+
+	var pipeline mongo.Pipeline
+	pipeline = append(pipeline, bson.D{{Key: "$match", Value: match}})
+
+	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{
+		bson.E{Key: "timestamp", Value: 1},
+		bson.E{Key: "_id", Value: 1},
+	}}})
+
+	// resume after the last operation of the previous page
+	if last != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"$or": bson.A{
+			bson.M{"timestamp": bson.M{"$gt": last.Timestamp}},
+			bson.M{"timestamp": last.Timestamp, "_id": bson.M{"$gt": last.ID}},
+		}}}})
+	}
+
+	// lookup globalTransactions
+	pipeline = append(pipeline, bson.D{{Key: "$lookup", Value: bson.D{{Key: "from", Value: "globalTransactions"}, {Key: "localField", Value: "_id"}, {Key: "foreignField", Value: "_id"}, {Key: "as", Value: "globalTransactions"}}}})
+
+	if f.Address != "" {
+		forms := addressForms(f.Address)
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"$or": bson.A{
+			bson.M{"globalTransactions.originTx.from": bson.M{"$in": forms}},
+			bson.M{"standardizedProperties.fromAddress": bson.M{"$in": forms}},
+			bson.M{"standardizedProperties.toAddress": bson.M{"$in": forms}},
+		}}}})
+	}
+
+	// Limit size of results
+	pipeline = append(pipeline, bson.D{{Key: "$limit", Value: limit}})
+
+	// lookup transferPrices
+	pipeline = append(pipeline, bson.D{{Key: "$lookup", Value: bson.D{{Key: "from", Value: repository.TransferPrices}, {Key: "localField", Value: "_id"}, {Key: "foreignField", Value: "_id"}, {Key: "as", Value: "transferPrices"}}}})
+
+	pipeline = append(pipeline, bson.D{{Key: "$project", Value: bson.D{
+		{Key: "timestamp", Value: "$timestamp"},
+		{Key: "sourceChain", Value: bson.M{"$ifNull": bson.A{"$standardizedProperties.fromChain", "$emitterChain"}}},
+		{Key: "emitterAddr", Value: "$emitterAddr"},
+		{Key: "sequence", Value: "$sequence"},
+		{Key: "sourceTxHash", Value: bson.M{"$arrayElemAt": bson.A{"$globalTransactions.originTx.nativeTxHash", 0}}},
+		{Key: "sourceAddress", Value: bson.M{"$ifNull": bson.A{
+			bson.M{"$arrayElemAt": bson.A{"$globalTransactions.originTx.from", 0}},
+			"$standardizedProperties.fromAddress",
+		}}},
+		{Key: "targetChain", Value: "$standardizedProperties.toChain"},
+		{Key: "targetAddress", Value: "$standardizedProperties.toAddress"},
+		{Key: "targetTxHash", Value: bson.M{"$arrayElemAt": bson.A{"$globalTransactions.destinationTx.txHash", 0}}},
+		{Key: "appIds", Value: "$rawStandardizedProperties.appIds"},
+		{Key: "tokenChain", Value: "$standardizedProperties.tokenChain"},
+		{Key: "tokenAddress", Value: "$standardizedProperties.tokenAddress"},
+		{Key: "amount", Value: "$standardizedProperties.amount"},
+		{Key: "fee", Value: "$standardizedProperties.fee"},
+		{Key: "symbol", Value: bson.M{"$arrayElemAt": bson.A{"$transferPrices.symbol", 0}}},
+		{Key: "coinGeckoId", Value: bson.M{"$arrayElemAt": bson.A{"$transferPrices.coinGeckoId", 0}}},
+		{Key: "tokenAmount", Value: bson.M{"$arrayElemAt": bson.A{"$transferPrices.tokenAmount", 0}}},
+		{Key: "price", Value: bson.M{"$arrayElemAt": bson.A{"$transferPrices.price", 0}}},
+		{Key: "usdAmount", Value: bson.M{"$arrayElemAt": bson.A{"$transferPrices.usdAmount", 0}}},
+	}}})
+
+	return pipeline
+}
+
+// addressForms returns the forms an address is stored in: as is, and in lowercase with the 0x prefix.
+func addressForms(address string) bson.A {
+	addressHex := strings.ToLower(address)
+	if !utils.StartsWith0x(address) {
+		addressHex = "0x" + addressHex
+	}
+	if addressHex == address {
+		return bson.A{address}
+	}
+	return bson.A{addressHex, address}
+}
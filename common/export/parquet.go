@@ -0,0 +1,69 @@
+package export
+
+import (
+	"io"
+	"time"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// parquetRowGroupSize is the size in bytes of the rows buffered for a row group of the Parquet files.
+const parquetRowGroupSize = 32 * 1024 * 1024
+
+// parquetWriter writes the rows with the CSV writer of parquet-go, which takes the values of the
+// columns of a row in order. All the columns are optional, the zero values are written as nulls.
+type parquetWriter struct {
+	w *writer.CSVWriter
+}
+
+func newParquetWriter(w io.Writer) (*parquetWriter, error) {
+	schema := make([]string, 0, len(columns))
+	for _, col := range columns {
+		schema = append(schema, "name="+col.name+", "+parquetTypeOf(col)+", repetitiontype=OPTIONAL")
+	}
+	pw, err := writer.NewCSVWriterFromWriter(schema, w, 1)
+	if err != nil {
+		return nil, err
+	}
+	pw.RowGroupSize = parquetRowGroupSize
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+	return &parquetWriter{w: pw}, nil
+}
+
+func (p *parquetWriter) Write(r *Row) error {
+	record := make([]any, len(columns))
+	for i, col := range columns {
+		switch v := col.value(r).(type) {
+		case uint16:
+			if v != 0 {
+				record[i] = int32(v)
+			}
+		case time.Time:
+			if !v.IsZero() {
+				record[i] = v.UnixMilli()
+			}
+		case string:
+			if v != "" {
+				record[i] = v
+			}
+		}
+	}
+	return p.w.Write(record)
+}
+
+func (p *parquetWriter) Close() error {
+	return p.w.WriteStop()
+}
+
+// parquetTypeOf returns the physical and the converted type of a column, in the schema format of parquet-go.
+func parquetTypeOf(col column) string {
+	switch col.value(&Row{}).(type) {
+	case uint16:
+		return "type=INT32, convertedtype=UINT_16"
+	case time.Time:
+		return "type=INT64, convertedtype=TIMESTAMP_MILLIS"
+	default:
+		return "type=BYTE_ARRAY, convertedtype=UTF8"
+	}
+}
@@ -0,0 +1,131 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Repository stores the exports.
+type Repository struct {
+	collection *mongo.Collection
+}
+
+// NewRepository creates a new export repository.
+func NewRepository(db *mongo.Database) *Repository {
+	return &Repository{collection: db.Collection(repository.Exports)}
+}
+
+// Create stores a new export.
+func (r *Repository) Create(ctx context.Context, e *Export) error {
+	_, err := r.collection.InsertOne(ctx, e)
+	return err
+}
+
+// FindByID returns an export, or ErrNotFound if it does not exist.
+func (r *Repository) FindByID(ctx context.Context, id string) (*Export, error) {
+	var e Export
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&e)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// CountPending returns the number of exports pending or running.
+func (r *Repository) CountPending(ctx context.Context) (int64, error) {
+	return r.collection.CountDocuments(ctx, bson.M{"status": bson.M{"$in": bson.A{StatusPending, StatusRunning}}})
+}
+
+// CountByClient returns the number of exports requested by a client since a time.
+func (r *Repository) CountByClient(ctx context.Context, client string, since time.Time) (int64, error) {
+	return r.collection.CountDocuments(ctx, bson.M{"client": client, "createdAt": bson.M{"$gte": since}})
+}
+
+// ClaimPending marks the oldest pending export as running and returns it, or nil if there are no pending exports.
+// The exports are claimed atomically, so concurrent runners do not run the same export.
+func (r *Repository) ClaimPending(ctx context.Context, now time.Time) (*Export, error) {
+	update := bson.M{"$set": bson.M{"status": StatusRunning, "startedAt": now, "updatedAt": now}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "createdAt", Value: 1}}).
+		SetReturnDocument(options.After)
+	var e Export
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"status": StatusPending}, update, opts).Decode(&e)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// Requeue marks the exports running since before startedBefore as pending again, so the exports
+// interrupted by a runner that stopped are run again. It returns the number of exports requeued.
+func (r *Repository) Requeue(ctx context.Context, startedBefore time.Time) (int64, error) {
+	filter := bson.M{"status": StatusRunning, "startedAt": bson.M{"$lt": startedBefore}}
+	update := bson.M{"$set": bson.M{"status": StatusPending, "updatedAt": time.Now()}}
+	res, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
+// Complete marks a running export as completed with the file stored.
+func (r *Repository) Complete(ctx context.Context, id string, f *File, now time.Time) error {
+	update := bson.M{"$set": bson.M{
+		"status":      StatusCompleted,
+		"key":         f.Key,
+		"rows":        f.Rows,
+		"size":        f.Size,
+		"completedAt": now,
+		"updatedAt":   now,
+	}}
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id, "status": StatusRunning}, update)
+	return err
+}
+
+// Fail marks a running export as failed.
+func (r *Repository) Fail(ctx context.Context, id string, cause error, now time.Time) error {
+	update := bson.M{"$set": bson.M{
+		"status":      StatusFailed,
+		"error":       cause.Error(),
+		"completedAt": now,
+		"updatedAt":   now,
+	}}
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id, "status": StatusRunning}, update)
+	return err
+}
+
+// FindExpired returns at most limit exports completed or failed before a time, the oldest first.
+func (r *Repository) FindExpired(ctx context.Context, before time.Time, limit int64) ([]*Export, error) {
+	filter := bson.M{
+		"status":      bson.M{"$in": bson.A{StatusCompleted, StatusFailed}},
+		"completedAt": bson.M{"$lt": before},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "completedAt", Value: 1}}).SetLimit(limit)
+	cur, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var exports []*Export
+	if err := cur.All(ctx, &exports); err != nil {
+		return nil, err
+	}
+	return exports, nil
+}
+
+// Delete deletes an export.
+func (r *Repository) Delete(ctx context.Context, id string) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
@@ -0,0 +1,199 @@
+package export
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+const unsignedPayload = "UNSIGNED-PAYLOAD"
+
+// S3Storage stores the files in a bucket of AWS S3 or of any S3-compatible store, with requests signed
+// with the AWS signature version 4.
+type S3Storage struct {
+	client      *http.Client
+	signer      *v4.Signer
+	credentials aws.Credentials
+	endpoint    *url.URL
+	region      string
+	bucket      string
+	prefix      string
+	pathStyle   bool
+}
+
+// NewS3Storage creates a storage in a bucket. The credentials default to the AWS_ACCESS_KEY_ID,
+// AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables.
+func NewS3Storage(cfg StorageConfig) (*S3Storage, error) {
+	if cfg.Bucket == "" {
+		return nil, errors.New("the bucket of the s3 storage is required")
+	}
+	if cfg.Region == "" {
+		return nil, errors.New("the region of the s3 storage is required")
+	}
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", cfg.Region)
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid s3 endpoint: %w", err)
+	}
+
+	credentials := aws.Credentials{AccessKeyID: cfg.AccessKeyID, SecretAccessKey: cfg.SecretAccessKey}
+	if credentials.AccessKeyID == "" {
+		credentials = aws.Credentials{
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		}
+	}
+	if !credentials.HasKeys() {
+		return nil, errors.New("the credentials of the s3 storage are required")
+	}
+
+	return &S3Storage{
+		client: &http.Client{},
+		signer: v4.NewSigner(func(o *v4.SignerOptions) {
+			// S3 expects the keys escaped only once.
+			o.DisableURIPathEscaping = true
+		}),
+		credentials: credentials,
+		endpoint:    u,
+		region:      cfg.Region,
+		bucket:      cfg.Bucket,
+		prefix:      strings.Trim(cfg.Prefix, "/"),
+		pathStyle:   cfg.PathStyle,
+	}, nil
+}
+
+// Put uploads a file with a single request, the files of the exports are below the 5GB limit of S3.
+func (s *S3Storage) Put(ctx context.Context, key string, r io.ReadSeeker, size int64, contentType string) error {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return err
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	payloadHash := hex.EncodeToString(h.Sum(nil))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.url(key), io.NopCloser(r))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if err := s.signer.SignHTTP(ctx, s.credentials, req, payloadHash, "s3", s.region, time.Now()); err != nil {
+		return err
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return s3Error("put", key, res)
+	}
+	return nil
+}
+
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url(key), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("X-Amz-Content-Sha256", unsignedPayload)
+	if err := s.signer.SignHTTP(ctx, s.credentials, req, unsignedPayload, "s3", s.region, time.Now()); err != nil {
+		return nil, 0, err
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	switch res.StatusCode {
+	case http.StatusOK:
+		return res.Body, res.ContentLength, nil
+	case http.StatusNotFound:
+		res.Body.Close()
+		return nil, 0, ErrFileNotFound
+	default:
+		defer res.Body.Close()
+		return nil, 0, s3Error("get", key, res)
+	}
+}
+
+// Delete deletes a file. S3 responds no content whether the file exists or not.
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.url(key), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Amz-Content-Sha256", unsignedPayload)
+	if err := s.signer.SignHTTP(ctx, s.credentials, req, unsignedPayload, "s3", s.region, time.Now()); err != nil {
+		return err
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	switch res.StatusCode {
+	case http.StatusNoContent, http.StatusOK, http.StatusNotFound:
+		return nil
+	default:
+		return s3Error("delete", key, res)
+	}
+}
+
+// PresignGet returns a url to download a file without credentials until it expires.
+func (s *S3Storage) PresignGet(ctx context.Context, key string, expires time.Duration) (string, error) {
+	u, err := url.Parse(s.url(key))
+	if err != nil {
+		return "", err
+	}
+	query := u.Query()
+	query.Set("X-Amz-Expires", strconv.FormatInt(int64(expires/time.Second), 10))
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	signed, _, err := s.signer.PresignHTTP(ctx, s.credentials, req, unsignedPayload, "s3", s.region, time.Now())
+	return signed, err
+}
+
+// url returns the url of the object of a key, with the bucket in the path or in the host.
+func (s *S3Storage) url(key string) string {
+	u := *s.endpoint
+	name := path.Join(s.prefix, key)
+	if s.pathStyle {
+		u.Path = path.Join("/", u.Path, s.bucket, name)
+	} else {
+		u.Host = s.bucket + "." + u.Host
+		u.Path = path.Join("/", u.Path, name)
+	}
+	return u.String()
+}
+
+func s3Error(op, key string, res *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+	return fmt.Errorf("s3 %s %s failed with status %d: %s", op, key, res.StatusCode, strings.TrimSpace(string(body)))
+}
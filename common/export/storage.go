@@ -0,0 +1,128 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ErrFileNotFound is returned when a file does not exist in a storage.
+var ErrFileNotFound = errors.New("export file not found")
+
+// Storage stores the files of the exports.
+type Storage interface {
+	Put(ctx context.Context, key string, r io.ReadSeeker, size int64, contentType string) error
+	// Get returns the content of a file and its size, or ErrFileNotFound if it does not exist.
+	Get(ctx context.Context, key string) (io.ReadCloser, int64, error)
+	// Delete deletes a file. Deleting a file that does not exist is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// Presigner is implemented by the storages that can serve the files directly, with a temporary url.
+type Presigner interface {
+	PresignGet(ctx context.Context, key string, expires time.Duration) (string, error)
+}
+
+// Storage types.
+const (
+	StorageLocal = "local"
+	StorageS3    = "s3"
+)
+
+// StorageConfig is the configuration of a storage.
+type StorageConfig struct {
+	// Type is the type of the storage, local or s3.
+	Type string
+	// Path is the directory of the local storage.
+	Path string
+	// Endpoint is the url of an S3-compatible store. It defaults to the AWS S3 endpoint of the region.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	Prefix          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// PathStyle addresses the bucket in the path of the urls instead of the host, as required by
+	// most S3-compatible stores.
+	PathStyle bool
+}
+
+// NewStorage creates the storage of the configuration.
+func NewStorage(cfg StorageConfig) (Storage, error) {
+	switch cfg.Type {
+	case StorageLocal, "":
+		if cfg.Path == "" {
+			return nil, errors.New("the path of the local storage is required")
+		}
+		return NewLocalStorage(cfg.Path), nil
+	case StorageS3:
+		return NewS3Storage(cfg)
+	default:
+		return nil, fmt.Errorf("invalid storage type %q", cfg.Type)
+	}
+}
+
+// LocalStorage stores the files in a directory of the local disk.
+type LocalStorage struct {
+	path string
+}
+
+// NewLocalStorage creates a storage in the directory path.
+func NewLocalStorage(path string) *LocalStorage {
+	return &LocalStorage{path: path}
+}
+
+// Put writes the file to a temporary file and renames it, so a file is never read half written.
+func (s *LocalStorage) Put(_ context.Context, key string, r io.ReadSeeker, _ int64, _ string) error {
+	name := s.file(key)
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
+
+func (s *LocalStorage) Get(_ context.Context, key string) (io.ReadCloser, int64, error) {
+	f, err := os.Open(s.file(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, 0, ErrFileNotFound
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+	return f, info.Size(), nil
+}
+
+func (s *LocalStorage) Delete(_ context.Context, key string) error {
+	err := os.Remove(s.file(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// file returns the name of the file of a key. The key is cleaned so it can not point outside the directory.
+func (s *LocalStorage) file(key string) string {
+	return filepath.Join(s.path, filepath.FromSlash(filepath.Clean("/"+key)))
+}
@@ -0,0 +1,130 @@
+package export
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testStorage(t *testing.T, s Storage) {
+	ctx := context.Background()
+
+	_, _, err := s.Get(ctx, "exports/missing.csv")
+	assert.ErrorIs(t, err, ErrFileNotFound)
+
+	content := "vaaId\n2/beef/1\n"
+	require.NoError(t, s.Put(ctx, "exports/1.csv", strings.NewReader(content), int64(len(content)), "text/csv"))
+
+	r, size, err := s.Get(ctx, "exports/1.csv")
+	require.NoError(t, err)
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, content, string(data))
+	assert.Equal(t, int64(len(content)), size)
+
+	require.NoError(t, s.Put(ctx, "exports/2.csv", strings.NewReader(content), int64(len(content)), "text/csv"))
+	require.NoError(t, s.Delete(ctx, "exports/2.csv"))
+	_, _, err = s.Get(ctx, "exports/2.csv")
+	assert.ErrorIs(t, err, ErrFileNotFound)
+	// deleting a file that does not exist is not an error.
+	assert.NoError(t, s.Delete(ctx, "exports/2.csv"))
+}
+
+func TestLocalStorage(t *testing.T) {
+	dir := t.TempDir()
+	s := NewLocalStorage(dir)
+	testStorage(t, s)
+
+	// the keys can not point outside the directory.
+	assert.Equal(t, dir+"/etc/passwd", s.file("../../etc/passwd"))
+}
+
+// s3Server is a fake S3-compatible store that checks the requests are signed.
+type s3Server struct {
+	sync.Mutex
+	objects map[string]string
+}
+
+func (s *s3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	if r.URL.Query().Get("X-Amz-Signature") == "" &&
+		!strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=key/") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		hash := sha256.Sum256(body)
+		if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(hash[:]) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.objects[r.URL.Path] = string(body)
+	case http.MethodGet:
+		object, ok := s.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = io.WriteString(w, object)
+	case http.MethodDelete:
+		delete(s.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestS3Storage(t *testing.T) {
+	server := &s3Server{objects: map[string]string{}}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	s, err := NewS3Storage(StorageConfig{
+		Type:            StorageS3,
+		Endpoint:        ts.URL,
+		Region:          "us-east-1",
+		Bucket:          "bucket",
+		Prefix:          "/wormscan/",
+		AccessKeyID:     "key",
+		SecretAccessKey: "secret",
+		PathStyle:       true,
+	})
+	require.NoError(t, err)
+	testStorage(t, s)
+	assert.Contains(t, server.objects, "/bucket/wormscan/exports/1.csv")
+
+	presigned, err := s.PresignGet(context.Background(), "exports/1.csv", time.Hour)
+	require.NoError(t, err)
+	u, err := url.Parse(presigned)
+	require.NoError(t, err)
+	assert.Equal(t, "/bucket/wormscan/exports/1.csv", u.Path)
+	assert.Equal(t, "3600", u.Query().Get("X-Amz-Expires"))
+
+	res, err := http.Get(presigned)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestS3Storage_URL(t *testing.T) {
+	s, err := NewS3Storage(StorageConfig{Region: "eu-west-1", Bucket: "bucket", AccessKeyID: "key", SecretAccessKey: "secret"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://bucket.s3.eu-west-1.amazonaws.com/exports/1.csv", s.url("exports/1.csv"))
+
+	_, err = NewS3Storage(StorageConfig{Region: "eu-west-1", AccessKeyID: "key", SecretAccessKey: "secret"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,159 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Row is an operation of an export, with the price of its token at transfer time.
+type Row struct {
+	VaaID          string    `json:"vaaId"`
+	Timestamp      time.Time `json:"timestamp"`
+	SourceChain    uint16    `json:"sourceChain"`
+	EmitterAddress string    `json:"emitterAddress"`
+	Sequence       string    `json:"sequence"`
+	SourceTxHash   string    `json:"sourceTxHash"`
+	SourceAddress  string    `json:"sourceAddress"`
+	TargetChain    uint16    `json:"targetChain"`
+	TargetAddress  string    `json:"targetAddress"`
+	TargetTxHash   string    `json:"targetTxHash"`
+	AppIDs         []string  `json:"appIds"`
+	TokenChain     uint16    `json:"tokenChain"`
+	TokenAddress   string    `json:"tokenAddress"`
+	Symbol         string    `json:"symbol"`
+	CoingeckoID    string    `json:"coingeckoId"`
+	// Amount is the amount of the vaa, TokenAmount the amount with the decimals of the token applied.
+	Amount      string `json:"amount"`
+	TokenAmount string `json:"tokenAmount"`
+	PriceUSD    string `json:"priceUsd"`
+	NotionalUSD string `json:"notionalUsd"`
+	Fee         string `json:"fee"`
+}
+
+// column is a column of the CSV and Parquet files. The value of a column is a string, a uint16 or a time.Time,
+// and its zero value is written as an empty or null value.
+type column struct {
+	name  string
+	value func(r *Row) any
+}
+
+// columns are the columns of the exports, in order. The names match the fields of the NDJSON exports.
+var columns = []column{
+	{"vaaId", func(r *Row) any { return r.VaaID }},
+	{"timestamp", func(r *Row) any { return r.Timestamp }},
+	{"sourceChain", func(r *Row) any { return r.SourceChain }},
+	{"emitterAddress", func(r *Row) any { return r.EmitterAddress }},
+	{"sequence", func(r *Row) any { return r.Sequence }},
+	{"sourceTxHash", func(r *Row) any { return r.SourceTxHash }},
+	{"sourceAddress", func(r *Row) any { return r.SourceAddress }},
+	{"targetChain", func(r *Row) any { return r.TargetChain }},
+	{"targetAddress", func(r *Row) any { return r.TargetAddress }},
+	{"targetTxHash", func(r *Row) any { return r.TargetTxHash }},
+	{"appIds", func(r *Row) any { return strings.Join(r.AppIDs, "|") }},
+	{"tokenChain", func(r *Row) any { return r.TokenChain }},
+	{"tokenAddress", func(r *Row) any { return r.TokenAddress }},
+	{"symbol", func(r *Row) any { return r.Symbol }},
+	{"coingeckoId", func(r *Row) any { return r.CoingeckoID }},
+	{"amount", func(r *Row) any { return r.Amount }},
+	{"tokenAmount", func(r *Row) any { return r.TokenAmount }},
+	{"priceUsd", func(r *Row) any { return r.PriceUSD }},
+	{"notionalUsd", func(r *Row) any { return r.NotionalUSD }},
+	{"fee", func(r *Row) any { return r.Fee }},
+}
+
+// Writer writes the rows of an export in a format.
+type Writer interface {
+	Write(r *Row) error
+	// Close writes the rows buffered and the end of the file. It does not close the underlying writer.
+	Close() error
+}
+
+// NewWriter creates a writer of the format.
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case FormatNDJSON:
+		b := bufio.NewWriter(w)
+		return &ndjsonWriter{b: b, enc: json.NewEncoder(b)}, nil
+	case FormatParquet:
+		return newParquetWriter(w)
+	default:
+		return nil, fmt.Errorf("invalid export format %q", format)
+	}
+}
+
+// csvWriter writes a header with the names of the columns and a record per row.
+type csvWriter struct {
+	w      *csv.Writer
+	header bool
+}
+
+func (c *csvWriter) Write(r *Row) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	record := make([]string, len(columns))
+	for i, col := range columns {
+		record[i] = csvValue(col.value(r))
+	}
+	return c.w.Write(record)
+}
+
+func (c *csvWriter) Close() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// writeHeader writes the header once, so the exports without rows also have it.
+func (c *csvWriter) writeHeader() error {
+	if c.header {
+		return nil
+	}
+	c.header = true
+	names := make([]string, len(columns))
+	for i, col := range columns {
+		names[i] = col.name
+	}
+	return c.w.Write(names)
+}
+
+func csvValue(v any) string {
+	switch v := v.(type) {
+	case uint16:
+		if v == 0 {
+			return ""
+		}
+		return strconv.FormatUint(uint64(v), 10)
+	case time.Time:
+		if v.IsZero() {
+			return ""
+		}
+		return v.UTC().Format(time.RFC3339)
+	default:
+		return v.(string)
+	}
+}
+
+// ndjsonWriter writes a JSON object per line.
+type ndjsonWriter struct {
+	b   *bufio.Writer
+	enc *json.Encoder
+}
+
+func (n *ndjsonWriter) Write(r *Row) error {
+	return n.enc.Encode(r)
+}
+
+func (n *ndjsonWriter) Close() error {
+	return n.b.Flush()
+}
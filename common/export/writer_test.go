@@ -0,0 +1,161 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+func testRows() []*Row {
+	return []*Row{
+		{
+			VaaID:          "2/000000000000000000000000000000000000000000000000000000000000beef/1",
+			Timestamp:      time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			SourceChain:    2,
+			EmitterAddress: "000000000000000000000000000000000000000000000000000000000000beef",
+			Sequence:       "1",
+			SourceTxHash:   "0xabc",
+			SourceAddress:  "0x1111",
+			TargetChain:    1,
+			TargetAddress:  "address, with a comma",
+			AppIDs:         []string{"PORTAL_TOKEN_BRIDGE", "CCTP"},
+			TokenChain:     2,
+			TokenAddress:   "0x2222",
+			Symbol:         "USDC",
+			CoingeckoID:    "usd-coin",
+			Amount:         "100000000",
+			TokenAmount:    "1",
+			PriceUSD:       "0.99",
+			NotionalUSD:    "0.99",
+		},
+		{
+			VaaID:       "1/0000000000000000000000000000000000000000000000000000000000000001/2",
+			Timestamp:   time.Date(2024, 1, 2, 3, 4, 6, 0, time.UTC),
+			SourceChain: 1,
+			Sequence:    "2",
+		},
+	}
+}
+
+func write(t *testing.T, format Format, rows []*Row) []byte {
+	var b bytes.Buffer
+	w, err := NewWriter(format, &b)
+	require.NoError(t, err)
+	for _, r := range rows {
+		require.NoError(t, w.Write(r))
+	}
+	require.NoError(t, w.Close())
+	return b.Bytes()
+}
+
+func TestCSVWriter(t *testing.T) {
+	lines := strings.Split(strings.TrimSuffix(string(write(t, FormatCSV, testRows())), "\n"), "\n")
+
+	require.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[0], "vaaId,timestamp,sourceChain,"))
+	assert.Equal(t, "2/000000000000000000000000000000000000000000000000000000000000beef/1,2024-01-02T03:04:05Z,2,"+
+		"000000000000000000000000000000000000000000000000000000000000beef,1,0xabc,0x1111,1,\"address, with a comma\",,"+
+		"PORTAL_TOKEN_BRIDGE|CCTP,2,0x2222,USDC,usd-coin,100000000,1,0.99,0.99,", lines[1])
+	assert.Equal(t, "1/0000000000000000000000000000000000000000000000000000000000000001/2,2024-01-02T03:04:06Z,1,,2,,,,,,,,,,,,,,,",
+		lines[2])
+
+	// the exports without rows have the header.
+	assert.Equal(t, lines[0]+"\n", string(write(t, FormatCSV, nil)))
+}
+
+func TestNDJSONWriter(t *testing.T) {
+	lines := strings.Split(strings.TrimSuffix(string(write(t, FormatNDJSON, testRows())), "\n"), "\n")
+
+	require.Len(t, lines, 2)
+	for i, r := range testRows() {
+		var decoded Row
+		require.NoError(t, json.Unmarshal([]byte(lines[i]), &decoded))
+		assert.Equal(t, *r, decoded)
+	}
+}
+
+// parquetRow is a row of the Parquet files, as read by parquet-go.
+type parquetRow struct {
+	VaaID          *string `parquet:"name=vaaId, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	Timestamp      *int64  `parquet:"name=timestamp, type=INT64, convertedtype=TIMESTAMP_MILLIS, repetitiontype=OPTIONAL"`
+	SourceChain    *int32  `parquet:"name=sourceChain, type=INT32, convertedtype=UINT_16, repetitiontype=OPTIONAL"`
+	EmitterAddress *string `parquet:"name=emitterAddress, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	Sequence       *string `parquet:"name=sequence, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	SourceTxHash   *string `parquet:"name=sourceTxHash, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	SourceAddress  *string `parquet:"name=sourceAddress, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	TargetChain    *int32  `parquet:"name=targetChain, type=INT32, convertedtype=UINT_16, repetitiontype=OPTIONAL"`
+	TargetAddress  *string `parquet:"name=targetAddress, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	TargetTxHash   *string `parquet:"name=targetTxHash, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	AppIDs         *string `parquet:"name=appIds, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	TokenChain     *int32  `parquet:"name=tokenChain, type=INT32, convertedtype=UINT_16, repetitiontype=OPTIONAL"`
+	TokenAddress   *string `parquet:"name=tokenAddress, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	Symbol         *string `parquet:"name=symbol, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	CoingeckoID    *string `parquet:"name=coingeckoId, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	Amount         *string `parquet:"name=amount, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	TokenAmount    *string `parquet:"name=tokenAmount, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	PriceUSD       *string `parquet:"name=priceUsd, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	NotionalUSD    *string `parquet:"name=notionalUsd, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	Fee            *string `parquet:"name=fee, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+}
+
+// readParquet reads the column names and the rows of a Parquet file with parquet-go.
+func readParquet(t *testing.T, data []byte) ([]string, []parquetRow) {
+	f, err := buffer.NewBufferFile(data)
+	require.NoError(t, err)
+
+	// the names of the schema of the footer are replaced by the names of the fields of parquetRow when it is read,
+	// so the names of the columns are read from the footer alone.
+	footer := &reader.ParquetReader{PFile: f}
+	require.NoError(t, footer.ReadFooter())
+	var names []string
+	for _, e := range footer.Footer.Schema[1:] {
+		names = append(names, e.Name)
+	}
+
+	pr, err := reader.NewParquetReader(f, new(parquetRow), 1)
+	require.NoError(t, err)
+	defer pr.ReadStop()
+	rows := make([]parquetRow, pr.GetNumRows())
+	require.NoError(t, pr.Read(&rows))
+	return names, rows
+}
+
+func TestParquetWriter(t *testing.T) {
+	rows := testRows()
+	names, read := readParquet(t, write(t, FormatParquet, rows))
+
+	expectedNames := make([]string, 0, len(columns))
+	for _, col := range columns {
+		expectedNames = append(expectedNames, col.name)
+	}
+	assert.Equal(t, expectedNames, names)
+
+	require.Len(t, read, len(rows))
+	assert.Equal(t, rows[0].VaaID, *read[0].VaaID)
+	assert.Equal(t, rows[0].Timestamp.UnixMilli(), *read[0].Timestamp)
+	assert.Equal(t, int32(rows[0].TargetChain), *read[0].TargetChain)
+	assert.Equal(t, "PORTAL_TOKEN_BRIDGE|CCTP", *read[0].AppIDs)
+	assert.Equal(t, rows[0].TargetAddress, *read[0].TargetAddress)
+	assert.Equal(t, rows[0].NotionalUSD, *read[0].NotionalUSD)
+	assert.Nil(t, read[0].Fee)
+
+	// the zero values are nulls.
+	assert.Equal(t, rows[1].VaaID, *read[1].VaaID)
+	assert.Equal(t, int32(rows[1].SourceChain), *read[1].SourceChain)
+	assert.Nil(t, read[1].TargetChain)
+	assert.Nil(t, read[1].AppIDs)
+	assert.Nil(t, read[1].Symbol)
+}
+
+func TestParquetWriter_Empty(t *testing.T) {
+	names, read := readParquet(t, write(t, FormatParquet, nil))
+	assert.Len(t, names, len(columns))
+	assert.Empty(t, read)
+}
@@ -0,0 +1,218 @@
+// Package lifecycle tracks the steps of each operation, from the message emitted in the source chain
+// to the redeem of its VAA in the target chain, in the operationLifecycle collection.
+//
+// Each component records the step it observes: tx-tracker the emission and the redeem, fly the
+// signed VAA and the governor processor the VAAs held by the governor. The status of an operation
+// is the furthest step reached, so the steps can be recorded in any order. The parser records the
+// target chain of the operation, so the operations expected to be redeemed are found without
+// joining the parsedVaa collection.
+package lifecycle
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Step is a step of the lifecycle of an operation.
+type Step string
+
+// Steps of the lifecycle of an operation, in order.
+//
+// A governed operation is held by the governor before it is signed, so the governed step
+// happens between the emission and the signature of the VAA.
+const (
+	StepEmitted  Step = "emitted"
+	StepGoverned Step = "governed"
+	StepSigned   Step = "signed"
+	StepRedeemed Step = "redeemed"
+)
+
+// Status is the status of an operation: the furthest step reached, or stuck.
+type Status string
+
+const (
+	StatusEmitted  = Status(StepEmitted)
+	StatusGoverned = Status(StepGoverned)
+	StatusSigned   = Status(StepSigned)
+	StatusRedeemed = Status(StepRedeemed)
+	// StatusStuck is the status of the operations that were signed but not redeemed in time.
+	StatusStuck Status = "stuck"
+)
+
+// ParseStatus parses a status.
+func ParseStatus(s string) (Status, bool) {
+	switch status := Status(strings.ToLower(s)); status {
+	case StatusEmitted, StatusGoverned, StatusSigned, StatusRedeemed, StatusStuck:
+		return status, true
+	default:
+		return "", false
+	}
+}
+
+// Filter returns the filter of the operationLifecycle collection that matches the operations with the status.
+func (s Status) Filter() bson.M {
+	if s == StatusStuck {
+		return bson.M{"stuck": true}
+	}
+	return bson.M{"status": s}
+}
+
+// TimeField returns the field of the operationLifecycle collection with the time when the operations
+// reached the status. The field is set only once, so it's used to sort and page the operations by status.
+func (s Status) TimeField() string {
+	if s == StatusStuck {
+		return "stuckAt"
+	}
+	return Step(s).field()
+}
+
+// field returns the field of the operationLifecycle collection with the time of the step.
+func (s Step) field() string {
+	return string(s) + "At"
+}
+
+// Lifecycle is the lifecycle of an operation.
+type Lifecycle struct {
+	ID           string      `bson:"_id" json:"-"`
+	EmitterChain sdk.ChainID `bson:"emitterChain" json:"-"`
+	ToChain      sdk.ChainID `bson:"toChain,omitempty" json:"-"`
+	Status       Status      `bson:"status" json:"status"`
+	EmittedAt    *time.Time  `bson:"emittedAt,omitempty" json:"emittedAt,omitempty"`
+	GovernedAt   *time.Time  `bson:"governedAt,omitempty" json:"governedAt,omitempty"`
+	SignedAt     *time.Time  `bson:"signedAt,omitempty" json:"signedAt,omitempty"`
+	RedeemedAt   *time.Time  `bson:"redeemedAt,omitempty" json:"redeemedAt,omitempty"`
+	Stuck        bool        `bson:"stuck" json:"stuck"`
+	StuckAt      *time.Time  `bson:"stuckAt,omitempty" json:"stuckAt,omitempty"`
+	UpdatedAt    *time.Time  `bson:"updatedAt" json:"-"`
+}
+
+// Event is a step of an operation and the time when it happened.
+type Event struct {
+	Step Step
+	At   time.Time
+}
+
+// Repository stores the lifecycle of the operations.
+type Repository struct {
+	collection *mongo.Collection
+}
+
+// NewRepository creates a new lifecycle repository.
+func NewRepository(db *mongo.Database) *Repository {
+	return &Repository{collection: db.Collection(repository.OperationLifecycle)}
+}
+
+// Record records the steps of an operation. The time of a step is only set the first time the step is recorded.
+func (r *Repository) Record(ctx context.Context, vaaID string, events ...Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	set := bson.M{"updatedAt": now}
+	if chain, ok := emitterChain(vaaID); ok {
+		set["emitterChain"] = chain
+	}
+	for _, e := range events {
+		field := e.Step.field()
+		set[field] = bson.M{"$ifNull": bson.A{"$" + field, e.At}}
+	}
+
+	// the update is a pipeline to derive the status from the steps recorded.
+	update := bson.A{
+		bson.M{"$set": set},
+		bson.M{"$set": bson.M{
+			"status": bson.M{"$switch": bson.M{
+				"branches": bson.A{
+					stepBranch(StepRedeemed),
+					stepBranch(StepSigned),
+					stepBranch(StepGoverned),
+				},
+				"default": StatusEmitted,
+			}},
+			// a redeemed operation is no longer stuck.
+			"stuck": bson.M{"$cond": bson.A{
+				hasStep(StepRedeemed),
+				false,
+				bson.M{"$ifNull": bson.A{"$stuck", false}},
+			}},
+		}},
+	}
+
+	_, err := r.collection.UpdateByID(ctx, vaaID, update, options.Update().SetUpsert(true))
+	return err
+}
+
+// RecordTarget records the target chain of an operation, known once its payload is parsed.
+func (r *Repository) RecordTarget(ctx context.Context, vaaID string, toChain sdk.ChainID) error {
+	set := bson.M{"toChain": toChain, "updatedAt": time.Now()}
+	if chain, ok := emitterChain(vaaID); ok {
+		set["emitterChain"] = chain
+	}
+	_, err := r.collection.UpdateByID(ctx, vaaID, bson.M{"$set": set}, options.Update().SetUpsert(true))
+	return err
+}
+
+// FindStuck returns the operations signed between signedAfter and signedBefore that were not redeemed
+// and are not flagged as stuck yet. Only the operations with a target chain recorded are considered.
+func (r *Repository) FindStuck(ctx context.Context, signedAfter, signedBefore time.Time, limit int64) ([]*Lifecycle, error) {
+	filter := bson.M{
+		"status":   StatusSigned,
+		"stuck":    bson.M{"$ne": true},
+		"toChain":  bson.M{"$gt": 0},
+		"signedAt": bson.M{"$gte": signedAfter, "$lt": signedBefore},
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "signedAt", Value: 1}, {Key: "_id", Value: 1}}).
+		SetLimit(limit)
+
+	cur, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var lifecycles []*Lifecycle
+	if err := cur.All(ctx, &lifecycles); err != nil {
+		return nil, err
+	}
+	return lifecycles, nil
+}
+
+// MarkStuck flags the operations as stuck, unless they were redeemed in the meantime.
+func (r *Repository) MarkStuck(ctx context.Context, vaaIDs []string, at time.Time) error {
+	// the operations already flagged are skipped, so the time they got stuck is never updated.
+	filter := bson.M{"_id": bson.M{"$in": vaaIDs}, "status": StatusSigned, "stuck": bson.M{"$ne": true}}
+	update := bson.M{"$set": bson.M{"stuck": true, "stuckAt": at, "updatedAt": time.Now()}}
+	_, err := r.collection.UpdateMany(ctx, filter, update)
+	return err
+}
+
+// hasStep returns an expression that is true if the step was recorded.
+func hasStep(s Step) bson.M {
+	return bson.M{"$ne": bson.A{bson.M{"$ifNull": bson.A{"$" + s.field(), nil}}, nil}}
+}
+
+// stepBranch returns a $switch branch that sets the status of the step if it was recorded.
+func stepBranch(s Step) bson.M {
+	return bson.M{"case": hasStep(s), "then": Status(s)}
+}
+
+// emitterChain parses the emitter chain of a vaa id.
+func emitterChain(vaaID string) (sdk.ChainID, bool) {
+	chain, _, ok := strings.Cut(vaaID, "/")
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(chain, 10, 16)
+	if err != nil {
+		return 0, false
+	}
+	return sdk.ChainID(id), true
+}
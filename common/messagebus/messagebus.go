@@ -0,0 +1,79 @@
+// Package messagebus defines a transport-neutral interface for the queues and topics
+// used by the services to exchange events.
+//
+// Two backends are supported: AWS SQS/SNS, and Redis Streams for deployments that run
+// outside AWS. In both backends a topic is consumed by several independent consumer
+// groups (SQS queues subscribed to a SNS topic, or Redis Streams consumer groups), messages
+// that are not acknowledged are delivered again once their visibility timeout elapses, and
+// messages delivered too many times are moved to a dead-letter queue.
+package messagebus
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	// BackendSQS uses AWS SNS topics and SQS queues.
+	BackendSQS = "sqs"
+	// BackendRedis uses Redis Streams and consumer groups.
+	BackendRedis = "redis"
+)
+
+// Message is a message received from a queue.
+type Message struct {
+	// ID is the identifier of the message assigned by the backend.
+	ID string
+	// Body is the payload of the message as it was published.
+	Body []byte
+	// ReceiveCount is the number of times the message has been delivered, including this one.
+	ReceiveCount int
+	// SentTimestamp is the time when the message was published, if known.
+	SentTimestamp *time.Time
+	// handle identifies the delivery of the message to acknowledge it.
+	handle string
+}
+
+// Consumer receives messages from a queue.
+//
+// Received messages are not delivered to other consumers of the same queue until the
+// visibility timeout elapses. Messages that are not acknowledged before that are delivered
+// again.
+type Consumer interface {
+	// Receive waits for new messages. It returns an empty slice if there are no messages.
+	Receive(ctx context.Context) ([]*Message, error)
+	// Ack removes a processed message from the queue.
+	Ack(ctx context.Context, msg *Message) error
+	// VisibilityTimeout returns how long received messages are hidden from other consumers.
+	VisibilityTimeout() time.Duration
+	// Name returns a name of the queue to be used in logs.
+	Name() string
+}
+
+// OutgoingMessage is a message to be published.
+type OutgoingMessage struct {
+	// GroupID is used by FIFO topics to keep the order of the messages of the same group.
+	GroupID string
+	// DeduplicationID is used to discard messages published more than once.
+	DeduplicationID string
+	// Body is the payload of the message.
+	Body []byte
+	// Attributes are published along with the message to be used by subscription filters.
+	Attributes map[string]string
+}
+
+// Producer publishes messages to a topic.
+type Producer interface {
+	Publish(ctx context.Context, msg *OutgoingMessage) error
+}
+
+// ValidateBackend returns an error if the backend is not supported.
+func ValidateBackend(backend string) error {
+	switch backend {
+	case BackendSQS, BackendRedis:
+		return nil
+	default:
+		return fmt.Errorf("unsupported message bus backend: %s", backend)
+	}
+}
@@ -0,0 +1,56 @@
+package messagebus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnwrapSNSNotification(t *testing.T) {
+	notification := []byte(`{"Type":"Notification","MessageId":"1","Message":"{\"id\":\"2/abc/1\"}"}`)
+	assert.Equal(t, `{"id":"2/abc/1"}`, string(unwrapSNSNotification(notification)))
+
+	// raw messages are returned as is.
+	raw := []byte(`{"id":"2/abc/1"}`)
+	assert.Equal(t, raw, unwrapSNSNotification(raw))
+	assert.Equal(t, []byte("invalid"), unwrapSNSNotification([]byte("invalid")))
+}
+
+func TestRedisStreamMessage(t *testing.T) {
+	values := newRedisStreamValues(&OutgoingMessage{
+		GroupID:         "2/abc/1",
+		DeduplicationID: "2/abc/1",
+		Body:            []byte(`{"id":"2/abc/1"}`),
+		Attributes:      map[string]string{"chainId": "2"},
+	})
+	assert.Equal(t, "2", values["attr:chainId"])
+	assert.Equal(t, "2/abc/1", values[redisFieldGroupID])
+
+	// redis returns the fields of the entries as strings.
+	entry := redis.XMessage{ID: "1700000000000-3", Values: map[string]interface{}{}}
+	for k, v := range values {
+		if b, ok := v.([]byte); ok {
+			v = string(b)
+		}
+		entry.Values[k] = v
+	}
+
+	msg := newRedisStreamMessage(entry, 2)
+	assert.Equal(t, "1700000000000-3", msg.ID)
+	assert.Equal(t, `{"id":"2/abc/1"}`, string(msg.Body))
+	assert.Equal(t, 2, msg.ReceiveCount)
+	assert.Equal(t, time.UnixMilli(1700000000000), *msg.SentTimestamp)
+}
+
+func TestRedisStreamIDTime(t *testing.T) {
+	assert.Equal(t, time.UnixMilli(1700000000000), *redisStreamIDTime("1700000000000-0"))
+	assert.Nil(t, redisStreamIDTime("invalid"))
+}
+
+func TestValidateBackend(t *testing.T) {
+	assert.NoError(t, ValidateBackend(BackendSQS))
+	assert.NoError(t, ValidateBackend(BackendRedis))
+	assert.Error(t, ValidateBackend("kafka"))
+}
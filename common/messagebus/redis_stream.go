@@ -0,0 +1,349 @@
+package messagebus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// redisFieldBody is the field of the stream entries with the body of the message.
+	redisFieldBody = "body"
+	// redisFieldGroupID is the field of the stream entries with the group id of the message.
+	redisFieldGroupID = "groupId"
+	// redisFieldDeduplicationID is the field of the stream entries with the deduplication id of the message.
+	redisFieldDeduplicationID = "deduplicationId"
+	// redisAttributePrefix is the prefix of the fields of the stream entries with the attributes of the message.
+	redisAttributePrefix = "attr:"
+	// redisDeadLetterSuffix is appended to the name of a stream to get the name of its dead-letter stream.
+	redisDeadLetterSuffix = ":dlq"
+)
+
+// RedisStreamProducerOption represents a Redis Streams producer option function.
+type RedisStreamProducerOption func(*RedisStreamProducer)
+
+// RedisStreamProducer is a Producer of a Redis stream.
+//
+// Each consumer group of the stream receives all the published messages, like the SQS
+// queues subscribed to a SNS topic.
+type RedisStreamProducer struct {
+	client           *redis.Client
+	stream           string
+	maxLen           int64
+	deduplicationTTL time.Duration
+}
+
+// NewRedisStreamProducer creates a Producer of a Redis stream.
+func NewRedisStreamProducer(client *redis.Client, stream string, opts ...RedisStreamProducerOption) *RedisStreamProducer {
+	p := &RedisStreamProducer{
+		client:           client,
+		stream:           stream,
+		maxLen:           1_000_000,
+		deduplicationTTL: 5 * time.Minute,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// WithMaxLen allows to specify the approximate maximum number of entries kept in the stream.
+func WithMaxLen(v int64) RedisStreamProducerOption {
+	return func(p *RedisStreamProducer) {
+		p.maxLen = v
+	}
+}
+
+// WithDeduplicationTTL allows to specify how long a deduplication id is remembered.
+func WithDeduplicationTTL(v time.Duration) RedisStreamProducerOption {
+	return func(p *RedisStreamProducer) {
+		p.deduplicationTTL = v
+	}
+}
+
+// Publish appends a message to the Redis stream.
+// Messages with a deduplication id already published within the deduplication TTL are discarded.
+func (p *RedisStreamProducer) Publish(ctx context.Context, msg *OutgoingMessage) error {
+	var deduplicationKey string
+	if msg.DeduplicationID != "" {
+		deduplicationKey = fmt.Sprintf("%s:dedup:%s", p.stream, msg.DeduplicationID)
+		ok, err := p.client.SetNX(ctx, deduplicationKey, 1, p.deduplicationTTL).Result()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+	}
+
+	err := p.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: p.stream,
+		MaxLen: p.maxLen,
+		Approx: true,
+		Values: newRedisStreamValues(msg),
+	}).Err()
+	if err != nil && deduplicationKey != "" {
+		// allow the message to be published again.
+		p.client.Del(ctx, deduplicationKey)
+	}
+	return err
+}
+
+// RedisStreamConsumerOption represents a Redis Streams consumer option function.
+type RedisStreamConsumerOption func(*RedisStreamConsumer)
+
+// RedisStreamConsumer is a Consumer of a Redis stream that belongs to a consumer group.
+//
+// The messages that are not acknowledged within the visibility timeout are claimed by the
+// next call to Receive of any consumer of the group. Messages delivered more than the
+// maximum receive count are moved to the dead-letter stream.
+type RedisStreamConsumer struct {
+	client            *redis.Client
+	stream            string
+	group             string
+	consumer          string
+	deadLetterStream  string
+	maxMessages       int64
+	maxReceiveCount   int64
+	visibilityTimeout time.Duration
+	waitTime          time.Duration
+}
+
+// NewRedisStreamConsumer creates a Consumer of a Redis stream, creating the stream and the
+// consumer group if they do not exist.
+func NewRedisStreamConsumer(ctx context.Context, client *redis.Client, stream, group string, opts ...RedisStreamConsumerOption) (*RedisStreamConsumer, error) {
+	consumer, err := os.Hostname()
+	if err != nil || consumer == "" {
+		consumer = "consumer"
+	}
+	c := &RedisStreamConsumer{
+		client:            client,
+		stream:            stream,
+		group:             group,
+		consumer:          consumer,
+		deadLetterStream:  stream + redisDeadLetterSuffix,
+		maxMessages:       10,
+		maxReceiveCount:   10,
+		visibilityTimeout: 60 * time.Second,
+		waitTime:          20 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	// the group starts from the beginning of the stream to not miss the messages published before it was created.
+	err = client.XGroupCreateMkStream(ctx, stream, group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return nil, fmt.Errorf("failed to create consumer group %s of stream %s: %w", group, stream, err)
+	}
+	return c, nil
+}
+
+// WithConsumerName allows to specify the name of the consumer within the group. Defaults to the hostname.
+func WithConsumerName(v string) RedisStreamConsumerOption {
+	return func(c *RedisStreamConsumer) {
+		c.consumer = v
+	}
+}
+
+// WithDeadLetterStream allows to specify the stream where the failed messages are moved.
+func WithDeadLetterStream(v string) RedisStreamConsumerOption {
+	return func(c *RedisStreamConsumer) {
+		c.deadLetterStream = v
+	}
+}
+
+// WithRedisMaxMessages allows to specify the maximum number of messages returned by Receive.
+func WithRedisMaxMessages(v int64) RedisStreamConsumerOption {
+	return func(c *RedisStreamConsumer) {
+		c.maxMessages = v
+	}
+}
+
+// WithMaxReceiveCount allows to specify the number of deliveries before a message is dead-lettered.
+func WithMaxReceiveCount(v int64) RedisStreamConsumerOption {
+	return func(c *RedisStreamConsumer) {
+		c.maxReceiveCount = v
+	}
+}
+
+// WithRedisVisibilityTimeout allows to specify the visibility timeout of the received messages.
+func WithRedisVisibilityTimeout(v time.Duration) RedisStreamConsumerOption {
+	return func(c *RedisStreamConsumer) {
+		c.visibilityTimeout = v
+	}
+}
+
+// WithRedisWaitTime allows to specify how long Receive waits for new messages.
+func WithRedisWaitTime(v time.Duration) RedisStreamConsumerOption {
+	return func(c *RedisStreamConsumer) {
+		c.waitTime = v
+	}
+}
+
+// Receive returns the expired messages of the group first, and then waits for new messages.
+func (c *RedisStreamConsumer) Receive(ctx context.Context) ([]*Message, error) {
+	messages, err := c.claimExpired(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(messages)) >= c.maxMessages {
+		return messages, nil
+	}
+
+	// do not block when there are expired messages to process.
+	block := c.waitTime
+	if len(messages) > 0 {
+		block = -1
+	}
+	streams, err := c.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    c.group,
+		Consumer: c.consumer,
+		Streams:  []string{c.stream, ">"},
+		Count:    c.maxMessages - int64(len(messages)),
+		Block:    block,
+	}).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+	for _, s := range streams {
+		for _, m := range s.Messages {
+			messages = append(messages, newRedisStreamMessage(m, 1))
+		}
+	}
+	return messages, nil
+}
+
+// claimExpired claims the messages of the group that were not acknowledged within the
+// visibility timeout, and moves to the dead-letter stream the ones delivered too many times.
+func (c *RedisStreamConsumer) claimExpired(ctx context.Context) ([]*Message, error) {
+	pending, err := c.client.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: c.stream,
+		Group:  c.group,
+		Idle:   c.visibilityTimeout,
+		Start:  "-",
+		End:    "+",
+		Count:  c.maxMessages,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(pending))
+	receiveCounts := make(map[string]int64, len(pending))
+	for _, p := range pending {
+		ids = append(ids, p.ID)
+		// claiming the message is a new delivery.
+		receiveCounts[p.ID] = p.RetryCount + 1
+	}
+
+	// another consumer may claim the same messages at the same time, only the claimed ones are returned.
+	claimed, err := c.client.XClaim(ctx, &redis.XClaimArgs{
+		Stream:   c.stream,
+		Group:    c.group,
+		Consumer: c.consumer,
+		MinIdle:  c.visibilityTimeout,
+		Messages: ids,
+	}).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	messages := make([]*Message, 0, len(claimed))
+	for _, m := range claimed {
+		receiveCount := receiveCounts[m.ID]
+		if receiveCount > c.maxReceiveCount {
+			if err := c.deadLetter(ctx, m, receiveCount-1); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		messages = append(messages, newRedisStreamMessage(m, receiveCount))
+	}
+	return messages, nil
+}
+
+// deadLetter moves a message to the dead-letter stream.
+func (c *RedisStreamConsumer) deadLetter(ctx context.Context, m redis.XMessage, receiveCount int64) error {
+	values := make(map[string]interface{}, len(m.Values)+3)
+	for k, v := range m.Values {
+		values[k] = v
+	}
+	values["sourceId"] = m.ID
+	values["sourceGroup"] = c.group
+	values["receiveCount"] = receiveCount
+
+	pipe := c.client.TxPipeline()
+	pipe.XAdd(ctx, &redis.XAddArgs{Stream: c.deadLetterStream, Values: values})
+	pipe.XAck(ctx, c.stream, c.group, m.ID)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Ack acknowledges the message in the consumer group.
+// The entry is kept in the stream for the other consumer groups until it is trimmed.
+func (c *RedisStreamConsumer) Ack(ctx context.Context, msg *Message) error {
+	return c.client.XAck(ctx, c.stream, c.group, msg.handle).Err()
+}
+
+// VisibilityTimeout returns the visibility timeout of the received messages.
+func (c *RedisStreamConsumer) VisibilityTimeout() time.Duration {
+	return c.visibilityTimeout
+}
+
+// Name returns the name of the stream and the consumer group.
+func (c *RedisStreamConsumer) Name() string {
+	return c.stream + "/" + c.group
+}
+
+// newRedisStreamValues returns the fields of the stream entry of a message.
+func newRedisStreamValues(msg *OutgoingMessage) map[string]interface{} {
+	values := map[string]interface{}{
+		redisFieldBody: msg.Body,
+	}
+	if msg.GroupID != "" {
+		values[redisFieldGroupID] = msg.GroupID
+	}
+	if msg.DeduplicationID != "" {
+		values[redisFieldDeduplicationID] = msg.DeduplicationID
+	}
+	for k, v := range msg.Attributes {
+		values[redisAttributePrefix+k] = v
+	}
+	return values
+}
+
+// newRedisStreamMessage returns the message of a stream entry.
+func newRedisStreamMessage(m redis.XMessage, receiveCount int64) *Message {
+	var body []byte
+	if v, ok := m.Values[redisFieldBody].(string); ok {
+		body = []byte(v)
+	}
+	return &Message{
+		ID:            m.ID,
+		Body:          body,
+		ReceiveCount:  int(receiveCount),
+		SentTimestamp: redisStreamIDTime(m.ID),
+		handle:        m.ID,
+	}
+}
+
+// redisStreamIDTime returns the time when a stream entry was added, which is the first part of its id.
+func redisStreamIDTime(id string) *time.Time {
+	ms, _, _ := strings.Cut(id, "-")
+	v, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil {
+		return nil
+	}
+	t := time.UnixMilli(v)
+	return &t
+}
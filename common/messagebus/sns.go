@@ -0,0 +1,48 @@
+package messagebus
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	aws_sns "github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+)
+
+// SNSProducer is a Producer of a SNS topic.
+type SNSProducer struct {
+	api *aws_sns.Client
+	url string
+}
+
+// NewSNSProducer creates a Producer of a SNS topic.
+func NewSNSProducer(awsConfig aws.Config, url string) *SNSProducer {
+	return &SNSProducer{
+		api: aws_sns.NewFromConfig(awsConfig),
+		url: url,
+	}
+}
+
+// Publish sends a message to the SNS topic.
+func (p *SNSProducer) Publish(ctx context.Context, msg *OutgoingMessage) error {
+	input := &aws_sns.PublishInput{
+		Message:  aws.String(string(msg.Body)),
+		TopicArn: aws.String(p.url),
+	}
+	if msg.GroupID != "" {
+		input.MessageGroupId = aws.String(msg.GroupID)
+	}
+	if msg.DeduplicationID != "" {
+		input.MessageDeduplicationId = aws.String(msg.DeduplicationID)
+	}
+	if len(msg.Attributes) > 0 {
+		input.MessageAttributes = make(map[string]types.MessageAttributeValue, len(msg.Attributes))
+		for k, v := range msg.Attributes {
+			input.MessageAttributes[k] = types.MessageAttributeValue{
+				DataType:    aws.String("String"),
+				StringValue: aws.String(v),
+			}
+		}
+	}
+	_, err := p.api.Publish(ctx, input)
+	return err
+}
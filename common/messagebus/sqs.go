@@ -0,0 +1,130 @@
+package messagebus
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	aws_sqs "github.com/aws/aws-sdk-go-v2/service/sqs"
+	aws_sqs_types "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/sqs"
+)
+
+// SQSConsumerOption represents a SQS consumer option function.
+type SQSConsumerOption func(*SQSConsumer)
+
+// SQSConsumer is a Consumer of a SQS queue.
+//
+// Redelivery and dead-lettering rely on the visibility timeout and redrive policy of the queue.
+type SQSConsumer struct {
+	consumer    *sqs.Consumer
+	snsEnvelope bool
+}
+
+// NewSQSConsumer creates a Consumer of a SQS queue.
+func NewSQSConsumer(consumer *sqs.Consumer, opts ...SQSConsumerOption) *SQSConsumer {
+	c := &SQSConsumer{consumer: consumer}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithSNSEnvelope is used when the queue is subscribed to a SNS topic without raw message
+// delivery, so the body of the received messages is the message published to the topic.
+func WithSNSEnvelope() SQSConsumerOption {
+	return func(c *SQSConsumer) {
+		c.snsEnvelope = true
+	}
+}
+
+// Receive retrieves messages from the SQS queue.
+func (c *SQSConsumer) Receive(ctx context.Context) ([]*Message, error) {
+	sqsMessages, err := c.consumer.GetMessages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]*Message, 0, len(sqsMessages))
+	for _, m := range sqsMessages {
+		messages = append(messages, c.newMessage(m))
+	}
+	return messages, nil
+}
+
+func (c *SQSConsumer) newMessage(m aws_sqs_types.Message) *Message {
+	body := []byte(aws.ToString(m.Body))
+	if c.snsEnvelope {
+		body = unwrapSNSNotification(body)
+	}
+	receiveCount, _ := strconv.Atoi(m.Attributes["ApproximateReceiveCount"])
+	return &Message{
+		ID:            aws.ToString(m.MessageId),
+		Body:          body,
+		ReceiveCount:  receiveCount,
+		SentTimestamp: sqs.GetSentTimestamp(m),
+		handle:        aws.ToString(m.ReceiptHandle),
+	}
+}
+
+// Ack deletes the message from the SQS queue.
+func (c *SQSConsumer) Ack(ctx context.Context, msg *Message) error {
+	return c.consumer.DeleteMessage(ctx, aws.String(msg.handle))
+}
+
+// VisibilityTimeout returns the visibility timeout of the received messages.
+func (c *SQSConsumer) VisibilityTimeout() time.Duration {
+	return c.consumer.GetVisibilityTimeout()
+}
+
+// Name returns the url of the SQS queue.
+func (c *SQSConsumer) Name() string {
+	return c.consumer.GetQueueUrl()
+}
+
+// snsNotification is the body of the SQS messages delivered by a SNS subscription.
+type snsNotification struct {
+	MessageID string `json:"MessageId"`
+	Message   string `json:"Message"`
+}
+
+// unwrapSNSNotification returns the message published to the SNS topic.
+// Bodies that are not SNS notifications are returned as is.
+func unwrapSNSNotification(body []byte) []byte {
+	var notification snsNotification
+	if err := json.Unmarshal(body, &notification); err != nil || notification.Message == "" {
+		return body
+	}
+	return []byte(notification.Message)
+}
+
+// SQSProducer is a Producer of a SQS queue, used when the queue is not subscribed to a SNS topic.
+type SQSProducer struct {
+	api *aws_sqs.Client
+	url string
+}
+
+// NewSQSProducer creates a Producer of a SQS queue.
+func NewSQSProducer(awsConfig aws.Config, url string) *SQSProducer {
+	return &SQSProducer{
+		api: aws_sqs.NewFromConfig(awsConfig),
+		url: url,
+	}
+}
+
+// Publish sends a message to the SQS queue. The attributes of the message are not sent.
+func (p *SQSProducer) Publish(ctx context.Context, msg *OutgoingMessage) error {
+	input := &aws_sqs.SendMessageInput{
+		MessageBody: aws.String(string(msg.Body)),
+		QueueUrl:    aws.String(p.url),
+	}
+	if msg.GroupID != "" {
+		input.MessageGroupId = aws.String(msg.GroupID)
+	}
+	if msg.DeduplicationID != "" {
+		input.MessageDeduplicationId = aws.String(msg.DeduplicationID)
+	}
+	_, err := p.api.SendMessage(ctx, input)
+	return err
+}
@@ -0,0 +1,64 @@
+package periodic
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Lock is a lease held by a single instance until it expires.
+type Lock interface {
+	// Acquire takes or renews the lock of the name for the ttl. It returns false if the lock
+	// is held by another instance.
+	Acquire(ctx context.Context, name string, ttl time.Duration) (bool, error)
+}
+
+// MongoLock is a Lock backed by the locks collection.
+type MongoLock struct {
+	collection *mongo.Collection
+	owner      string
+}
+
+// NewMongoLock creates a new MongoLock owned by this instance.
+func NewMongoLock(db *mongo.Database) *MongoLock {
+	hostname, _ := os.Hostname()
+	return &MongoLock{
+		collection: db.Collection(repository.Locks),
+		owner:      fmt.Sprintf("%s/%s", hostname, uuid.NewString()),
+	}
+}
+
+// Acquire updates the lock document if it is owned by this instance or expired. Otherwise the
+// upsert fails with a duplicate key error, since the document of the name already exists.
+func (l *MongoLock) Acquire(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	filter := bson.M{
+		"_id": name,
+		"$or": bson.A{
+			bson.M{"owner": l.owner},
+			bson.M{"expiresAt": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"owner":     l.owner,
+			"expiresAt": now.Add(ttl),
+			"updatedAt": now,
+		},
+	}
+	_, err := l.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,82 @@
+// Package periodic runs tasks at a fixed interval, optionally in a single instance of a service
+// that runs several replicas.
+package periodic
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RunFunc is a task run periodically. now is the time of the run.
+type RunFunc func(ctx context.Context, now time.Time) error
+
+// Option represents a Runner option function.
+type Option func(*Runner)
+
+// Runner runs a task at a fixed interval.
+type Runner struct {
+	name     string
+	interval time.Duration
+	run      RunFunc
+	lock     Lock
+	logger   *zap.Logger
+}
+
+// NewRunner creates a Runner of a task. The name identifies the task in the logs and the lock.
+func NewRunner(name string, interval time.Duration, run RunFunc, logger *zap.Logger, opts ...Option) *Runner {
+	r := &Runner{
+		name:     name,
+		interval: interval,
+		run:      run,
+		logger:   logger.With(zap.String("task", name)),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// WithLock runs the task only in the instance that holds the lock of the task.
+// The lock is held for two intervals and renewed on every run, so another instance takes over
+// the task when the holder stops.
+func WithLock(lock Lock) Option {
+	return func(r *Runner) {
+		r.lock = lock
+	}
+}
+
+// Start runs the task immediately and then every interval until the context is cancelled.
+func (r *Runner) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			r.tick(ctx, time.Now())
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// tick runs the task if the lock is acquired.
+func (r *Runner) tick(ctx context.Context, now time.Time) {
+	if r.lock != nil {
+		acquired, err := r.lock.Acquire(ctx, r.name, 2*r.interval)
+		if err != nil {
+			r.logger.Error("Error acquiring the lock of the task", zap.Error(err))
+			return
+		}
+		if !acquired {
+			r.logger.Debug("Lock of the task held by another instance")
+			return
+		}
+	}
+	if err := r.run(ctx, now); err != nil {
+		r.logger.Error("Error running the task", zap.Error(err))
+	}
+}
@@ -0,0 +1,76 @@
+package periodic
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type fakeLock struct {
+	acquired bool
+	err      error
+	ttl      time.Duration
+}
+
+func (l *fakeLock) Acquire(_ context.Context, _ string, ttl time.Duration) (bool, error) {
+	l.ttl = ttl
+	return l.acquired, l.err
+}
+
+func TestRunnerTick(t *testing.T) {
+	testCases := []struct {
+		name string
+		lock *fakeLock
+		runs int
+	}{
+		{"without lock", nil, 1},
+		{"lock acquired", &fakeLock{acquired: true}, 1},
+		{"lock held by another instance", &fakeLock{acquired: false}, 0},
+		{"lock error", &fakeLock{err: errors.New("unavailable")}, 0},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var runs int
+			run := func(context.Context, time.Time) error {
+				runs++
+				return errors.New("failed")
+			}
+			var opts []Option
+			if testCase.lock != nil {
+				opts = append(opts, WithLock(testCase.lock))
+			}
+			r := NewRunner("task", time.Minute, run, zap.NewNop(), opts...)
+
+			r.tick(context.Background(), time.Now())
+			assert.Equal(t, testCase.runs, runs)
+			if testCase.lock != nil {
+				assert.Equal(t, 2*time.Minute, testCase.lock.ttl)
+			}
+		})
+	}
+}
+
+func TestRunnerStart(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	runs := make(chan time.Time, 10)
+	r := NewRunner("task", 10*time.Millisecond, func(_ context.Context, now time.Time) error {
+		runs <- now
+		return nil
+	}, zap.NewNop())
+	r.Start(ctx)
+
+	// the first run is immediate and the next ones follow the interval.
+	for i := 0; i < 3; i++ {
+		select {
+		case <-runs:
+		case <-time.After(time.Second):
+			t.Fatal("task not run")
+		}
+	}
+}
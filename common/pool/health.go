@@ -0,0 +1,144 @@
+package pool
+
+import (
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of the circuit breaker of a pool item.
+type CircuitState int
+
+const (
+	// CircuitClosed is the state of a healthy item, it receives requests.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen is the state of an ejected item, it does not receive requests until the cooldown expires.
+	CircuitOpen
+	// CircuitHalfOpen is the state of an item that is being probed after the cooldown.
+	CircuitHalfOpen
+)
+
+// String returns the name of the circuit state.
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+const (
+	// defaultFailureThreshold is the number of consecutive failures that opens the circuit.
+	defaultFailureThreshold = 5
+	// defaultCooldown is the time an item is ejected before it is probed again.
+	defaultCooldown = 30 * time.Second
+	// maxCooldown is the upper bound of the cooldown, which doubles every time a probe fails.
+	maxCooldown = 10 * time.Minute
+	// ewmaWeight is the weight of the last event in the success rate and latency averages.
+	ewmaWeight = 0.2
+)
+
+// health tracks the success rate, latency and circuit breaker of a pool item.
+// It is shared by all the copies of the item returned by the pool.
+type health struct {
+	mu                  sync.Mutex
+	state               CircuitState
+	consecutiveFailures int
+	failureThreshold    int
+	baseCooldown        time.Duration
+	cooldown            time.Duration
+	openedAt            time.Time
+	probeStartedAt      time.Time
+	successRate         float64
+	latency             time.Duration
+}
+
+func newHealth(failureThreshold int, cooldown time.Duration) *health {
+	return &health{
+		state:            CircuitClosed,
+		failureThreshold: failureThreshold,
+		baseCooldown:     cooldown,
+		cooldown:         cooldown,
+		successRate:      1,
+	}
+}
+
+// availability returns the state of the circuit at the given time.
+// An open circuit whose cooldown expired switches to half-open and the item is
+// handed out as a probe. Only one probe is handed out per cooldown period.
+func (h *health) availability(now time.Time) (state CircuitState, probe bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	switch h.state {
+	case CircuitOpen:
+		if now.Before(h.openedAt.Add(h.cooldown)) {
+			return CircuitOpen, false
+		}
+		h.state = CircuitHalfOpen
+		h.probeStartedAt = now
+		return CircuitHalfOpen, true
+	case CircuitHalfOpen:
+		// the probe was handed out but never reported, hand it out again.
+		if now.After(h.probeStartedAt.Add(h.cooldown)) {
+			h.probeStartedAt = now
+			return CircuitHalfOpen, true
+		}
+		return CircuitHalfOpen, false
+	default:
+		return CircuitClosed, false
+	}
+}
+
+// score returns the success rate of the item.
+func (h *health) score() float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.successRate
+}
+
+// success records a successful request and closes the circuit.
+func (h *health) success(latency time.Duration) CircuitState {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.successRate = ewma(h.successRate, 1)
+	h.latency = time.Duration(ewma(float64(h.latency), float64(latency)))
+	h.consecutiveFailures = 0
+	h.state = CircuitClosed
+	h.cooldown = h.baseCooldown
+	return h.state
+}
+
+// failure records a failed request. The circuit is opened when the number of
+// consecutive failures reaches the threshold, or when the probe of a half-open item fails.
+func (h *health) failure(latency time.Duration, now time.Time) CircuitState {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.successRate = ewma(h.successRate, 0)
+	h.latency = time.Duration(ewma(float64(h.latency), float64(latency)))
+	h.consecutiveFailures++
+
+	switch h.state {
+	case CircuitHalfOpen:
+		h.cooldown *= 2
+		if h.cooldown > maxCooldown {
+			h.cooldown = maxCooldown
+		}
+		h.state = CircuitOpen
+		h.openedAt = now
+	case CircuitClosed:
+		if h.failureThreshold > 0 && h.consecutiveFailures >= h.failureThreshold {
+			h.state = CircuitOpen
+			h.openedAt = now
+		}
+	}
+	return h.state
+}
+
+func ewma(average, value float64) float64 {
+	return average*(1-ewmaWeight) + value*ewmaWeight
+}
@@ -0,0 +1,88 @@
+package pool
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Metrics is the interface to report the health of the pool items.
+type Metrics interface {
+	IncRequest(pool, item string, success bool)
+	ObserveLatency(pool, item string, latency time.Duration)
+	SetCircuitState(pool, item string, state CircuitState)
+}
+
+// DummyMetrics is a dummy implementation of Metrics.
+type DummyMetrics struct{}
+
+// NewDummyMetrics returns a new instance of DummyMetrics.
+func NewDummyMetrics() *DummyMetrics {
+	return &DummyMetrics{}
+}
+
+// IncRequest is a dummy implementation of IncRequest.
+func (d *DummyMetrics) IncRequest(pool, item string, success bool) {}
+
+// ObserveLatency is a dummy implementation of ObserveLatency.
+func (d *DummyMetrics) ObserveLatency(pool, item string, latency time.Duration) {}
+
+// SetCircuitState is a dummy implementation of SetCircuitState.
+func (d *DummyMetrics) SetCircuitState(pool, item string, state CircuitState) {}
+
+// PrometheusMetrics is a Prometheus implementation of Metrics.
+type PrometheusMetrics struct {
+	requests     *prometheus.CounterVec
+	latency      *prometheus.HistogramVec
+	circuitState *prometheus.GaugeVec
+}
+
+// NewPrometheusMetrics returns a new instance of PrometheusMetrics.
+// It must be created once per service and shared by all its pools.
+func NewPrometheusMetrics(environment, service string) *PrometheusMetrics {
+	constLabels := map[string]string{
+		"environment": environment,
+		"service":     service,
+	}
+	return &PrometheusMetrics{
+		requests: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "pool_item_request_count",
+				Help:        "Total number of requests by pool item",
+				ConstLabels: constLabels,
+			}, []string{"pool", "item", "status"}),
+		latency: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:        "pool_item_request_duration_seconds",
+				Help:        "Duration of the requests by pool item",
+				ConstLabels: constLabels,
+				Buckets:     []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+			}, []string{"pool", "item"}),
+		circuitState: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:        "pool_item_circuit_state",
+				Help:        "Circuit breaker state by pool item: 0 closed, 1 open, 2 half-open",
+				ConstLabels: constLabels,
+			}, []string{"pool", "item"}),
+	}
+}
+
+// IncRequest increments the number of requests of a pool item.
+func (m *PrometheusMetrics) IncRequest(pool, item string, success bool) {
+	status := "success"
+	if !success {
+		status = "failed"
+	}
+	m.requests.WithLabelValues(pool, item, status).Inc()
+}
+
+// ObserveLatency records the latency of a request of a pool item.
+func (m *PrometheusMetrics) ObserveLatency(pool, item string, latency time.Duration) {
+	m.latency.WithLabelValues(pool, item).Observe(latency.Seconds())
+}
+
+// SetCircuitState sets the circuit breaker state of a pool item.
+func (m *PrometheusMetrics) SetCircuitState(pool, item string, state CircuitState) {
+	m.circuitState.WithLabelValues(pool, item).Set(float64(state))
+}
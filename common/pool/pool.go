@@ -10,7 +10,38 @@ import (
 
 // Pool is a pool of items.
 type Pool struct {
-	items []Item
+	name             string
+	items            []Item
+	metrics          Metrics
+	failureThreshold int
+	cooldown         time.Duration
+}
+
+// Option is a pool option.
+type Option func(*Pool)
+
+// WithName sets the name of the pool, it is used to label the metrics.
+func WithName(name string) Option {
+	return func(p *Pool) {
+		p.name = name
+	}
+}
+
+// WithMetrics sets the metrics used to report the health of the pool items.
+func WithMetrics(metrics Metrics) Option {
+	return func(p *Pool) {
+		p.metrics = metrics
+	}
+}
+
+// WithCircuitBreaker sets the number of consecutive failures that ejects an item
+// from the pool and the cooldown before the item is probed again.
+// A failureThreshold of zero disables the circuit breaker.
+func WithCircuitBreaker(failureThreshold int, cooldown time.Duration) Option {
+	return func(p *Pool) {
+		p.failureThreshold = failureThreshold
+		p.cooldown = cooldown
+	}
 }
 
 // Item defines the item of the pool.
@@ -24,11 +55,24 @@ type Item struct {
 	priority uint8
 	// rateLimit is the rate limiter for the item.
 	rateLimit *rate.Limiter
+	// pool is the name of the pool the item belongs to.
+	pool string
+	// health tracks the result of the requests sent to the item.
+	health *health
+	// metrics reports the health of the item.
+	metrics Metrics
 }
 
 // NewPool creates a new pool.
-func NewPool(cfg []Config) *Pool {
-	p := &Pool{}
+func NewPool(cfg []Config, opts ...Option) *Pool {
+	p := &Pool{
+		metrics:          NewDummyMetrics(),
+		failureThreshold: defaultFailureThreshold,
+		cooldown:         defaultCooldown,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
 	for _, c := range cfg {
 		p.addItem(c)
 	}
@@ -43,87 +87,123 @@ func (p *Pool) addItem(cfg Config) {
 		priority:    cfg.Priority,
 		rateLimit: rate.NewLimiter(
 			rate.Every(time.Minute/time.Duration(cfg.RequestsPerMinute)), 1),
+		pool:    p.name,
+		health:  newHealth(p.failureThreshold, p.cooldown),
+		metrics: p.metrics,
 	}
 	p.items = append(p.items, i)
+	p.metrics.SetCircuitState(p.name, i.Description, CircuitClosed)
 }
 
 // GetItem returns the next available item of the pool.
 func (p *Pool) GetItem() Item {
+	items := p.GetItems()
 	// check if there is no item
-	if len(p.items) == 0 {
+	if len(items) == 0 {
 		return Item{}
 	}
-
-	// get the next available item
-	itemWithScore := []struct {
-		item  Item
-		score float64
-	}{}
-
-	now := time.Now()
-	for _, i := range p.items {
-		tokenAt := i.rateLimit.TokensAt(now)
-		itemWithScore = append(itemWithScore, struct {
-			item  Item
-			score float64
-		}{
-			item:  i,
-			score: tokenAt,
-		})
-	}
-
-	// sort by score and priority
-	sort.Slice(itemWithScore, func(i, j int) bool {
-		if itemWithScore[i].score == itemWithScore[j].score {
-			return itemWithScore[i].item.priority < itemWithScore[j].item.priority
-		}
-		return itemWithScore[i].score > itemWithScore[j].score
-	})
-
-	return itemWithScore[0].item
+	return items[0]
 }
 
-// GetItems returns the list of items sorted by score and priority.
-// Once there is an event on the item, it must be notified using the method NotifyEvent.
+// GetItems returns the list of available items sorted by score and priority.
+//
+// The score of an item is the number of tokens of its rate limiter weighted by
+// its success rate. Items ejected by the circuit breaker are left out, except for
+// a single probe request once their cooldown expires, which is placed first.
+// If every item is ejected, all of them are returned so that the caller can still try.
+//
+// Once there is an event on the item, it must be notified using the methods
+// NotifySuccess or NotifyFailure.
 func (p *Pool) GetItems() []Item {
 	if len(p.items) == 0 {
 		return []Item{}
 	}
 
-	itemsWithScore := []struct {
-		item  Item
-		score float64
-	}{}
-
 	now := time.Now()
+	var probes, available, ejected []scoredItem
 	for _, i := range p.items {
-		tokenAt := i.rateLimit.TokensAt(now)
-		itemsWithScore = append(itemsWithScore, struct {
-			item  Item
-			score float64
-		}{
+		state, probe := i.health.availability(now)
+		if probe {
+			p.metrics.SetCircuitState(p.name, i.Description, state)
+		}
+		si := scoredItem{
 			item:  i,
-			score: tokenAt,
-		})
+			score: i.rateLimit.TokensAt(now) * i.health.score(),
+		}
+		switch {
+		case probe:
+			probes = append(probes, si)
+		case state == CircuitClosed:
+			available = append(available, si)
+		default:
+			ejected = append(ejected, si)
+		}
 	}
 
-	// sort by score and priority
-	sort.Slice(itemsWithScore, func(i, j int) bool {
-		if itemsWithScore[i].score == itemsWithScore[j].score {
-			return itemsWithScore[i].item.priority < itemsWithScore[j].item.priority
-		}
-		return itemsWithScore[i].score > itemsWithScore[j].score
-	})
+	if len(probes) == 0 && len(available) == 0 {
+		available = ejected
+	}
+	sortByScore(available)
 
-	// convert itemsWithScore to items
-	items := []Item{}
-	for _, i := range itemsWithScore {
+	// convert scored items to items
+	items := make([]Item, 0, len(probes)+len(available))
+	for _, i := range probes {
+		items = append(items, i.item)
+	}
+	for _, i := range available {
 		items = append(items, i.item)
 	}
 	return items
 }
 
+type scoredItem struct {
+	item  Item
+	score float64
+}
+
+// sortByScore sorts the items by score and priority.
+func sortByScore(items []scoredItem) {
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].score == items[j].score {
+			return items[i].item.priority < items[j].item.priority
+		}
+		return items[i].score > items[j].score
+	})
+}
+
 // Wait waits for the rate limiter to allow the next item request.
 func (i *Item) Wait(ctx context.Context) error {
 	return i.rateLimit.Wait(ctx)
 }
+
+// NotifySuccess reports a successful request to the item.
+func (i *Item) NotifySuccess(latency time.Duration) {
+	if i.health == nil {
+		return
+	}
+	state := i.health.success(latency)
+	i.metrics.IncRequest(i.pool, i.Description, true)
+	i.metrics.ObserveLatency(i.pool, i.Description, latency)
+	i.metrics.SetCircuitState(i.pool, i.Description, state)
+}
+
+// NotifyFailure reports a failed request to the item.
+// The item is ejected from the pool after too many consecutive failures.
+func (i *Item) NotifyFailure(latency time.Duration) {
+	if i.health == nil {
+		return
+	}
+	state := i.health.failure(latency, time.Now())
+	i.metrics.IncRequest(i.pool, i.Description, false)
+	i.metrics.ObserveLatency(i.pool, i.Description, latency)
+	i.metrics.SetCircuitState(i.pool, i.Description, state)
+}
+
+// NotifyEvent reports the result of a request to the item that started at the given time.
+func (i *Item) NotifyEvent(start time.Time, err error) {
+	if err != nil {
+		i.NotifyFailure(time.Since(start))
+		return
+	}
+	i.NotifySuccess(time.Since(start))
+}
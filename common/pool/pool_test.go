@@ -0,0 +1,87 @@
+package pool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestPool(failureThreshold int, cooldown time.Duration) *Pool {
+	return NewPool([]Config{
+		{Id: "http://rpc-1", Description: "rpc-1", Priority: 1, RequestsPerMinute: 60},
+		{Id: "http://rpc-2", Description: "rpc-2", Priority: 2, RequestsPerMinute: 60},
+	}, WithName("test"), WithCircuitBreaker(failureThreshold, cooldown))
+}
+
+func itemIds(items []Item) []string {
+	ids := make([]string, 0, len(items))
+	for _, i := range items {
+		ids = append(ids, i.Id)
+	}
+	return ids
+}
+
+func TestPool_GetItemsByPriority(t *testing.T) {
+	p := newTestPool(3, time.Minute)
+	assert.Equal(t, []string{"http://rpc-1", "http://rpc-2"}, itemIds(p.GetItems()))
+	assert.Equal(t, "http://rpc-1", p.GetItem().Id)
+}
+
+func TestPool_FailuresLowerTheScore(t *testing.T) {
+	p := newTestPool(3, time.Minute)
+	items := p.GetItems()
+	items[0].NotifyFailure(time.Millisecond)
+	assert.Equal(t, []string{"http://rpc-2", "http://rpc-1"}, itemIds(p.GetItems()))
+}
+
+func TestPool_CircuitBreaker(t *testing.T) {
+	p := newTestPool(3, 50*time.Millisecond)
+	rpc1 := p.GetItems()[0]
+
+	// consecutive failures eject the item.
+	for i := 0; i < 3; i++ {
+		rpc1.NotifyFailure(time.Millisecond)
+	}
+	assert.Equal(t, []string{"http://rpc-2"}, itemIds(p.GetItems()))
+
+	// once the cooldown expires, the item is probed first, only once.
+	time.Sleep(60 * time.Millisecond)
+	assert.Equal(t, []string{"http://rpc-1", "http://rpc-2"}, itemIds(p.GetItems()))
+	assert.Equal(t, []string{"http://rpc-2"}, itemIds(p.GetItems()))
+
+	// a successful probe closes the circuit.
+	rpc1.NotifySuccess(time.Millisecond)
+	assert.ElementsMatch(t, []string{"http://rpc-1", "http://rpc-2"}, itemIds(p.GetItems()))
+}
+
+func TestPool_FailedProbeReopensTheCircuit(t *testing.T) {
+	p := newTestPool(1, 50*time.Millisecond)
+	rpc1 := p.GetItems()[0]
+	rpc1.NotifyFailure(time.Millisecond)
+
+	time.Sleep(60 * time.Millisecond)
+	assert.Equal(t, "http://rpc-1", p.GetItems()[0].Id)
+	rpc1.NotifyFailure(time.Millisecond)
+
+	// the cooldown doubles after a failed probe.
+	time.Sleep(60 * time.Millisecond)
+	assert.Equal(t, []string{"http://rpc-2"}, itemIds(p.GetItems()))
+}
+
+func TestPool_AllItemsEjected(t *testing.T) {
+	p := newTestPool(1, time.Minute)
+	for _, i := range p.GetItems() {
+		i.NotifyFailure(time.Millisecond)
+	}
+	assert.Len(t, p.GetItems(), 2)
+}
+
+func TestPool_CircuitBreakerDisabled(t *testing.T) {
+	p := newTestPool(0, time.Minute)
+	rpc1 := p.GetItems()[0]
+	for i := 0; i < 10; i++ {
+		rpc1.NotifyFailure(time.Millisecond)
+	}
+	assert.Len(t, p.GetItems(), 2)
+}
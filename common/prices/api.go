@@ -22,9 +22,10 @@ type PricesApi struct {
 	log    *zap.Logger
 }
 
-func NewPricesApi(url string, log *zap.Logger) *PricesApi {
+// NewPricesApi creates a client of the prices api of the notional service, the requests fail after the timeout.
+func NewPricesApi(url string, timeout time.Duration, log *zap.Logger) *PricesApi {
 	return &PricesApi{
-		client: resty.New().SetBaseURL(url),
+		client: resty.New().SetBaseURL(url).SetTimeout(timeout),
 		log:    log,
 	}
 }
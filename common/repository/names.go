@@ -1,12 +1,28 @@
 package repository
 
 const (
-	VaaIdTxHash      = "vaaIdTxHash"
-	TransferPrices   = "transferPrices"
-	Vaas             = "vaas"
-	DuplicateVaas    = "duplicateVaas"
-	GuardianSets     = "guardianSets"
-	NodeGovernorVaas = "nodeGovernorVaas"
-	GovernorVaas     = "governorVaas"
-	Observations     = "observations"
+	VaaIdTxHash         = "vaaIdTxHash"
+	TransferPrices      = "transferPrices"
+	Vaas                = "vaas"
+	DuplicateVaas       = "duplicateVaas"
+	GuardianSets        = "guardianSets"
+	NodeGovernorVaas    = "nodeGovernorVaas"
+	GovernorVaas        = "governorVaas"
+	Observations        = "observations"
+	ParsedVaa           = "parsedVaa"
+	TokenRegistry       = "tokenRegistry"
+	HeartbeatHistory    = "heartbeatHistory"
+	SigningProgress     = "signingProgress"
+	SourceMessages      = "sourceMessages"
+	BackfillCheckpoints = "backfillCheckpoints"
+	AddressActivity     = "addressActivity"
+	OperationLifecycle  = "operationLifecycle"
+	VaaAuditFindings    = "vaaAuditFindings"
+	VaaAuditReports     = "vaaAuditReports"
+	Exports             = "exports"
+	Locks               = "locks"
+
+	WebhookSubscriptions = "webhookSubscriptions"
+	WebhookDeliveries    = "webhookDeliveries"
+	WebhookDeadLetters   = "webhookDeadLetters"
 )
@@ -32,6 +32,9 @@ type VaaDoc struct {
 	TxHash           string     `bson:"txHash"`
 	Version          int        `bson:"version"`
 	Revision         int        `bson:"revision"`
+	Digest           string     `bson:"digest"`
+	VaaID            string     `bson:"vaaId,omitempty"`         // only set in the duplicate VAAs.
+	OriginTxHash     *string    `bson:"_originTxHash,omitempty"` // only set in the vaas with a re-encoded txHash.
 }
 
 // VaaQuery is a query for VAA.
@@ -43,6 +46,33 @@ type VaaQuery struct {
 	Sequence       *string
 }
 
+// toBSON returns the filter of the query.
+func (q VaaQuery) toBSON() bson.M {
+	filter := bson.M{}
+
+	if q.StartTime != nil || q.EndTime != nil {
+		rangeTimestamp := bson.M{}
+		if q.StartTime != nil {
+			rangeTimestamp["$gte"] = q.StartTime
+		}
+		if q.EndTime != nil {
+			rangeTimestamp["$lt"] = q.EndTime
+		}
+		filter["timestamp"] = rangeTimestamp
+	}
+
+	if q.EmitterChainID != nil {
+		filter["emitterChain"] = q.EmitterChainID
+	}
+	if q.EmitterAddress != nil {
+		filter["emitterAddr"] = q.EmitterAddress
+	}
+	if q.Sequence != nil {
+		filter["sequence"] = q.Sequence
+	}
+	return filter
+}
+
 // Pagination is a pagination for VAA.
 type Pagination struct {
 	Page     int64
@@ -58,6 +88,15 @@ func NewVaaRepository(db *mongo.Database, logger *zap.Logger) *VaaRepository {
 	}
 }
 
+// NewDuplicateVaaRepository create a new Vaa repository over the duplicate VAAs, which are stored
+// with the same fields of the VAAs and the id of the original VAA.
+func NewDuplicateVaaRepository(db *mongo.Database, logger *zap.Logger) *VaaRepository {
+	return &VaaRepository{db: db,
+		logger: logger.With(zap.String("module", "DuplicateVaaRepository")),
+		vaas:   db.Collection(DuplicateVaas),
+	}
+}
+
 // FindById finds VAA by id.
 func (r *VaaRepository) FindById(ctx context.Context, id string) (*VaaDoc, error) {
 	var vaaDoc VaaDoc
@@ -92,28 +131,7 @@ func (r *VaaRepository) FindPageByTimeRange(ctx context.Context, startTime time.
 // FindPage finds VAA by query and pagination.
 func (r *VaaRepository) FindPage(ctx context.Context, query VaaQuery, pagination Pagination) ([]*VaaDoc, error) {
 
-	filter := bson.M{}
-
-	if query.StartTime != nil || query.EndTime != nil {
-		rangeTimestamp := bson.M{}
-		if query.StartTime != nil {
-			rangeTimestamp["$gte"] = query.StartTime
-		}
-		if query.EndTime != nil {
-			rangeTimestamp["$lt"] = query.EndTime
-		}
-		filter["timestamp"] = rangeTimestamp
-	}
-
-	if query.EmitterChainID != nil {
-		filter["emitterChain"] = query.EmitterChainID
-	}
-	if query.EmitterAddress != nil {
-		filter["emitterAddr"] = query.EmitterAddress
-	}
-	if query.Sequence != nil {
-		filter["sequence"] = query.Sequence
-	}
+	filter := query.toBSON()
 
 	sort := -1
 	if pagination.SortAsc {
@@ -130,3 +148,33 @@ func (r *VaaRepository) FindPage(ctx context.Context, query VaaQuery, pagination
 	err = cur.All(ctx, &vaas)
 	return vaas, err
 }
+
+// VaaCursor is the position of a VAA in the VAAs sorted by ascending timestamp and id.
+type VaaCursor struct {
+	Timestamp time.Time
+	ID        string
+}
+
+// FindPageAfter finds a page of VAA sorted by ascending timestamp and id, starting after the cursor.
+// Unlike FindPage, the pages do not shift when VAAs are inserted, so a scan can be resumed from the
+// last VAA processed.
+func (r *VaaRepository) FindPageAfter(ctx context.Context, query VaaQuery, after *VaaCursor, limit int64) ([]*VaaDoc, error) {
+	filter := query.toBSON()
+	if after != nil {
+		filter["$or"] = bson.A{
+			bson.M{"timestamp": bson.M{"$gt": after.Timestamp}},
+			bson.M{"timestamp": after.Timestamp, "_id": bson.M{"$gt": after.ID}},
+		}
+	}
+
+	opts := options.Find().
+		SetLimit(limit).
+		SetSort(bson.D{{Key: "timestamp", Value: 1}, {Key: "_id", Value: 1}})
+	cur, err := r.vaas.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var vaas []*VaaDoc
+	err = cur.All(ctx, &vaas)
+	return vaas, err
+}
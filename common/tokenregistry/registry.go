@@ -0,0 +1,147 @@
+// Package tokenregistry implements a token registry built from Token Bridge attestation VAAs.
+//
+// The registry complements the static token lists of domain.TokenProvider: tokens are
+// recorded as soon as they are attested, and operators can map them to a coingecko ID
+// and to the addresses of their wrapped assets.
+package tokenregistry
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// DefaultRefreshInterval is the default interval between two reloads of the registry.
+const DefaultRefreshInterval = 5 * time.Minute
+
+// Registry is an in-memory snapshot of the token registry that is reloaded periodically.
+// It implements domain.TokenRegistry.
+type Registry struct {
+	repository      *Repository
+	refreshInterval time.Duration
+	logger          *zap.Logger
+
+	mu            sync.RWMutex
+	tokens        []domain.TokenMetadata
+	byContractID  map[string]*domain.TokenMetadata
+	byCoingeckoID map[string]*domain.TokenMetadata
+}
+
+// NewRegistry creates a new registry. It is empty until Start is called.
+func NewRegistry(repository *Repository, refreshInterval time.Duration, logger *zap.Logger) *Registry {
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultRefreshInterval
+	}
+	return &Registry{
+		repository:      repository,
+		refreshInterval: refreshInterval,
+		logger:          logger.With(zap.String("module", "TokenRegistry")),
+		byContractID:    make(map[string]*domain.TokenMetadata),
+		byCoingeckoID:   make(map[string]*domain.TokenMetadata),
+	}
+}
+
+// Start loads the registry and reloads it in background until the context is cancelled.
+func (r *Registry) Start(ctx context.Context) error {
+	if err := r.Refresh(ctx); err != nil {
+		return err
+	}
+
+	go func() {
+		ticker := time.NewTicker(r.refreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.Refresh(ctx); err != nil {
+					r.logger.Error("failed to refresh token registry", zap.Error(err))
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Refresh reloads the tokens of the registry from the database.
+func (r *Registry) Refresh(ctx context.Context) error {
+	docs, err := r.repository.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+	r.load(docs)
+	r.logger.Debug("token registry refreshed", zap.Int("tokens", len(docs)))
+	return nil
+}
+
+func (r *Registry) load(docs []TokenDoc) {
+	tokens := make([]domain.TokenMetadata, 0, len(docs))
+	for _, doc := range docs {
+		tokens = append(tokens, domain.TokenMetadata{
+			TokenChain:   doc.TokenChain,
+			TokenAddress: doc.TokenAddress,
+			Symbol:       domain.Symbol(doc.Symbol),
+			CoingeckoID:  doc.CoingeckoID,
+			Decimals:     int64(doc.Decimals),
+		})
+	}
+
+	byContractID := make(map[string]*domain.TokenMetadata, len(tokens))
+	byCoingeckoID := make(map[string]*domain.TokenMetadata)
+	for i := range tokens {
+		byContractID[contractID(tokens[i].TokenChain, tokens[i].TokenAddress)] = &tokens[i]
+		if tokens[i].CoingeckoID != "" {
+			byCoingeckoID[tokens[i].CoingeckoID] = &tokens[i]
+		}
+	}
+	// wrapped assets resolve to the metadata of the origin token.
+	for i, doc := range docs {
+		for _, wrapped := range doc.WrappedAssets {
+			key := contractID(wrapped.Chain, wrapped.Address)
+			if _, ok := byContractID[key]; !ok {
+				byContractID[key] = &tokens[i]
+			}
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tokens = tokens
+	r.byContractID = byContractID
+	r.byCoingeckoID = byCoingeckoID
+}
+
+// GetTokenByAddress returns a token by its origin address, or by the address of one of its wrapped assets.
+func (r *Registry) GetTokenByAddress(tokenChain sdk.ChainID, tokenAddress string) (*domain.TokenMetadata, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	token, ok := r.byContractID[contractID(tokenChain, tokenAddress)]
+	return token, ok
+}
+
+// GetTokenByCoingeckoID returns a token by the coingecko ID set by operators.
+func (r *Registry) GetTokenByCoingeckoID(coingeckoID string) (*domain.TokenMetadata, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	token, ok := r.byCoingeckoID[coingeckoID]
+	return token, ok
+}
+
+// GetAllTokens returns all the tokens of the registry.
+//
+// The caller must not modify the `[]TokenMetadata` returned.
+func (r *Registry) GetAllTokens() []domain.TokenMetadata {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.tokens
+}
+
+func contractID(tokenChain sdk.ChainID, tokenAddress string) string {
+	return fmt.Sprintf("%d-%s", tokenChain, tokenAddress)
+}
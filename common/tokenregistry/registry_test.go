@@ -0,0 +1,72 @@
+package tokenregistry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const (
+	testTokenAddress   = "000000000000000000000000aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	testWrappedAddress = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+)
+
+func newTestRegistry() *Registry {
+	r := NewRegistry(nil, 0, zap.NewNop())
+	r.load([]TokenDoc{
+		{
+			ID:            TokenID(sdk.ChainIDEthereum, testTokenAddress),
+			TokenChain:    sdk.ChainIDEthereum,
+			TokenAddress:  testTokenAddress,
+			Symbol:        "NEW",
+			Name:          "New Token",
+			Decimals:      18,
+			CoingeckoID:   "new-token",
+			WrappedAssets: []WrappedAsset{{Chain: sdk.ChainIDSolana, Address: testWrappedAddress}},
+		},
+	})
+	return r
+}
+
+func TestRegistry_GetTokenByAddress(t *testing.T) {
+	r := newTestRegistry()
+
+	token, ok := r.GetTokenByAddress(sdk.ChainIDEthereum, testTokenAddress)
+	assert.True(t, ok)
+	assert.Equal(t, domain.Symbol("NEW"), token.Symbol)
+	assert.Equal(t, int64(18), token.Decimals)
+
+	// wrapped assets resolve to the origin token.
+	wrapped, ok := r.GetTokenByAddress(sdk.ChainIDSolana, testWrappedAddress)
+	assert.True(t, ok)
+	assert.Equal(t, sdk.ChainIDEthereum, wrapped.TokenChain)
+
+	_, ok = r.GetTokenByAddress(sdk.ChainIDBSC, testTokenAddress)
+	assert.False(t, ok)
+}
+
+func TestRegistry_GetTokenByCoingeckoID(t *testing.T) {
+	r := newTestRegistry()
+
+	token, ok := r.GetTokenByCoingeckoID("new-token")
+	assert.True(t, ok)
+	assert.Equal(t, testTokenAddress, token.TokenAddress)
+}
+
+func TestTokenProvider_WithTokenRegistry(t *testing.T) {
+	p := domain.NewTokenProvider(domain.P2pMainNet, domain.WithTokenRegistry(newTestRegistry()))
+
+	// static tokens take precedence over the registry.
+	static, ok := p.GetTokenByCoingeckoID("wormhole")
+	assert.True(t, ok)
+	assert.Equal(t, domain.Symbol("W"), static.Symbol)
+
+	token, ok := p.GetTokenByAddress(sdk.ChainIDEthereum, testTokenAddress)
+	assert.True(t, ok)
+	assert.Equal(t, "new-token", token.CoingeckoID)
+	assert.Contains(t, p.GetAllCoingeckoIDs(), "new-token")
+	assert.Equal(t, len(domain.NewTokenProvider(domain.P2pMainNet).GetAllTokens())+1, len(p.GetAllTokens()))
+}
@@ -0,0 +1,169 @@
+package tokenregistry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	commonRepo "github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"github.com/wormhole-foundation/wormhole-explorer/common/vaaparser"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// ErrTokenNotFound is returned when a token is not part of the registry.
+var ErrTokenNotFound = errors.New("token not found")
+
+// Attestation contains information about the VAA that attested a token.
+type Attestation struct {
+	VaaID        string      `bson:"vaaId" json:"vaaId"`
+	EmitterChain sdk.ChainID `bson:"emitterChain" json:"emitterChain"`
+	Timestamp    time.Time   `bson:"timestamp" json:"timestamp"`
+}
+
+// WrappedAsset is the address of the wrapped representation of a token on a foreign chain.
+type WrappedAsset struct {
+	Chain   sdk.ChainID `bson:"chain" json:"chain"`
+	Address string      `bson:"address" json:"address"`
+}
+
+// TokenDoc is a token of the registry.
+//
+// Symbol, name and decimals come from the last Token Bridge attestation of the token.
+// The coingecko ID and the wrapped assets are maintained by operators.
+type TokenDoc struct {
+	ID            string         `bson:"_id" json:"id"`
+	TokenChain    sdk.ChainID    `bson:"tokenChain" json:"tokenChain"`
+	TokenAddress  string         `bson:"tokenAddress" json:"tokenAddress"`
+	Symbol        string         `bson:"symbol" json:"symbol"`
+	Name          string         `bson:"name" json:"name"`
+	Decimals      uint8          `bson:"decimals" json:"decimals"`
+	CoingeckoID   string         `bson:"coingeckoId,omitempty" json:"coingeckoId,omitempty"`
+	Attestation   *Attestation   `bson:"attestation,omitempty" json:"attestation,omitempty"`
+	WrappedAssets []WrappedAsset `bson:"wrappedAssets,omitempty" json:"wrappedAssets,omitempty"`
+	CreatedAt     time.Time      `bson:"createdAt" json:"createdAt"`
+	UpdatedAt     time.Time      `bson:"updatedAt" json:"updatedAt"`
+}
+
+// TokenID returns the identifier of a token in the registry.
+func TokenID(tokenChain sdk.ChainID, tokenAddress string) string {
+	return fmt.Sprintf("%d/%s", tokenChain, tokenAddress)
+}
+
+// Repository stores the tokens of the registry.
+type Repository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewRepository creates a new token registry repository.
+func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
+	return &Repository{
+		collection: db.Collection(commonRepo.TokenRegistry),
+		logger:     logger.With(zap.String("module", "TokenRegistryRepository")),
+	}
+}
+
+// UpsertAttestation records the metadata of a token attested by the Token Bridge.
+//
+// Tokens can be attested more than once (e.g.: to update their name), so the
+// metadata is only replaced by attestations that are newer than the stored one.
+func (r *Repository) UpsertAttestation(ctx context.Context, vaa *sdk.VAA, attestation *vaaparser.TokenBridgeAttestation) error {
+	id := TokenID(attestation.TokenChain, attestation.TokenAddress)
+	now := time.Now()
+
+	filter := bson.M{
+		"_id": id,
+		"$or": bson.A{
+			bson.M{"attestation": bson.M{"$exists": false}},
+			bson.M{"attestation.timestamp": bson.M{"$lte": vaa.Timestamp}},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"tokenChain":   attestation.TokenChain,
+			"tokenAddress": attestation.TokenAddress,
+			"symbol":       attestation.Symbol,
+			"name":         attestation.Name,
+			"decimals":     attestation.Decimals,
+			"attestation": Attestation{
+				VaaID:        vaa.MessageID(),
+				EmitterChain: vaa.EmitterChain,
+				Timestamp:    vaa.Timestamp,
+			},
+			"updatedAt": now,
+		},
+		"$setOnInsert": bson.M{"createdAt": now},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	// the upsert conflicts with the existing document when it holds a newer attestation.
+	if mongo.IsDuplicateKeyError(err) {
+		r.logger.Debug("token already has a newer attestation", zap.String("id", id), zap.String("vaaId", vaa.MessageID()))
+		return nil
+	}
+	return err
+}
+
+// SetCoingeckoID sets the coingecko ID of a token of the registry.
+func (r *Repository) SetCoingeckoID(ctx context.Context, tokenChain sdk.ChainID, tokenAddress, coingeckoID string) (*TokenDoc, error) {
+	update := bson.M{
+		"$set": bson.M{
+			"coingeckoId": coingeckoID,
+			"updatedAt":   time.Now(),
+		},
+	}
+	return r.findOneAndUpdate(ctx, tokenChain, tokenAddress, update)
+}
+
+// AddWrappedAsset adds the address of a wrapped representation of a token of the registry.
+func (r *Repository) AddWrappedAsset(ctx context.Context, tokenChain sdk.ChainID, tokenAddress string, wrapped WrappedAsset) (*TokenDoc, error) {
+	update := bson.M{
+		"$addToSet": bson.M{"wrappedAssets": wrapped},
+		"$set":      bson.M{"updatedAt": time.Now()},
+	}
+	return r.findOneAndUpdate(ctx, tokenChain, tokenAddress, update)
+}
+
+func (r *Repository) findOneAndUpdate(ctx context.Context, tokenChain sdk.ChainID, tokenAddress string, update bson.M) (*TokenDoc, error) {
+	var token TokenDoc
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": TokenID(tokenChain, tokenAddress)}, update, opts).Decode(&token)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// FindByAddress returns a token of the registry by its origin chain and address.
+func (r *Repository) FindByAddress(ctx context.Context, tokenChain sdk.ChainID, tokenAddress string) (*TokenDoc, error) {
+	var token TokenDoc
+	err := r.collection.FindOne(ctx, bson.M{"_id": TokenID(tokenChain, tokenAddress)}).Decode(&token)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// FindAll returns all the tokens of the registry.
+func (r *Repository) FindAll(ctx context.Context) ([]TokenDoc, error) {
+	cur, err := r.collection.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	var tokens []TokenDoc
+	if err := cur.All(ctx, &tokens); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
@@ -0,0 +1,90 @@
+package vaaparser
+
+import (
+	"fmt"
+
+	vaaPayloadParser "github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// cctpDeposit is the payload type of a Wormhole CCTP integration deposit.
+const cctpDeposit = 1
+
+// cctpDomains maps Circle CCTP domains to Wormhole chain IDs.
+var cctpDomains = map[uint32]sdk.ChainID{
+	0: sdk.ChainIDEthereum,
+	1: sdk.ChainIDAvalanche,
+	2: sdk.ChainIDOptimism,
+	3: sdk.ChainIDArbitrum,
+	5: sdk.ChainIDSolana,
+	6: sdk.ChainIDBase,
+	7: sdk.ChainIDPolygon,
+}
+
+// CCTPDeposit is the parsed payload of a Wormhole CCTP integration deposit.
+type CCTPDeposit struct {
+	PayloadType   uint8  `json:"payloadType" bson:"payloadType"`
+	TokenAddress  string `json:"tokenAddress" bson:"tokenAddress"`
+	Amount        string `json:"amount" bson:"amount"`
+	SourceDomain  uint32 `json:"sourceDomain" bson:"sourceDomain"`
+	TargetDomain  uint32 `json:"targetDomain" bson:"targetDomain"`
+	Nonce         uint64 `json:"nonce" bson:"nonce"`
+	BurnSource    string `json:"burnSource" bson:"burnSource"`
+	MintRecipient string `json:"mintRecipient" bson:"mintRecipient"`
+	Payload       string `json:"payload,omitempty" bson:"payload,omitempty"`
+}
+
+// parseCCTP parses a Wormhole CCTP integration payload.
+func parseCCTP(vaa *sdk.VAA) (*vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse, error) {
+	r := newReader(vaa.Payload)
+	payloadType := r.uint8()
+	if r.err == nil && payloadType != cctpDeposit {
+		return nil, fmt.Errorf("unsupported cctp payload type %d", payloadType)
+	}
+	tokenAddress := r.address()
+	amount := r.uint256()
+	sourceDomain := r.uint32()
+	targetDomain := r.uint32()
+	nonce := r.uint64()
+	burnSource := r.address()
+	mintRecipient := r.address()
+	payloadLen := r.uint16()
+	payload := r.next(int(payloadLen))
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	toChain, ok := cctpDomains[targetDomain]
+	if !ok {
+		return nil, fmt.Errorf("unknown cctp target domain %d", targetDomain)
+	}
+
+	parsedPayload := CCTPDeposit{
+		PayloadType:   payloadType,
+		TokenAddress:  tokenAddress.String(),
+		Amount:        amount.String(),
+		SourceDomain:  sourceDomain,
+		TargetDomain:  targetDomain,
+		Nonce:         nonce,
+		BurnSource:    burnSource.String(),
+		MintRecipient: mintRecipient.String(),
+		Payload:       fmt.Sprintf("%x", payload),
+	}
+
+	properties := vaaPayloadParser.StandardizedProperties{
+		AppIds:       []string{domain.AppIdCCTPWormhole},
+		FromChain:    vaa.EmitterChain,
+		FromAddress:  nativeAddress(vaa.EmitterChain, burnSource),
+		ToChain:      toChain,
+		ToAddress:    nativeAddress(toChain, mintRecipient),
+		TokenChain:   vaa.EmitterChain,
+		TokenAddress: nativeAddress(vaa.EmitterChain, tokenAddress),
+		Amount:       parsedPayload.Amount,
+	}
+
+	return &vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse{
+		ParsedPayload:          parsedPayload,
+		StandardizedProperties: properties,
+	}, nil
+}
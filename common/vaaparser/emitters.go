@@ -0,0 +1,94 @@
+package vaaparser
+
+import (
+	"strings"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// protocol identifies the payload layout used by an emitter.
+type protocol int
+
+const (
+	protocolUnknown protocol = iota
+	protocolTokenBridge
+	protocolCCTP
+	protocolGenericRelayer
+)
+
+// emitterKey identifies an emitter by chain and 32-byte hex address (without 0x prefix).
+type emitterKey struct {
+	chainID sdk.ChainID
+	address string
+}
+
+// mainnetTokenBridgeEmitters are the Token Bridge emitters on mainnet.
+var mainnetTokenBridgeEmitters = map[sdk.ChainID]string{
+	sdk.ChainIDSolana:    "ec7372995d5cc8732397fb0ad35c0121e0eaa90d26f828a534cab54391b3a4f5",
+	sdk.ChainIDEthereum:  "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585",
+	sdk.ChainIDBSC:       "000000000000000000000000b6f6d86a8f9879a9c87f643768d9efc38c1da6e7",
+	sdk.ChainIDPolygon:   "0000000000000000000000005a58505a96d1dbf8df91cb21b54419fc36e93fde",
+	sdk.ChainIDAvalanche: "0000000000000000000000000e082f06ff657d94310cb8ce8b0d9a04541d8052",
+	sdk.ChainIDOasis:     "0000000000000000000000005848c791e09901b40a9ef749f2a6735b418d7564",
+	sdk.ChainIDAlgorand:  "67e93fa6c8ac5c819990aa7340c0c16b508abb1178be9b30d024b8ac25193d45",
+	sdk.ChainIDAurora:    "00000000000000000000000051b5123a7b0f9b2ba265f9c4c8de7d78d52f510f",
+	sdk.ChainIDFantom:    "0000000000000000000000007c9fc5741288cdfdd83ceb07f3ea7e22618d79d2",
+	sdk.ChainIDKarura:    "000000000000000000000000ae9d7fe007b3327aa64a32824aaac52c42a6e624",
+	sdk.ChainIDAcala:     "000000000000000000000000ae9d7fe007b3327aa64a32824aaac52c42a6e624",
+	sdk.ChainIDKlaytn:    "0000000000000000000000005b08ac39eaed75c0439fc750d9fe7e1f9dd0193f",
+	sdk.ChainIDCelo:      "000000000000000000000000796dff6d74f3e27060b71255fe517bfb23c93eed",
+	sdk.ChainIDNear:      "148410499d3fcda4dcfd68a1ebfcdddda16ab28326448d4aae4d2f0465cdfcb7",
+	sdk.ChainIDMoonbeam:  "000000000000000000000000b1731c586ca89a23809861c6103f0b96b3f57d92",
+	sdk.ChainIDTerra2:    "a463ad028fb79679cfc8ce1efba35ac0e77b35080a1abe9bebe83461f176b0a3",
+	sdk.ChainIDInjective: "00000000000000000000000045dbea4617971d93188eda21530bc6503d153313",
+	sdk.ChainIDSui:       "ccceeb29348f71bdd22ffef43a2a19c1f5b5e17c5cca5411529120182672ade5",
+	sdk.ChainIDAptos:     "0000000000000000000000000000000000000000000000000000000000000001",
+	sdk.ChainIDArbitrum:  "0000000000000000000000000b2402144bb366a632d14b83f244d2e0e21bd39c",
+	sdk.ChainIDOptimism:  "0000000000000000000000001d68124e65fafc907325e3edbf8c4d84499daa8b",
+	sdk.ChainIDBase:      "0000000000000000000000008d2de8d2f73f1f4cab472ac9a881c9b123c79627",
+}
+
+// mainnetCCTPEmitters are the Wormhole CCTP integration emitters on mainnet.
+var mainnetCCTPEmitters = map[sdk.ChainID]string{
+	sdk.ChainIDEthereum:  "000000000000000000000000aada05bd399372f0b0463744c09113c137636f6a",
+	sdk.ChainIDAvalanche: "00000000000000000000000009fb06a271faff70a651047395aaeb6265265f13",
+	sdk.ChainIDOptimism:  "0000000000000000000000002703483b1a5a7c577e8680de9df8be03c6f30e3c",
+	sdk.ChainIDArbitrum:  "0000000000000000000000002703483b1a5a7c577e8680de9df8be03c6f30e3c",
+	sdk.ChainIDBase:      "00000000000000000000000003fabb06fa052557143dc28efcfc63fc12843f1d",
+	sdk.ChainIDPolygon:   "0000000000000000000000000ff28217dcc90372345954563486528aa865cdd6",
+}
+
+// mainnetGenericRelayerEmitters are the generic relayer emitters on mainnet.
+var mainnetGenericRelayerEmitters = map[sdk.ChainID]string{
+	sdk.ChainIDEthereum:  "00000000000000000000000027428dd2d3dd32a4d7f7c497eaaa23130d894911",
+	sdk.ChainIDBSC:       "00000000000000000000000027428dd2d3dd32a4d7f7c497eaaa23130d894911",
+	sdk.ChainIDPolygon:   "00000000000000000000000027428dd2d3dd32a4d7f7c497eaaa23130d894911",
+	sdk.ChainIDAvalanche: "00000000000000000000000027428dd2d3dd32a4d7f7c497eaaa23130d894911",
+	sdk.ChainIDFantom:    "00000000000000000000000027428dd2d3dd32a4d7f7c497eaaa23130d894911",
+	sdk.ChainIDCelo:      "00000000000000000000000027428dd2d3dd32a4d7f7c497eaaa23130d894911",
+	sdk.ChainIDMoonbeam:  "00000000000000000000000027428dd2d3dd32a4d7f7c497eaaa23130d894911",
+	sdk.ChainIDArbitrum:  "00000000000000000000000027428dd2d3dd32a4d7f7c497eaaa23130d894911",
+	sdk.ChainIDOptimism:  "00000000000000000000000027428dd2d3dd32a4d7f7c497eaaa23130d894911",
+	sdk.ChainIDBase:      "00000000000000000000000027428dd2d3dd32a4d7f7c497eaaa23130d894911",
+}
+
+// newEmitterTable returns the known emitters for the given p2p network.
+//
+// Only mainnet emitters are registered. VAAs from any other emitter are
+// delegated to the fallback parser.
+func newEmitterTable(p2pNetwork string) map[emitterKey]protocol {
+	table := make(map[emitterKey]protocol)
+	if p2pNetwork != domain.P2pMainNet {
+		return table
+	}
+	register := func(emitters map[sdk.ChainID]string, p protocol) {
+		for chainID, address := range emitters {
+			table[emitterKey{chainID: chainID, address: strings.ToLower(address)}] = p
+		}
+	}
+	register(mainnetTokenBridgeEmitters, protocolTokenBridge)
+	register(mainnetCCTPEmitters, protocolCCTP)
+	register(mainnetGenericRelayerEmitters, protocolGenericRelayer)
+	return table
+}
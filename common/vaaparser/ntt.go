@@ -0,0 +1,99 @@
+package vaaparser
+
+import (
+	"bytes"
+	"fmt"
+
+	vaaPayloadParser "github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+var (
+	// nttTransceiverPrefix is the prefix of the messages sent by the NTT wormhole transceiver.
+	nttTransceiverPrefix = []byte{0x99, 0x45, 0xFF, 0x10}
+	// nttTransferPrefix is the prefix of a NTT native token transfer ("\x99NTT").
+	nttTransferPrefix = []byte{0x99, 0x4E, 0x54, 0x54}
+)
+
+// NTTTransfer is the parsed payload of a native token transfer sent through the wormhole transceiver.
+type NTTTransfer struct {
+	SourceNttManager    string      `json:"sourceNttManager" bson:"sourceNttManager"`
+	RecipientNttManager string      `json:"recipientNttManager" bson:"recipientNttManager"`
+	MessageID           string      `json:"messageId" bson:"messageId"`
+	Sender              string      `json:"sender" bson:"sender"`
+	Decimals            uint8       `json:"decimals" bson:"decimals"`
+	Amount              string      `json:"amount" bson:"amount"`
+	SourceToken         string      `json:"sourceToken" bson:"sourceToken"`
+	To                  string      `json:"to" bson:"to"`
+	ToChain             sdk.ChainID `json:"toChain" bson:"toChain"`
+}
+
+// isNTT returns true if the payload was emitted by an NTT wormhole transceiver.
+func isNTT(payload []byte) bool {
+	return bytes.HasPrefix(payload, nttTransceiverPrefix)
+}
+
+// parseNTT parses a NTT wormhole transceiver message carrying a native token transfer.
+func parseNTT(vaa *sdk.VAA) (*vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse, error) {
+	r := newReader(vaa.Payload)
+	r.next(len(nttTransceiverPrefix))
+	sourceManager := r.address()
+	recipientManager := r.address()
+	managerPayload := r.next(int(r.uint16()))
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	// decode the NTT manager message.
+	m := newReader(managerPayload)
+	messageID := m.next(32)
+	sender := m.address()
+	transferPayload := m.next(int(m.uint16()))
+	if m.err != nil {
+		return nil, m.err
+	}
+	if !bytes.HasPrefix(transferPayload, nttTransferPrefix) {
+		return nil, fmt.Errorf("unsupported ntt manager payload")
+	}
+
+	// decode the native token transfer.
+	t := newReader(transferPayload)
+	t.next(len(nttTransferPrefix))
+	decimals := t.uint8()
+	amount := t.uint64()
+	sourceToken := t.address()
+	to := t.address()
+	toChain := t.chainID()
+	if t.err != nil {
+		return nil, t.err
+	}
+
+	payload := NTTTransfer{
+		SourceNttManager:    sourceManager.String(),
+		RecipientNttManager: recipientManager.String(),
+		MessageID:           fmt.Sprintf("%x", messageID),
+		Sender:              sender.String(),
+		Decimals:            decimals,
+		Amount:              fmt.Sprintf("%d", amount),
+		SourceToken:         sourceToken.String(),
+		To:                  to.String(),
+		ToChain:             toChain,
+	}
+
+	properties := vaaPayloadParser.StandardizedProperties{
+		AppIds:       []string{domain.AppIdNTT},
+		FromChain:    vaa.EmitterChain,
+		FromAddress:  nativeAddress(vaa.EmitterChain, sender),
+		ToChain:      toChain,
+		ToAddress:    nativeAddress(toChain, to),
+		TokenChain:   vaa.EmitterChain,
+		TokenAddress: nativeAddress(vaa.EmitterChain, sourceToken),
+		Amount:       payload.Amount,
+	}
+
+	return &vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse{
+		ParsedPayload:          payload,
+		StandardizedProperties: properties,
+	}, nil
+}
@@ -0,0 +1,101 @@
+// Package vaaparser implements an in-process VAA payload parser.
+//
+// It decodes the payloads of the most common protocols (Token Bridge, Wormhole CCTP
+// integration, NTT and the generic relayer) and produces the same response as the
+// vaa-payload-parser service. VAAs from any other protocol are delegated to a
+// fallback parser, usually the vaa-payload-parser HTTP client.
+package vaaparser
+
+import (
+	"errors"
+	"fmt"
+
+	vaaPayloadParser "github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// Parser parses VAA payloads in-process.
+type Parser struct {
+	emitters map[emitterKey]protocol
+	fallback vaaPayloadParser.VaaParser
+	logger   *zap.Logger
+}
+
+// New creates a new in-process parser for the given p2p network.
+//
+// The fallback parser is optional; when it is nil, VAAs from unknown
+// protocols return vaaPayloadParser.ErrNotFound.
+func New(p2pNetwork string, fallback vaaPayloadParser.VaaParser, logger *zap.Logger) *Parser {
+	return &Parser{
+		emitters: newEmitterTable(p2pNetwork),
+		fallback: fallback,
+		logger:   logger,
+	}
+}
+
+// ParseVaaWithStandarizedProperties parses the payload of a VAA and builds its standardized properties.
+func (p *Parser) ParseVaaWithStandarizedProperties(vaa *sdk.VAA) (*vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse, error) {
+	response, err := p.parse(vaa)
+	if errors.Is(err, vaaPayloadParser.ErrNotFound) && p.fallback != nil {
+		return p.fallback.ParseVaaWithStandarizedProperties(vaa)
+	}
+	return response, err
+}
+
+// ParseVaa parses the payload of a VAA.
+func (p *Parser) ParseVaa(vaa *sdk.VAA) (any, error) {
+	response, err := p.parse(vaa)
+	if errors.Is(err, vaaPayloadParser.ErrNotFound) && p.fallback != nil {
+		return p.fallback.ParseVaa(vaa)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// parse dispatches the VAA to the protocol parser of its emitter.
+//
+// It returns vaaPayloadParser.ErrNotFound when the protocol is not supported, and
+// vaaPayloadParser.ErrUnprocessableEntity when the payload does not match the protocol layout.
+func (p *Parser) parse(vaa *sdk.VAA) (*vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse, error) {
+	var parseFunc func(*sdk.VAA) (*vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse, error)
+
+	key := emitterKey{chainID: vaa.EmitterChain, address: vaa.EmitterAddress.String()}
+	switch p.emitters[key] {
+	case protocolTokenBridge:
+		parseFunc = parseTokenBridge
+	case protocolCCTP:
+		parseFunc = parseCCTP
+	case protocolGenericRelayer:
+		parseFunc = parseGenericRelayer
+	default:
+		// NTT transceivers are deployed per token, so they are identified by the payload prefix.
+		if !isNTT(vaa.Payload) {
+			return nil, vaaPayloadParser.ErrNotFound
+		}
+		parseFunc = parseNTT
+	}
+
+	response, err := parseFunc(vaa)
+	if err != nil {
+		p.logger.Debug("failed to parse vaa payload",
+			zap.String("vaaId", vaa.MessageID()),
+			zap.Error(err))
+		return nil, fmt.Errorf("%w: %v", vaaPayloadParser.ErrUnprocessableEntity, err)
+	}
+	return response, nil
+}
+
+// nativeAddress converts a 32-byte wormhole address into the native address format of the chain.
+//
+// When the chain format is unknown, the hex representation is returned.
+func nativeAddress(chainID sdk.ChainID, addr sdk.Address) string {
+	native, err := domain.TranslateEmitterAddress(chainID, addr.String())
+	if err != nil {
+		return "0x" + addr.String()
+	}
+	return native
+}
@@ -0,0 +1,185 @@
+package vaaparser
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vaaPayloadParser "github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// fallbackParser is a VaaParser that records whether it was called.
+type fallbackParser struct {
+	called bool
+}
+
+func (f *fallbackParser) ParseVaaWithStandarizedProperties(vaa *sdk.VAA) (*vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse, error) {
+	f.called = true
+	return &vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse{}, nil
+}
+
+func (f *fallbackParser) ParseVaa(vaa *sdk.VAA) (any, error) {
+	f.called = true
+	return nil, nil
+}
+
+func mustAddress(t *testing.T, s string) sdk.Address {
+	addr, err := sdk.StringToAddress(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return addr
+}
+
+func u16(v uint16) []byte {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, v)
+	return b
+}
+
+func u64(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
+
+func u256(v int64) []byte {
+	return big.NewInt(v).FillBytes(make([]byte, 32))
+}
+
+func concat(parts ...[]byte) []byte {
+	var out []byte
+	for _, p := range parts {
+		out = append(out, p...)
+	}
+	return out
+}
+
+func TestParseTokenBridgeTransfer(t *testing.T) {
+	tokenAddress := mustAddress(t, "000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48")
+	toAddress := mustAddress(t, "0000000000000000000000000ff664edd699bd85610c2782d9dbbbad704b6fc5")
+
+	vaa := &sdk.VAA{
+		EmitterChain:   sdk.ChainIDEthereum,
+		EmitterAddress: mustAddress(t, mainnetTokenBridgeEmitters[sdk.ChainIDEthereum]),
+		Sequence:       1,
+		Payload: concat([]byte{tokenBridgeTransfer}, u256(10000000), tokenAddress[:],
+			u16(uint16(sdk.ChainIDEthereum)), toAddress[:], u16(uint16(sdk.ChainIDBSC)), u256(0)),
+	}
+
+	p := New(domain.P2pMainNet, nil, zap.NewNop())
+	result, err := p.ParseVaaWithStandarizedProperties(vaa)
+	assert.NoError(t, err)
+
+	payload, ok := result.ParsedPayload.(TokenBridgeTransfer)
+	assert.True(t, ok)
+	assert.Equal(t, uint8(tokenBridgeTransfer), payload.PayloadType)
+	assert.Equal(t, "10000000", payload.Amount)
+	assert.Equal(t, tokenAddress.String(), payload.TokenAddress)
+	assert.Equal(t, sdk.ChainIDBSC, payload.ToChain)
+
+	sp := result.StandardizedProperties
+	assert.Equal(t, []string{domain.AppIdPortalTokenBridge}, sp.AppIds)
+	assert.Equal(t, sdk.ChainIDEthereum, sp.FromChain)
+	assert.Equal(t, sdk.ChainIDBSC, sp.ToChain)
+	assert.Equal(t, "0x0ff664edd699bd85610c2782d9dbbbad704b6fc5", sp.ToAddress)
+	assert.Equal(t, "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", sp.TokenAddress)
+	assert.Equal(t, "10000000", sp.Amount)
+	assert.Equal(t, "", sp.Fee)
+}
+
+func TestParseTokenBridgeAttestation(t *testing.T) {
+	tokenAddress := mustAddress(t, "000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48")
+	symbol := make([]byte, 32)
+	copy(symbol, "USDC")
+	name := make([]byte, 32)
+	copy(name, "USD Coin")
+
+	vaa := &sdk.VAA{
+		EmitterChain:   sdk.ChainIDEthereum,
+		EmitterAddress: mustAddress(t, mainnetTokenBridgeEmitters[sdk.ChainIDEthereum]),
+		Payload: concat([]byte{tokenBridgeAttestMeta}, tokenAddress[:],
+			u16(uint16(sdk.ChainIDEthereum)), []byte{6}, symbol, name),
+	}
+
+	p := New(domain.P2pMainNet, nil, zap.NewNop())
+	result, err := p.ParseVaaWithStandarizedProperties(vaa)
+	assert.NoError(t, err)
+
+	payload, ok := result.ParsedPayload.(TokenBridgeAttestation)
+	assert.True(t, ok)
+	assert.Equal(t, uint8(6), payload.Decimals)
+	assert.Equal(t, "USDC", payload.Symbol)
+	assert.Equal(t, "USD Coin", payload.Name)
+	assert.Equal(t, "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", result.StandardizedProperties.TokenAddress)
+}
+
+func TestParseNTT(t *testing.T) {
+	manager := mustAddress(t, "000000000000000000000000eb502b1d35e975321b21cce0e8890d20a7eb289d")
+	sender := mustAddress(t, "0000000000000000000000000ff664edd699bd85610c2782d9dbbbad704b6fc5")
+	token := mustAddress(t, "000000000000000000000000b0ffa8000886e57f86dd5264b9582b2ad87b2b91")
+	recipient := mustAddress(t, "0000000000000000000000001111111111111111111111111111111111111111")
+
+	transfer := concat(nttTransferPrefix, []byte{8}, u64(150000000), token[:], recipient[:], u16(uint16(sdk.ChainIDArbitrum)))
+	managerMessage := concat(make([]byte, 32), sender[:], u16(uint16(len(transfer))), transfer)
+	payload := concat(nttTransceiverPrefix, manager[:], manager[:], u16(uint16(len(managerMessage))), managerMessage, u16(0))
+
+	vaa := &sdk.VAA{
+		EmitterChain:   sdk.ChainIDEthereum,
+		EmitterAddress: mustAddress(t, "000000000000000000000000db55492d7190d1baE8ACbE03911C4E3E7426870c"),
+		Payload:        payload,
+	}
+
+	p := New(domain.P2pMainNet, nil, zap.NewNop())
+	result, err := p.ParseVaaWithStandarizedProperties(vaa)
+	assert.NoError(t, err)
+
+	sp := result.StandardizedProperties
+	assert.Equal(t, []string{domain.AppIdNTT}, sp.AppIds)
+	assert.Equal(t, sdk.ChainIDArbitrum, sp.ToChain)
+	assert.Equal(t, "0x1111111111111111111111111111111111111111", sp.ToAddress)
+	assert.Equal(t, "0xb0ffa8000886e57f86dd5264b9582b2ad87b2b91", sp.TokenAddress)
+	assert.Equal(t, "0x0ff664edd699bd85610c2782d9dbbbad704b6fc5", sp.FromAddress)
+	assert.Equal(t, "150000000", sp.Amount)
+}
+
+func TestParseMalformedPayload(t *testing.T) {
+	vaa := &sdk.VAA{
+		EmitterChain:   sdk.ChainIDEthereum,
+		EmitterAddress: mustAddress(t, mainnetTokenBridgeEmitters[sdk.ChainIDEthereum]),
+		Payload:        []byte{tokenBridgeTransfer, 0x01, 0x02},
+	}
+
+	fallback := &fallbackParser{}
+	p := New(domain.P2pMainNet, fallback, zap.NewNop())
+	_, err := p.ParseVaaWithStandarizedProperties(vaa)
+	assert.True(t, errors.Is(err, vaaPayloadParser.ErrUnprocessableEntity))
+	assert.False(t, fallback.called)
+}
+
+func TestParseUnknownEmitter(t *testing.T) {
+	payload, _ := hex.DecodeString("0102030405")
+	vaa := &sdk.VAA{
+		EmitterChain:   sdk.ChainIDEthereum,
+		EmitterAddress: mustAddress(t, "000000000000000000000000f890982f9310df57d00f659cf4fd87e65aded8d7"),
+		Payload:        payload,
+	}
+
+	// without fallback, the vaa is not found.
+	p := New(domain.P2pMainNet, nil, zap.NewNop())
+	_, err := p.ParseVaaWithStandarizedProperties(vaa)
+	assert.True(t, errors.Is(err, vaaPayloadParser.ErrNotFound))
+
+	// with fallback, the vaa is delegated.
+	fallback := &fallbackParser{}
+	p = New(domain.P2pMainNet, fallback, zap.NewNop())
+	_, err = p.ParseVaaWithStandarizedProperties(vaa)
+	assert.NoError(t, err)
+	assert.True(t, fallback.called)
+}
@@ -0,0 +1,100 @@
+package vaaparser
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// errShortPayload is returned when the payload is shorter than the layout being decoded.
+var errShortPayload = errors.New("payload too short")
+
+// reader decodes big-endian fields from a VAA payload.
+//
+// The first decoding error is kept and all subsequent reads are no-ops,
+// so callers only need to check the error once at the end.
+type reader struct {
+	buf []byte
+	off int
+	err error
+}
+
+func newReader(buf []byte) *reader {
+	return &reader{buf: buf}
+}
+
+func (r *reader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || r.off+n > len(r.buf) {
+		r.err = errShortPayload
+		return nil
+	}
+	b := r.buf[r.off : r.off+n]
+	r.off += n
+	return b
+}
+
+func (r *reader) uint8() uint8 {
+	b := r.next(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (r *reader) uint16() uint16 {
+	b := r.next(2)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint16(b)
+}
+
+func (r *reader) uint32() uint32 {
+	b := r.next(4)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(b)
+}
+
+func (r *reader) uint64() uint64 {
+	b := r.next(8)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+func (r *reader) uint256() *big.Int {
+	b := r.next(32)
+	if b == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).SetBytes(b)
+}
+
+func (r *reader) address() sdk.Address {
+	var addr sdk.Address
+	b := r.next(32)
+	if b != nil {
+		copy(addr[:], b)
+	}
+	return addr
+}
+
+func (r *reader) chainID() sdk.ChainID {
+	return sdk.ChainID(r.uint16())
+}
+
+// rest returns all the bytes that have not been read yet.
+func (r *reader) rest() []byte {
+	if r.err != nil {
+		return nil
+	}
+	return r.next(len(r.buf) - r.off)
+}
@@ -0,0 +1,184 @@
+package vaaparser
+
+import (
+	"fmt"
+
+	vaaPayloadParser "github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// Generic relayer payload types.
+const (
+	relayerDeliveryInstruction   = 1
+	relayerRedeliveryInstruction = 2
+)
+
+// vaaKeyType is the message key type of a VAA key.
+const vaaKeyType = 1
+
+// RelayerMessageKey is an additional message that must be delivered along with a delivery instruction.
+type RelayerMessageKey struct {
+	KeyType        uint8       `json:"keyType" bson:"keyType"`
+	ChainID        sdk.ChainID `json:"chainId,omitempty" bson:"chainId,omitempty"`
+	EmitterAddress string      `json:"emitterAddress,omitempty" bson:"emitterAddress,omitempty"`
+	Sequence       uint64      `json:"sequence,omitempty" bson:"sequence,omitempty"`
+	Key            string      `json:"key,omitempty" bson:"key,omitempty"`
+}
+
+// RelayerDeliveryInstruction is the parsed payload of a generic relayer delivery instruction.
+type RelayerDeliveryInstruction struct {
+	PayloadType            uint8               `json:"payloadType" bson:"payloadType"`
+	TargetChainID          sdk.ChainID         `json:"targetChainId" bson:"targetChainId"`
+	TargetAddress          string              `json:"targetAddress" bson:"targetAddress"`
+	Payload                string              `json:"payload" bson:"payload"`
+	RequestedReceiverValue string              `json:"requestedReceiverValue" bson:"requestedReceiverValue"`
+	ExtraReceiverValue     string              `json:"extraReceiverValue" bson:"extraReceiverValue"`
+	EncodedExecutionInfo   string              `json:"encodedExecutionInfo" bson:"encodedExecutionInfo"`
+	RefundChainID          sdk.ChainID         `json:"refundChainId" bson:"refundChainId"`
+	RefundAddress          string              `json:"refundAddress" bson:"refundAddress"`
+	RefundDeliveryProvider string              `json:"refundDeliveryProvider" bson:"refundDeliveryProvider"`
+	SourceDeliveryProvider string              `json:"sourceDeliveryProvider" bson:"sourceDeliveryProvider"`
+	SenderAddress          string              `json:"senderAddress" bson:"senderAddress"`
+	MessageKeys            []RelayerMessageKey `json:"messageKeys" bson:"messageKeys"`
+}
+
+// RelayerRedeliveryInstruction is the parsed payload of a generic relayer redelivery instruction.
+type RelayerRedeliveryInstruction struct {
+	PayloadType               uint8             `json:"payloadType" bson:"payloadType"`
+	DeliveryVaaKey            RelayerMessageKey `json:"deliveryVaaKey" bson:"deliveryVaaKey"`
+	TargetChainID             sdk.ChainID       `json:"targetChainId" bson:"targetChainId"`
+	NewRequestedReceiverValue string            `json:"newRequestedReceiverValue" bson:"newRequestedReceiverValue"`
+	NewEncodedExecutionInfo   string            `json:"newEncodedExecutionInfo" bson:"newEncodedExecutionInfo"`
+	NewSourceDeliveryProvider string            `json:"newSourceDeliveryProvider" bson:"newSourceDeliveryProvider"`
+	NewSenderAddress          string            `json:"newSenderAddress" bson:"newSenderAddress"`
+}
+
+// parseGenericRelayer parses a generic relayer payload.
+func parseGenericRelayer(vaa *sdk.VAA) (*vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse, error) {
+	if len(vaa.Payload) == 0 {
+		return nil, errShortPayload
+	}
+
+	switch vaa.Payload[0] {
+	case relayerDeliveryInstruction:
+		return parseDeliveryInstruction(vaa)
+	case relayerRedeliveryInstruction:
+		return parseRedeliveryInstruction(vaa)
+	default:
+		return nil, fmt.Errorf("unsupported generic relayer payload type %d", vaa.Payload[0])
+	}
+}
+
+func parseDeliveryInstruction(vaa *sdk.VAA) (*vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse, error) {
+	r := newReader(vaa.Payload)
+	payloadType := r.uint8()
+	targetChain := r.chainID()
+	targetAddress := r.address()
+	payload := r.next(int(r.uint32()))
+	requestedReceiverValue := r.uint256()
+	extraReceiverValue := r.uint256()
+	executionInfo := r.next(int(r.uint32()))
+	refundChain := r.chainID()
+	refundAddress := r.address()
+	refundDeliveryProvider := r.address()
+	sourceDeliveryProvider := r.address()
+	sender := r.address()
+	keysLen := int(r.uint8())
+	var keys []RelayerMessageKey
+	for i := 0; i < keysLen && r.err == nil; i++ {
+		keys = append(keys, readMessageKey(r))
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	parsedPayload := RelayerDeliveryInstruction{
+		PayloadType:            payloadType,
+		TargetChainID:          targetChain,
+		TargetAddress:          targetAddress.String(),
+		Payload:                fmt.Sprintf("%x", payload),
+		RequestedReceiverValue: requestedReceiverValue.String(),
+		ExtraReceiverValue:     extraReceiverValue.String(),
+		EncodedExecutionInfo:   fmt.Sprintf("%x", executionInfo),
+		RefundChainID:          refundChain,
+		RefundAddress:          refundAddress.String(),
+		RefundDeliveryProvider: refundDeliveryProvider.String(),
+		SourceDeliveryProvider: sourceDeliveryProvider.String(),
+		SenderAddress:          sender.String(),
+		MessageKeys:            keys,
+	}
+
+	properties := vaaPayloadParser.StandardizedProperties{
+		AppIds:      []string{domain.AppIdGenericRelayer},
+		FromChain:   vaa.EmitterChain,
+		FromAddress: nativeAddress(vaa.EmitterChain, sender),
+		ToChain:     targetChain,
+		ToAddress:   nativeAddress(targetChain, targetAddress),
+	}
+
+	return &vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse{
+		ParsedPayload:          parsedPayload,
+		StandardizedProperties: properties,
+	}, nil
+}
+
+func parseRedeliveryInstruction(vaa *sdk.VAA) (*vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse, error) {
+	r := newReader(vaa.Payload)
+	payloadType := r.uint8()
+	deliveryVaaKey := readVaaKey(r)
+	targetChain := r.chainID()
+	newRequestedReceiverValue := r.uint256()
+	newExecutionInfo := r.next(int(r.uint32()))
+	newSourceDeliveryProvider := r.address()
+	newSender := r.address()
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	parsedPayload := RelayerRedeliveryInstruction{
+		PayloadType:               payloadType,
+		DeliveryVaaKey:            deliveryVaaKey,
+		TargetChainID:             targetChain,
+		NewRequestedReceiverValue: newRequestedReceiverValue.String(),
+		NewEncodedExecutionInfo:   fmt.Sprintf("%x", newExecutionInfo),
+		NewSourceDeliveryProvider: newSourceDeliveryProvider.String(),
+		NewSenderAddress:          newSender.String(),
+	}
+
+	properties := vaaPayloadParser.StandardizedProperties{
+		AppIds:      []string{domain.AppIdGenericRelayer},
+		FromChain:   vaa.EmitterChain,
+		FromAddress: nativeAddress(vaa.EmitterChain, newSender),
+		ToChain:     targetChain,
+	}
+
+	return &vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse{
+		ParsedPayload:          parsedPayload,
+		StandardizedProperties: properties,
+	}, nil
+}
+
+// readMessageKey decodes a message key: VAA keys are encoded inline, any other
+// key type is encoded as length-prefixed bytes.
+func readMessageKey(r *reader) RelayerMessageKey {
+	keyType := r.uint8()
+	if keyType == vaaKeyType {
+		return readVaaKey(r)
+	}
+	key := r.next(int(r.uint32()))
+	return RelayerMessageKey{KeyType: keyType, Key: fmt.Sprintf("%x", key)}
+}
+
+// readVaaKey decodes the (chain, emitter, sequence) triple that identifies a VAA.
+func readVaaKey(r *reader) RelayerMessageKey {
+	chainID := r.chainID()
+	emitter := r.address()
+	sequence := r.uint64()
+	return RelayerMessageKey{
+		KeyType:        vaaKeyType,
+		ChainID:        chainID,
+		EmitterAddress: emitter.String(),
+		Sequence:       sequence,
+	}
+}
@@ -0,0 +1,174 @@
+package vaaparser
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	vaaPayloadParser "github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// Token Bridge payload types.
+const (
+	tokenBridgeTransfer            = 1
+	tokenBridgeAttestMeta          = 2
+	tokenBridgeTransferWithPayload = 3
+)
+
+// TokenBridgeTransfer is the parsed payload of a Token Bridge transfer (payload types 1 and 3).
+type TokenBridgeTransfer struct {
+	PayloadType  uint8       `json:"payloadType" bson:"payloadType"`
+	Amount       string      `json:"amount" bson:"amount"`
+	TokenAddress string      `json:"tokenAddress" bson:"tokenAddress"`
+	TokenChain   sdk.ChainID `json:"tokenChain" bson:"tokenChain"`
+	ToAddress    string      `json:"toAddress" bson:"toAddress"`
+	ToChain      sdk.ChainID `json:"toChain" bson:"toChain"`
+	Fee          string      `json:"fee,omitempty" bson:"fee,omitempty"`
+	FromAddress  string      `json:"fromAddress,omitempty" bson:"fromAddress,omitempty"`
+	Payload      string      `json:"payload,omitempty" bson:"payload,omitempty"`
+}
+
+// TokenBridgeAttestation is the parsed payload of a Token Bridge attestation (payload type 2).
+type TokenBridgeAttestation struct {
+	PayloadType  uint8       `json:"payloadType" bson:"payloadType"`
+	TokenAddress string      `json:"tokenAddress" bson:"tokenAddress"`
+	TokenChain   sdk.ChainID `json:"tokenChain" bson:"tokenChain"`
+	Decimals     uint8       `json:"decimals" bson:"decimals"`
+	Symbol       string      `json:"symbol" bson:"symbol"`
+	Name         string      `json:"name" bson:"name"`
+}
+
+// IsTokenBridgeAttestation reports whether a Token Bridge payload is an attestation.
+func IsTokenBridgeAttestation(data []byte) bool {
+	return len(data) > 0 && data[0] == tokenBridgeAttestMeta
+}
+
+// ParseTokenBridgeAttestation decodes a Token Bridge AttestMeta payload (payload type 2).
+//
+// It does not check the emitter of the VAA, callers must make sure the payload
+// was emitted by a Token Bridge contract.
+func ParseTokenBridgeAttestation(data []byte) (*TokenBridgeAttestation, error) {
+	attestation, _, err := decodeTokenBridgeAttestation(data)
+	return attestation, err
+}
+
+func decodeTokenBridgeAttestation(data []byte) (*TokenBridgeAttestation, sdk.Address, error) {
+	r := newReader(data)
+	payloadType := r.uint8()
+	tokenAddress := r.address()
+	tokenChain := r.chainID()
+	decimals := r.uint8()
+	symbol := r.next(32)
+	name := r.next(32)
+	if r.err != nil {
+		return nil, sdk.Address{}, r.err
+	}
+	if payloadType != tokenBridgeAttestMeta {
+		return nil, sdk.Address{}, fmt.Errorf("token bridge payload type %d is not an attestation", payloadType)
+	}
+
+	return &TokenBridgeAttestation{
+		PayloadType:  payloadType,
+		TokenAddress: tokenAddress.String(),
+		TokenChain:   tokenChain,
+		Decimals:     decimals,
+		Symbol:       fixedString(symbol),
+		Name:         fixedString(name),
+	}, tokenAddress, nil
+}
+
+// parseTokenBridge parses a Token Bridge payload.
+func parseTokenBridge(vaa *sdk.VAA) (*vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse, error) {
+	if len(vaa.Payload) == 0 {
+		return nil, errShortPayload
+	}
+
+	switch vaa.Payload[0] {
+	case tokenBridgeTransfer, tokenBridgeTransferWithPayload:
+		return parseTokenBridgeTransfer(vaa)
+	case tokenBridgeAttestMeta:
+		return parseTokenBridgeAttestation(vaa)
+	default:
+		return nil, fmt.Errorf("unsupported token bridge payload type %d", vaa.Payload[0])
+	}
+}
+
+func parseTokenBridgeTransfer(vaa *sdk.VAA) (*vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse, error) {
+	r := newReader(vaa.Payload)
+	payloadType := r.uint8()
+	amount := r.uint256()
+	tokenAddress := r.address()
+	tokenChain := r.chainID()
+	toAddress := r.address()
+	toChain := r.chainID()
+
+	payload := TokenBridgeTransfer{
+		PayloadType:  payloadType,
+		Amount:       amount.String(),
+		TokenAddress: tokenAddress.String(),
+		TokenChain:   tokenChain,
+		ToAddress:    toAddress.String(),
+		ToChain:      toChain,
+	}
+
+	// payload type 1 carries a relayer fee, payload type 3 carries the sender and an arbitrary payload.
+	var fromAddress string
+	if payloadType == tokenBridgeTransfer {
+		payload.Fee = r.uint256().String()
+	} else {
+		from := r.address()
+		payload.FromAddress = from.String()
+		payload.Payload = fmt.Sprintf("%x", r.rest())
+		fromAddress = nativeAddress(vaa.EmitterChain, from)
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	properties := vaaPayloadParser.StandardizedProperties{
+		AppIds:       []string{domain.AppIdPortalTokenBridge},
+		FromChain:    vaa.EmitterChain,
+		FromAddress:  fromAddress,
+		ToChain:      toChain,
+		ToAddress:    nativeAddress(toChain, toAddress),
+		TokenChain:   tokenChain,
+		TokenAddress: nativeAddress(tokenChain, tokenAddress),
+		Amount:       payload.Amount,
+	}
+	if payloadType == tokenBridgeTransfer && payload.Fee != "0" {
+		properties.FeeChain = tokenChain
+		properties.FeeAddress = properties.TokenAddress
+		properties.Fee = payload.Fee
+	}
+
+	return &vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse{
+		ParsedPayload:          payload,
+		StandardizedProperties: properties,
+	}, nil
+}
+
+func parseTokenBridgeAttestation(vaa *sdk.VAA) (*vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse, error) {
+	payload, tokenAddress, err := decodeTokenBridgeAttestation(vaa.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := vaaPayloadParser.StandardizedProperties{
+		AppIds:       []string{domain.AppIdPortalTokenBridge},
+		FromChain:    vaa.EmitterChain,
+		TokenChain:   payload.TokenChain,
+		TokenAddress: nativeAddress(payload.TokenChain, tokenAddress),
+	}
+
+	return &vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse{
+		ParsedPayload:          *payload,
+		StandardizedProperties: properties,
+	}, nil
+}
+
+// fixedString decodes a right-padded, fixed-size string field (e.g.: the symbol of an attestation).
+func fixedString(b []byte) string {
+	return strings.ToValidUTF8(string(bytes.TrimRight(b, "\x00")), "")
+}
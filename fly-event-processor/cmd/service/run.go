@@ -13,15 +13,20 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/go-redis/redis/v8"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/sqs"
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/health"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
+	"github.com/wormhole-foundation/wormhole-explorer/common/periodic"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
 
 	governorConsumer "github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/consumer/governor"
+	reconcilerConsumer "github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/consumer/reconciler"
 	vaaConsumer "github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/consumer/vaa"
 	governorProcessor "github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/processor/governor"
+	reconcilerProcessor "github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/processor/reconciler"
 	vaaprocessor "github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/processor/vaa"
 
 	txTracker "github.com/wormhole-foundation/wormhole-explorer/common/client/txtracker"
@@ -97,6 +102,22 @@ func Run() {
 	governorStatus := governorConsumer.New(governorStatusConsumerFunc, governorProcessor.Process, logger, metrics, cfg.P2pNetwork, cfg.GovernorConsumerWorkerSize)
 	governorStatus.Start(rootCtx)
 
+	// create and start a log message published consumer and the source message reconciler.
+	if cfg.ReconcilerEnabled {
+		sourceMessageProcessor := reconcilerProcessor.NewProcessor(repository, logger, metrics)
+		logMessagePublishedConsumeFunc := newLogMessagePublishedConsumeFunc(rootCtx, cfg, metrics, logger)
+		logMessagePublished := reconcilerConsumer.New(logMessagePublishedConsumeFunc, sourceMessageProcessor.Process, logger, metrics, cfg.ReconcilerConsumerWorkerSize)
+		logMessagePublished.Start(rootCtx)
+
+		reconciler := reconcilerProcessor.NewReconciler(repository, reconcilerProcessor.Config{
+			Interval:  time.Duration(cfg.ReconcilerIntervalSeconds) * time.Second,
+			Grace:     time.Duration(cfg.ReconcilerGraceSeconds) * time.Second,
+			Lookback:  time.Duration(cfg.ReconcilerLookbackHours) * time.Hour,
+			BatchSize: cfg.ReconcilerBatchSize,
+		}, logger, metrics)
+		reconciler.Start(rootCtx, periodic.NewMongoLock(db.Database))
+	}
+
 	logger.Info("Started wormholescan-fly-event-processor")
 
 	// Waiting for signal
@@ -154,7 +175,12 @@ func newAwsConfig(ctx context.Context, cfg *config.ServiceConfiguration) (aws.Co
 	return awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(region))
 }
 
-func newSqsConsumer(ctx context.Context, cfg *config.ServiceConfiguration, sqsUrl string) (*sqs.Consumer, error) {
+func newConsumer(ctx context.Context, cfg *config.ServiceConfiguration, sqsUrl, stream string) (messagebus.Consumer, error) {
+	if cfg.MessageBus == messagebus.BackendRedis {
+		return messagebus.NewRedisStreamConsumer(ctx, newMessageBusRedisClient(cfg), stream, cfg.MessageBusConsumerGroup,
+			messagebus.WithRedisMaxMessages(10),
+			messagebus.WithRedisVisibilityTimeout(60*time.Second))
+	}
 
 	awsconfig, err := newAwsConfig(ctx, cfg)
 	if err != nil {
@@ -167,7 +193,14 @@ func newSqsConsumer(ctx context.Context, cfg *config.ServiceConfiguration, sqsUr
 		sqs.WithMaxMessages(10),
 		sqs.WithVisibilityTimeout(60),
 	)
-	return consumer, err
+	if err != nil {
+		return nil, err
+	}
+	return messagebus.NewSQSConsumer(consumer, messagebus.WithSNSEnvelope()), nil
+}
+
+func newMessageBusRedisClient(cfg *config.ServiceConfiguration) *redis.Client {
+	return redis.NewClient(&redis.Options{Addr: cfg.MessageBusRedisURI})
 }
 
 func makeHealthChecks(
@@ -176,6 +209,13 @@ func makeHealthChecks(
 	db *mongo.Database,
 ) ([]health.Check, error) {
 
+	if cfg.MessageBus == messagebus.BackendRedis {
+		return []health.Check{
+			health.Redis(newMessageBusRedisClient(cfg)),
+			health.Mongo(db),
+		}, nil
+	}
+
 	awsConfig, err := newAwsConfig(ctx, cfg)
 	if err != nil {
 		return nil, err
@@ -185,6 +225,9 @@ func makeHealthChecks(
 		health.SQS(awsConfig, cfg.DuplicateVaaSQSUrl),
 		health.Mongo(db),
 	}
+	if cfg.ReconcilerEnabled {
+		plugins = append(plugins, health.SQS(awsConfig, cfg.LogMessagePublishedSQSUrl))
+	}
 
 	return plugins, nil
 }
@@ -196,6 +239,13 @@ func newMetrics(cfg *config.ServiceConfiguration) metrics.Metrics {
 	return metrics.NewPrometheusMetrics(cfg.Environment)
 }
 
+func newPoolMetrics(cfg *config.ServiceConfiguration) pool.Metrics {
+	if !cfg.MetricsEnabled {
+		return pool.NewDummyMetrics()
+	}
+	return pool.NewPrometheusMetrics(cfg.Environment, "wormscan-fly-event-processor")
+}
+
 func newGuardianProviderPool(cfg *config.ServiceConfiguration) (*pool.Pool, error) {
 	if cfg.GuardianAPIConfigurationJson == nil {
 		return nil, errors.New("guardian api provider configuration is missing")
@@ -214,7 +264,9 @@ func newGuardianProviderPool(cfg *config.ServiceConfiguration) (*pool.Pool, erro
 	if len(guardianCfgs) == 0 {
 		return nil, errors.New("guardian api provider configuration is empty")
 	}
-	return pool.NewPool(guardianCfgs), nil
+	return pool.NewPool(guardianCfgs,
+		pool.WithName("guardian"),
+		pool.WithMetrics(newPoolMetrics(cfg))), nil
 }
 
 func newDuplicateVaaConsumeFunc(
@@ -224,12 +276,12 @@ func newDuplicateVaaConsumeFunc(
 	logger *zap.Logger,
 ) queue.ConsumeFunc[queue.EventDuplicateVaa] {
 
-	sqsConsumer, err := newSqsConsumer(ctx, cfg, cfg.DuplicateVaaSQSUrl)
+	consumer, err := newConsumer(ctx, cfg, cfg.DuplicateVaaSQSUrl, cfg.DuplicateVaaStream)
 	if err != nil {
-		logger.Fatal("failed to create sqs consumer", zap.Error(err))
+		logger.Fatal("failed to create consumer", zap.Error(err))
 	}
 
-	vaaQueue := queue.NewEventSqs[queue.EventDuplicateVaa](sqsConsumer,
+	vaaQueue := queue.NewEventQueue[queue.EventDuplicateVaa](consumer,
 		metrics.IncDuplicatedVaaConsumedQueue, logger)
 	return vaaQueue.Consume
 }
@@ -241,16 +293,33 @@ func newGovernorStatusConsumeFunc(
 	logger *zap.Logger,
 ) queue.ConsumeFunc[queue.EventGovernorStatus] {
 
-	sqsConsumer, err := newSqsConsumer(ctx, cfg, cfg.GovernorSQSUrl)
+	consumer, err := newConsumer(ctx, cfg, cfg.GovernorSQSUrl, cfg.GovernorStatusStream)
 	if err != nil {
-		logger.Fatal("failed to create sqs consumer", zap.Error(err))
+		logger.Fatal("failed to create consumer", zap.Error(err))
 	}
 
-	governorStatusQueue := queue.NewEventSqs[queue.EventGovernorStatus](sqsConsumer,
+	governorStatusQueue := queue.NewEventQueue[queue.EventGovernorStatus](consumer,
 		metrics.IncGovernorStatusConsumedQueue, logger)
 	return governorStatusQueue.Consume
 }
 
+func newLogMessagePublishedConsumeFunc(
+	ctx context.Context,
+	cfg *config.ServiceConfiguration,
+	metrics metrics.Metrics,
+	logger *zap.Logger,
+) queue.ConsumeFunc[queue.EventLogMessagePublished] {
+
+	consumer, err := newConsumer(ctx, cfg, cfg.LogMessagePublishedSQSUrl, cfg.LogMessagePublishedStream)
+	if err != nil {
+		logger.Fatal("failed to create consumer", zap.Error(err))
+	}
+
+	logMessagePublishedQueue := queue.NewEventQueue[queue.EventLogMessagePublished](consumer,
+		metrics.IncLogMessagePublishedConsumedQueue, logger)
+	return logMessagePublishedQueue.Consume
+}
+
 func newCreateTxHashFunc(
 	cfg *config.ServiceConfiguration,
 	logger *zap.Logger,
@@ -8,6 +8,7 @@ import (
 
 	"github.com/joho/godotenv"
 	"github.com/sethvargo/go-envconfig"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
 )
 
 const (
@@ -39,6 +40,13 @@ type ServiceConfiguration struct {
 	// Database configuration
 	MongoURI      string `env:"MONGODB_URI,required"`
 	MongoDatabase string `env:"MONGODB_DATABASE,required"`
+	// Message bus configuration, the backend of the queues is sqs or redis.
+	MessageBus                string `env:"MESSAGE_BUS,default=sqs"`
+	MessageBusRedisURI        string `env:"MESSAGE_BUS_REDIS_URI"`
+	MessageBusConsumerGroup   string `env:"MESSAGE_BUS_CONSUMER_GROUP,default=fly-event-processor"`
+	DuplicateVaaStream        string `env:"DUPLICATE_VAA_STREAM,default=duplicated-vaa"`
+	GovernorStatusStream      string `env:"GOVERNOR_STATUS_STREAM,default=governor-status"`
+	LogMessagePublishedStream string `env:"LOG_MESSAGE_PUBLISHED_STREAM,default=log-message-published"`
 	// AWS configuration
 	AwsEndpoint        string `env:"AWS_ENDPOINT"`
 	AwsAccessKeyID     string `env:"AWS_ACCESS_KEY_ID"`
@@ -46,6 +54,14 @@ type ServiceConfiguration struct {
 	AwsRegion          string `env:"AWS_REGION"`
 	DuplicateVaaSQSUrl string `env:"DUPLICATE_VAA_SQS_URL"`
 	GovernorSQSUrl     string `env:"GOVERNOR_SQS_URL"`
+	// Source message reconciler configuration
+	ReconcilerEnabled            bool   `env:"RECONCILER_ENABLED,default=false"`
+	LogMessagePublishedSQSUrl    string `env:"LOG_MESSAGE_PUBLISHED_SQS_URL"`
+	ReconcilerConsumerWorkerSize int    `env:"RECONCILER_CONSUMER_WORKER_SIZE,default=1"`
+	ReconcilerIntervalSeconds    int64  `env:"RECONCILER_INTERVAL_SECONDS,default=60"`
+	ReconcilerGraceSeconds       int64  `env:"RECONCILER_GRACE_SECONDS,default=300"`
+	ReconcilerLookbackHours      int64  `env:"RECONCILER_LOOKBACK_HOURS,default=72"`
+	ReconcilerBatchSize          int64  `env:"RECONCILER_BATCH_SIZE,default=500"`
 	// Tx-tracker client configuration
 	TxTrackerUrl     string `env:"TX_TRACKER_URL,required"`
 	TxTrackerTimeout int64  `env:"TX_TRACKER_TIMEOUT,default=10"`
@@ -74,6 +90,9 @@ func New(ctx context.Context) (*ServiceConfiguration, error) {
 	if err := envconfig.Process(ctx, &configuration); err != nil {
 		return nil, err
 	}
+	if err := messagebus.ValidateBackend(configuration.MessageBus); err != nil {
+		return nil, err
+	}
 
 	// Load guardian api provider configuration.
 	if configuration.GuardianAPIProviderPath != "" {
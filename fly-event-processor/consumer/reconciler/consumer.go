@@ -0,0 +1,102 @@
+package reconciler
+
+import (
+	"context"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
+	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/internal/metrics"
+	reconcilerProcessor "github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/processor/reconciler"
+	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/queue"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// Consumer consumer struct definition.
+type Consumer struct {
+	consumeFunc queue.ConsumeFunc[queue.EventLogMessagePublished]
+	processor   reconcilerProcessor.ProcessorFunc
+	logger      *zap.Logger
+	metrics     metrics.Metrics
+	workersSize int
+}
+
+// New creates a new log message published consumer.
+func New(
+	consumeFunc queue.ConsumeFunc[queue.EventLogMessagePublished],
+	processor reconcilerProcessor.ProcessorFunc,
+	logger *zap.Logger,
+	metrics metrics.Metrics,
+	workersSize int,
+) *Consumer {
+
+	c := Consumer{
+		consumeFunc: consumeFunc,
+		processor:   processor,
+		logger:      logger,
+		metrics:     metrics,
+		workersSize: workersSize,
+	}
+
+	return &c
+}
+
+// Start consumes messages from the log message published queue and stores them as source messages.
+func (c *Consumer) Start(ctx context.Context) {
+	ch := c.consumeFunc(ctx)
+	for i := 0; i < c.workersSize; i++ {
+		go c.producerLoop(ctx, ch)
+	}
+}
+
+func (c *Consumer) producerLoop(ctx context.Context, ch <-chan queue.ConsumerMessage[queue.EventLogMessagePublished]) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-ch:
+			c.processEvent(ctx, msg)
+		}
+	}
+}
+
+func (c *Consumer) processEvent(ctx context.Context, msg queue.ConsumerMessage[queue.EventLogMessagePublished]) {
+	event := msg.Data()
+
+	// Check if the event is a log message published event.
+	if event.Type != events.LogMessagePublishedType {
+		msg.Done()
+		c.logger.Debug("event is not a log message published",
+			zap.Any("event", event))
+		return
+	}
+
+	chainID := sdk.ChainID(event.Data.ChainID)
+	logger := c.logger.With(
+		zap.String("trackId", event.TrackID),
+		zap.String("type", event.Type),
+		zap.String("txHash", event.Data.TxHash))
+
+	if msg.IsExpired() {
+		msg.Failed()
+		logger.Debug("event is expired")
+		c.metrics.IncLogMessagePublishedExpired(chainID)
+		return
+	}
+
+	params := &reconcilerProcessor.Params{
+		TrackID:             event.TrackID,
+		LogMessagePublished: &event.Data,
+	}
+
+	err := c.processor(ctx, params)
+	if err != nil {
+		msg.Failed()
+		logger.Error("failed to process log-message-published event", zap.Error(err))
+		c.metrics.IncLogMessagePublishedFailed(chainID)
+		return
+	}
+
+	msg.Done()
+	logger.Debug("log-message-published event processed")
+	c.metrics.IncLogMessagePublishedProcessed(chainID)
+}
@@ -42,3 +42,21 @@ func (d *DummyMetrics) IncGovernorVaaAdded(chainID sdk.ChainID) {}
 
 // IndGovenorVaaDeleted dummy implementation.
 func (d *DummyMetrics) IndGovenorVaaDeleted(chainID sdk.ChainID) {}
+
+// IncLogMessagePublishedConsumedQueue dummy implementation.
+func (d *DummyMetrics) IncLogMessagePublishedConsumedQueue() {}
+
+// IncLogMessagePublishedProcessed dummy implementation.
+func (d *DummyMetrics) IncLogMessagePublishedProcessed(chainID sdk.ChainID) {}
+
+// IncLogMessagePublishedFailed dummy implementation.
+func (d *DummyMetrics) IncLogMessagePublishedFailed(chainID sdk.ChainID) {}
+
+// IncLogMessagePublishedExpired dummy implementation.
+func (d *DummyMetrics) IncLogMessagePublishedExpired(chainID sdk.ChainID) {}
+
+// IncSourceMessageSigned dummy implementation.
+func (d *DummyMetrics) IncSourceMessageSigned(chainID sdk.ChainID) {}
+
+// IncSourceMessageUnsigned dummy implementation.
+func (d *DummyMetrics) IncSourceMessageUnsigned(chainID sdk.ChainID) {}
@@ -16,6 +16,12 @@ type Metrics interface {
 	IncGovernorStatusExpired(node string, address string)
 	IncGovernorVaaAdded(chainID sdk.ChainID)
 	IndGovenorVaaDeleted(chainID sdk.ChainID)
+	IncLogMessagePublishedConsumedQueue()
+	IncLogMessagePublishedProcessed(chainID sdk.ChainID)
+	IncLogMessagePublishedFailed(chainID sdk.ChainID)
+	IncLogMessagePublishedExpired(chainID sdk.ChainID)
+	IncSourceMessageSigned(chainID sdk.ChainID)
+	IncSourceMessageUnsigned(chainID sdk.ChainID)
 }
 
 // IncDuplicatedVaaConsumedQueue increments the counter of consumed queue
@@ -11,6 +11,7 @@ type PrometheusMetrics struct {
 	duplicatedVaaCount  *prometheus.CounterVec
 	governorStatusCount *prometheus.CounterVec
 	governorVaaCount    *prometheus.CounterVec
+	sourceMessageCount  *prometheus.CounterVec
 }
 
 // NewPrometheusMetrics returns a new instance of PrometheusMetrics.
@@ -43,6 +44,15 @@ func NewPrometheusMetrics(environment string) *PrometheusMetrics {
 					"service":     serviceName,
 				},
 			}, []string{"chain", "type"}),
+		sourceMessageCount: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "wormscan_fly_event_processor_source_message_count",
+				Help: "The total number of source chain messages processed",
+				ConstLabels: map[string]string{
+					"environment": environment,
+					"service":     serviceName,
+				},
+			}, []string{"chain", "type"}),
 	}
 }
 
@@ -106,3 +116,38 @@ func (m *PrometheusMetrics) IndGovenorVaaDeleted(chainID sdk.ChainID) {
 	chain := chainID.String()
 	m.governorVaaCount.WithLabelValues(chain, "deleted").Inc()
 }
+
+// IncLogMessagePublishedConsumedQueue increments the total number of log message published events consumed queue.
+func (m *PrometheusMetrics) IncLogMessagePublishedConsumedQueue() {
+	m.sourceMessageCount.WithLabelValues("all", "consumed_queue").Inc()
+}
+
+// IncLogMessagePublishedProcessed increments the total number of log message published events processed.
+func (m *PrometheusMetrics) IncLogMessagePublishedProcessed(chainID sdk.ChainID) {
+	chain := chainID.String()
+	m.sourceMessageCount.WithLabelValues(chain, "processed").Inc()
+}
+
+// IncLogMessagePublishedFailed increments the total number of log message published events failed.
+func (m *PrometheusMetrics) IncLogMessagePublishedFailed(chainID sdk.ChainID) {
+	chain := chainID.String()
+	m.sourceMessageCount.WithLabelValues(chain, "failed").Inc()
+}
+
+// IncLogMessagePublishedExpired increments the total number of log message published events expired.
+func (m *PrometheusMetrics) IncLogMessagePublishedExpired(chainID sdk.ChainID) {
+	chain := chainID.String()
+	m.sourceMessageCount.WithLabelValues(chain, "expired").Inc()
+}
+
+// IncSourceMessageSigned increments the total number of source chain messages reconciled with a VAA.
+func (m *PrometheusMetrics) IncSourceMessageSigned(chainID sdk.ChainID) {
+	chain := chainID.String()
+	m.sourceMessageCount.WithLabelValues(chain, "signed").Inc()
+}
+
+// IncSourceMessageUnsigned increments the total number of source chain messages without a VAA after the finality time.
+func (m *PrometheusMetrics) IncSourceMessageUnsigned(chainID sdk.ChainID) {
+	chain := chainID.String()
+	m.sourceMessageCount.WithLabelValues(chain, "unsigned").Inc()
+}
@@ -4,14 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	txTracker "github.com/wormhole-foundation/wormhole-explorer/common/client/txtracker"
+	"github.com/wormhole-foundation/wormhole-explorer/common/lifecycle"
 	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/storage"
 	"go.uber.org/zap"
 )
 
+// governorReleaseDelay is the time the governor holds a vaa before releasing it.
+const governorReleaseDelay = 24 * time.Hour
+
 // Processor is a governor processor.
 type Processor struct {
 	repository       *storage.Repository
@@ -112,9 +117,28 @@ func (p *Processor) Process(
 		return err
 	}
 
+	// 7. Record the new governed vaas in the lifecycle of their operations.
+	p.recordGoverned(ctx, governorVaasToAdd, logger)
+
 	return nil
 }
 
+// recordGoverned records the governed step of the operations of the governor vaas.
+// The governor releases a vaa 24 hours after it was enqueued, which is when the message was emitted.
+func (p *Processor) recordGoverned(ctx context.Context, governorVaas []domain.GovernorVaa, logger *zap.Logger) {
+	for _, governorVaa := range governorVaas {
+		governedAt := governorVaa.ReleaseTime.Add(-governorReleaseDelay)
+		err := p.repository.RecordLifecycle(ctx, governorVaa.ID,
+			lifecycle.Event{Step: lifecycle.StepEmitted, At: governedAt},
+			lifecycle.Event{Step: lifecycle.StepGoverned, At: governedAt})
+		if err != nil {
+			logger.Warn("failed to record governed vaa in operation lifecycle",
+				zap.Error(err),
+				zap.String("vaaID", governorVaa.ID))
+		}
+	}
+}
+
 // getNodeGovernorVaaIds gets the current governor vaaIds stored in the database by node address.
 func (p *Processor) getNodeGovernorVaaIds(
 	ctx context.Context,
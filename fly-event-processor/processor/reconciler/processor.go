@@ -0,0 +1,66 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/storage"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// Processor stores the messages published on the source chains to reconcile them with the signed VAAs.
+type Processor struct {
+	repository *storage.Repository
+	logger     *zap.Logger
+	metrics    metrics.Metrics
+}
+
+// NewProcessor creates a new source message processor.
+func NewProcessor(
+	repository *storage.Repository,
+	logger *zap.Logger,
+	metrics metrics.Metrics,
+) *Processor {
+
+	return &Processor{
+		repository: repository,
+		logger:     logger,
+		metrics:    metrics,
+	}
+}
+
+// Process stores a log message published event as a pending source message.
+func (p *Processor) Process(ctx context.Context, params *Params) error {
+	plm := params.LogMessagePublished
+	chainID := sdk.ChainID(plm.ChainID)
+
+	emitter, err := sdk.StringToAddress(plm.Attributes.Sender)
+	if err != nil {
+		return fmt.Errorf("error converting emitter address: %w", err)
+	}
+
+	doc := &storage.SourceMessageDoc{
+		ID:           fmt.Sprintf("%d/%s/%d", chainID, emitter.String(), plm.Attributes.Sequence),
+		EmitterChain: chainID,
+		EmitterAddr:  emitter.String(),
+		Sequence:     plm.Attributes.Sequence,
+		TxHash:       plm.TxHash,
+		BlockHeight:  plm.BlockHeight,
+		BlockTime:    plm.BlockTime,
+		FinalizedAt:  plm.BlockTime.Add(domain.GetFinalityTimeByChainID(chainID)),
+		UpdatedAt:    time.Now(),
+	}
+
+	if err := p.repository.UpsertSourceMessage(ctx, doc); err != nil {
+		p.logger.Error("failed to upsert source message",
+			zap.String("trackId", params.TrackID),
+			zap.String("id", doc.ID),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
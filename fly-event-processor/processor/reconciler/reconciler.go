@@ -0,0 +1,99 @@
+package reconciler
+
+import (
+	"context"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/periodic"
+	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/storage"
+	"go.uber.org/zap"
+)
+
+// Config is the configuration of the Reconciler.
+type Config struct {
+	// Interval is the time between runs.
+	Interval time.Duration
+	// Grace is the time to wait after the finality time of a message before flagging it as unsigned.
+	Grace time.Duration
+	// Lookback is the maximum age of the finality time of the messages to reconcile.
+	Lookback time.Duration
+	// BatchSize is the maximum number of messages reconciled on each query.
+	BatchSize int64
+}
+
+// Reconciler joins the source messages with the signed VAAs and flags the messages
+// that are still unsigned after the finality time of their chain.
+type Reconciler struct {
+	repository *storage.Repository
+	cfg        Config
+	logger     *zap.Logger
+	metrics    metrics.Metrics
+}
+
+// NewReconciler creates a new Reconciler.
+func NewReconciler(
+	repository *storage.Repository,
+	cfg Config,
+	logger *zap.Logger,
+	metrics metrics.Metrics,
+) *Reconciler {
+
+	return &Reconciler{
+		repository: repository,
+		cfg:        cfg,
+		logger:     logger.With(zap.String("module", "SourceMessageReconciler")),
+		metrics:    metrics,
+	}
+}
+
+// Start runs the reconciler periodically until the context is cancelled. Only the instance that
+// holds the lock runs it, so the replicas of the service do not run it at the same time.
+func (r *Reconciler) Start(ctx context.Context, lock periodic.Lock) {
+	periodic.NewRunner("source-message-reconciler", r.cfg.Interval, r.run, r.logger, periodic.WithLock(lock)).Start(ctx)
+}
+
+// run reconciles the messages finalized in the lookback window, in batches.
+// Each message is reconciled at most once per run.
+func (r *Reconciler) run(ctx context.Context, now time.Time) error {
+	to := now.Add(-r.cfg.Grace)
+	from := now.Add(-r.cfg.Lookback)
+	for {
+		msgs, err := r.repository.FindSourceMessagesToReconcile(ctx, from, to, now, r.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, m := range msgs {
+			if err := r.reconcile(ctx, m, now); err != nil {
+				return err
+			}
+		}
+		if int64(len(msgs)) < r.cfg.BatchSize {
+			return nil
+		}
+	}
+}
+
+// reconcile sets the status of a message depending on whether it has a VAA.
+func (r *Reconciler) reconcile(ctx context.Context, m *storage.SourceMessageToReconcile, now time.Time) error {
+	status := storage.SourceMessageUnsigned
+	if m.HasVaa() {
+		status = storage.SourceMessageSigned
+	}
+	if err := r.repository.UpdateSourceMessageStatus(ctx, m.ID, status, now); err != nil {
+		return err
+	}
+
+	switch {
+	case status == storage.SourceMessageSigned:
+		r.metrics.IncSourceMessageSigned(m.EmitterChain)
+	case m.Status == storage.SourceMessagePending:
+		// only the first time the message is flagged as unsigned.
+		r.logger.Warn("Source message unsigned after finality time",
+			zap.String("id", m.ID),
+			zap.String("txHash", m.TxHash),
+			zap.Time("finalizedAt", m.FinalizedAt))
+		r.metrics.IncSourceMessageUnsigned(m.EmitterChain)
+	}
+	return nil
+}
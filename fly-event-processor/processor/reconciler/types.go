@@ -0,0 +1,15 @@
+package reconciler
+
+import (
+	"context"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
+)
+
+type Params struct {
+	TrackID             string
+	LogMessagePublished *events.LogMessagePublished
+}
+
+// ProcessorFunc is a function to process a log message published event.
+type ProcessorFunc func(context.Context, *Params) error
@@ -6,6 +6,7 @@ import (
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/guardian"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
+	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/storage"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -50,7 +51,7 @@ func (p *Processor) Process(ctx context.Context, params *Params) error {
 
 	// 1.2 if the event time has not reached the finality time, the event fail and
 	// will be reprocesed on the next retry.
-	finalityTime := getFinalityTimeByChainID(params.ChainID)
+	finalityTime := domain.GetFinalityTimeByChainID(params.ChainID)
 	if vaaDoc.Timestamp == nil {
 		logger.Error("vaa timestamp is nil")
 		return errors.New("vaa timestamp is nil")
@@ -77,7 +78,14 @@ func (p *Processor) Process(ctx context.Context, params *Params) error {
 			logger.Error("error creating guardian api client", zap.Error(err))
 			continue
 		}
+		start := time.Now()
 		signedVaa, err = guardianAPIClient.GetSignedVAA(params.VaaID)
+		// a missing vaa is a valid response of a healthy guardian.
+		if errors.Is(err, guardian.ErrVaaNotFound) {
+			g.NotifySuccess(time.Since(start))
+		} else {
+			g.NotifyEvent(start, err)
+		}
 		if err != nil {
 			logger.Error("error getting signed vaa from guardian api", zap.Error(err))
 			continue
@@ -148,83 +156,3 @@ func (p *Processor) Process(ctx context.Context, params *Params) error {
 	p.metrics.IncDuplicatedVaaCanNotFixed(params.ChainID)
 	return errors.New("can't fix duplicate vaa")
 }
-
-func getFinalityTimeByChainID(chainID sdk.ChainID) time.Duration {
-	// Time to finalize for each chain.
-	// ref: https://docs.wormhole.com/wormhole/reference/constants
-	switch chainID {
-	case sdk.ChainIDSolana:
-		return 14 * time.Second
-	case sdk.ChainIDEthereum:
-		return 975 * time.Second
-	case sdk.ChainIDTerra:
-		return 6 * time.Second
-	case sdk.ChainIDBSC:
-		return 48 * time.Second
-	case sdk.ChainIDPolygon:
-		return 66 * time.Second
-	case sdk.ChainIDAvalanche:
-		return 2 * time.Second
-	case sdk.ChainIDOasis:
-		return 12 * time.Second
-	case sdk.ChainIDAlgorand:
-		return 4 * time.Second
-	case sdk.ChainIDFantom:
-		return 5 * time.Second
-	case sdk.ChainIDKarura:
-		return 24 * time.Second
-	case sdk.ChainIDAcala:
-		return 24 * time.Second
-	case sdk.ChainIDKlaytn:
-		return 1 * time.Second
-	case sdk.ChainIDCelo:
-		return 10 * time.Second
-	case sdk.ChainIDNear:
-		return 2 * time.Second
-	case sdk.ChainIDMoonbeam:
-		return 24 * time.Second
-	case sdk.ChainIDTerra2:
-		return 6 * time.Second
-	case sdk.ChainIDInjective:
-		return 3 * time.Second
-	case sdk.ChainIDSui:
-		return 3 * time.Second
-	case sdk.ChainIDAptos:
-		return 4 * time.Second
-	case sdk.ChainIDArbitrum:
-		return 1066 * time.Second
-	case sdk.ChainIDOptimism:
-		return 1026 * time.Second
-	case sdk.ChainIDXpla:
-		return 5 * time.Second
-	case sdk.ChainIDBase:
-		return 1026 * time.Second
-	case sdk.ChainIDSei:
-		return 1 * time.Second
-	case sdk.ChainIDScroll:
-		return 1200 * time.Second
-	case sdk.ChainIDMantle:
-		return 1200 * time.Second
-	case sdk.ChainIDBlast:
-		return 1200 * time.Second
-	case sdk.ChainIDXLayer:
-		return 1200 * time.Second
-	case sdk.ChainIDBerachain:
-		return 5 * time.Second
-	case sdk.ChainIDWormchain:
-		return 5 * time.Second
-	case sdk.ChainIDSepolia:
-		return 975 * time.Second
-	case sdk.ChainIDArbitrumSepolia:
-		return 1066 * time.Second
-	case sdk.ChainIDBaseSepolia:
-		return 1026 * time.Second
-	case sdk.ChainIDOptimismSepolia:
-		return 1026 * time.Second
-	case sdk.ChainIDHolesky:
-		return 975 * time.Second
-	default:
-		// The default value is the max finality time.
-		return 1066 * time.Second
-	}
-}
@@ -0,0 +1,137 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
+	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/internal/metrics"
+	"go.uber.org/zap"
+)
+
+// EventQueueOption represents an event queue option function.
+type EventQueueOption[T Event] func(*EventQueue[T])
+
+// EventQueue represents an event queue consumed from the message bus.
+type EventQueue[T Event] struct {
+	consumer             messagebus.Consumer
+	ch                   chan ConsumerMessage[T]
+	chSize               int
+	wg                   sync.WaitGroup
+	incConsumedQueueFunc metrics.IncConsumedQueue
+	logger               *zap.Logger
+}
+
+// NewEventQueue creates an event queue instance.
+func NewEventQueue[T Event](
+	consumer messagebus.Consumer,
+	incConsumedQueueFunc metrics.IncConsumedQueue,
+	logger *zap.Logger,
+	opts ...EventQueueOption[T]) *EventQueue[T] {
+	s := &EventQueue[T]{
+		consumer:             consumer,
+		chSize:               10,
+		incConsumedQueueFunc: incConsumedQueueFunc,
+		logger:               logger.With(zap.String("queue", consumer.Name())),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.ch = make(chan ConsumerMessage[T], s.chSize)
+	return s
+}
+
+// WithChannelSize allows to specify an channel size when setting a value.
+func WithChannelSize[T Event](size int) EventQueueOption[T] {
+	return func(d *EventQueue[T]) {
+		d.chSize = size
+	}
+}
+
+// Consume returns the channel with the received messages from the queue.
+func (q *EventQueue[T]) Consume(ctx context.Context) <-chan ConsumerMessage[T] {
+	go func() {
+		for {
+			messages, err := q.consumer.Receive(ctx)
+			if err != nil {
+				q.logger.Error("Error getting messages from queue", zap.Error(err))
+				continue
+			}
+			q.logger.Debug("Received messages from queue", zap.Int("count", len(messages)))
+			expiredAt := time.Now().Add(q.consumer.VisibilityTimeout())
+			for _, msg := range messages {
+
+				q.incConsumedQueueFunc()
+				var event T
+				err := json.Unmarshal(msg.Body, &event)
+				if err != nil {
+					q.logger.Error("Error decoding message from queue", zap.ByteString("body", msg.Body), zap.Error(err))
+					if err = q.consumer.Ack(ctx, msg); err != nil {
+						q.logger.Error("Error deleting message from queue", zap.Error(err))
+					}
+					continue
+				}
+
+				q.wg.Add(1)
+				q.ch <- &consumerMessage[T]{
+					msg:       msg,
+					data:      event,
+					wg:        &q.wg,
+					logger:    q.logger,
+					consumer:  q.consumer,
+					expiredAt: expiredAt,
+					retry:     uint8(msg.ReceiveCount),
+					ctx:       ctx,
+				}
+			}
+			q.wg.Wait()
+		}
+
+	}()
+	return q.ch
+}
+
+// Close closes all consumer resources.
+func (q *EventQueue[T]) Close() {
+	close(q.ch)
+}
+
+type consumerMessage[T Event] struct {
+	msg       *messagebus.Message
+	data      T
+	consumer  messagebus.Consumer
+	wg        *sync.WaitGroup
+	logger    *zap.Logger
+	expiredAt time.Time
+	retry     uint8
+	ctx       context.Context
+}
+
+func (m *consumerMessage[T]) Done() {
+	if err := m.consumer.Ack(m.ctx, m.msg); err != nil {
+		m.logger.Error("Error deleting message from queue",
+			zap.Bool("isExpired", m.IsExpired()),
+			zap.Time("expiredAt", m.expiredAt),
+			zap.Error(err),
+		)
+	}
+	m.wg.Done()
+}
+
+func (m *consumerMessage[T]) Data() T {
+	return m.data
+}
+
+func (m *consumerMessage[T]) Failed() {
+	m.wg.Done()
+}
+
+func (m *consumerMessage[T]) IsExpired() bool {
+	return m.expiredAt.Before(time.Now())
+}
+
+func (m *consumerMessage[T]) Retry() uint8 {
+	return m.retry
+}
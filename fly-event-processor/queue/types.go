@@ -3,6 +3,8 @@ package queue
 import (
 	"context"
 	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
 )
 
 const (
@@ -10,15 +12,9 @@ const (
 	GovernorStatusEventType = "governor-status"
 )
 
-// sqsEvent represents a event data from SQS.
-type sqsEvent struct {
-	MessageID string `json:"MessageId"`
-	Message   string `json:"Message"`
-}
-
 // Event represents a event data.
 type Event interface {
-	EventDuplicateVaa | EventGovernorStatus
+	EventDuplicateVaa | EventGovernorStatus | EventLogMessagePublished
 }
 
 // EventDuplicateVaa defition.
@@ -80,6 +76,15 @@ type EnqueuedVAA struct {
 	TxHash        string `bson:"txhash" json:"txHash"`
 }
 
+// EventLogMessagePublished defition.
+// The events published by the blockchain-watcher use the `event` field for the type.
+type EventLogMessagePublished struct {
+	TrackID string                     `json:"trackId"`
+	Type    string                     `json:"event"`
+	Source  string                     `json:"source"`
+	Data    events.LogMessagePublished `json:"data"`
+}
+
 // ConsumerMessage defition.
 type ConsumerMessage[T any] interface {
 	Retry() uint8
@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/lifecycle"
 	commonRepo "github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
@@ -16,6 +17,8 @@ type Repository struct {
 	duplicateVaas    *mongo.Collection
 	nodeGovernorVaas *mongo.Collection
 	governorVaas     *mongo.Collection
+	sourceMessages   *mongo.Collection
+	lifecycle        *lifecycle.Repository
 }
 
 // New creates a new repository.
@@ -26,6 +29,8 @@ func NewRepository(logger *zap.Logger, db *mongo.Database) *Repository {
 		duplicateVaas:    db.Collection(commonRepo.DuplicateVaas),
 		nodeGovernorVaas: db.Collection(commonRepo.NodeGovernorVaas),
 		governorVaas:     db.Collection(commonRepo.GovernorVaas),
+		sourceMessages:   db.Collection(commonRepo.SourceMessages),
+		lifecycle:        lifecycle.NewRepository(db),
 	}
 	return &r
 }
@@ -253,3 +258,8 @@ func (r *Repository) UpdateGovernor(
 
 	return nil
 }
+
+// RecordLifecycle records the steps of an operation in its lifecycle.
+func (r *Repository) RecordLifecycle(ctx context.Context, vaaID string, events ...lifecycle.Event) error {
+	return r.lifecycle.Record(ctx, vaaID, events...)
+}
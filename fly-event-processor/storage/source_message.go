@@ -0,0 +1,126 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	commonRepo "github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Source message status.
+const (
+	// SourceMessagePending is the status of a message that did not reach the finality time yet.
+	SourceMessagePending = "pending"
+	// SourceMessageSigned is the status of a message with a VAA.
+	SourceMessageSigned = "signed"
+	// SourceMessageUnsigned is the status of a message without a VAA after the finality time.
+	SourceMessageUnsigned = "unsigned"
+)
+
+// SourceMessageDoc represents a message published on the source chain, reconciled with the signed VAAs.
+type SourceMessageDoc struct {
+	ID           string      `bson:"_id"`
+	EmitterChain sdk.ChainID `bson:"emitterChain"`
+	EmitterAddr  string      `bson:"emitterAddr"`
+	Sequence     uint64      `bson:"sequence"`
+	TxHash       string      `bson:"txHash"`
+	BlockHeight  string      `bson:"blockHeight"`
+	BlockTime    time.Time   `bson:"blockTime"`
+	FinalizedAt  time.Time   `bson:"finalizedAt"`
+	Status       string      `bson:"status"`
+	SignedAt     *time.Time  `bson:"signedAt,omitempty"`
+	ReconciledAt *time.Time  `bson:"reconciledAt,omitempty"`
+	UpdatedAt    time.Time   `bson:"updatedAt"`
+}
+
+// SourceMessageToReconcile represents a source message with the VAA it was signed with, if any.
+type SourceMessageToReconcile struct {
+	SourceMessageDoc `bson:",inline"`
+	Vaas             []struct {
+		ID string `bson:"_id"`
+	} `bson:"vaas"`
+}
+
+// UpsertSourceMessage inserts a source message as pending, or updates it without changing its status.
+func (r *Repository) UpsertSourceMessage(ctx context.Context, doc *SourceMessageDoc) error {
+	update := bson.M{
+		"$set": bson.M{
+			"emitterChain": doc.EmitterChain,
+			"emitterAddr":  doc.EmitterAddr,
+			"sequence":     doc.Sequence,
+			"txHash":       doc.TxHash,
+			"blockHeight":  doc.BlockHeight,
+			"blockTime":    doc.BlockTime,
+			"finalizedAt":  doc.FinalizedAt,
+			"updatedAt":    doc.UpdatedAt,
+		},
+		"$setOnInsert": bson.M{
+			"status":    SourceMessagePending,
+			"createdAt": doc.UpdatedAt,
+		},
+	}
+	_, err := r.sourceMessages.UpdateByID(ctx, doc.ID, update, options.Update().SetUpsert(true))
+	return err
+}
+
+// FindSourceMessagesToReconcile finds the pending and unsigned messages finalized in the range [from, to]
+// that were never reconciled or were reconciled before [reconciledBefore], with the VAA of the message, if any.
+func (r *Repository) FindSourceMessagesToReconcile(
+	ctx context.Context,
+	from, to, reconciledBefore time.Time,
+	limit int64,
+) ([]*SourceMessageToReconcile, error) {
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "status", Value: bson.M{"$in": bson.A{SourceMessagePending, SourceMessageUnsigned}}},
+			{Key: "finalizedAt", Value: bson.M{"$gte": from, "$lte": to}},
+			{Key: "$or", Value: bson.A{
+				bson.M{"reconciledAt": bson.M{"$exists": false}},
+				bson.M{"reconciledAt": bson.M{"$lt": reconciledBefore}},
+			}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "finalizedAt", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+		{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: commonRepo.Vaas},
+			{Key: "localField", Value: "_id"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "pipeline", Value: bson.A{bson.M{"$project": bson.M{"_id": 1}}}},
+			{Key: "as", Value: "vaas"},
+		}}},
+	}
+
+	cur, err := r.sourceMessages.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var docs []*SourceMessageToReconcile
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// UpdateSourceMessageStatus updates the status of a source message after reconciling it.
+func (r *Repository) UpdateSourceMessageStatus(ctx context.Context, id, status string, now time.Time) error {
+	set := bson.M{
+		"status":       status,
+		"reconciledAt": now,
+		"updatedAt":    now,
+	}
+	if status == SourceMessageSigned {
+		set["signedAt"] = now
+	}
+	_, err := r.sourceMessages.UpdateByID(ctx, id, bson.M{"$set": set})
+	return err
+}
+
+// HasVaa returns whether the message has been signed.
+func (m *SourceMessageToReconcile) HasVaa() bool {
+	return len(m.Vaas) > 0
+}
@@ -7,7 +7,6 @@ import (
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/config"
-	"github.com/wormhole-foundation/wormhole-explorer/fly/internal/sqs"
 )
 
 func NewAwsConfig(ctx context.Context, config *config.Configuration) (aws.Config, error) {
@@ -41,20 +40,3 @@ func NewAwsConfig(ctx context.Context, config *config.Configuration) (aws.Config
 
 	return awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(config.Aws.AwsRegion))
 }
-
-func NewSQSProducer(awsConfig aws.Config, sqsURL string) (*sqs.Producer, error) {
-
-	return sqs.NewProducer(awsConfig, sqsURL)
-}
-
-func NewSQSConsumer(sqsURL string, ctx context.Context, cfg *config.Configuration) (*sqs.Consumer, error) {
-
-	awsConfig, err := NewAwsConfig(ctx, cfg)
-	if err != nil {
-		return nil, err
-	}
-
-	return sqs.NewConsumer(awsConfig, sqsURL,
-		sqs.WithMaxMessages(10),
-		sqs.WithVisibilityTimeout(120))
-}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/health"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/config"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/event"
 	"go.uber.org/zap"
@@ -14,14 +15,19 @@ func NewEventDispatcher(ctx context.Context, config *config.Configuration, logge
 		return event.NewNoopEventDispatcher(), health.Noop()
 	}
 
+	if config.MessageBus.Backend == messagebus.BackendRedis {
+		client := newMessageBusRedisClient(config)
+		ed := event.NewMessageBusEventDispatcher(
+			messagebus.NewRedisStreamProducer(client, config.MessageBus.DuplicateVaaStream),
+			messagebus.NewRedisStreamProducer(client, config.MessageBus.GovernorStatusStream))
+		return ed, health.Redis(client)
+	}
+
 	awsConfig, err := NewAwsConfig(ctx, config)
 	if err != nil {
 		logger.Fatal("could not create aws config", zap.Error(err))
 	}
 
-	ed, err := event.NewSnsEventDispatcher(awsConfig, config.Aws.EventsSnsUrl)
-	if err != nil {
-		logger.Fatal("could not create sns event dispatcher", zap.Error(err))
-	}
-	return ed, health.SNS(awsConfig, config.Aws.EventsSnsUrl)
+	snsProducer := messagebus.NewSNSProducer(awsConfig, config.Aws.EventsSnsUrl)
+	return event.NewMessageBusEventDispatcher(snsProducer, snsProducer), health.SNS(awsConfig, config.Aws.EventsSnsUrl)
 }
@@ -0,0 +1,43 @@
+package builder
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/sqs"
+	"github.com/wormhole-foundation/wormhole-explorer/common/health"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/config"
+)
+
+// newQueue creates the producer and the consumer of a queue depending on the message bus
+// backend (SQS queue or Redis stream), and the health check of the queue.
+func newQueue(ctx context.Context, cfg *config.Configuration, sqsUrl, stream string) (messagebus.Producer, messagebus.Consumer, health.Check, error) {
+	if cfg.MessageBus.Backend == messagebus.BackendRedis {
+		client := newMessageBusRedisClient(cfg)
+		consumer, err := messagebus.NewRedisStreamConsumer(ctx, client, stream, cfg.MessageBus.ConsumerGroup,
+			messagebus.WithRedisMaxMessages(10),
+			messagebus.WithRedisVisibilityTimeout(120*time.Second))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return messagebus.NewRedisStreamProducer(client, stream), consumer, health.Redis(client), nil
+	}
+
+	awsConfig, err := NewAwsConfig(ctx, cfg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sqsConsumer, err := sqs.NewConsumer(awsConfig, sqsUrl,
+		sqs.WithMaxMessages(10),
+		sqs.WithVisibilityTimeout(120))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return messagebus.NewSQSProducer(awsConfig, sqsUrl), messagebus.NewSQSConsumer(sqsConsumer), health.SQS(awsConfig, sqsUrl), nil
+}
+
+func newMessageBusRedisClient(cfg *config.Configuration) *redis.Client {
+	return redis.NewClient(&redis.Options{Addr: cfg.MessageBus.RedisURI})
+}
@@ -14,7 +14,7 @@ import (
 	"go.uber.org/zap"
 )
 
-// Creates two callbacks depending on whether the execution is local (memory queue) or not (SQS queue)
+// Creates two callbacks depending on whether the execution is local (memory queue) or not (SQS queue or Redis stream)
 // callback to obtain queue messages from a queue
 // callback to publish vaa non pyth messages to a sink
 func NewObservationConsumePublish(ctx context.Context, config *config.Configuration, logger *zap.Logger) (health.Check, processor.ObservationQueueConsumeFunc, processor.ObservationPushFunc) {
@@ -23,23 +23,17 @@ func NewObservationConsumePublish(ctx context.Context, config *config.Configurat
 		return health.Noop(), obsQueue.Consume, obsQueue.Publish
 	}
 
-	awsConfig, err := NewAwsConfig(ctx, config)
-	if err != nil {
-		logger.Fatal("could not create aws config", zap.Error(err))
+	var sqsUrl string
+	if config.Aws != nil {
+		sqsUrl = config.Aws.ObservationsSqsUrl
 	}
-
-	sqsProducer, err := NewSQSProducer(awsConfig, config.Aws.ObservationsSqsUrl)
-	if err != nil {
-		logger.Fatal("could not create sqs producer", zap.Error(err))
-	}
-
-	sqsConsumer, err := NewSQSConsumer(config.Aws.ObservationsSqsUrl, ctx, config)
+	producer, consumer, check, err := newQueue(ctx, config, sqsUrl, config.MessageBus.ObservationsStream)
 	if err != nil {
-		logger.Fatal("could not create sqs consumer", zap.Error(err))
+		logger.Fatal("could not create observation queue", zap.Error(err))
 	}
 
-	observationQueue := queue.NewObservationSqs(sqsProducer, sqsConsumer, logger)
-	return health.SQS(awsConfig, config.Aws.ObservationsSqsUrl), observationQueue.Consume, observationQueue.Publish
+	observationQueue := queue.NewObservationQueue(producer, consumer, logger)
+	return check, observationQueue.Consume, observationQueue.Publish
 }
 
 func NewTxHashStore(ctx context.Context, config *config.Configuration, metrics metrics.Metrics, db *mongo.Database, logger *zap.Logger) (txhash.TxHashStore, error) {
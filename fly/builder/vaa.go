@@ -28,7 +28,7 @@ func NewVAARedisProducerFunc(cfg *config.Configuration, logger *zap.Logger) (pro
 	return producer.NewRedisProducer(client, channel).Push, nil
 }
 
-// Creates two callbacks depending on whether the execution is local (memory queue) or not (SQS queue)
+// Creates two callbacks depending on whether the execution is local (memory queue) or not (SQS queue or Redis stream)
 // callback to obtain queue messages from a queue
 // callback to publish vaa non pyth messages to a sink
 func NewVAAConsumePublish(ctx context.Context, cfg *config.Configuration, logger *zap.Logger) (health.Check, processor.VAAQueueConsumeFunc, processor.VAAPushFunc) {
@@ -37,23 +37,17 @@ func NewVAAConsumePublish(ctx context.Context, cfg *config.Configuration, logger
 		return health.Noop(), vaaQueue.Consume, vaaQueue.Publish
 	}
 
-	awsConfig, err := NewAwsConfig(ctx, cfg)
-	if err != nil {
-		logger.Fatal("could not create aws config", zap.Error(err))
+	var sqsUrl string
+	if cfg.Aws != nil {
+		sqsUrl = cfg.Aws.SqsUrl
 	}
-
-	sqsProducer, err := NewSQSProducer(awsConfig, cfg.Aws.SqsUrl)
-	if err != nil {
-		logger.Fatal("could not create sqs producer", zap.Error(err))
-	}
-
-	sqsConsumer, err := NewSQSConsumer(cfg.Aws.SqsUrl, ctx, cfg)
+	producer, consumer, check, err := newQueue(ctx, cfg, sqsUrl, cfg.MessageBus.VaasStream)
 	if err != nil {
-		logger.Fatal("could not create sqs consumer", zap.Error(err))
+		logger.Fatal("could not create vaa queue", zap.Error(err))
 	}
 
-	vaaQueue := queue.NewVaaSqs(sqsProducer, sqsConsumer, logger)
-	return health.SQS(awsConfig, cfg.Aws.SqsUrl), vaaQueue.Consume, vaaQueue.Publish
+	vaaQueue := queue.NewVAAQueue(producer, consumer, logger)
+	return check, vaaQueue.Consume, vaaQueue.Publish
 }
 
 func NewVAANotifierFunc(cfg *config.Configuration, logger *zap.Logger) processor.VAANotifyFunc {
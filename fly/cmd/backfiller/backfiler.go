@@ -1,112 +1,96 @@
 package main
 
 import (
-	"bufio"
-	"bytes"
 	"context"
-	"fmt"
-	"io"
-	"log"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
-	"github.com/briandowns/spinner"
-	"github.com/schollz/progressbar/v3"
+	"github.com/wormhole-foundation/wormhole-explorer/common/backfill"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
+	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/event"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/storage"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/txhash"
+	"go.uber.org/zap"
 )
 
-func RunBackfiller(cfg WorkerConfiguration, workerFunc GenericWorker) {
-	ctx := context.Background()
-	wp := NewWorkpool(ctx, cfg, workerFunc)
-
-	b := Backfiller{
-		Filename: cfg.Filename,
-		Workpool: wp,
-	}
-	err := b.Run()
-	if err != nil {
-		fmt.Println(err)
-	}
-
-	fmt.Println("done backfiller!")
+// GenericWorker processes a line of the backfiller file.
+type GenericWorker func(ctx context.Context, repo *storage.Repository, txHashStore txhash.TxHashStore, item string) error
+
+type WorkerConfiguration struct {
+	LogLevel          string `env:"LOG_LEVEL,default=INFO"`
+	MongoURI          string `env:"MONGODB_URI,required"`
+	MongoDatabase     string `env:"MONGODB_DATABASE,required"`
+	Filename          string `env:"FILENAME,required"`
+	WorkerCount       int    `env:"WORKER_COUNT"`
+	PageSize          int64  `env:"PAGE_SIZE"`
+	RequestsPerMinute int64  `env:"REQUESTS_PER_MINUTE"`
+	JobName           string `env:"JOB_NAME,required"`
+	Resume            bool   `env:"RESUME"`
+	DryRun            bool   `env:"DRY_RUN"`
+	FailuresFile      string `env:"FAILURES_FILE"`
+	NotifyEnabled     bool   `env:"NOTIFY_ENABLED"`
+	AwsRegion         string `env:"AWS_REGION"`
+	AwsAccessKeyId    string `env:"AWS_ACCESS_KEY_ID"`
+	AwsSecretKey      string `env:"AWS_SECRET_ACCESS_KEY"`
+	AwsEndpoint       string `env:"AWS_ENDPOINT"`
+	AwsSnsURL         string `env:"AWS_SNS_URL"`
 }
 
-type Backfiller struct {
-	Filename string
-	Strategy string
-	Workpool *Workpool
-}
+// RunBackfiller processes the lines of the backfiller file with the worker function as a resumable backfill job.
+func RunBackfiller(cfg WorkerConfiguration, workerFunc GenericWorker) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	logger := logger.New("wormhole-fly-backfiller", logger.WithLevel(cfg.LogLevel))
 
-func (b *Backfiller) Run() error {
-	f, err := os.Open(b.Filename)
+	db, err := dbutil.Connect(ctx, logger, cfg.MongoURI, cfg.MongoDatabase, false)
 	if err != nil {
-		return err
+		logger.Fatal("could not connect to DB", zap.Error(err))
 	}
 
-	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
-	s.Color("red")
-	s.Suffix = fmt.Sprintf(" counting lines")
-
-	s.Start()
-	pLines, err := b.countLines()
+	alertClient := alert.NewDummyClient()
+	metricsClient := metrics.NewDummyMetrics()
+	vaaTopicFunc, err := newVAATopicProducerFunc(ctx, cfg, alertClient, metricsClient, logger)
 	if err != nil {
-		return err
+		logger.Fatal("could not create vaa topic producer", zap.Error(err))
 	}
-	s.Stop()
-
-	fmt.Printf("lines: %d \n ", pLines)
-
-	b.Workpool.Bar = progressbar.Default(int64(pLines))
-
-	counter := 0
-	defer f.Close()
-
-	r := bufio.NewReader(f)
-
-	// read file line by line and send to workpool
-	for {
-		line, _, err := r.ReadLine() //loading chunk into buffer
-		if err != nil {
-			if err == io.EOF {
-				break
-			}
-			log.Fatalf("a real error happened here: %v\n", err)
-		}
-		b.Workpool.Queue <- string(line)
-		counter += 1
+	txHashStore := txhash.NewMongoTxHash(db.Database, logger)
+	repository := storage.NewRepository(alertClient, metricsClient, db.Database, vaaTopicFunc, txHashStore,
+		event.NewNoopEventDispatcher(), logger)
+
+	strategy := backfill.StrategyFunc[string](func(ctx context.Context, line string) error {
+		return workerFunc(ctx, repository, txHashStore, line)
+	})
+	jobConfig := backfill.Config{
+		Name:              cfg.JobName,
+		PageSize:          cfg.PageSize,
+		Workers:           cfg.WorkerCount,
+		RequestsPerMinute: cfg.RequestsPerMinute,
+		Resume:            cfg.Resume,
+		DryRun:            cfg.DryRun,
+		FailuresFile:      cfg.FailuresFile,
 	}
+	job := backfill.NewJob[string](jobConfig, backfill.NewLineSource(cfg.Filename), strategy,
+		backfill.NewMongoCheckpointStore(db.Database), logger)
 
-	// send exit signal to all workers
-	for i := 0; i < b.Workpool.Workers; i++ {
-		b.Workpool.Queue <- "exit"
+	checkpoint, err := job.Run(ctx)
+	if err != nil {
+		logger.Error("Backfill interrupted, run it again with --resume to continue", zap.Error(err))
 	}
 
-	// wait for all workers to finish
-	b.Workpool.WG.Wait()
-
-	fmt.Printf("processed %d lines\n", counter)
-
-	return nil
-}
-
-func (b *Backfiller) countLines() (int, error) {
-	file, _ := os.Open(b.Filename)
-	defer file.Close()
+	db.DisconnectWithTimeout(10 * time.Second)
 
-	buf := make([]byte, 32*1024)
-	count := 0
-	lineSep := []byte{'\n'}
-
-	for {
-		c, err := file.Read(buf)
-		count += bytes.Count(buf[:c], lineSep)
-
-		switch {
-		case err == io.EOF:
-			return count, nil
-
-		case err != nil:
-			return count, err
-		}
+	if checkpoint != nil {
+		logger.Info("Finished backfiller",
+			zap.Uint64("processed", checkpoint.Processed),
+			zap.Uint64("failed", checkpoint.Failed))
+	}
+	if err != nil {
+		os.Exit(1)
 	}
-
 }
@@ -29,117 +29,79 @@ func execute() error {
 }
 
 func addVaaBackfillerCommand(root *cobra.Command) {
-	var mongoUri, mongoDb, filename, awsRegion, awsAccessKeyId, awsSecretKey, AwsEndpoint, AwsSnsURL string
-	var workerCount int
-	var notifyEnabled bool
+	var cfg WorkerConfiguration
 
 	vaaBackfillerCommand := &cobra.Command{
 		Use:   "vaa",
 		Short: "Run vaa backfiller",
 		Run: func(_ *cobra.Command, _ []string) {
-			workerConfiguration := WorkerConfiguration{
-				MongoURI:       mongoUri,
-				MongoDatabase:  mongoDb,
-				Filename:       filename,
-				WorkerCount:    workerCount,
-				NotifyEnabled:  notifyEnabled,
-				AwsRegion:      awsRegion,
-				AwsAccessKeyId: awsAccessKeyId,
-				AwsSecretKey:   awsSecretKey,
-				AwsEndpoint:    AwsEndpoint,
-				AwsSnsURL:      AwsSnsURL,
-			}
-			RunBackfiller(workerConfiguration, workerVaa)
+			RunBackfiller(cfg, workerVaa)
 		},
 	}
-	vaaBackfillerCommand.Flags().StringVar(&mongoUri, "mongo-uri", "", "Mongo connection")
-	vaaBackfillerCommand.Flags().StringVar(&mongoDb, "mongo-database", "", "Mongo database")
-	vaaBackfillerCommand.Flags().StringVar(&filename, "filename", "", "vaa backfiller filename")
-	vaaBackfillerCommand.Flags().IntVar(&workerCount, "worker-count", 100, "backfiller worker count")
-	vaaBackfillerCommand.Flags().BoolVar(&notifyEnabled, "notify-enabled", true, "backfiller notify pipeline")
-	vaaBackfillerCommand.Flags().StringVar(&awsRegion, "aws-region", "", "AWS region")
-	vaaBackfillerCommand.Flags().StringVar(&awsAccessKeyId, "aws-access-key-id", "", "AWS access key id")
-	vaaBackfillerCommand.Flags().StringVar(&awsSecretKey, "aws-secret-access-key", "", "AWS secret access key")
-	vaaBackfillerCommand.Flags().StringVar(&AwsEndpoint, "aws-endpoint", "", "AWS endpoint")
-	vaaBackfillerCommand.Flags().StringVar(&AwsSnsURL, "aws-sns-url", "", "AWS SNS URL")
-
-	vaaBackfillerCommand.MarkFlagRequired("mongo-uri")
-	vaaBackfillerCommand.MarkFlagRequired("mongo-database")
-	vaaBackfillerCommand.MarkFlagRequired("filename")
-	vaaBackfillerCommand.MarkFlagRequired("aws-region")
-	vaaBackfillerCommand.MarkFlagRequired("aws-sns-url")
-
+	addWorkerFlags(vaaBackfillerCommand, &cfg, "fly-vaa", true)
 	root.AddCommand(vaaBackfillerCommand)
-
 }
 
 func addTxHashCommand(root *cobra.Command) {
-	var mongoUri, mongoDb, filename, awsRegion, awsAccessKeyId, awsSecretKey, AwsEndpoint, AwsSnsURL string
-	var workerCount int
-	var notifyEnabled bool
+	var cfg WorkerConfiguration
 
 	txHashBackfillerCommand := &cobra.Command{
 		Use:   "txhash",
 		Short: "Run txhash backfiller",
 		Run: func(_ *cobra.Command, _ []string) {
-			workerConfiguration := WorkerConfiguration{
-				MongoURI:       mongoUri,
-				MongoDatabase:  mongoDb,
-				Filename:       filename,
-				WorkerCount:    workerCount,
-				AwsRegion:      awsRegion,
-				AwsAccessKeyId: awsAccessKeyId,
-				AwsSecretKey:   awsSecretKey,
-				AwsEndpoint:    AwsEndpoint,
-				AwsSnsURL:      AwsSnsURL,
-			}
-			RunBackfiller(workerConfiguration, workerTxHash)
+			RunBackfiller(cfg, workerTxHash)
 		},
 	}
-	txHashBackfillerCommand.Flags().StringVar(&mongoUri, "mongo-uri", "", "Mongo connection")
-	txHashBackfillerCommand.Flags().StringVar(&mongoDb, "mongo-database", "", "Mongo database")
-	txHashBackfillerCommand.Flags().StringVar(&filename, "filename", "", "vaa backfiller filename")
-	txHashBackfillerCommand.Flags().IntVar(&workerCount, "worker-count", 100, "backfiller worker count")
-	txHashBackfillerCommand.Flags().BoolVar(&notifyEnabled, "notify-enabled", false, "backfiller notify pipeline")
-	txHashBackfillerCommand.Flags().StringVar(&awsRegion, "aws-region", "", "AWS region")
-	txHashBackfillerCommand.Flags().StringVar(&awsAccessKeyId, "aws-access-key-id", "", "AWS access key id")
-	txHashBackfillerCommand.Flags().StringVar(&awsSecretKey, "aws-secret-access-key", "", "AWS secret access key")
-	txHashBackfillerCommand.Flags().StringVar(&AwsEndpoint, "aws-endpoint", "", "AWS endpoint")
-	txHashBackfillerCommand.Flags().StringVar(&AwsSnsURL, "aws-sns-url", "", "AWS SNS URL")
-
-	txHashBackfillerCommand.MarkFlagRequired("mongo-uri")
-	txHashBackfillerCommand.MarkFlagRequired("mongo-database")
-	txHashBackfillerCommand.MarkFlagRequired("filename")
-	txHashBackfillerCommand.MarkFlagRequired("aws-region")
-	txHashBackfillerCommand.MarkFlagRequired("aws-sns-url")
-
+	addWorkerFlags(txHashBackfillerCommand, &cfg, "fly-txhash", false)
 	root.AddCommand(txHashBackfillerCommand)
 }
 
+// addWorkerFlags adds the flags of the backfillers that read a file.
+func addWorkerFlags(cmd *cobra.Command, cfg *WorkerConfiguration, jobName string, notifyEnabled bool) {
+	cmd.Flags().StringVar(&cfg.LogLevel, "log-level", "INFO", "log level")
+	cmd.Flags().StringVar(&cfg.MongoURI, "mongo-uri", "", "Mongo connection")
+	cmd.Flags().StringVar(&cfg.MongoDatabase, "mongo-database", "", "Mongo database")
+	cmd.Flags().StringVar(&cfg.Filename, "filename", "", "vaa backfiller filename")
+	cmd.Flags().IntVar(&cfg.WorkerCount, "worker-count", 100, "backfiller worker count")
+	cmd.Flags().Int64Var(&cfg.PageSize, "page-size", 1000, "number of lines read from the file at a time")
+	cmd.Flags().Int64Var(&cfg.RequestsPerMinute, "requests-per-minute", 0, "maximum number of lines processed per minute (default no limit)")
+	cmd.Flags().StringVar(&cfg.JobName, "job-name", jobName, "name of the job used to store its checkpoint")
+	cmd.Flags().BoolVar(&cfg.Resume, "resume", false, "resume the job from its last checkpoint")
+	cmd.Flags().BoolVar(&cfg.DryRun, "dry-run", false, "read the lines without processing them")
+	cmd.Flags().StringVar(&cfg.FailuresFile, "failures-file", "", "csv file where the lines that failed are appended")
+	cmd.Flags().BoolVar(&cfg.NotifyEnabled, "notify-enabled", notifyEnabled, "backfiller notify pipeline")
+	cmd.Flags().StringVar(&cfg.AwsRegion, "aws-region", "", "AWS region")
+	cmd.Flags().StringVar(&cfg.AwsAccessKeyId, "aws-access-key-id", "", "AWS access key id")
+	cmd.Flags().StringVar(&cfg.AwsSecretKey, "aws-secret-access-key", "", "AWS secret access key")
+	cmd.Flags().StringVar(&cfg.AwsEndpoint, "aws-endpoint", "", "AWS endpoint")
+	cmd.Flags().StringVar(&cfg.AwsSnsURL, "aws-sns-url", "", "AWS SNS URL")
+
+	cmd.MarkFlagRequired("mongo-uri")
+	cmd.MarkFlagRequired("mongo-database")
+	cmd.MarkFlagRequired("filename")
+}
+
 func addTxHashEncodingCommand(root *cobra.Command) {
-	var logLevel, mongoUri, mongoDb string
-	var chainID uint16
-	var pageSize int64
+	var cfg TxHashEncondingConfig
 	txHashFixEncodingCommand := &cobra.Command{
 		Use:   "txHashEncoding",
 		Short: "Run txHash encoding backfiller",
 		Run: func(_ *cobra.Command, _ []string) {
-			cfg := TxHashEncondingConfig{
-				LogLevel:      logLevel,
-				MongoURI:      mongoUri,
-				MongoDatabase: mongoDb,
-				ChainID:       chainID,
-				PageSize:      pageSize,
-			}
 			RunTxHashEncoding(cfg)
 		},
 	}
 
-	txHashFixEncodingCommand.Flags().StringVar(&logLevel, "log-level", "info", "Log level")
-	txHashFixEncodingCommand.Flags().StringVar(&mongoUri, "mongo-uri", "", "Mongo connection")
-	txHashFixEncodingCommand.Flags().StringVar(&mongoDb, "mongo-database", "", "Mongo database")
-	txHashFixEncodingCommand.Flags().Uint16Var(&chainID, "chain-id", 0, "Chain ID")
-	txHashFixEncodingCommand.Flags().Int64Var(&pageSize, "page-size", 100, "Page size")
+	txHashFixEncodingCommand.Flags().StringVar(&cfg.LogLevel, "log-level", "info", "Log level")
+	txHashFixEncodingCommand.Flags().StringVar(&cfg.MongoURI, "mongo-uri", "", "Mongo connection")
+	txHashFixEncodingCommand.Flags().StringVar(&cfg.MongoDatabase, "mongo-database", "", "Mongo database")
+	txHashFixEncodingCommand.Flags().Uint16Var(&cfg.ChainID, "chain-id", 0, "Chain ID")
+	txHashFixEncodingCommand.Flags().Int64Var(&cfg.PageSize, "page-size", 100, "Page size")
+	txHashFixEncodingCommand.Flags().IntVar(&cfg.WorkerCount, "worker-count", 1, "number of VAA documents processed concurrently")
+	txHashFixEncodingCommand.Flags().Int64Var(&cfg.RequestsPerMinute, "requests-per-minute", 0, "maximum number of VAA documents processed per minute (default no limit)")
+	txHashFixEncodingCommand.Flags().StringVar(&cfg.JobName, "job-name", "", "name of the job used to store its checkpoint (default fly-txhash-encoding-<chain-id>)")
+	txHashFixEncodingCommand.Flags().BoolVar(&cfg.Resume, "resume", false, "resume the job from its last checkpoint")
+	txHashFixEncodingCommand.Flags().BoolVar(&cfg.DryRun, "dry-run", false, "read the VAA documents without processing them")
+	txHashFixEncodingCommand.Flags().StringVar(&cfg.FailuresFile, "failures-file", "", "csv file where the VAA documents that failed are appended")
 
 	txHashFixEncodingCommand.MarkFlagRequired("mongo-uri")
 	txHashFixEncodingCommand.MarkFlagRequired("mongo-database")
@@ -3,12 +3,18 @@ package main
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/backfill"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/event"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/producer"
@@ -19,24 +25,31 @@ import (
 )
 
 type TxHashEncondingConfig struct {
-	LogLevel      string `env:"LOG_LEVEL,required"`
-	MongoURI      string `env:"MONGODB_URI,required"`
-	MongoDatabase string `env:"MONGODB_DATABASE,required"`
-	ChainID       uint16 `env:"CHAIN_ID,required"`
-	PageSize      int64  `env:"PAGE_SIZE,required"`
+	LogLevel          string `env:"LOG_LEVEL,required"`
+	MongoURI          string `env:"MONGODB_URI,required"`
+	MongoDatabase     string `env:"MONGODB_DATABASE,required"`
+	ChainID           uint16 `env:"CHAIN_ID,required"`
+	PageSize          int64  `env:"PAGE_SIZE,required"`
+	WorkerCount       int    `env:"WORKER_COUNT"`
+	RequestsPerMinute int64  `env:"REQUESTS_PER_MINUTE"`
+	JobName           string `env:"JOB_NAME,required"`
+	Resume            bool   `env:"RESUME"`
+	DryRun            bool   `env:"DRY_RUN"`
+	FailuresFile      string `env:"FAILURES_FILE"`
 }
 
 func RunTxHashEncoding(cfg TxHashEncondingConfig) {
-	ctx := context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
 	logger := logger.New("wormhole-fly", logger.WithLevel(cfg.LogLevel))
 
 	db, err := dbutil.Connect(ctx, logger, cfg.MongoURI, cfg.MongoDatabase, false)
 	if err != nil {
 		logger.Fatal("could not connect to DB", zap.Error(err))
 	}
-	defer db.DisconnectWithTimeout(10 * time.Second)
 
-	repository := storage.NewRepository(
+	repo := storage.NewRepository(
 		alert.NewDummyClient(),
 		metrics.NewDummyMetrics(),
 		db.Database,
@@ -45,59 +58,70 @@ func RunTxHashEncoding(cfg TxHashEncondingConfig) {
 		event.NewNoopEventDispatcher(),
 		logger)
 
-	workerTxHashEncoding(ctx, logger, repository, vaa.ChainID(cfg.ChainID), cfg.PageSize)
-}
+	chainID := vaa.ChainID(cfg.ChainID)
+	if cfg.JobName == "" {
+		cfg.JobName = fmt.Sprintf("fly-txhash-encoding-%d", cfg.ChainID)
+	}
+	log := logger.With(zap.String("chainID", chainID.String()))
+	strategy := backfill.StrategyFunc[*repository.VaaDoc](func(ctx context.Context, v *repository.VaaDoc) error {
+		return workerTxHashEncoding(ctx, log, repo, chainID, v)
+	})
+	jobConfig := backfill.Config{
+		Name:              cfg.JobName,
+		PageSize:          cfg.PageSize,
+		Workers:           cfg.WorkerCount,
+		RequestsPerMinute: cfg.RequestsPerMinute,
+		Resume:            cfg.Resume,
+		DryRun:            cfg.DryRun,
+		FailuresFile:      cfg.FailuresFile,
+	}
+	query := repository.VaaQuery{EmitterChainID: &chainID}
+	source := backfill.NewVaaSource(repository.NewVaaRepository(db.Database, logger), query)
+	job := backfill.NewJob[*repository.VaaDoc](jobConfig, source, strategy, backfill.NewMongoCheckpointStore(db.Database), logger)
 
-func workerTxHashEncoding(ctx context.Context, logger *zap.Logger, repo *storage.Repository, chainID vaa.ChainID, pageSize int64) {
+	checkpoint, err := job.Run(ctx)
+	if err != nil {
+		log.Error("Backfill interrupted, run it again with --resume to continue", zap.Error(err))
+	}
 
-	log := logger.With(zap.String("chainID", chainID.String()))
-	log.Info("Processing chain")
-	page := int64(0)
-	for {
-		log.Info("Processing page", zap.Int64("page", page))
+	db.DisconnectWithTimeout(10 * time.Second)
 
-		vaas, err := repo.FindVaaByChainID(ctx, chainID, page, pageSize)
-		if err != nil {
-			log.Error("Failed to get vaas", zap.Error(err))
-			break
-		}
+	if checkpoint != nil {
+		log.Info("Finished txHash encoding backfiller",
+			zap.Uint64("processed", checkpoint.Processed),
+			zap.Uint64("failed", checkpoint.Failed))
+	}
+	if err != nil {
+		os.Exit(1)
+	}
+}
 
-		if len(vaas) == 0 {
-			log.Info("Empty page", zap.Int64("page", page))
-			break
-		}
-		for _, v := range vaas {
-			l := log.With(zap.String("vaaId", v.ID), zap.String("txHash", v.TxHash))
-			// check if txHash is already processed
-			if v.OriginTxHash != nil && *v.OriginTxHash != "" {
-				l.Debug("Already processed")
-				continue
-			}
-			// check if txHash is not a hexadecimal, ignore it
-			if len(v.TxHash) != 64 && len(v.TxHash) != 66 {
-				l.Debug("txHash is not hexadecimal, ignore it")
-				continue
-			}
+func workerTxHashEncoding(ctx context.Context, logger *zap.Logger, repo *storage.Repository, chainID vaa.ChainID, v *repository.VaaDoc) error {
+	l := logger.With(zap.String("vaaId", v.ID), zap.String("txHash", v.TxHash))
+	// check if txHash is already processed
+	if v.OriginTxHash != nil && *v.OriginTxHash != "" {
+		l.Debug("Already processed")
+		return nil
+	}
+	// check if txHash is not a hexadecimal, ignore it
+	if len(v.TxHash) != 64 && len(v.TxHash) != 66 {
+		l.Debug("txHash is not hexadecimal, ignore it")
+		return nil
+	}
 
-			hexTxHash, err := hex.DecodeString(v.TxHash)
-			// txHash is not hex
-			if err != nil {
-				l.Error("txHash can not decode to hexadecimal", zap.Error(err))
-				continue
-			}
+	hexTxHash, err := hex.DecodeString(v.TxHash)
+	// txHash is not hex
+	if err != nil {
+		return fmt.Errorf("txHash can not decode to hexadecimal: %w", err)
+	}
 
-			newTxHash, err := domain.EncodeTrxHashByChainID(chainID, hexTxHash)
-			if err != nil {
-				l.Error("Failed to encode txHash", zap.String("vaaId", v.ID), zap.String("txHash", v.TxHash), zap.Error(err))
-				continue
-			}
-			err = repo.ReplaceVaaTxHash(ctx, v.ID, v.TxHash, newTxHash)
-			if err != nil {
-				l.Error("replacing txHash", zap.Error(err))
-				continue
-			}
-			l.Debug("Processing vaa")
-		}
-		page++
+	newTxHash, err := domain.EncodeTrxHashByChainID(chainID, hexTxHash)
+	if err != nil {
+		return fmt.Errorf("failed to encode txHash: %w", err)
+	}
+	if err := repo.ReplaceVaaTxHash(ctx, v.ID, v.TxHash, newTxHash); err != nil {
+		return fmt.Errorf("replacing txHash: %w", err)
 	}
+	l.Debug("Processing vaa")
+	return nil
 }
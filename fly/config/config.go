@@ -2,11 +2,13 @@ package config
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/joho/godotenv"
 	"github.com/sethvargo/go-envconfig"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
 )
 
 // p2p network configuration constants.
@@ -55,15 +57,20 @@ type Configuration struct {
 	P2pPort                   uint   `env:"P2P_PORT,required"`
 	PprofEnabled              bool   `env:"PPROF_ENABLED"`
 	MaxHealthTimeSeconds      int64  `env:"MAX_HEALTH_TIME_SECONDS,default=60"`
+	HeartbeatSampleSeconds    int64  `env:"HEARTBEAT_SAMPLE_INTERVAL_SECONDS,default=60"`
 	IsLocal                   bool
 	Redis                     *RedisConfiguration
 	Aws                       *AwsConfiguration
+	MessageBus                *MessageBusConfiguration
 	ObservationsDedup         Cache `env:", prefix=OBSERVATIONS_DEDUP_,required"`
 	ObservationsTxHash        Cache `env:", prefix=OBSERVATIONS_TX_HASH_,required"`
 	VaasDedup                 Cache `env:", prefix=VAAS_DEDUP_,required"`
 	VaasPythDedup             Cache `env:", prefix=VAAS_PYTH_DEDUP_,required"`
 
 	EthereumUrl string `env:"ETHEREUM_URL,required"`
+
+	GuardianMiss GuardianMissConfiguration `env:", prefix=GUARDIAN_MISS_"`
+	SequenceGap  SequenceGapConfiguration  `env:", prefix=SEQUENCE_GAP_"`
 }
 
 type RedisConfiguration struct {
@@ -82,6 +89,41 @@ type AwsConfiguration struct {
 	EventsSnsUrl       string `env:"EVENTS_SNS_URL,required"`
 }
 
+// MessageBusConfiguration is the configuration of the backend of the queues and topics, sqs or redis.
+// When the backend is redis, the queues and topics are Redis streams and the aws configuration is not used.
+type MessageBusConfiguration struct {
+	Backend              string `env:"MESSAGE_BUS,default=sqs"`
+	RedisURI             string `env:"MESSAGE_BUS_REDIS_URI"`
+	ConsumerGroup        string `env:"MESSAGE_BUS_CONSUMER_GROUP,default=fly"`
+	VaasStream           string `env:"VAAS_STREAM,default=fly-vaas"`
+	ObservationsStream   string `env:"OBSERVATIONS_STREAM,default=fly-observations"`
+	DuplicateVaaStream   string `env:"DUPLICATE_VAA_STREAM,default=duplicated-vaa"`
+	GovernorStatusStream string `env:"GOVERNOR_STATUS_STREAM,default=governor-status"`
+}
+
+// GuardianMissConfiguration is the configuration of the detection of the guardians that stop observing a chain.
+type GuardianMissConfiguration struct {
+	Enabled             bool    `env:"ENABLED"`
+	IntervalSeconds     int64   `env:"INTERVAL_SECONDS,default=300"`
+	WindowSeconds       int64   `env:"WINDOW_SECONDS,default=3600"`
+	BaselineSeconds     int64   `env:"BASELINE_SECONDS,default=86400"`
+	MinVaas             int     `env:"MIN_VAAS,default=20"`
+	MaxBaselineMissRate float64 `env:"MAX_BASELINE_MISS_RATE,default=0.5"`
+}
+
+// SequenceGapConfiguration is the configuration of the detection and backfill of the missing vaa sequences.
+type SequenceGapConfiguration struct {
+	Enabled                   bool     `env:"ENABLED"`
+	IntervalSeconds           int64    `env:"INTERVAL_SECONDS,default=600"`
+	WindowSeconds             int64    `env:"WINDOW_SECONDS,default=86400"`
+	SettleSeconds             int64    `env:"SETTLE_SECONDS,default=1800"`
+	MaxRange                  uint64   `env:"MAX_RANGE,default=10000"`
+	MaxBackfillsPerRun        int      `env:"MAX_BACKFILLS_PER_RUN,default=100"`
+	RetrySeconds              int64    `env:"RETRY_SECONDS,default=3600"`
+	GuardianApiUrls           []string `env:"GUARDIAN_API_URLS"`
+	GuardianApiTimeoutSeconds int64    `env:"GUARDIAN_API_TIMEOUT_SECONDS,default=10"`
+}
+
 type Cache struct {
 	ExpirationInSeconds int64 `env:"CACHE_EXPIRATION_SECONDS,required"`
 	NumKeys             int64 `env:"CACHE_NUM_KEYS,required"`
@@ -106,11 +148,26 @@ func New(ctx context.Context, isLocal *bool) (*Configuration, error) {
 		}
 		configuration.Redis = &redis
 
-		var aws AwsConfiguration
-		if err := envconfig.Process(ctx, &aws); err != nil {
+		var messageBus MessageBusConfiguration
+		if err := envconfig.Process(ctx, &messageBus); err != nil {
 			return nil, err
 		}
-		configuration.Aws = &aws
+		if err := messagebus.ValidateBackend(messageBus.Backend); err != nil {
+			return nil, err
+		}
+		configuration.MessageBus = &messageBus
+
+		if messageBus.Backend == messagebus.BackendRedis {
+			if messageBus.RedisURI == "" {
+				return nil, errors.New("MESSAGE_BUS_REDIS_URI is required when the message bus is redis")
+			}
+		} else {
+			var aws AwsConfiguration
+			if err := envconfig.Process(ctx, &aws); err != nil {
+				return nil, err
+			}
+			configuration.Aws = &aws
+		}
 	}
 
 	return &configuration, nil
@@ -0,0 +1,80 @@
+package event
+
+import (
+	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/internal/track"
+)
+
+// MessageBusEventDispatcher publishes the events to the message bus.
+//
+// In SNS the events are published to the same topic and the subscriptions filter them by the
+// messageType attribute. Redis streams can not filter the messages, so each type of event is
+// published to its own stream.
+type MessageBusEventDispatcher struct {
+	duplicateVaaProducer   messagebus.Producer
+	governorStatusProducer messagebus.Producer
+}
+
+// NewMessageBusEventDispatcher creates a MessageBusEventDispatcher.
+func NewMessageBusEventDispatcher(duplicateVaaProducer, governorStatusProducer messagebus.Producer) *MessageBusEventDispatcher {
+	return &MessageBusEventDispatcher{
+		duplicateVaaProducer:   duplicateVaaProducer,
+		governorStatusProducer: governorStatusProducer,
+	}
+}
+
+func (s *MessageBusEventDispatcher) NewDuplicateVaa(ctx context.Context, e DuplicateVaa) error {
+	body, err := json.Marshal(event{
+		TrackID: track.GetTrackIDForDuplicatedVAA(e.VaaID),
+		Type:    "duplicated-vaa",
+		Source:  "fly",
+		Data:    e,
+	})
+	if err != nil {
+		return err
+	}
+	groupID := createDeduplicationIDForDuplicateVaa(e)
+	return s.duplicateVaaProducer.Publish(ctx, &messagebus.OutgoingMessage{
+		GroupID:         groupID,
+		DeduplicationID: groupID,
+		Body:            body,
+		Attributes:      map[string]string{"messageType": "duplicated-vaa"},
+	})
+}
+
+func createDeduplicationIDForDuplicateVaa(e DuplicateVaa) string {
+	id := fmt.Sprintf("%s%s", e.Digest, e.VaaID)
+	h := sha512.New()
+	io.WriteString(h, id)
+	deduplicationID := base64.StdEncoding.EncodeToString(h.Sum(nil))
+	if len(deduplicationID) > 127 {
+		return deduplicationID[:127]
+	}
+	return deduplicationID
+}
+
+func (s *MessageBusEventDispatcher) NewGovernorStatus(ctx context.Context, e GovernorStatus) error {
+	body, err := json.Marshal(event{
+		TrackID: track.GetTrackIDForGovernorStatus(e.NodeName, e.Timestamp),
+		Type:    "governor-status",
+		Source:  "fly",
+		Data:    e,
+	})
+	if err != nil {
+		return err
+	}
+	groupID := fmt.Sprintf("%s-%v", e.NodeAddress, e.Timestamp)
+	return s.governorStatusProducer.Publish(ctx, &messagebus.OutgoingMessage{
+		GroupID:         groupID,
+		DeduplicationID: groupID,
+		Body:            body,
+		Attributes:      map[string]string{"messageType": "governor"},
+	})
+}
@@ -7,8 +7,6 @@ require (
 	github.com/aws/aws-sdk-go-v2 v1.18.0
 	github.com/aws/aws-sdk-go-v2/config v1.15.1
 	github.com/aws/aws-sdk-go-v2/credentials v1.11.0
-	github.com/aws/aws-sdk-go-v2/service/sns v1.20.2
-	github.com/aws/aws-sdk-go-v2/service/sqs v1.20.2
 	github.com/certusone/wormhole/node v0.0.0-20240416174455-25e60611a867
 	github.com/dgraph-io/ristretto v0.1.1
 	github.com/eko/gocache/v3 v3.1.2
@@ -38,6 +36,8 @@ require (
 	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.4.22 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.3.34 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.9.1 // indirect
+	github.com/aws/aws-sdk-go-v2/service/sns v1.20.2 // indirect
+	github.com/aws/aws-sdk-go-v2/service/sqs v1.20.2 // indirect
 	github.com/aws/aws-sdk-go-v2/service/sso v1.11.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/sts v1.16.1 // indirect
 	github.com/aws/smithy-go v1.13.5 // indirect
@@ -128,7 +128,6 @@ require (
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/bgentry/speakeasy v0.1.0 // indirect
 	github.com/bradfitz/gomemcache v0.0.0-20221031212613-62deef7fc822 // indirect
-	github.com/briandowns/spinner v1.20.0
 	github.com/btcsuite/btcd v0.22.1 // indirect
 	github.com/btcsuite/btcd/btcec/v2 v2.3.2 // indirect
 	github.com/cenkalti/backoff/v4 v4.2.0 // indirect
@@ -306,15 +305,9 @@ require (
 	lukechampine.com/blake3 v1.2.1 // indirect
 )
 
-require (
-	github.com/fatih/color v1.14.1 // indirect
-	github.com/schollz/progressbar/v3 v3.13.0
-)
+require github.com/fatih/color v1.14.1 // indirect
 
-require (
-	github.com/mitchellh/colorstring v0.0.0-20190213212951-d06e56a500db // indirect
-	github.com/wormhole-foundation/wormhole-explorer/common v0.0.0-00010101000000-000000000000
-)
+require github.com/wormhole-foundation/wormhole-explorer/common v0.0.0-00010101000000-000000000000
 
 // Needed for cosmos-sdk based chains.  See
 // https://github.com/cosmos/cosmos-sdk/issues/10925 for more details.
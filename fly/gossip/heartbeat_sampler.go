@@ -0,0 +1,57 @@
+package gossip
+
+import (
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/storage"
+)
+
+// heartbeatSampler decides which heartbeats are stored in the heartbeat history.
+//
+// Guardians send a heartbeat every few seconds, so only one heartbeat per guardian
+// and interval is sampled. The intervals are aligned to the clock, so every fly replica
+// samples the same intervals. Restarts and version changes are always recorded.
+// It is not safe for concurrent use.
+type heartbeatSampler struct {
+	interval time.Duration
+	last     map[string]heartbeatSamplerState
+}
+
+type heartbeatSamplerState struct {
+	sampledAt     time.Time
+	bootTimestamp int64
+	version       string
+}
+
+func newHeartbeatSampler(interval time.Duration) *heartbeatSampler {
+	return &heartbeatSampler{
+		interval: interval,
+		last:     make(map[string]heartbeatSamplerState),
+	}
+}
+
+// sample returns the event of the heartbeat history entry for a heartbeat received
+// at the given time, or false if the heartbeat must not be sampled.
+func (s *heartbeatSampler) sample(hb *gossipv1.Heartbeat, now time.Time) (string, bool) {
+	last, ok := s.last[hb.GuardianAddr]
+
+	var event string
+	switch {
+	case ok && last.bootTimestamp != hb.BootTimestamp:
+		event = storage.HeartbeatEventBoot
+	case ok && last.version != hb.Version:
+		event = storage.HeartbeatEventVersion
+	case !ok || !now.Truncate(s.interval).Equal(last.sampledAt.Truncate(s.interval)):
+		event = storage.HeartbeatEventSample
+	default:
+		return "", false
+	}
+
+	s.last[hb.GuardianAddr] = heartbeatSamplerState{
+		sampledAt:     now,
+		bootTimestamp: hb.BootTimestamp,
+		version:       hb.Version,
+	}
+	return event, true
+}
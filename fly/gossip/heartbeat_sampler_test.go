@@ -0,0 +1,50 @@
+package gossip
+
+import (
+	"testing"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/storage"
+)
+
+func TestHeartbeatSampler(t *testing.T) {
+	sampler := newHeartbeatSampler(time.Minute)
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	hb := &gossipv1.Heartbeat{GuardianAddr: "0x01", BootTimestamp: 1, Version: "v2.24.0"}
+
+	// the first heartbeat of a guardian is always sampled.
+	event, ok := sampler.sample(hb, now)
+	assert.True(t, ok)
+	assert.Equal(t, storage.HeartbeatEventSample, event)
+
+	// heartbeats within the interval are skipped.
+	_, ok = sampler.sample(hb, now.Add(10*time.Second))
+	assert.False(t, ok)
+
+	// other guardians are sampled independently.
+	_, ok = sampler.sample(&gossipv1.Heartbeat{GuardianAddr: "0x02", BootTimestamp: 1}, now.Add(10*time.Second))
+	assert.True(t, ok)
+
+	event, ok = sampler.sample(hb, now.Add(time.Minute))
+	assert.True(t, ok)
+	assert.Equal(t, storage.HeartbeatEventSample, event)
+
+	// the intervals are aligned to the clock.
+	_, ok = sampler.sample(hb, now.Add(time.Minute+59*time.Second))
+	assert.False(t, ok)
+	_, ok = sampler.sample(hb, now.Add(2*time.Minute+time.Second))
+	assert.True(t, ok)
+
+	// restarts and version changes are recorded even within the interval.
+	restarted := &gossipv1.Heartbeat{GuardianAddr: "0x01", BootTimestamp: 2, Version: "v2.24.0"}
+	event, ok = sampler.sample(restarted, now.Add(2*time.Minute+2*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, storage.HeartbeatEventBoot, event)
+
+	upgraded := &gossipv1.Heartbeat{GuardianAddr: "0x01", BootTimestamp: 2, Version: "v2.25.0"}
+	event, ok = sampler.sample(upgraded, now.Add(2*time.Minute+3*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, storage.HeartbeatEventVersion, event)
+}
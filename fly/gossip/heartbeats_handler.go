@@ -2,6 +2,7 @@ package gossip
 
 import (
 	"context"
+	"time"
 
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 
@@ -16,21 +17,30 @@ type heartbeatsHandler struct {
 	repository  *storage.Repository
 	guardian    *health.GuardianCheck
 	metrics     metrics.Metrics
+	sampler     *heartbeatSampler
 	logger      *zap.Logger
 }
 
+// NewHeartbeatsHandler creates a heartbeats handler.
+// Heartbeats are sampled into the heartbeat history every sampleInterval, a zero interval disables the history.
 func NewHeartbeatsHandler(
 	heartbeatsC chan *gossipv1.Heartbeat,
 	repository *storage.Repository,
 	guardian *health.GuardianCheck,
 	metrics metrics.Metrics,
+	sampleInterval time.Duration,
 	logger *zap.Logger,
 ) *heartbeatsHandler {
+	var sampler *heartbeatSampler
+	if sampleInterval > 0 {
+		sampler = newHeartbeatSampler(sampleInterval)
+	}
 	return &heartbeatsHandler{
 		heartbeatsC: heartbeatsC,
 		repository:  repository,
 		guardian:    guardian,
 		metrics:     metrics,
+		sampler:     sampler,
 		logger:      logger,
 	}
 }
@@ -51,7 +61,23 @@ func (h *heartbeatsHandler) Start(ctx context.Context) {
 				} else {
 					h.metrics.IncHeartbeatInserted(hb.NodeName)
 				}
+				h.sampleHeartbeat(ctx, hb)
 			}
 		}
 	}()
 }
+
+// sampleHeartbeat stores the heartbeat in the heartbeat history when it is sampled.
+func (h *heartbeatsHandler) sampleHeartbeat(ctx context.Context, hb *gossipv1.Heartbeat) {
+	if h.sampler == nil {
+		return
+	}
+	now := time.Now()
+	event, ok := h.sampler.sample(hb, now)
+	if !ok {
+		return
+	}
+	if err := h.repository.InsertHeartbeatSample(ctx, storage.NewHeartbeatSample(hb, event, now, h.sampler.interval)); err != nil {
+		h.logger.Error("Error inserting heartbeat sample", zap.String("nodeName", hb.NodeName), zap.Error(err))
+	}
+}
@@ -0,0 +1,180 @@
+// Package guardianmiss detects the guardians that stop observing a chain by comparing the
+// signers of the vaas with the guardians of their guardian set.
+package guardianmiss
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/periodic"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/guardiansets"
+	flyAlert "github.com/wormhole-foundation/wormhole-explorer/fly/internal/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/internal/metrics"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+const (
+	// bucketSize is the time resolution of the windows.
+	bucketSize = 5 * time.Minute
+	// readDelay is the time to wait before reading the vaas indexed, to not miss the ones still being written.
+	readDelay = 1 * time.Minute
+)
+
+// Config is the configuration of the Detector.
+type Config struct {
+	// Interval is the time between runs.
+	Interval time.Duration
+	// Window is the recent window where a guardian is expected to sign the vaas.
+	Window time.Duration
+	// Baseline is the window before the recent one used to know the chains covered by a guardian.
+	Baseline time.Duration
+	// MinVaas is the minimum number of vaas of a chain in both windows to evaluate a guardian.
+	MinVaas int
+	// MaxBaselineMissRate is the maximum miss rate in the baseline window to consider a chain covered by a guardian.
+	MaxBaselineMissRate float64
+}
+
+// Detector computes per-guardian, per-chain miss rates over rolling windows and raises an alert
+// when a guardian stops observing a chain it previously covered.
+type Detector struct {
+	vaas        *mongo.Collection
+	gsHistory   *guardiansets.GuardianSetHistory
+	alertClient alert.AlertClient
+	metrics     metrics.Metrics
+	cfg         Config
+	stats       *stats
+	cursor      time.Time
+	alerted     map[Key]bool
+	logger      *zap.Logger
+}
+
+// NewDetector creates a new Detector.
+func NewDetector(db *mongo.Database, gsHistory *guardiansets.GuardianSetHistory, alertClient alert.AlertClient,
+	metrics metrics.Metrics, cfg Config, logger *zap.Logger) *Detector {
+	return &Detector{
+		vaas:        db.Collection(repository.Vaas),
+		gsHistory:   gsHistory,
+		alertClient: alertClient,
+		metrics:     metrics,
+		cfg:         cfg,
+		stats:       newStats(bucketSize),
+		alerted:     make(map[Key]bool),
+		logger:      logger.With(zap.String("module", "GuardianMissDetector")),
+	}
+}
+
+// Start runs the detector periodically until the context is cancelled. Only the instance that
+// holds the lock runs it, so the replicas of the service do not run it at the same time.
+func (d *Detector) Start(ctx context.Context, lock periodic.Lock) {
+	periodic.NewRunner("guardian-miss-detector", d.cfg.Interval, d.run, d.logger, periodic.WithLock(lock)).Start(ctx)
+}
+
+// run reads the vaas indexed since the last run and evaluates the windows ending at now.
+func (d *Detector) run(ctx context.Context, now time.Time) error {
+	to := now.Add(-readDelay).Truncate(bucketSize)
+	windowStart := to.Add(-d.cfg.Window)
+	baselineStart := windowStart.Add(-d.cfg.Baseline)
+	if d.cursor.Before(baselineStart) {
+		d.cursor = baselineStart
+	}
+
+	if err := d.load(ctx, to); err != nil {
+		return err
+	}
+	d.stats.prune(baselineStart)
+
+	recent := d.stats.counts(windowStart, to)
+	for k, c := range recent {
+		d.metrics.SetGuardianMissRate(k.GuardianAddr, k.Chain, c.MissRate())
+	}
+
+	baseline := d.stats.counts(baselineStart, windowStart)
+	stopped := stoppedObserving(baseline, recent, d.cfg.MinVaas, d.cfg.MaxBaselineMissRate)
+	active := make(map[Key]bool, len(stopped))
+	for _, k := range stopped {
+		active[k] = true
+		if d.alerted[k] {
+			continue
+		}
+		d.logger.Warn("Guardian stopped observing chain",
+			zap.String("guardianAddr", k.GuardianAddr),
+			zap.Stringer("chain", k.Chain),
+			zap.Float64("baselineMissRate", baseline[k].MissRate()),
+			zap.Int("recentVaas", recent[k].Expected))
+		d.sendAlert(ctx, k, baseline[k], recent[k])
+	}
+	d.alerted = active
+	return nil
+}
+
+// load reads the vaas indexed in the range (cursor, to] and adds their signatures to the stats.
+func (d *Detector) load(ctx context.Context, to time.Time) error {
+	if !d.cursor.Before(to) {
+		return nil
+	}
+	filter := bson.M{"indexedAt": bson.M{"$gt": d.cursor, "$lte": to}}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "indexedAt", Value: 1}}).
+		SetProjection(bson.M{"vaas": 1, "indexedAt": 1})
+	cur, err := d.vaas.Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var doc struct {
+			Vaa       []byte    `bson:"vaas"`
+			IndexedAt time.Time `bson:"indexedAt"`
+		}
+		if err := cur.Decode(&doc); err != nil {
+			return err
+		}
+		v, err := sdk.Unmarshal(doc.Vaa)
+		if err != nil {
+			d.logger.Warn("Error unmarshalling vaa", zap.Error(err))
+			continue
+		}
+		gs, ok := d.gsHistory.GetByIndex(v.GuardianSetIndex)
+		if !ok {
+			continue
+		}
+		d.stats.add(v, &gs, doc.IndexedAt)
+	}
+	if err := cur.Err(); err != nil {
+		return err
+	}
+	d.cursor = to
+	return nil
+}
+
+// sendAlert raises an alert for a guardian that stopped observing a chain.
+// The alias of the alert includes the guardian and the chain to not merge the alerts of different guardians.
+func (d *Detector) sendAlert(ctx context.Context, k Key, baseline, recent Counts) {
+	alertContext := alert.AlertContext{
+		Details: map[string]string{
+			"guardianAddr":     k.GuardianAddr,
+			"chain":            k.Chain.String(),
+			"baselineMissRate": fmt.Sprintf("%.2f", baseline.MissRate()),
+			"baselineVaas":     fmt.Sprint(baseline.Expected),
+			"recentVaas":       fmt.Sprint(recent.Expected),
+			"window":           d.cfg.Window.String(),
+		},
+	}
+	a, err := d.alertClient.CreateAlert(flyAlert.GuardianStoppedObserving, alertContext)
+	if err != nil {
+		d.logger.Error("Error creating alert", zap.Error(err))
+		return
+	}
+	a.Alias = fmt.Sprintf("%s-%s-%d", a.Alias, k.GuardianAddr, k.Chain)
+	if err := d.alertClient.Send(ctx, a); err != nil {
+		d.logger.Error("Error sending alert", zap.Error(err))
+	}
+}
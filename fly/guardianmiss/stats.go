@@ -0,0 +1,124 @@
+package guardianmiss
+
+import (
+	"sort"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// Key identifies the vaas of a chain expected to be signed by a guardian.
+type Key struct {
+	GuardianAddr string
+	Chain        sdk.ChainID
+}
+
+// Counts is the number of vaas expected to be signed by a guardian and the ones it signed.
+type Counts struct {
+	Expected int
+	Signed   int
+}
+
+// MissRate returns the rate of the expected vaas not signed by the guardian.
+func (c Counts) MissRate() float64 {
+	if c.Expected == 0 {
+		return 0
+	}
+	return 1 - float64(c.Signed)/float64(c.Expected)
+}
+
+// stats accumulates the signatures of the vaas in time buckets.
+type stats struct {
+	bucketSize time.Duration
+	buckets    map[int64]map[Key]*Counts
+}
+
+func newStats(bucketSize time.Duration) *stats {
+	return &stats{
+		bucketSize: bucketSize,
+		buckets:    make(map[int64]map[Key]*Counts),
+	}
+}
+
+// add counts the signers of a vaa against the guardians of its guardian set.
+func (s *stats) add(v *sdk.VAA, gs *common.GuardianSet, t time.Time) {
+	signed := make(map[int]bool, len(v.Signatures))
+	for _, sig := range v.Signatures {
+		signed[int(sig.Index)] = true
+	}
+
+	bucket := s.bucket(t)
+	for i, addr := range gs.Keys {
+		k := Key{GuardianAddr: addr.Hex(), Chain: v.EmitterChain}
+		c, ok := bucket[k]
+		if !ok {
+			c = &Counts{}
+			bucket[k] = c
+		}
+		c.Expected++
+		if signed[i] {
+			c.Signed++
+		}
+	}
+}
+
+func (s *stats) bucket(t time.Time) map[Key]*Counts {
+	start := t.Truncate(s.bucketSize).Unix()
+	bucket, ok := s.buckets[start]
+	if !ok {
+		bucket = make(map[Key]*Counts)
+		s.buckets[start] = bucket
+	}
+	return bucket
+}
+
+// counts returns the counts of the buckets that start in the range [from, to).
+func (s *stats) counts(from, to time.Time) map[Key]Counts {
+	result := make(map[Key]Counts)
+	for start, bucket := range s.buckets {
+		if start < from.Unix() || start >= to.Unix() {
+			continue
+		}
+		for k, c := range bucket {
+			r := result[k]
+			r.Expected += c.Expected
+			r.Signed += c.Signed
+			result[k] = r
+		}
+	}
+	return result
+}
+
+// prune removes the buckets that start before the given time.
+func (s *stats) prune(before time.Time) {
+	for start := range s.buckets {
+		if start < before.Unix() {
+			delete(s.buckets, start)
+		}
+	}
+}
+
+// stoppedObserving returns the guardians that signed the vaas of a chain in the baseline window
+// and did not sign any of the vaas of the chain in the recent window.
+// Only chains with at least minVaas vaas in both windows are considered.
+func stoppedObserving(baseline, recent map[Key]Counts, minVaas int, maxBaselineMissRate float64) []Key {
+	var stopped []Key
+	for k, b := range baseline {
+		if b.Expected < minVaas || b.MissRate() > maxBaselineMissRate {
+			continue
+		}
+		r, ok := recent[k]
+		if !ok || r.Expected < minVaas || r.Signed > 0 {
+			continue
+		}
+		stopped = append(stopped, k)
+	}
+	sort.Slice(stopped, func(i, j int) bool {
+		if stopped[i].GuardianAddr != stopped[j].GuardianAddr {
+			return stopped[i].GuardianAddr < stopped[j].GuardianAddr
+		}
+		return stopped[i].Chain < stopped[j].Chain
+	})
+	return stopped
+}
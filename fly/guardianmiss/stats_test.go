@@ -0,0 +1,50 @@
+package guardianmiss
+
+import (
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestStoppedObserving(t *testing.T) {
+	g0 := eth_common.HexToAddress("0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5")
+	g1 := eth_common.HexToAddress("0xfF6CB952589BDE862c25Ef4392132fb9D4A42157")
+	gs := &common.GuardianSet{Keys: []eth_common.Address{g0, g1}}
+
+	s := newStats(bucketSize)
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	windowStart := start.Add(2 * time.Hour)
+	end := windowStart.Add(time.Hour)
+
+	// both guardians sign the vaas of ethereum in the baseline window, and only g0 in the recent one.
+	for i := 0; i < 20; i++ {
+		v := &sdk.VAA{EmitterChain: sdk.ChainIDEthereum, Signatures: []*sdk.Signature{{Index: 0}, {Index: 1}}}
+		s.add(v, gs, start.Add(time.Duration(i)*time.Minute))
+		v = &sdk.VAA{EmitterChain: sdk.ChainIDEthereum, Signatures: []*sdk.Signature{{Index: 0}}}
+		s.add(v, gs, windowStart.Add(time.Duration(i)*time.Minute))
+	}
+	// g1 never signs the vaas of solana.
+	for i := 0; i < 20; i++ {
+		v := &sdk.VAA{EmitterChain: sdk.ChainIDSolana, Signatures: []*sdk.Signature{{Index: 0}}}
+		s.add(v, gs, start.Add(time.Duration(i)*time.Minute))
+		s.add(v, gs, windowStart.Add(time.Duration(i)*time.Minute))
+	}
+
+	baseline := s.counts(start, windowStart)
+	recent := s.counts(windowStart, end)
+	assert.Equal(t, Counts{Expected: 20, Signed: 20}, baseline[Key{GuardianAddr: g1.Hex(), Chain: sdk.ChainIDEthereum}])
+	assert.Equal(t, float64(1), recent[Key{GuardianAddr: g1.Hex(), Chain: sdk.ChainIDEthereum}].MissRate())
+
+	stopped := stoppedObserving(baseline, recent, 20, 0.5)
+	assert.Equal(t, []Key{{GuardianAddr: g1.Hex(), Chain: sdk.ChainIDEthereum}}, stopped)
+
+	// not enough vaas in the windows.
+	assert.Empty(t, stoppedObserving(baseline, recent, 21, 0.5))
+
+	s.prune(windowStart)
+	assert.Empty(t, s.counts(start, windowStart))
+}
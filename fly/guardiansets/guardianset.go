@@ -9,6 +9,7 @@ import (
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	flyAlert "github.com/wormhole-foundation/wormhole-explorer/fly/internal/alert"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
@@ -28,16 +29,14 @@ type GuardianSetHistory struct {
 	alertClient            alert.AlertClient
 }
 
-// Verify takes a VAA as input and validates its guardian signatures.
+// Verify takes a VAA as input and validates its guardian signatures and quorum.
 func (h *GuardianSetHistory) Verify(ctx context.Context, vaa *sdk.VAA) error {
-	idx := vaa.GuardianSetIndex
-
 	h.RLock()
-	lenGuardianSetsByIndex := uint32(len(h.guardianSetsByIndex))
+	err := domain.VerifyVaa(vaa, h.guardianSetsByIndex)
+	lenGuardianSetsByIndex := len(h.guardianSetsByIndex)
+	h.RUnlock()
 
-	// Make sure the index exists
-	if idx >= lenGuardianSetsByIndex {
-		h.RUnlock()
+	if errors.Is(err, domain.ErrUnknownGuardianSet) {
 		alertContext := alert.AlertContext{
 			Details: map[string]string{
 				"vaaID":               vaa.MessageID(),
@@ -46,21 +45,8 @@ func (h *GuardianSetHistory) Verify(ctx context.Context, vaa *sdk.VAA) error {
 			},
 		}
 		_ = h.alertClient.CreateAndSend(ctx, flyAlert.GuardianSetUnknown, alertContext)
-		return fmt.Errorf("guardian Set Index is out of bounds: got %d, max is %d",
-			vaa.GuardianSetIndex,
-			lenGuardianSetsByIndex,
-		)
-	}
-
-	keysGuardianSets := h.guardianSetsByIndex[idx].Keys
-	h.RUnlock()
-
-	// Verify guardian signatures
-	if vaa.VerifySignatures(keysGuardianSets) {
-		return nil
-	} else {
-		return errors.New("VAA contains invalid signatures")
 	}
+	return err
 }
 
 // GetLatest returns the lastest guardian set.
@@ -70,6 +56,16 @@ func (h *GuardianSetHistory) GetLatest() common.GuardianSet {
 	return h.guardianSetsByIndex[len(h.guardianSetsByIndex)-1]
 }
 
+// GetByIndex returns the guardian set with the given index.
+func (h *GuardianSetHistory) GetByIndex(idx uint32) (common.GuardianSet, bool) {
+	h.RLock()
+	defer h.RUnlock()
+	if idx >= uint32(len(h.guardianSetsByIndex)) {
+		return common.GuardianSet{}, false
+	}
+	return h.guardianSetsByIndex[idx], true
+}
+
 func (h *GuardianSetHistory) Add(gs common.GuardianSet, t time.Time) {
 	h.Lock()
 	h.guardianSetsByIndex = append(h.guardianSetsByIndex, gs)
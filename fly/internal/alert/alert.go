@@ -20,6 +20,7 @@ const (
 	// warning alerts
 	GuardianSetUnknown       = "GUARDIAN_SET_UNKNOWN"
 	ObservationWithoutTxHash = "OBSERVATION_WITHOUT_TX_HASH"
+	GuardianStoppedObserving = "GUARDIAN_STOPPED_OBSERVING"
 )
 
 func LoadAlerts(cfg alert.AlertConfig) map[string]alert.Alert {
@@ -101,5 +102,14 @@ func LoadAlerts(cfg alert.AlertConfig) map[string]alert.Alert {
 		Entity:      "fly",
 		Priority:    alert.INFORMATIONAL,
 	}
+	alerts[GuardianStoppedObserving] = alert.Alert{
+		Alias:       GuardianStoppedObserving,
+		Message:     fmt.Sprintf("[%s] %s", cfg.Environment, "Guardian stopped observing a chain"),
+		Description: "A guardian did not sign any of the recent vaas of a chain it used to sign.",
+		Actions:     []string{"check the heartbeats of the guardian for the chain", "contact the guardian operator"},
+		Tags:        []string{cfg.Environment, "fly", "guardian", "vaa"},
+		Entity:      "fly",
+		Priority:    alert.HIGH,
+	}
 	return alerts
 }
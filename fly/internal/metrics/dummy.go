@@ -87,3 +87,11 @@ func (m *DummyMetrics) IncConsistencyLevelByChainID(chainID sdk.ChainID, consist
 func (m *DummyMetrics) IncDuplicateVaaByChainID(chain sdk.ChainID) {}
 
 func (m *DummyMetrics) VaaProcessingDuration(chain sdk.ChainID, start *time.Time) {}
+
+func (m *DummyMetrics) SetGuardianMissRate(guardianAddr string, chain sdk.ChainID, rate float64) {}
+
+func (m *DummyMetrics) SetVaaSequenceGaps(chain sdk.ChainID, missing int) {}
+
+func (m *DummyMetrics) IncVaaGapBackfilled(chain sdk.ChainID) {}
+
+func (m *DummyMetrics) IncVaaGapBackfillFailed(chain sdk.ChainID) {}
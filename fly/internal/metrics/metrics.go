@@ -54,4 +54,12 @@ type Metrics interface {
 
 	// vaas processing duration
 	VaaProcessingDuration(chain sdk.ChainID, start *time.Time)
+
+	// guardian miss rate metrics
+	SetGuardianMissRate(guardianAddr string, chain sdk.ChainID, rate float64)
+
+	// sequence gap metrics
+	SetVaaSequenceGaps(chain sdk.ChainID, missing int)
+	IncVaaGapBackfilled(chain sdk.ChainID)
+	IncVaaGapBackfillFailed(chain sdk.ChainID)
 }
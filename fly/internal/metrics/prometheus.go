@@ -24,6 +24,9 @@ type PrometheusMetrics struct {
 	consistenceLevelChainCount    *prometheus.CounterVec
 	duplicateVaaByChainCount      *prometheus.CounterVec
 	vaaProcessingDuration         *prometheus.HistogramVec
+	guardianMissRate              *prometheus.GaugeVec
+	vaaSequenceGaps               *prometheus.GaugeVec
+	vaaGapBackfillCount           *prometheus.CounterVec
 }
 
 // NewPrometheusMetrics returns a new instance of PrometheusMetrics.
@@ -120,6 +123,24 @@ func NewPrometheusMetrics(environment string) *PrometheusMetrics {
 		},
 		[]string{"chain"},
 	)
+	guardianMissRate := promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:        "guardian_miss_rate_by_chain",
+			Help:        "Rate of the vaas of the last window not signed by the guardian by chain",
+			ConstLabels: constLabels,
+		}, []string{"guardian_address", "chain"})
+	vaaSequenceGaps := promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:        "vaa_sequence_gaps_by_chain",
+			Help:        "Number of missing vaa sequences of the emitters by chain",
+			ConstLabels: constLabels,
+		}, []string{"chain"})
+	vaaGapBackfillCount := promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:        "vaa_gap_backfill_count_by_chain",
+			Help:        "Total number of missing vaas backfilled from the guardian api by chain",
+			ConstLabels: constLabels,
+		}, []string{"chain", "type"})
 	return &PrometheusMetrics{
 		vaaReceivedCount:              vaaReceivedCount,
 		vaaTotal:                      vaaTotal,
@@ -134,6 +155,9 @@ func NewPrometheusMetrics(environment string) *PrometheusMetrics {
 		consistenceLevelChainCount:    consistenceLevelChainCount,
 		duplicateVaaByChainCount:      duplicateVaaByChainCount,
 		vaaProcessingDuration:         vaaProcessingDuration,
+		guardianMissRate:              guardianMissRate,
+		vaaSequenceGaps:               vaaSequenceGaps,
+		vaaGapBackfillCount:           vaaGapBackfillCount,
 	}
 }
 
@@ -268,3 +292,23 @@ func (m *PrometheusMetrics) VaaProcessingDuration(chain sdk.ChainID, start *time
 	elapsed := float64(time.Since(*start).Nanoseconds()) / 1e9
 	m.vaaProcessingDuration.WithLabelValues(chain.String()).Observe(elapsed)
 }
+
+// SetGuardianMissRate sets the rate of the vaas of a chain not signed by a guardian.
+func (m *PrometheusMetrics) SetGuardianMissRate(guardianAddr string, chain sdk.ChainID, rate float64) {
+	m.guardianMissRate.WithLabelValues(guardianAddr, chain.String()).Set(rate)
+}
+
+// SetVaaSequenceGaps sets the number of missing vaa sequences of the emitters of a chain.
+func (m *PrometheusMetrics) SetVaaSequenceGaps(chain sdk.ChainID, missing int) {
+	m.vaaSequenceGaps.WithLabelValues(chain.String()).Set(float64(missing))
+}
+
+// IncVaaGapBackfilled increases the number of missing vaas backfilled from the guardian api.
+func (m *PrometheusMetrics) IncVaaGapBackfilled(chain sdk.ChainID) {
+	m.vaaGapBackfillCount.WithLabelValues(chain.String(), "filled").Inc()
+}
+
+// IncVaaGapBackfillFailed increases the number of missing vaas that could not be backfilled from the guardian api.
+func (m *PrometheusMetrics) IncVaaGapBackfillFailed(chain sdk.ChainID) {
+	m.vaaGapBackfillCount.WithLabelValues(chain.String(), "failed").Inc()
+}
@@ -9,15 +9,19 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/guardian"
 	healthcheck "github.com/wormhole-foundation/wormhole-explorer/common/health"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/periodic"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/builder"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/config"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/gossip"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/guardianmiss"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/internal/health"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/migration"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/processor"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/producer"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/sequencegap"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/server"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/storage"
 
@@ -132,7 +136,7 @@ func main() {
 	healthObservations, observationQueueConsume, observationPublish := builder.NewObservationConsumePublish(rootCtx, cfg, logger)
 	observationGossipConsumer := processor.NewObservationGossipConsumer(observationPublish, gst, p2pNetworkConfig.Enviroment,
 		cfg.ObservationsChannelSize, cfg.ObservationsWorkersSize, metrics, txHashStore, repository, logger)
-	observationQueueConsumer := processor.NewObservationQueueConsumer(observationQueueConsume, repository, metrics, logger)
+	observationQueueConsumer := processor.NewObservationQueueConsumer(observationQueueConsume, repository, gst, metrics, logger)
 	observationGossipConsumer.Start(rootCtx)
 	observationQueueConsumer.Start(rootCtx)
 
@@ -169,13 +173,52 @@ func main() {
 	vaaHandler.Start(rootCtx)
 
 	// Heartbeats handler
-	hearbeatsHandler := gossip.NewHeartbeatsHandler(channels.HeartbeatChannel, repository, guardianCheck, metrics, logger)
+	hearbeatsHandler := gossip.NewHeartbeatsHandler(channels.HeartbeatChannel, repository, guardianCheck, metrics, time.Duration(cfg.HeartbeatSampleSeconds)*time.Second, logger)
 	hearbeatsHandler.Start(rootCtx)
 
 	// Governor config handler
 	governorConfigHandler := gossip.NewGovernorConfigHandler(channels.GovConfigChannel, repository, guardianCheck, metrics, logger)
 	governorConfigHandler.Start(rootCtx)
 
+	// the detectors run in a single replica, the one that holds their lock.
+	detectorLock := periodic.NewMongoLock(db.Database)
+
+	// Guardian miss detector
+	if cfg.GuardianMiss.Enabled {
+		guardianMissDetector := guardianmiss.NewDetector(db.Database, guardianSetHistory, alertClient, metrics, guardianmiss.Config{
+			Interval:            time.Duration(cfg.GuardianMiss.IntervalSeconds) * time.Second,
+			Window:              time.Duration(cfg.GuardianMiss.WindowSeconds) * time.Second,
+			Baseline:            time.Duration(cfg.GuardianMiss.BaselineSeconds) * time.Second,
+			MinVaas:             cfg.GuardianMiss.MinVaas,
+			MaxBaselineMissRate: cfg.GuardianMiss.MaxBaselineMissRate,
+		}, logger)
+		guardianMissDetector.Start(rootCtx, detectorLock)
+	}
+
+	// Sequence gap detector
+	if cfg.SequenceGap.Enabled {
+		if len(cfg.SequenceGap.GuardianApiUrls) == 0 {
+			logger.Fatal("guardian api urls are required to backfill the sequence gaps")
+		}
+		var guardianClients []*guardian.GuardianAPIClient
+		for _, url := range cfg.SequenceGap.GuardianApiUrls {
+			c, err := guardian.NewGuardianAPIClient(cfg.SequenceGap.GuardianApiTimeoutSeconds, url, logger)
+			if err != nil {
+				logger.Fatal("could not create guardian api client", zap.Error(err))
+			}
+			guardianClients = append(guardianClients, &c)
+		}
+		sequenceGapDetector := sequencegap.NewDetector(db.Database, repository, guardianClients, guardianSetHistory, metrics, sequencegap.Config{
+			Interval:           time.Duration(cfg.SequenceGap.IntervalSeconds) * time.Second,
+			Window:             time.Duration(cfg.SequenceGap.WindowSeconds) * time.Second,
+			SettleDelay:        time.Duration(cfg.SequenceGap.SettleSeconds) * time.Second,
+			MaxRange:           cfg.SequenceGap.MaxRange,
+			MaxBackfillsPerRun: cfg.SequenceGap.MaxBackfillsPerRun,
+			RetryInterval:      time.Duration(cfg.SequenceGap.RetrySeconds) * time.Second,
+		}, logger)
+		sequenceGapDetector.Start(rootCtx, detectorLock)
+	}
+
 	// Governor status handler
 	governorStatusHandler := gossip.NewGovernorStatusHandler(channels.GovStatusChannel, repository, guardianCheck, metrics, logger)
 	governorStatusHandler.Start(rootCtx)
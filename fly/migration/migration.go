@@ -4,12 +4,19 @@ import (
 	"context"
 	"errors"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/lifecycle"
 	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// heartbeatHistoryRetentionSeconds is the retention of the heartbeat history (90 days).
+const heartbeatHistoryRetentionSeconds = 90 * 24 * 60 * 60
+
+// signingProgressRetentionSeconds is the retention of the signing progress of the messages (30 days).
+const signingProgressRetentionSeconds = 30 * 24 * 60 * 60
+
 // TODO: move this to migration tool that support mongodb.
 func Run(db *mongo.Database) error {
 	// Created governorConfig collection.
@@ -114,6 +121,13 @@ func Run(db *mongo.Database) error {
 		return err
 	}
 
+	// create index in vaas collection by indexedAt, used to read the new vaas.
+	indexVaaByIndexedAt := mongo.IndexModel{Keys: bson.D{{Key: "indexedAt", Value: 1}}}
+	_, err = db.Collection("vaas").Indexes().CreateOne(context.TODO(), indexVaaByIndexedAt)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
 	// create index in observations collection by indexedAt.
 	indexObservationsByIndexedAt := mongo.IndexModel{Keys: bson.D{{Key: "indexedAt", Value: 1}}}
 	_, err = db.Collection(repository.Observations).Indexes().CreateOne(context.TODO(), indexObservationsByIndexedAt)
@@ -121,6 +135,17 @@ func Run(db *mongo.Database) error {
 		return err
 	}
 
+	// create index in observations collection by indexedAt and _id for keyset pagination.
+	indexObservationsByIndexedAtId := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "indexedAt", Value: -1},
+			{Key: "_id", Value: -1},
+		}}
+	_, err = db.Collection(repository.Observations).Indexes().CreateOne(context.TODO(), indexObservationsByIndexedAtId)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
 	// create index in observations collection.
 	indexObservationsByEmitterChainAndAddressAndSequence := mongo.IndexModel{
 		Keys: bson.D{
@@ -271,6 +296,18 @@ func Run(db *mongo.Database) error {
 		return err
 	}
 
+	// create index in parsedVaa collection by timestamp and _id for keyset pagination.
+	indexParsedVaaByTimestampId := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "timestamp", Value: -1},
+			{Key: "_id", Value: -1},
+		},
+	}
+	_, err = db.Collection("parsedVaa").Indexes().CreateOne(context.TODO(), indexParsedVaaByTimestampId)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
 	// create index for duplicateVaas by vaaId
 	indexDuplicateVaasByVaadID := mongo.IndexModel{
 		Keys: bson.D{
@@ -290,6 +327,207 @@ func Run(db *mongo.Database) error {
 		return err
 	}
 
+	// create index in webhookSubscriptions collection by active and events.
+	indexWebhookSubscriptionsByActiveEvents := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "active", Value: 1},
+			{Key: "events", Value: 1},
+		}}
+	_, err = db.Collection(repository.WebhookSubscriptions).Indexes().CreateOne(context.TODO(), indexWebhookSubscriptionsByActiveEvents)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create index in webhookSubscriptions collection by owner and createdAt.
+	indexWebhookSubscriptionsByOwnerCreatedAt := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "owner", Value: 1},
+			{Key: "createdAt", Value: -1},
+		}}
+	_, err = db.Collection(repository.WebhookSubscriptions).Indexes().CreateOne(context.TODO(), indexWebhookSubscriptionsByOwnerCreatedAt)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create index in webhookDeliveries collection by nextAttemptAt.
+	indexWebhookDeliveriesByNextAttemptAt := mongo.IndexModel{
+		Keys: bson.D{{Key: "nextAttemptAt", Value: 1}}}
+	_, err = db.Collection(repository.WebhookDeliveries).Indexes().CreateOne(context.TODO(), indexWebhookDeliveriesByNextAttemptAt)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create index in webhookDeadLetters collection by subscriptionId.
+	indexWebhookDeadLettersBySubscriptionId := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "subscriptionId", Value: 1},
+			{Key: "failedAt", Value: -1},
+		}}
+	_, err = db.Collection(repository.WebhookDeadLetters).Indexes().CreateOne(context.TODO(), indexWebhookDeadLettersBySubscriptionId)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// Create heartbeatHistory collection. It is not a time-series collection, so that
+	// the entries can be upserted by id by every fly replica.
+	err = db.CreateCollection(context.TODO(), repository.HeartbeatHistory)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create ttl index in heartbeatHistory collection by timestamp.
+	indexHeartbeatHistoryByTimestamp := mongo.IndexModel{
+		Keys:    bson.D{{Key: "timestamp", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(heartbeatHistoryRetentionSeconds),
+	}
+	_, err = db.Collection(repository.HeartbeatHistory).Indexes().CreateOne(context.TODO(), indexHeartbeatHistoryByTimestamp)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create index in heartbeatHistory collection by guardianAddr and timestamp.
+	indexHeartbeatHistoryByGuardianAddrTimestamp := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "guardianAddr", Value: 1},
+			{Key: "timestamp", Value: -1},
+		}}
+	_, err = db.Collection(repository.HeartbeatHistory).Indexes().CreateOne(context.TODO(), indexHeartbeatHistoryByGuardianAddrTimestamp)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create signingProgress collection.
+	err = db.CreateCollection(context.TODO(), repository.SigningProgress)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create index in signingProgress collection by vaaAt and firstObservationAt, used to find the pending vaas.
+	indexSigningProgressByVaaAtFirstObservationAt := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "vaaAt", Value: 1},
+			{Key: "firstObservationAt", Value: -1},
+		}}
+	_, err = db.Collection(repository.SigningProgress).Indexes().CreateOne(context.TODO(), indexSigningProgressByVaaAtFirstObservationAt)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create ttl index in signingProgress collection by updatedAt.
+	indexSigningProgressByUpdatedAt := mongo.IndexModel{
+		Keys:    bson.D{{Key: "updatedAt", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(signingProgressRetentionSeconds),
+	}
+	_, err = db.Collection(repository.SigningProgress).Indexes().CreateOne(context.TODO(), indexSigningProgressByUpdatedAt)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create sourceMessages collection.
+	err = db.CreateCollection(context.TODO(), repository.SourceMessages)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create index in sourceMessages collection by status and finalizedAt, used to reconcile and find the unsigned messages.
+	indexSourceMessagesByStatusFinalizedAt := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "status", Value: 1},
+			{Key: "finalizedAt", Value: -1},
+		}}
+	_, err = db.Collection(repository.SourceMessages).Indexes().CreateOne(context.TODO(), indexSourceMessagesByStatusFinalizedAt)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create index in sourceMessages collection by emitter and sequence, used to find the sequence gaps.
+	indexSourceMessagesByEmitterSequence := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "emitterChain", Value: 1},
+			{Key: "emitterAddr", Value: 1},
+			{Key: "sequence", Value: 1},
+		}}
+	_, err = db.Collection(repository.SourceMessages).Indexes().CreateOne(context.TODO(), indexSourceMessagesByEmitterSequence)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create addressActivity collection.
+	err = db.CreateCollection(context.TODO(), repository.AddressActivity)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create index in addressActivity collection by address and timestamp, used to get the activity of an address.
+	indexAddressActivityByAddressTimestamp := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "address", Value: 1},
+			{Key: "timestamp", Value: -1},
+			{Key: "_id", Value: -1},
+		}}
+	_, err = db.Collection(repository.AddressActivity).Indexes().CreateOne(context.TODO(), indexAddressActivityByAddressTimestamp)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create index in addressActivity collection by address, chain and timestamp, used to get the activity of an address in a chain.
+	indexAddressActivityByAddressChainTimestamp := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "address", Value: 1},
+			{Key: "chain", Value: 1},
+			{Key: "timestamp", Value: -1},
+		}}
+	_, err = db.Collection(repository.AddressActivity).Indexes().CreateOne(context.TODO(), indexAddressActivityByAddressChainTimestamp)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create operationLifecycle collection.
+	err = db.CreateCollection(context.TODO(), repository.OperationLifecycle)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create indexes in operationLifecycle collection by status and the time the status was reached, used to
+	// find the stuck operations and to page the operations by status.
+	for _, status := range []lifecycle.Status{lifecycle.StatusEmitted, lifecycle.StatusGoverned, lifecycle.StatusSigned, lifecycle.StatusRedeemed} {
+		indexOperationLifecycleByStatusTime := mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: status.TimeField(), Value: 1},
+				{Key: "_id", Value: 1},
+			}}
+		_, err = db.Collection(repository.OperationLifecycle).Indexes().CreateOne(context.TODO(), indexOperationLifecycleByStatusTime)
+		if err != nil && isNotAlreadyExistsError(err) {
+			return err
+		}
+	}
+
+	// create index in operationLifecycle collection by stuck and stuckAt, used to page the stuck operations.
+	indexOperationLifecycleByStuckAt := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "stuck", Value: 1},
+			{Key: "stuckAt", Value: 1},
+			{Key: "_id", Value: 1},
+		}}
+	_, err = db.Collection(repository.OperationLifecycle).Indexes().CreateOne(context.TODO(), indexOperationLifecycleByStuckAt)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create indexes in exports collection, used to count the exports pending and requested by a client,
+	// and to find the expired exports.
+	for _, keys := range []bson.D{
+		{{Key: "status", Value: 1}, {Key: "createdAt", Value: 1}},
+		{{Key: "client", Value: 1}, {Key: "createdAt", Value: 1}},
+		{{Key: "status", Value: 1}, {Key: "completedAt", Value: 1}},
+	} {
+		_, err = db.Collection(repository.Exports).Indexes().CreateOne(context.TODO(), mongo.IndexModel{Keys: keys})
+		if err != nil && isNotAlreadyExistsError(err) {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -125,7 +125,9 @@ func (c *observationGossipConsumer) process(ctx context.Context, o *gossipv1.Sig
 			err = consumer.repository.UpsertObservation(ctx, obs, false)
 			if err != nil {
 				consumer.logger.Error("Error inserting observation in repository", zap.String("id", o.MessageId), zap.Error(err))
+				return
 			}
+			upsertSigningProgress(ctx, consumer.repository, consumer.gst, obs, consumer.logger.With(zap.String("id", o.MessageId)))
 		}
 	}(c, ctx, o)
 
@@ -3,8 +3,11 @@ package processor
 import (
 	"context"
 
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/storage"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 
 	"go.uber.org/zap"
 )
@@ -13,6 +16,7 @@ import (
 type ObservationQueueConsumer struct {
 	consume    ObservationQueueConsumeFunc
 	repository *storage.Repository
+	gst        *common.GuardianSetState
 	metrics    metrics.Metrics
 	logger     *zap.Logger
 }
@@ -21,11 +25,13 @@ type ObservationQueueConsumer struct {
 func NewObservationQueueConsumer(
 	consume ObservationQueueConsumeFunc,
 	repository *storage.Repository,
+	gst *common.GuardianSetState,
 	metrics metrics.Metrics,
 	logger *zap.Logger) *ObservationQueueConsumer {
 	return &ObservationQueueConsumer{
 		consume:    consume,
 		repository: repository,
+		gst:        gst,
 		metrics:    metrics,
 		logger:     logger,
 	}
@@ -50,8 +56,23 @@ func (c *ObservationQueueConsumer) Start(ctx context.Context) {
 				msg.Failed()
 				continue
 			}
+			upsertSigningProgress(ctx, c.repository, c.gst, obs, log)
 			msg.Done(ctx)
 			c.logger.Info("Observation saved in repository")
 		}
 	}()
 }
+
+// upsertSigningProgress updates the signing progress of the message of an observation against
+// the quorum of the current guardian set. Errors are logged because the observation is already stored.
+func upsertSigningProgress(ctx context.Context, repository *storage.Repository, gst *common.GuardianSetState,
+	obs *gossipv1.SignedObservation, logger *zap.Logger) {
+	gs := gst.Get()
+	if gs == nil {
+		return
+	}
+	err := repository.UpsertSigningProgress(ctx, obs, gs.Index, sdk.CalculateQuorum(len(gs.Keys)))
+	if err != nil {
+		logger.Warn("Error updating signing progress", zap.Error(err))
+	}
+}
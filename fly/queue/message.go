@@ -5,42 +5,41 @@ import (
 	"sync"
 	"time"
 
-	"github.com/wormhole-foundation/wormhole-explorer/fly/internal/sqs"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
 	"go.uber.org/zap"
 )
 
-type sqsConsumerMessage[T any] struct {
-	data          T
-	consumer      *sqs.Consumer
-	id            *string
-	logger        *zap.Logger
-	expiredAt     time.Time
-	wg            *sync.WaitGroup
-	ctx           context.Context
-	sentTimestamp *time.Time
+type consumerMessage[T any] struct {
+	msg       *messagebus.Message
+	data      T
+	consumer  messagebus.Consumer
+	logger    *zap.Logger
+	expiredAt time.Time
+	wg        *sync.WaitGroup
+	ctx       context.Context
 }
 
-func (m *sqsConsumerMessage[T]) Data() T {
+func (m *consumerMessage[T]) Data() T {
 	return m.data
 }
 
-func (m *sqsConsumerMessage[T]) Done(ctx context.Context) {
-	if err := m.consumer.DeleteMessage(ctx, m.id); err != nil {
-		m.logger.Error("Error deleting message from SQS", zap.Error(err))
+func (m *consumerMessage[T]) Done(ctx context.Context) {
+	if err := m.consumer.Ack(ctx, m.msg); err != nil {
+		m.logger.Error("Error deleting message from queue", zap.Error(err))
 	}
 	m.wg.Done()
 }
 
-func (m *sqsConsumerMessage[T]) Failed() {
+func (m *consumerMessage[T]) Failed() {
 	m.wg.Done()
 }
 
-func (m *sqsConsumerMessage[T]) IsExpired() bool {
+func (m *consumerMessage[T]) IsExpired() bool {
 	return m.expiredAt.Before(time.Now())
 }
 
-func (m *sqsConsumerMessage[T]) SentTimestamp() *time.Time {
-	return m.sentTimestamp
+func (m *consumerMessage[T]) SentTimestamp() *time.Time {
+	return m.msg.SentTimestamp
 }
 
 type memoryConsumerMessageQueue[T any] struct {
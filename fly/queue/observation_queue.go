@@ -12,66 +12,70 @@ import (
 	"time"
 
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
-	"github.com/wormhole-foundation/wormhole-explorer/fly/internal/sqs"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
 	"go.uber.org/zap"
 )
 
-// ObservationSqs represents a observation queue in SQS.
-type ObservationSqs struct {
-	producer *sqs.Producer
-	consumer *sqs.Consumer
+// ObservationQueue represents a observation queue in the message bus.
+type ObservationQueue struct {
+	producer messagebus.Producer
+	consumer messagebus.Consumer
 	ch       chan Message[*gossipv1.SignedObservation]
 	chSize   int
 	wg       sync.WaitGroup
 	logger   *zap.Logger
 }
 
-// NewObservationSqs creates a observation queue in SQS instances.
-func NewObservationSqs(producer *sqs.Producer, consumer *sqs.Consumer, logger *zap.Logger, opts ...VAASqsOption) *ObservationSqs {
-	s := &ObservationSqs{
+// NewObservationQueue creates a observation queue instance.
+func NewObservationQueue(producer messagebus.Producer, consumer messagebus.Consumer, logger *zap.Logger) *ObservationQueue {
+	s := &ObservationQueue{
 		producer: producer,
 		consumer: consumer,
 		chSize:   10,
-		logger:   logger.With(zap.String("queueUrl", consumer.GetQueueUrl()))}
+		logger:   logger.With(zap.String("queue", consumer.Name()))}
 	s.ch = make(chan Message[*gossipv1.SignedObservation], s.chSize)
 	return s
 }
 
-// Publish sends the message to a SQS queue.
-func (q *ObservationSqs) Publish(ctx context.Context, o *gossipv1.SignedObservation) error {
+// Publish sends the message to the queue.
+func (q *ObservationQueue) Publish(ctx context.Context, o *gossipv1.SignedObservation) error {
 	dto := toObservation(o)
 	body, err := json.Marshal(dto)
 	if err != nil {
 		return err
 	}
 	deduplicationID := createObservationDeduplicationID(o)
-	return q.producer.SendMessage(ctx, deduplicationID, deduplicationID, string(body))
+	return q.producer.Publish(ctx, &messagebus.OutgoingMessage{
+		GroupID:         deduplicationID,
+		DeduplicationID: deduplicationID,
+		Body:            body,
+	})
 }
 
-// Consume returns the channel with the received messages from SQS queue.
-func (q *ObservationSqs) Consume(ctx context.Context) <-chan Message[*gossipv1.SignedObservation] {
+// Consume returns the channel with the received messages from the queue.
+func (q *ObservationQueue) Consume(ctx context.Context) <-chan Message[*gossipv1.SignedObservation] {
 	go func() {
 		for {
-			messages, err := q.consumer.GetMessages(ctx)
+			messages, err := q.consumer.Receive(ctx)
 			if err != nil {
-				q.logger.Error("Error getting messages from SQS", zap.Error(err))
+				q.logger.Error("Error getting messages from queue", zap.Error(err))
 				continue
 			}
-			q.logger.Info("Received messages from SQS", zap.Int("count", len(messages)))
-			expiredAt := time.Now().Add(q.consumer.GetVisibilityTimeout())
+			q.logger.Info("Received messages from queue", zap.Int("count", len(messages)))
+			expiredAt := time.Now().Add(q.consumer.VisibilityTimeout())
 			for _, msg := range messages {
 				var obs Observation
-				err := json.Unmarshal([]byte(*msg.Body), &obs)
+				err := json.Unmarshal(msg.Body, &obs)
 				if err != nil {
-					q.logger.Error("Error decoding message from SQS", zap.Error(err))
+					q.logger.Error("Error decoding message from queue", zap.Error(err))
 					continue
 				}
 				q.logger.Info("Observation message received", zap.String("id", obs.MessageID))
 
 				//TODO check if callback is better than channel
 				q.wg.Add(1)
-				q.ch <- &sqsConsumerMessage[*gossipv1.SignedObservation]{
-					id:        msg.ReceiptHandle,
+				q.ch <- &consumerMessage[*gossipv1.SignedObservation]{
+					msg:       msg,
 					data:      fromObservation(&obs),
 					wg:        &q.wg,
 					logger:    q.logger,
@@ -87,7 +91,7 @@ func (q *ObservationSqs) Consume(ctx context.Context) <-chan Message[*gossipv1.S
 }
 
 // Close closes all consumer resources.
-func (q *ObservationSqs) Close() {
+func (q *ObservationQueue) Close() {
 	close(q.ch)
 }
 
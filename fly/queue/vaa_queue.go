@@ -0,0 +1,107 @@
+package queue
+
+import (
+	"context"
+	"encoding/base64"
+	"sync"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// VAAQueueOption represents a VAA queue option function.
+type VAAQueueOption func(*VAAQueue)
+
+// VAAQueue represents a VAA queue in the message bus.
+type VAAQueue struct {
+	producer messagebus.Producer
+	consumer messagebus.Consumer
+	ch       chan Message[[]byte]
+	chSize   int
+	wg       sync.WaitGroup
+	logger   *zap.Logger
+}
+
+// NewVAAQueue creates a VAA queue instance.
+func NewVAAQueue(producer messagebus.Producer, consumer messagebus.Consumer, logger *zap.Logger, opts ...VAAQueueOption) *VAAQueue {
+	s := &VAAQueue{
+		producer: producer,
+		consumer: consumer,
+		chSize:   10,
+		logger:   logger.With(zap.String("queue", consumer.Name()))}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.ch = make(chan Message[[]byte], s.chSize)
+	return s
+}
+
+// WithChannelSize allows to specify an channel size when setting a value.
+func WithChannelSize(size int) VAAQueueOption {
+	return func(d *VAAQueue) {
+		d.chSize = size
+	}
+}
+
+// Publish sends the message to the queue.
+func (q *VAAQueue) Publish(ctx context.Context, v *sdk.VAA, data []byte) error {
+	body := base64.StdEncoding.EncodeToString(data)
+	deduplicationID := createVaaDeduplicationID(v)
+	return q.producer.Publish(ctx, &messagebus.OutgoingMessage{
+		GroupID:         deduplicationID,
+		DeduplicationID: deduplicationID,
+		Body:            []byte(body),
+	})
+}
+
+// Consume returns the channel with the received messages from the queue.
+func (q *VAAQueue) Consume(ctx context.Context) <-chan Message[[]byte] {
+	go func() {
+		for {
+			messages, err := q.consumer.Receive(ctx)
+			if err != nil {
+				q.logger.Error("Error getting messages from queue", zap.Error(err))
+				continue
+			}
+			expiredAt := time.Now().Add(q.consumer.VisibilityTimeout())
+			for _, msg := range messages {
+				body, err := base64.StdEncoding.DecodeString(string(msg.Body))
+				if err != nil {
+					q.logger.Error("Error decoding message from queue", zap.Error(err))
+					continue
+				}
+
+				//TODO check if callback is better than channel
+				q.wg.Add(1)
+				q.ch <- &consumerMessage[[]byte]{
+					msg:       msg,
+					data:      body,
+					wg:        &q.wg,
+					logger:    q.logger,
+					consumer:  q.consumer,
+					expiredAt: expiredAt,
+					ctx:       ctx,
+				}
+			}
+			q.wg.Wait()
+		}
+	}()
+	return q.ch
+}
+
+// Close closes all consumer resources.
+func (q *VAAQueue) Close() {
+	close(q.ch)
+}
+
+func createVaaDeduplicationID(v *sdk.VAA) string {
+	deduplicationID := domain.CreateUniqueVaaID(v)
+	if len(deduplicationID) > 127 {
+		return deduplicationID[:127]
+	}
+	return deduplicationID
+}
@@ -0,0 +1,259 @@
+// Package sequencegap detects the missing sequences of the emitters in the vaas collection
+// and backfills the missing vaas from the guardian public api.
+package sequencegap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/guardian"
+	"github.com/wormhole-foundation/wormhole-explorer/common/periodic"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/guardiansets"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/storage"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// idsBatchSize is the maximum number of vaa ids queried at once.
+const idsBatchSize = 1000
+
+// Config is the configuration of the Detector.
+type Config struct {
+	// Interval is the time between runs.
+	Interval time.Duration
+	// Window is the time range of the indexed vaas used to find the active emitters and their sequence ranges.
+	Window time.Duration
+	// SettleDelay is the time to wait before considering a missing sequence a gap, to not race with gossip.
+	SettleDelay time.Duration
+	// MaxRange is the maximum number of sequences scanned per emitter.
+	MaxRange uint64
+	// MaxBackfillsPerRun is the maximum number of vaas requested to the guardians per run.
+	MaxBackfillsPerRun int
+	// RetryInterval is the time to wait before requesting a missing vaa again.
+	RetryInterval time.Duration
+}
+
+// Detector scans the vaas of the active emitters for missing sequences and fills each gap with the
+// signed vaa fetched from the guardian api, verified against the guardian set history.
+//
+// Vaas held by the governor are reported as gaps until they are released.
+type Detector struct {
+	vaas       *mongo.Collection
+	repository *storage.Repository
+	guardians  []*guardian.GuardianAPIClient
+	gsHistory  *guardiansets.GuardianSetHistory
+	metrics    metrics.Metrics
+	cfg        Config
+	attempts   *attempts
+	chains     map[sdk.ChainID]bool
+	logger     *zap.Logger
+}
+
+// NewDetector creates a new Detector.
+func NewDetector(db *mongo.Database, repo *storage.Repository, guardians []*guardian.GuardianAPIClient,
+	gsHistory *guardiansets.GuardianSetHistory, metrics metrics.Metrics, cfg Config, logger *zap.Logger) *Detector {
+	return &Detector{
+		vaas:       db.Collection(repository.Vaas),
+		repository: repo,
+		guardians:  guardians,
+		gsHistory:  gsHistory,
+		metrics:    metrics,
+		cfg:        cfg,
+		attempts:   newAttempts(cfg.RetryInterval),
+		chains:     make(map[sdk.ChainID]bool),
+		logger:     logger.With(zap.String("module", "SequenceGapDetector")),
+	}
+}
+
+// Start runs the detector periodically until the context is cancelled. Only the instance that
+// holds the lock runs it, so the replicas of the service do not run it at the same time.
+func (d *Detector) Start(ctx context.Context, lock periodic.Lock) {
+	periodic.NewRunner("sequence-gap-detector", d.cfg.Interval, d.run, d.logger, periodic.WithLock(lock)).Start(ctx)
+}
+
+// missingVaa is a vaa missing in the vaas collection.
+type missingVaa struct {
+	id    string
+	chain sdk.ChainID
+}
+
+// run scans the active emitters for gaps, reports them and backfills the missing vaas.
+func (d *Detector) run(ctx context.Context, now time.Time) error {
+	to := now.Add(-d.cfg.SettleDelay)
+	ranges, err := d.findEmitterRanges(ctx, to.Add(-d.cfg.Window), to)
+	if err != nil {
+		return err
+	}
+
+	missingByChain := make(map[sdk.ChainID]int)
+	var missing []missingVaa
+	for _, r := range ranges {
+		r = r.limit(d.cfg.MaxRange)
+		found, err := d.findSequences(ctx, r)
+		if err != nil {
+			return err
+		}
+		seqs := missingSequences(r.Min, r.Max, found)
+		missingByChain[r.Chain] += len(seqs)
+		if len(seqs) == 0 {
+			continue
+		}
+		d.logger.Warn("Found sequence gaps",
+			zap.Stringer("chain", r.Chain),
+			zap.String("emitter", r.Address),
+			zap.Uint64("minSequence", r.Min),
+			zap.Uint64("maxSequence", r.Max),
+			zap.Int("missing", len(seqs)))
+		for _, seq := range seqs {
+			missing = append(missing, missingVaa{id: r.vaaID(seq), chain: r.Chain})
+		}
+	}
+
+	// chains without gaps in this run are reset to zero.
+	for chain := range d.chains {
+		if _, ok := missingByChain[chain]; !ok {
+			d.metrics.SetVaaSequenceGaps(chain, 0)
+		}
+	}
+	d.chains = make(map[sdk.ChainID]bool, len(missingByChain))
+	for chain, count := range missingByChain {
+		d.metrics.SetVaaSequenceGaps(chain, count)
+		d.chains[chain] = true
+	}
+
+	d.attempts.prune(now)
+	backfills := 0
+	for _, m := range missing {
+		if backfills >= d.cfg.MaxBackfillsPerRun {
+			break
+		}
+		if !d.attempts.allow(m.id, now) {
+			continue
+		}
+		backfills++
+		if err := d.backfill(ctx, m.id); err != nil {
+			d.logger.Info("Error backfilling missing vaa", zap.String("id", m.id), zap.Error(err))
+			d.metrics.IncVaaGapBackfillFailed(m.chain)
+			continue
+		}
+		d.logger.Info("Backfilled missing vaa", zap.String("id", m.id))
+		d.metrics.IncVaaGapBackfilled(m.chain)
+	}
+	return nil
+}
+
+// findEmitterRanges returns the range of sequences of the emitters with vaas indexed in the range [from, to).
+func (d *Detector) findEmitterRanges(ctx context.Context, from, to time.Time) ([]emitterRange, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "indexedAt", Value: bson.M{"$gte": from, "$lt": to}},
+		}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "chain", Value: "$emitterChain"},
+				{Key: "address", Value: "$emitterAddr"},
+			}},
+			{Key: "min", Value: bson.M{"$min": bson.M{"$toLong": "$sequence"}}},
+			{Key: "max", Value: bson.M{"$max": bson.M{"$toLong": "$sequence"}}},
+		}}},
+	}
+	cur, err := d.vaas.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var docs []struct {
+		ID struct {
+			Chain   sdk.ChainID `bson:"chain"`
+			Address string      `bson:"address"`
+		} `bson:"_id"`
+		Min int64 `bson:"min"`
+		Max int64 `bson:"max"`
+	}
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	ranges := make([]emitterRange, 0, len(docs))
+	for _, doc := range docs {
+		ranges = append(ranges, emitterRange{
+			Emitter: Emitter{Chain: doc.ID.Chain, Address: doc.ID.Address},
+			Min:     uint64(doc.Min),
+			Max:     uint64(doc.Max),
+		})
+	}
+	return ranges, nil
+}
+
+// findSequences returns the sequences of the range stored in the vaas collection.
+// The vaas are looked up by id since the sequence is stored as a string.
+func (d *Detector) findSequences(ctx context.Context, r emitterRange) (map[uint64]bool, error) {
+	found := make(map[uint64]bool)
+	for start := r.Min; start <= r.Max; start += idsBatchSize {
+		end := start + idsBatchSize - 1
+		if end > r.Max {
+			end = r.Max
+		}
+		ids := make([]string, 0, end-start+1)
+		bySeq := make(map[string]uint64, end-start+1)
+		for seq := start; seq <= end; seq++ {
+			id := r.vaaID(seq)
+			ids = append(ids, id)
+			bySeq[id] = seq
+		}
+
+		cur, err := d.vaas.Find(ctx, bson.M{"_id": bson.M{"$in": ids}}, options.Find().SetProjection(bson.M{"_id": 1}))
+		if err != nil {
+			return nil, err
+		}
+		var docs []struct {
+			ID string `bson:"_id"`
+		}
+		if err := cur.All(ctx, &docs); err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			found[bySeq[doc.ID]] = true
+		}
+		if end == r.Max {
+			break
+		}
+	}
+	return found, nil
+}
+
+// backfill fetches a missing vaa from the guardians, verifies its signatures and stores it.
+func (d *Detector) backfill(ctx context.Context, id string) error {
+	var errs []error
+	for _, g := range d.guardians {
+		signedVaa, err := g.GetSignedVAA(id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", g.BaseURL, err))
+			continue
+		}
+		v, err := sdk.Unmarshal(signedVaa.VaaBytes)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: error unmarshalling vaa: %w", g.BaseURL, err))
+			continue
+		}
+		if v.MessageID() != id {
+			errs = append(errs, fmt.Errorf("%s: guardian returned vaa %s", g.BaseURL, v.MessageID()))
+			continue
+		}
+		if err := d.gsHistory.Verify(ctx, v); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", g.BaseURL, err))
+			continue
+		}
+		return d.repository.UpsertVaa(ctx, v, signedVaa.VaaBytes)
+	}
+	if len(errs) == 0 {
+		return errors.New("no guardian api configured")
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,79 @@
+package sequencegap
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// Emitter identifies the vaas of an emitter.
+type Emitter struct {
+	Chain   sdk.ChainID
+	Address string
+}
+
+// vaaID returns the id of the vaa of the emitter with the given sequence.
+func (e Emitter) vaaID(seq uint64) string {
+	return fmt.Sprintf("%d/%s/%d", e.Chain, e.Address, seq)
+}
+
+// emitterRange is the range of sequences of an emitter to scan for gaps.
+type emitterRange struct {
+	Emitter
+	Min uint64
+	Max uint64
+}
+
+// limit shrinks the range to the last maxRange sequences.
+func (r emitterRange) limit(maxRange uint64) emitterRange {
+	if maxRange > 0 && r.Max-r.Min >= maxRange {
+		r.Min = r.Max - maxRange + 1
+	}
+	return r
+}
+
+// missingSequences returns the sequences in the range [from, to] not found, in ascending order.
+func missingSequences(from, to uint64, found map[uint64]bool) []uint64 {
+	var missing []uint64
+	for seq := from; seq <= to; seq++ {
+		if !found[seq] {
+			missing = append(missing, seq)
+		}
+		if seq == to {
+			break
+		}
+	}
+	return missing
+}
+
+// attempts tracks the backfill attempts of the missing vaas to not request them to the guardians on every run.
+type attempts struct {
+	retryAfter time.Duration
+	last       map[string]time.Time
+}
+
+func newAttempts(retryAfter time.Duration) *attempts {
+	return &attempts{
+		retryAfter: retryAfter,
+		last:       make(map[string]time.Time),
+	}
+}
+
+// allow returns whether a vaa can be requested again and records the attempt.
+func (a *attempts) allow(id string, now time.Time) bool {
+	if last, ok := a.last[id]; ok && now.Sub(last) < a.retryAfter {
+		return false
+	}
+	a.last[id] = now
+	return true
+}
+
+// prune removes the attempts that can be retried.
+func (a *attempts) prune(now time.Time) {
+	for id, last := range a.last {
+		if now.Sub(last) >= a.retryAfter {
+			delete(a.last, id)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package sequencegap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestMissingSequences(t *testing.T) {
+	found := map[uint64]bool{10: true, 11: true, 14: true, 16: true}
+	assert.Equal(t, []uint64{12, 13, 15}, missingSequences(10, 16, found))
+	assert.Empty(t, missingSequences(10, 11, found))
+	assert.Equal(t, []uint64{3}, missingSequences(3, 3, found))
+}
+
+func TestEmitterRangeLimit(t *testing.T) {
+	e := Emitter{Chain: sdk.ChainIDEthereum, Address: "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585"}
+	assert.Equal(t, "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/7", e.vaaID(7))
+
+	r := emitterRange{Emitter: e, Min: 1, Max: 100}
+	assert.Equal(t, uint64(91), r.limit(10).Min)
+	assert.Equal(t, uint64(1), r.limit(100).Min)
+	assert.Equal(t, uint64(1), r.limit(0).Min)
+}
+
+func TestAttempts(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	a := newAttempts(time.Hour)
+	assert.True(t, a.allow("2/abc/1", now))
+	assert.False(t, a.allow("2/abc/1", now.Add(30*time.Minute)))
+	assert.True(t, a.allow("2/abc/1", now.Add(time.Hour)))
+
+	a.prune(now.Add(3 * time.Hour))
+	assert.Empty(t, a.last)
+}
@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// Heartbeat sample events.
+const (
+	// HeartbeatEventSample is a periodic sample of the heartbeat of a guardian.
+	HeartbeatEventSample = "sample"
+	// HeartbeatEventBoot is recorded when the boot timestamp of a guardian changes (i.e. it restarted).
+	HeartbeatEventBoot = "boot"
+	// HeartbeatEventVersion is recorded when the version of a guardian changes.
+	HeartbeatEventVersion = "version"
+)
+
+// HeartbeatSample is an entry of the heartbeat history of a guardian.
+type HeartbeatSample struct {
+	ID            string                   `bson:"_id"`
+	Timestamp     time.Time                `bson:"timestamp"`
+	GuardianAddr  string                   `bson:"guardianAddr"`
+	NodeName      string                   `bson:"nodeName"`
+	Version       string                   `bson:"version"`
+	BootTimestamp time.Time                `bson:"bootTimestamp"`
+	Counter       int64                    `bson:"counter"`
+	Event         string                   `bson:"event"`
+	Networks      []HeartbeatSampleNetwork `bson:"networks"`
+}
+
+// HeartbeatSampleNetwork is the status of a chain reported in a heartbeat.
+type HeartbeatSampleNetwork struct {
+	ID         uint32 `bson:"id"`
+	Height     int64  `bson:"height"`
+	ErrorCount uint64 `bson:"errorCount"`
+}
+
+// NewHeartbeatSample creates a heartbeat history entry from a heartbeat received at the given time.
+//
+// The id is the same in every fly replica: samples are identified by the guardian and the sample interval
+// they were received in, and boots and version changes by the guardian and its boot timestamp.
+func NewHeartbeatSample(hb *gossipv1.Heartbeat, event string, receivedAt time.Time, interval time.Duration) *HeartbeatSample {
+	networks := make([]HeartbeatSampleNetwork, 0, len(hb.Networks))
+	for _, n := range hb.Networks {
+		networks = append(networks, HeartbeatSampleNetwork{
+			ID:         n.Id,
+			Height:     n.Height,
+			ErrorCount: n.ErrorCount,
+		})
+	}
+	var id string
+	switch event {
+	case HeartbeatEventSample:
+		id = fmt.Sprintf("%s/%s/%d", hb.GuardianAddr, event, receivedAt.Truncate(interval).Unix())
+	case HeartbeatEventVersion:
+		id = fmt.Sprintf("%s/%s/%d/%s", hb.GuardianAddr, event, hb.BootTimestamp, hb.Version)
+	default:
+		id = fmt.Sprintf("%s/%s/%d", hb.GuardianAddr, event, hb.BootTimestamp)
+	}
+	return &HeartbeatSample{
+		ID:            id,
+		Timestamp:     receivedAt,
+		GuardianAddr:  hb.GuardianAddr,
+		NodeName:      hb.NodeName,
+		Version:       hb.Version,
+		BootTimestamp: time.Unix(0, hb.BootTimestamp),
+		Counter:       hb.Counter,
+		Event:         event,
+		Networks:      networks,
+	}
+}
+
+// InsertHeartbeatSample appends an entry to the heartbeat history.
+// The entry is only inserted once, when other fly replicas store the same entry it is left untouched.
+func (s *Repository) InsertHeartbeatSample(ctx context.Context, sample *HeartbeatSample) error {
+	update := bson.M{"$setOnInsert": sample}
+	_, err := s.collections.heartbeatHist.UpdateByID(ctx, sample.ID, update, options.Update().SetUpsert(true))
+	if err != nil {
+		s.log.Error("Error inserting heartbeat sample", zap.String("guardianAddr", sample.GuardianAddr), zap.Error(err))
+	}
+	return err
+}
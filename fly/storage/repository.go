@@ -13,6 +13,7 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/common/events"
+	"github.com/wormhole-foundation/wormhole-explorer/common/lifecycle"
 	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
 	"github.com/wormhole-foundation/wormhole-explorer/fly/event"
@@ -38,6 +39,7 @@ type Repository struct {
 	txHashStore     txhash.TxHashStore
 	eventDispatcher event.EventDispatcher
 	log             *zap.Logger
+	lifecycle       *lifecycle.Repository
 	collections     struct {
 		vaas           *mongo.Collection
 		heartbeats     *mongo.Collection
@@ -47,6 +49,8 @@ type Repository struct {
 		vaasPythnet    *mongo.Collection
 		vaaCounts      *mongo.Collection
 		duplicateVaas  *mongo.Collection
+		heartbeatHist  *mongo.Collection
+		signingProg    *mongo.Collection
 	}
 }
 
@@ -57,7 +61,7 @@ func NewRepository(alertService alert.AlertClient, metrics metrics.Metrics,
 	txHashStore txhash.TxHashStore,
 	eventDispatcher event.EventDispatcher,
 	log *zap.Logger) *Repository {
-	return &Repository{alertService, metrics, db, vaaTopicFunc, txHashStore, eventDispatcher, log, struct {
+	return &Repository{alertService, metrics, db, vaaTopicFunc, txHashStore, eventDispatcher, log, lifecycle.NewRepository(db), struct {
 		vaas           *mongo.Collection
 		heartbeats     *mongo.Collection
 		observations   *mongo.Collection
@@ -66,6 +70,8 @@ func NewRepository(alertService alert.AlertClient, metrics metrics.Metrics,
 		vaasPythnet    *mongo.Collection
 		vaaCounts      *mongo.Collection
 		duplicateVaas  *mongo.Collection
+		heartbeatHist  *mongo.Collection
+		signingProg    *mongo.Collection
 	}{
 		vaas:           db.Collection(repository.Vaas),
 		heartbeats:     db.Collection("heartbeats"),
@@ -74,7 +80,9 @@ func NewRepository(alertService alert.AlertClient, metrics metrics.Metrics,
 		governorStatus: db.Collection("governorStatus"),
 		vaasPythnet:    db.Collection("vaasPythnet"),
 		vaaCounts:      db.Collection("vaaCounts"),
-		duplicateVaas:  db.Collection(repository.DuplicateVaas)}}
+		duplicateVaas:  db.Collection(repository.DuplicateVaas),
+		heartbeatHist:  db.Collection(repository.HeartbeatHistory),
+		signingProg:    db.Collection(repository.SigningProgress)}}
 }
 
 func (s *Repository) UpsertVaa(ctx context.Context, v *vaa.VAA, serializedVaa []byte) error {
@@ -134,6 +142,10 @@ func (s *Repository) UpsertVaa(ctx context.Context, v *vaa.VAA, serializedVaa []
 	if err == nil && s.isNewRecord(result) {
 		s.metrics.IncVaaInserted(v.EmitterChain)
 		s.updateVAACount(v.EmitterChain)
+		if vaa.ChainIDPythNet != v.EmitterChain {
+			s.setSigningProgressVaa(ctx, id, now)
+			s.recordSigned(ctx, v, now)
+		}
 
 		// send signedvaa event to topic.
 		event, newErr := events.NewNotificationEvent[events.SignedVaa](
@@ -157,6 +169,17 @@ func (s *Repository) UpsertVaa(ctx context.Context, v *vaa.VAA, serializedVaa []
 	return err
 }
 
+// recordSigned records the signed vaa in the lifecycle of its operation.
+// The timestamp of the vaa is the time when the message was emitted.
+func (s *Repository) recordSigned(ctx context.Context, v *vaa.VAA, now time.Time) {
+	err := s.lifecycle.Record(ctx, v.MessageID(),
+		lifecycle.Event{Step: lifecycle.StepEmitted, At: v.Timestamp},
+		lifecycle.Event{Step: lifecycle.StepSigned, At: now})
+	if err != nil {
+		s.log.Warn("Error recording signed vaa in operation lifecycle", zap.String("id", v.MessageID()), zap.Error(err))
+	}
+}
+
 func (s *Repository) UpsertObservation(ctx context.Context, o *gossipv1.SignedObservation, saveTxHash bool) error {
 	vaaID := strings.Split(o.MessageId, "/")
 	chainIDStr, emitter, sequenceStr := vaaID[0], vaaID[1], vaaID[2]
@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// UpsertSigningProgress adds the guardian of an observation to the signing progress of its message.
+//
+// The signing progress keeps the guardians that signed the message and the time when the
+// message reached the quorum of the guardian set.
+func (s *Repository) UpsertSigningProgress(ctx context.Context, o *gossipv1.SignedObservation, guardianSetIndex uint32, quorum int) error {
+	vaaID := strings.Split(o.MessageId, "/")
+	if len(vaaID) != 3 {
+		return nil
+	}
+	chainID, err := strconv.ParseUint(vaaID[0], 10, 16)
+	if err != nil {
+		return err
+	}
+	if vaa.ChainID(chainID) == vaa.ChainIDPythNet {
+		return nil
+	}
+	sequence, err := strconv.ParseUint(vaaID[2], 10, 64)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	guardianAddr := eth_common.BytesToAddress(o.GetAddr()).String()
+
+	// the update is a pipeline to set the quorum time only once, when the number of signatures
+	// reaches the quorum for the first time.
+	guardians := bson.M{"$setUnion": bson.A{bson.M{"$ifNull": bson.A{"$guardians", bson.A{}}}, bson.A{guardianAddr}}}
+	firstObservationAt := bson.M{"$ifNull": bson.A{"$firstObservationAt", now}}
+	update := bson.A{
+		bson.M{"$set": bson.M{
+			"emitterChain":       vaa.ChainID(chainID),
+			"emitterAddr":        vaaID[1],
+			"sequence":           strconv.FormatUint(sequence, 10),
+			"guardianSetIndex":   guardianSetIndex,
+			"quorum":             quorum,
+			"guardians":          guardians,
+			"firstObservationAt": firstObservationAt,
+			"updatedAt":          now,
+		}},
+		bson.M{"$set": bson.M{
+			"signatures": bson.M{"$size": "$guardians"},
+		}},
+		bson.M{"$set": bson.M{
+			"quorumAt": bson.M{"$cond": bson.A{
+				bson.M{"$and": bson.A{
+					bson.M{"$eq": bson.A{bson.M{"$ifNull": bson.A{"$quorumAt", nil}}, nil}},
+					bson.M{"$gte": bson.A{"$signatures", "$quorum"}},
+				}},
+				now,
+				"$quorumAt",
+			}},
+		}},
+		bson.M{"$set": bson.M{
+			"timeToQuorumMs": bson.M{"$cond": bson.A{
+				bson.M{"$eq": bson.A{bson.M{"$ifNull": bson.A{"$quorumAt", nil}}, nil}},
+				"$$REMOVE",
+				bson.M{"$subtract": bson.A{"$quorumAt", "$firstObservationAt"}},
+			}},
+		}},
+	}
+
+	opts := options.Update().SetUpsert(true)
+	_, err = s.collections.signingProg.UpdateByID(ctx, o.MessageId, update, opts)
+	if err != nil {
+		s.log.Error("Error updating signing progress", zap.String("messageId", o.MessageId), zap.Error(err))
+	}
+	return err
+}
+
+// setSigningProgressVaa records the time when the VAA of a message was stored.
+func (s *Repository) setSigningProgressVaa(ctx context.Context, id string, now time.Time) {
+	update := bson.M{"$set": bson.M{"vaaAt": now}}
+	_, err := s.collections.signingProg.UpdateOne(ctx, bson.M{"_id": id, "vaaAt": bson.M{"$exists": false}}, update)
+	if err != nil {
+		s.log.Warn("Error updating signing progress", zap.String("id", id), zap.Error(err))
+	}
+}
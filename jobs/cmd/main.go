@@ -15,6 +15,8 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/stats"
 
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/common/backfill"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
 	"github.com/wormhole-foundation/wormhole-explorer/common/configuration"
 
@@ -24,15 +26,22 @@ import (
 	common "github.com/wormhole-foundation/wormhole-explorer/common/coingecko"
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/export"
+	"github.com/wormhole-foundation/wormhole-explorer/common/lifecycle"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
 	filePrices "github.com/wormhole-foundation/wormhole-explorer/common/prices"
 	"github.com/wormhole-foundation/wormhole-explorer/jobs/config"
+	jobsAlert "github.com/wormhole-foundation/wormhole-explorer/jobs/internal/alert"
 	"github.com/wormhole-foundation/wormhole-explorer/jobs/internal/coingecko"
 	apiPrices "github.com/wormhole-foundation/wormhole-explorer/jobs/internal/prices"
 	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs"
+	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/address"
+	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/audit"
+	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/exports"
 	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/migration"
 	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/notional"
 	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/report"
+	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/transfers"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
@@ -97,6 +106,18 @@ func main() {
 	case jobs.JobIDMigrationNativeTxHash:
 		job := initMigrateNativeTxHashJob(ctx, logger)
 		err = job.Run(ctx)
+	case jobs.JobIDAddressActivity:
+		job := initAddressActivityJob(ctx, logger)
+		err = job.Run(ctx)
+	case jobs.JobIDStuckTransfers:
+		job := initStuckTransfersJob(ctx, logger)
+		err = job.Run(ctx)
+	case jobs.JobIDVaaAudit:
+		job := initVaaAuditJob(ctx, logger)
+		err = job.Run(ctx)
+	case jobs.JobIDExports:
+		job := initExportsJob(ctx, logger)
+		err = job.Run(ctx)
 	case jobs.JobIDNTTTopAddressStats:
 		job := initNTTTopAddressStatsJob(ctx, logger)
 		err = job.Run(ctx)
@@ -266,6 +287,139 @@ func initMigrateNativeTxHashJob(ctx context.Context, logger *zap.Logger) *migrat
 	return migration.NewMigrationNativeTxHash(db.Database, cfgJob.PageSize, logger)
 }
 
+func initAddressActivityJob(ctx context.Context, logger *zap.Logger) *address.AddressActivityJob {
+	cfgJob, errCfg := configuration.LoadFromEnv[config.AddressActivityConfiguration](ctx)
+	if errCfg != nil {
+		log.Fatal("error creating config", errCfg)
+	}
+	db, err := dbutil.Connect(ctx, logger, cfgJob.MongoURI, cfgJob.MongoDatabase, false)
+	if err != nil {
+		logger.Fatal("Failed to connect MongoDB", zap.Error(err))
+	}
+
+	// without a start date, the job refreshes the recent operations, which may have been updated since
+	// the last run (e.g. the origin transaction is tracked after the vaa is parsed).
+	resume := cfgJob.FromDate != ""
+	to := time.Now().UTC().Truncate(time.Minute)
+	from := to.Add(-time.Duration(cfgJob.LookbackHours) * time.Hour)
+	if resume {
+		// the time range names the checkpoint of the job, so the end time can not default to now.
+		if cfgJob.ToDate == "" {
+			logger.Fatal("TO_DATE is required with FROM_DATE")
+		}
+		var end *time.Time
+		from, end, err = backfill.ParseTimeRange(cfgJob.FromDate, cfgJob.ToDate)
+		if err != nil {
+			logger.Fatal("Invalid time range", zap.Error(err))
+		}
+		to = *end
+	}
+	return address.NewAddressActivityJob(db.Database, from, to, cfgJob.PageSize, cfgJob.NumWorkers, resume, logger)
+}
+
+func initStuckTransfersJob(ctx context.Context, logger *zap.Logger) *transfers.StuckTransfersJob {
+	cfgJob, errCfg := configuration.LoadFromEnv[config.StuckTransfersConfiguration](ctx)
+	if errCfg != nil {
+		log.Fatal("error creating config", errCfg)
+	}
+	db, err := dbutil.Connect(ctx, logger, cfgJob.MongoURI, cfgJob.MongoDatabase, false)
+	if err != nil {
+		logger.Fatal("Failed to connect MongoDB", zap.Error(err))
+	}
+
+	// init alert client.
+	var alertClient alert.AlertClient = alert.NewDummyClient()
+	if cfgJob.AlertEnabled {
+		alertConfig := alert.AlertConfig{
+			Environment: cfgJob.Environment,
+			Enabled:     cfgJob.AlertEnabled,
+			ApiKey:      cfgJob.AlertApiKey,
+		}
+		alertClient, err = alert.NewAlertService(alertConfig, jobsAlert.LoadAlerts)
+		if err != nil {
+			logger.Fatal("Failed to create alert client", zap.Error(err))
+		}
+	}
+
+	stuckAfter := time.Duration(cfgJob.StuckAfterHours) * time.Hour
+	maxAge := time.Duration(cfgJob.MaxAgeHours) * time.Hour
+	return transfers.NewStuckTransfersJob(lifecycle.NewRepository(db.Database), alertClient, stuckAfter, maxAge, cfgJob.PageSize, logger)
+}
+
+func initVaaAuditJob(ctx context.Context, logger *zap.Logger) *audit.VaaAuditJob {
+	cfgJob, errCfg := configuration.LoadFromEnv[config.VaaAuditConfiguration](ctx)
+	if errCfg != nil {
+		log.Fatal("error creating config", errCfg)
+	}
+	db, err := dbutil.Connect(ctx, logger, cfgJob.MongoURI, cfgJob.MongoDatabase, false)
+	if err != nil {
+		logger.Fatal("Failed to connect MongoDB", zap.Error(err))
+	}
+
+	// init alert client.
+	var alertClient alert.AlertClient = alert.NewDummyClient()
+	if cfgJob.AlertEnabled {
+		alertConfig := alert.AlertConfig{
+			Environment: cfgJob.Environment,
+			Enabled:     cfgJob.AlertEnabled,
+			ApiKey:      cfgJob.AlertApiKey,
+		}
+		alertClient, err = alert.NewAlertService(alertConfig, jobsAlert.LoadAlerts)
+		if err != nil {
+			logger.Fatal("Failed to create alert client", zap.Error(err))
+		}
+	}
+
+	// without a start date, the job audits the recent vaas.
+	resume := cfgJob.FromDate != ""
+	to := time.Now().UTC().Truncate(time.Minute)
+	from := to.Add(-time.Duration(cfgJob.LookbackHours) * time.Hour)
+	if resume {
+		// the time range names the checkpoint of the job, so the end time can not default to now.
+		if cfgJob.ToDate == "" {
+			logger.Fatal("TO_DATE is required with FROM_DATE")
+		}
+		var end *time.Time
+		from, end, err = backfill.ParseTimeRange(cfgJob.FromDate, cfgJob.ToDate)
+		if err != nil {
+			logger.Fatal("Invalid time range", zap.Error(err))
+		}
+		to = *end
+	}
+	return audit.NewVaaAuditJob(db.Database, cfgJob.P2pNetwork, alertClient, from, to, cfgJob.PageSize, cfgJob.NumWorkers, resume, logger)
+}
+
+func initExportsJob(ctx context.Context, logger *zap.Logger) *exports.ExportsJob {
+	cfgJob, errCfg := configuration.LoadFromEnv[config.ExportsConfiguration](ctx)
+	if errCfg != nil {
+		log.Fatal("error creating config", errCfg)
+	}
+	db, err := dbutil.Connect(ctx, logger, cfgJob.MongoURI, cfgJob.MongoDatabase, false)
+	if err != nil {
+		logger.Fatal("Failed to connect MongoDB", zap.Error(err))
+	}
+
+	storage, err := export.NewStorage(export.StorageConfig{
+		Type:            cfgJob.ExportStorage,
+		Path:            cfgJob.ExportPath,
+		Endpoint:        cfgJob.S3Endpoint,
+		Region:          cfgJob.S3Region,
+		Bucket:          cfgJob.S3Bucket,
+		Prefix:          cfgJob.S3Prefix,
+		AccessKeyID:     cfgJob.S3AccessKeyID,
+		SecretAccessKey: cfgJob.S3SecretAccessKey,
+		PathStyle:       cfgJob.S3PathStyle,
+	})
+	if err != nil {
+		logger.Fatal("Failed to create export storage", zap.Error(err))
+	}
+
+	exporter := export.NewExporter(db.Database, storage, cfgJob.PageSize, logger)
+	requeueTimeout := time.Duration(cfgJob.RequeueTimeoutMinutes) * time.Minute
+	ttl := time.Duration(cfgJob.TTLHours) * time.Hour
+	return exports.NewExportsJob(export.NewRepository(db.Database), exporter, storage, requeueTimeout, ttl, logger)
+}
+
 func initNTTTopAddressStatsJob(ctx context.Context, logger *zap.Logger) *stats.NTTTopAddressJob {
 	cfgJob, errCfg := configuration.LoadFromEnv[config.NTTTopAddressStatsConfiguration](ctx)
 	if errCfg != nil {
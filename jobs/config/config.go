@@ -106,3 +106,65 @@ type NTTMedianStatsConfiguration struct {
 	CacheUrl             string `env:"CACHE_URL,required"`
 	CachePrefix          string `env:"CACHE_PREFIX,required"`
 }
+
+type AddressActivityConfiguration struct {
+	MongoURI      string `env:"MONGODB_URI,required"`
+	MongoDatabase string `env:"MONGODB_DATABASE,required"`
+	PageSize      int64  `env:"PAGE_SIZE,default=500"`
+	NumWorkers    int    `env:"NUM_WORKERS,default=10"`
+	// FromDate and ToDate set the time range of a resumable backfill, ToDate is required with FromDate
+	// because the range names the checkpoint. If FromDate is empty, the job processes the operations
+	// of the last LookbackHours.
+	FromDate      string `env:"FROM_DATE"`
+	ToDate        string `env:"TO_DATE"`
+	LookbackHours int    `env:"LOOKBACK_HOURS,default=6"`
+}
+
+type StuckTransfersConfiguration struct {
+	MongoURI        string `env:"MONGODB_URI,required"`
+	MongoDatabase   string `env:"MONGODB_DATABASE,required"`
+	Environment     string `env:"ENVIRONMENT,required"`
+	AlertEnabled    bool   `env:"ALERT_ENABLED"`
+	AlertApiKey     string `env:"ALERT_API_KEY"`
+	PageSize        int64  `env:"PAGE_SIZE,default=500"`
+	StuckAfterHours int    `env:"STUCK_AFTER_HOURS,default=24"`
+	// MaxAgeHours bounds the transfers checked, so old transfers that were never redeemed are not flagged at once.
+	MaxAgeHours int `env:"MAX_AGE_HOURS,default=168"`
+}
+
+type VaaAuditConfiguration struct {
+	MongoURI      string `env:"MONGODB_URI,required"`
+	MongoDatabase string `env:"MONGODB_DATABASE,required"`
+	P2pNetwork    string `env:"P2P_NETWORK,required"`
+	Environment   string `env:"ENVIRONMENT,required"`
+	AlertEnabled  bool   `env:"ALERT_ENABLED"`
+	AlertApiKey   string `env:"ALERT_API_KEY"`
+	PageSize      int64  `env:"PAGE_SIZE,default=500"`
+	NumWorkers    int    `env:"NUM_WORKERS,default=10"`
+	// FromDate and ToDate set the time range of a resumable audit, ToDate is required with FromDate
+	// because the range names the checkpoint. If FromDate is empty, the job audits the vaas of the
+	// last LookbackHours.
+	FromDate      string `env:"FROM_DATE"`
+	ToDate        string `env:"TO_DATE"`
+	LookbackHours int    `env:"LOOKBACK_HOURS,default=2"`
+}
+
+type ExportsConfiguration struct {
+	MongoURI      string `env:"MONGODB_URI,required"`
+	MongoDatabase string `env:"MONGODB_DATABASE,required"`
+	PageSize      int64  `env:"PAGE_SIZE,default=1000"`
+	// RequeueTimeoutMinutes is the time after which a running export is considered interrupted and run again.
+	RequeueTimeoutMinutes int `env:"REQUEUE_TIMEOUT_MINUTES,default=60"`
+	// TTLHours is the time after which a completed or failed export is deleted with its file, 0 keeps them.
+	TTLHours int `env:"EXPORT_TTL_HOURS,default=168"`
+	// ExportStorage is the storage of the export files, local or s3.
+	ExportStorage     string `env:"EXPORT_STORAGE,default=local"`
+	ExportPath        string `env:"EXPORT_PATH"`
+	S3Endpoint        string `env:"S3_ENDPOINT"`
+	S3Region          string `env:"S3_REGION"`
+	S3Bucket          string `env:"S3_BUCKET"`
+	S3Prefix          string `env:"S3_PREFIX"`
+	S3AccessKeyID     string `env:"S3_ACCESS_KEY_ID"`
+	S3SecretAccessKey string `env:"S3_SECRET_ACCESS_KEY"`
+	S3PathStyle       bool   `env:"S3_PATH_STYLE"`
+}
@@ -0,0 +1,41 @@
+package alert
+
+import (
+	"fmt"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+)
+
+// alert key constants definition.
+const (
+	StuckTransfer    = "STUCK_TRANSFER"
+	VaaAuditFindings = "VAA_AUDIT_FINDINGS"
+)
+
+func LoadAlerts(cfg alert.AlertConfig) map[string]alert.Alert {
+	alerts := make(map[string]alert.Alert)
+
+	// Alert transfer signed but not redeemed.
+	alerts[StuckTransfer] = alert.Alert{
+		Alias:       StuckTransfer,
+		Message:     fmt.Sprintf("[%s] %s", cfg.Environment, "Transfer signed but not redeemed"),
+		Description: "A transfer was signed by the guardians but its vaa was not redeemed in the target chain in time.",
+		Actions:     []string{"check the destination transaction of the operation", "check tx-tracker for the target chain"},
+		Tags:        []string{cfg.Environment, "jobs", "lifecycle", "stuck"},
+		Entity:      "jobs",
+		Priority:    alert.MODERATE,
+	}
+
+	// Alert stored vaas with integrity issues.
+	alerts[VaaAuditFindings] = alert.Alert{
+		Alias:       VaaAuditFindings,
+		Message:     fmt.Sprintf("[%s] %s", cfg.Environment, "Stored vaas with integrity issues"),
+		Description: "The vaa audit found stored vaas with invalid signatures or fields that do not match the vaa.",
+		Actions:     []string{"check the findings first found by the run (firstRunId) in the vaaAuditFindings collection", "check the backfillers that stored the vaas"},
+		Tags:        []string{cfg.Environment, "jobs", "vaa", "audit"},
+		Entity:      "jobs",
+		Priority:    alert.HIGH,
+	}
+
+	return alerts
+}
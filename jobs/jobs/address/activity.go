@@ -0,0 +1,134 @@
+package address
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// Direction of an operation from the point of view of an address.
+const (
+	DirectionSent     = "sent"
+	DirectionReceived = "received"
+)
+
+// Activity is an operation sent or received by an address, stored in the addressActivity collection.
+type Activity struct {
+	ID                  string      `bson:"_id"`
+	VaaID               string      `bson:"vaaId"`
+	Address             string      `bson:"address"`
+	NativeAddress       string      `bson:"nativeAddress"`
+	Chain               sdk.ChainID `bson:"chain"`
+	Direction           string      `bson:"direction"`
+	CounterpartyChain   sdk.ChainID `bson:"counterpartyChain"`
+	CounterpartyAddress string      `bson:"counterpartyAddress"`
+	TokenChain          sdk.ChainID `bson:"tokenChain"`
+	TokenAddress        string      `bson:"tokenAddress"`
+	Symbol              string      `bson:"symbol"`
+	TokenAmount         string      `bson:"tokenAmount"`
+	UsdAmount           string      `bson:"usdAmount"`
+	AppIDs              []string    `bson:"appIds"`
+	TxHash              string      `bson:"txHash"`
+	Timestamp           time.Time   `bson:"timestamp"`
+	UpdatedAt           time.Time   `bson:"updatedAt"`
+}
+
+// operation is a parsed vaa joined with its origin transaction and its price at transfer time.
+type operation struct {
+	ID                     string                        `bson:"_id"`
+	Timestamp              time.Time                     `bson:"timestamp"`
+	StandardizedProperties parser.StandardizedProperties `bson:"standardizedProperties"`
+	OriginTx               *struct {
+		ChainID      sdk.ChainID `bson:"chainId"`
+		From         string      `bson:"from"`
+		NativeTxHash string      `bson:"nativeTxHash"`
+	} `bson:"originTx"`
+	Price *struct {
+		Symbol      string `bson:"symbol"`
+		TokenAmount string `bson:"tokenAmount"`
+		UsdAmount   string `bson:"usdAmount"`
+	} `bson:"price"`
+}
+
+// party is one side of an operation.
+type party struct {
+	chain         sdk.ChainID
+	nativeAddress string
+	address       string
+}
+
+func newParty(chain sdk.ChainID, nativeAddress string) party {
+	p := party{chain: chain, nativeAddress: nativeAddress}
+	if nativeAddress == "" {
+		return p
+	}
+	if address, err := domain.NormalizeNativeAddress(chain, nativeAddress); err == nil {
+		p.address = address
+	}
+	return p
+}
+
+// counterpartyAddress returns the normalized address of the party, or the native address if it can't be normalized.
+func (p party) counterpartyAddress() string {
+	if p.address != "" {
+		return p.address
+	}
+	return p.nativeAddress
+}
+
+// buildActivities returns the activities of the sender and the receiver of an operation.
+// The sides of the operation whose address is unknown or can't be normalized are skipped.
+func buildActivities(op *operation, now time.Time) []*Activity {
+	props := op.StandardizedProperties
+
+	senderChain, senderAddress := props.FromChain, props.FromAddress
+	var txHash string
+	if op.OriginTx != nil {
+		if op.OriginTx.ChainID != 0 {
+			senderChain = op.OriginTx.ChainID
+		}
+		if op.OriginTx.From != "" {
+			senderAddress = op.OriginTx.From
+		}
+		txHash = op.OriginTx.NativeTxHash
+	}
+	sender := newParty(senderChain, senderAddress)
+	receiver := newParty(props.ToChain, props.ToAddress)
+
+	newActivity := func(direction string, self, counterparty party) *Activity {
+		a := &Activity{
+			ID:                  fmt.Sprintf("%s/%s", op.ID, direction),
+			VaaID:               op.ID,
+			Address:             self.address,
+			NativeAddress:       self.nativeAddress,
+			Chain:               self.chain,
+			Direction:           direction,
+			CounterpartyChain:   counterparty.chain,
+			CounterpartyAddress: counterparty.counterpartyAddress(),
+			TokenChain:          props.TokenChain,
+			TokenAddress:        props.TokenAddress,
+			AppIDs:              props.AppIds,
+			TxHash:              txHash,
+			Timestamp:           op.Timestamp,
+			UpdatedAt:           now,
+		}
+		if op.Price != nil {
+			a.Symbol = op.Price.Symbol
+			a.TokenAmount = op.Price.TokenAmount
+			a.UsdAmount = op.Price.UsdAmount
+		}
+		return a
+	}
+
+	var activities []*Activity
+	if sender.address != "" {
+		activities = append(activities, newActivity(DirectionSent, sender, receiver))
+	}
+	if receiver.address != "" {
+		activities = append(activities, newActivity(DirectionReceived, receiver, sender))
+	}
+	return activities
+}
@@ -0,0 +1,77 @@
+package address
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestBuildActivities(t *testing.T) {
+	now := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	op := &operation{
+		ID:        "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1",
+		Timestamp: now.Add(-time.Hour),
+		StandardizedProperties: parser.StandardizedProperties{
+			AppIds:       []string{"PORTAL_TOKEN_BRIDGE"},
+			FromChain:    sdk.ChainIDEthereum,
+			ToChain:      sdk.ChainIDSolana,
+			ToAddress:    "3QFeCHsG9WDXzMozWyck8RUxmw59jyj7MPnQd4w2mbDL",
+			TokenChain:   sdk.ChainIDEthereum,
+			TokenAddress: "000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2",
+		},
+	}
+	op.OriginTx = &struct {
+		ChainID      sdk.ChainID `bson:"chainId"`
+		From         string      `bson:"from"`
+		NativeTxHash string      `bson:"nativeTxHash"`
+	}{ChainID: sdk.ChainIDEthereum, From: "0x3Ee18B2214AFF97000D974cf647E7C347E8fa585", NativeTxHash: "0xabc"}
+	op.Price = &struct {
+		Symbol      string `bson:"symbol"`
+		TokenAmount string `bson:"tokenAmount"`
+		UsdAmount   string `bson:"usdAmount"`
+	}{Symbol: "WETH", TokenAmount: "1.5", UsdAmount: "4500"}
+
+	activities := buildActivities(op, now)
+	require.Len(t, activities, 2)
+
+	sent, received := activities[0], activities[1]
+	assert.Equal(t, op.ID+"/sent", sent.ID)
+	assert.Equal(t, DirectionSent, sent.Direction)
+	assert.Equal(t, "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585", sent.Address)
+	assert.Equal(t, "0x3Ee18B2214AFF97000D974cf647E7C347E8fa585", sent.NativeAddress)
+	assert.Equal(t, sdk.ChainIDEthereum, sent.Chain)
+	assert.Equal(t, sdk.ChainIDSolana, sent.CounterpartyChain)
+	assert.Equal(t, "23ac499437a8e6533b790d5578af5d39b349883188eca535b957d82a0e77eb03", sent.CounterpartyAddress)
+	assert.Equal(t, "WETH", sent.Symbol)
+	assert.Equal(t, "4500", sent.UsdAmount)
+	assert.Equal(t, "0xabc", sent.TxHash)
+	assert.Equal(t, now, sent.UpdatedAt)
+
+	assert.Equal(t, op.ID+"/received", received.ID)
+	assert.Equal(t, "23ac499437a8e6533b790d5578af5d39b349883188eca535b957d82a0e77eb03", received.Address)
+	assert.Equal(t, sdk.ChainIDSolana, received.Chain)
+	assert.Equal(t, sent.Address, received.CounterpartyAddress)
+	assert.Equal(t, []string{"PORTAL_TOKEN_BRIDGE"}, received.AppIDs)
+}
+
+func TestBuildActivitiesWithoutSender(t *testing.T) {
+	op := &operation{
+		ID: "1/ec7372995d5cc8732397fb0ad35c0121e0eaa90d26f828a534cab54391b3a4f5/10",
+		StandardizedProperties: parser.StandardizedProperties{
+			FromChain: sdk.ChainIDSolana,
+			ToChain:   sdk.ChainIDEthereum,
+			ToAddress: "0x3ee18b2214aff97000d974cf647e7c347e8fa585",
+		},
+	}
+
+	activities := buildActivities(op, time.Now())
+	require.Len(t, activities, 1)
+	assert.Equal(t, DirectionReceived, activities[0].Direction)
+	assert.Equal(t, sdk.ChainIDSolana, activities[0].CounterpartyChain)
+	assert.Empty(t, activities[0].CounterpartyAddress)
+	assert.Empty(t, activities[0].UsdAmount)
+}
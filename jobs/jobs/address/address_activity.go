@@ -0,0 +1,151 @@
+// Package address materializes the operations sent and received by each address in the
+// addressActivity collection, which backs the address portfolio and activity endpoints of the api.
+package address
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/backfill"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// AddressActivityJob is the job to build the addressActivity collection from the operations in a time range.
+type AddressActivityJob struct {
+	db          *mongo.Database
+	from        time.Time
+	to          time.Time
+	pageSize    int64
+	workers     int
+	resume      bool
+	collections struct {
+		parsedVaa       *mongo.Collection
+		addressActivity *mongo.Collection
+	}
+	logger *zap.Logger
+}
+
+// NewAddressActivityJob creates a new address activity job for the operations between from and to.
+// If resume is true, the job continues from the checkpoint of a previous run over the same range.
+func NewAddressActivityJob(
+	db *mongo.Database,
+	from, to time.Time,
+	pageSize int64,
+	workers int,
+	resume bool,
+	logger *zap.Logger) *AddressActivityJob {
+	j := &AddressActivityJob{
+		db:       db,
+		from:     from,
+		to:       to,
+		pageSize: pageSize,
+		workers:  workers,
+		resume:   resume,
+		logger:   logger.With(zap.String("module", "AddressActivityJob")),
+	}
+	j.collections.parsedVaa = db.Collection(repository.ParsedVaa)
+	j.collections.addressActivity = db.Collection(repository.AddressActivity)
+	return j
+}
+
+// Run runs the address activity job.
+func (j *AddressActivityJob) Run(ctx context.Context) error {
+	cfg := backfill.Config{
+		Name:     fmt.Sprintf("address-activity-%s-%s", j.from.Format(time.RFC3339), j.to.Format(time.RFC3339)),
+		PageSize: j.pageSize,
+		Workers:  j.workers,
+		Resume:   j.resume,
+	}
+	j.logger.Info("Building address activity",
+		zap.Time("from", j.from),
+		zap.Time("to", j.to))
+	// only the resumable runs store checkpoints, the periodic runs over the recent operations start from scratch.
+	var checkpoints backfill.CheckpointStore
+	if j.resume {
+		checkpoints = backfill.NewMongoCheckpointStore(j.db)
+	}
+	job := backfill.NewJob[*operation](cfg, j, backfill.StrategyFunc[*operation](j.upsert), checkpoints, j.logger)
+	_, err := job.Run(ctx)
+	return err
+}
+
+// Next returns the page of operations following the cursor, sorted by ascending timestamp and id.
+func (j *AddressActivityJob) Next(ctx context.Context, cursor string, limit int64) ([]*operation, string, error) {
+	after, err := backfill.ParseTimeCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	filter := bson.D{{Key: "timestamp", Value: bson.M{"$gte": j.from, "$lt": j.to}}}
+	if after != nil {
+		filter = append(filter, bson.E{Key: "$or", Value: bson.A{
+			bson.M{"timestamp": bson.M{"$gt": after.Timestamp}},
+			bson.M{"timestamp": after.Timestamp, "_id": bson.M{"$gt": after.ID}},
+		}})
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$sort", Value: bson.D{{Key: "timestamp", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+		{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: "globalTransactions"},
+			{Key: "localField", Value: "_id"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: "globalTransactions"},
+		}}},
+		{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: repository.TransferPrices},
+			{Key: "localField", Value: "_id"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: "transferPrices"},
+		}}},
+		{{Key: "$project", Value: bson.D{
+			{Key: "timestamp", Value: 1},
+			{Key: "standardizedProperties", Value: 1},
+			{Key: "originTx", Value: bson.M{"$arrayElemAt": bson.A{"$globalTransactions.originTx", 0}}},
+			{Key: "price", Value: bson.M{"$arrayElemAt": bson.A{"$transferPrices", 0}}},
+		}}},
+	}
+
+	cur, err := j.collections.parsedVaa.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, "", err
+	}
+	var operations []*operation
+	if err := cur.All(ctx, &operations); err != nil {
+		return nil, "", err
+	}
+	if len(operations) == 0 {
+		return nil, cursor, nil
+	}
+	last := operations[len(operations)-1]
+	next := backfill.TimeCursor{Timestamp: last.Timestamp, ID: last.ID}
+	return operations, next.String(), nil
+}
+
+// Query returns the time range of the job.
+func (j *AddressActivityJob) Query() string {
+	return fmt.Sprintf("from=%s,to=%s", j.from.Format(time.RFC3339), j.to.Format(time.RFC3339))
+}
+
+// Key returns the id of the operation.
+func (j *AddressActivityJob) Key(op *operation) string {
+	return op.ID
+}
+
+// upsert stores the activities of the sender and the receiver of an operation.
+func (j *AddressActivityJob) upsert(ctx context.Context, op *operation) error {
+	for _, a := range buildActivities(op, time.Now()) {
+		_, err := j.collections.addressActivity.ReplaceOne(ctx, bson.M{"_id": a.ID}, a, options.Replace().SetUpsert(true))
+		if err != nil {
+			return fmt.Errorf("failed to upsert address activity %s: %w", a.ID, err)
+		}
+	}
+	return nil
+}
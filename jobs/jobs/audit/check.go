@@ -0,0 +1,92 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// IssueCode identifies an integrity issue of a stored VAA.
+type IssueCode string
+
+// Integrity issues of the stored VAAs.
+const (
+	IssueMalformedVaa             IssueCode = "MALFORMED_VAA"
+	IssueUnknownGuardianSet       IssueCode = "UNKNOWN_GUARDIAN_SET"
+	IssueInvalidSignatures        IssueCode = "INVALID_SIGNATURES"
+	IssueNoQuorum                 IssueCode = "NO_QUORUM"
+	IssueMissingDigest            IssueCode = "MISSING_DIGEST"
+	IssueDigestMismatch           IssueCode = "DIGEST_MISMATCH"
+	IssueIDMismatch               IssueCode = "ID_MISMATCH"
+	IssueEmitterMismatch          IssueCode = "EMITTER_MISMATCH"
+	IssueSequenceMismatch         IssueCode = "SEQUENCE_MISMATCH"
+	IssueGuardianSetIndexMismatch IssueCode = "GUARDIAN_SET_INDEX_MISMATCH"
+)
+
+// Issue is an integrity issue found in a stored VAA.
+type Issue struct {
+	Code   IssueCode `bson:"code"`
+	Detail string    `bson:"detail"`
+}
+
+// checkVaa parses the VAA of a document of the vaas or duplicateVaas collection, verifies its signatures
+// and checks the fields stored with it match the VAA. It returns the issues found.
+func checkVaa(collection string, doc *repository.VaaDoc, gs *guardianSets) []Issue {
+	v, err := sdk.Unmarshal(doc.Vaa)
+	if err != nil {
+		return []Issue{{Code: IssueMalformedVaa, Detail: err.Error()}}
+	}
+
+	var issues []Issue
+	add := func(code IssueCode, format string, args ...any) {
+		issues = append(issues, Issue{Code: code, Detail: fmt.Sprintf(format, args...)})
+	}
+
+	if err := gs.verify(v); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnknownGuardianSet):
+			add(IssueUnknownGuardianSet, "%v", err)
+		case errors.Is(err, domain.ErrNoQuorum):
+			add(IssueNoQuorum, "%v", err)
+		default:
+			add(IssueInvalidSignatures, "%v", err)
+		}
+	}
+
+	digest := utils.NormalizeHex(v.HexDigest())
+	switch {
+	case doc.Digest == "":
+		add(IssueMissingDigest, "expected %s", digest)
+	case utils.NormalizeHex(doc.Digest) != digest:
+		add(IssueDigestMismatch, "stored %s, expected %s", doc.Digest, digest)
+	}
+
+	// the duplicate VAAs are identified by the message id and the signing digest.
+	if collection == repository.DuplicateVaas {
+		if id := domain.CreateUniqueVaaID(v); doc.ID != id {
+			add(IssueIDMismatch, "stored %s, expected %s", doc.ID, id)
+		}
+		if doc.VaaID != v.MessageID() {
+			add(IssueIDMismatch, "stored vaaId %s, expected %s", doc.VaaID, v.MessageID())
+		}
+	} else if doc.ID != v.MessageID() {
+		add(IssueIDMismatch, "stored %s, expected %s", doc.ID, v.MessageID())
+	}
+
+	if doc.ChainID != uint16(v.EmitterChain) || utils.NormalizeHex(doc.EmitterAddress) != v.EmitterAddress.String() {
+		add(IssueEmitterMismatch, "stored %d/%s, expected %d/%s",
+			doc.ChainID, doc.EmitterAddress, v.EmitterChain, v.EmitterAddress.String())
+	}
+	if sequence := strconv.FormatUint(v.Sequence, 10); doc.Sequence != sequence {
+		add(IssueSequenceMismatch, "stored %s, expected %s", doc.Sequence, sequence)
+	}
+	if doc.GuardianSetIndex != v.GuardianSetIndex {
+		add(IssueGuardianSetIndexMismatch, "stored %d, expected %d", doc.GuardianSetIndex, v.GuardianSetIndex)
+	}
+	return issues
+}
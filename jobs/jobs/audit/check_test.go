@@ -0,0 +1,182 @@
+package audit
+
+import (
+	"crypto/ecdsa"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// newGuardians creates the keys of a guardian set of n guardians.
+func newGuardians(t *testing.T, n int) ([]*ecdsa.PrivateKey, *guardianSets) {
+	keys := make([]*ecdsa.PrivateKey, 0, n)
+	addresses := make([]eth_common.Address, 0, n)
+	for i := 0; i < n; i++ {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys = append(keys, key)
+		addresses = append(addresses, crypto.PubkeyToAddress(key.PublicKey))
+	}
+	return keys, &guardianSets{byIndex: []common.GuardianSet{{Index: 0, Keys: addresses}}}
+}
+
+// newVaa creates a VAA signed by the keys.
+func newVaa(keys ...*ecdsa.PrivateKey) *sdk.VAA {
+	v := &sdk.VAA{
+		Version:          sdk.SupportedVAAVersion,
+		GuardianSetIndex: 0,
+		Timestamp:        time.Unix(1700000000, 0),
+		Nonce:            1,
+		Sequence:         42,
+		ConsistencyLevel: 1,
+		EmitterChain:     sdk.ChainIDEthereum,
+		EmitterAddress:   sdk.Address{31: 1},
+		Payload:          []byte{1, 2, 3},
+	}
+	for i, key := range keys {
+		v.AddSignature(key, uint8(i))
+	}
+	return v
+}
+
+// newVaaDoc creates the document of a VAA as stored by fly.
+func newVaaDoc(t *testing.T, v *sdk.VAA) *repository.VaaDoc {
+	data, err := v.Marshal()
+	require.NoError(t, err)
+	return &repository.VaaDoc{
+		ID:               v.MessageID(),
+		Vaa:              data,
+		ChainID:          uint16(v.EmitterChain),
+		EmitterAddress:   v.EmitterAddress.String(),
+		Sequence:         strconv.FormatUint(v.Sequence, 10),
+		GuardianSetIndex: v.GuardianSetIndex,
+		Digest:           v.HexDigest(),
+	}
+}
+
+func codes(issues []Issue) []IssueCode {
+	result := make([]IssueCode, 0, len(issues))
+	for _, issue := range issues {
+		result = append(result, issue.Code)
+	}
+	return result
+}
+
+func TestCheckVaa(t *testing.T) {
+	keys, gs := newGuardians(t, 3)
+	other, _ := newGuardians(t, 1)
+
+	testCases := []struct {
+		name     string
+		doc      func() *repository.VaaDoc
+		expected []IssueCode
+	}{
+		{
+			name:     "valid",
+			doc:      func() *repository.VaaDoc { return newVaaDoc(t, newVaa(keys...)) },
+			expected: []IssueCode{},
+		},
+		{
+			name:     "malformed",
+			doc:      func() *repository.VaaDoc { return &repository.VaaDoc{ID: "2/a/1", Vaa: []byte{1, 2}} },
+			expected: []IssueCode{IssueMalformedVaa},
+		},
+		{
+			name:     "invalid signatures",
+			doc:      func() *repository.VaaDoc { return newVaaDoc(t, newVaa(keys[0], keys[1], other[0])) },
+			expected: []IssueCode{IssueInvalidSignatures},
+		},
+		{
+			name:     "no quorum",
+			doc:      func() *repository.VaaDoc { return newVaaDoc(t, newVaa(keys[0], keys[1])) },
+			expected: []IssueCode{IssueNoQuorum},
+		},
+		{
+			name: "unknown guardian set",
+			doc: func() *repository.VaaDoc {
+				v := newVaa()
+				v.GuardianSetIndex = 1
+				v.AddSignature(keys[0], 0)
+				return newVaaDoc(t, v)
+			},
+			expected: []IssueCode{IssueUnknownGuardianSet},
+		},
+		{
+			name: "missing digest",
+			doc: func() *repository.VaaDoc {
+				doc := newVaaDoc(t, newVaa(keys...))
+				doc.Digest = ""
+				return doc
+			},
+			expected: []IssueCode{IssueMissingDigest},
+		},
+		{
+			name: "fields do not match",
+			doc: func() *repository.VaaDoc {
+				doc := newVaaDoc(t, newVaa(keys...))
+				doc.ID = "2/0000000000000000000000000000000000000000000000000000000000000001/43"
+				doc.Digest = "0x00"
+				doc.ChainID = uint16(sdk.ChainIDSolana)
+				doc.Sequence = "43"
+				doc.GuardianSetIndex = 4
+				return doc
+			},
+			expected: []IssueCode{
+				IssueDigestMismatch,
+				IssueIDMismatch,
+				IssueEmitterMismatch,
+				IssueSequenceMismatch,
+				IssueGuardianSetIndexMismatch,
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, codes(checkVaa(repository.Vaas, testCase.doc(), gs)))
+		})
+	}
+}
+
+func TestCheckVaa_Duplicate(t *testing.T) {
+	keys, gs := newGuardians(t, 1)
+	v := newVaa(keys...)
+
+	doc := newVaaDoc(t, v)
+	doc.ID = domain.CreateUniqueVaaID(v)
+	doc.VaaID = v.MessageID()
+	assert.Empty(t, checkVaa(repository.DuplicateVaas, doc, gs))
+
+	// a duplicate stored with the id of the vaa.
+	doc.ID = v.MessageID()
+	assert.Equal(t, []IssueCode{IssueIDMismatch}, codes(checkVaa(repository.DuplicateVaas, doc, gs)))
+}
+
+func TestNewGuardianSets(t *testing.T) {
+	mainnet, _ := domain.GetMainnetGuardianSet()
+	key := func(i uint32, b byte) repository.GuardianSetKeyDoc {
+		return repository.GuardianSetKeyDoc{Index: i, Address: []byte{b}}
+	}
+
+	gs := newGuardianSets(domain.P2pMainNet, []*repository.GuardianSetDoc{
+		// the sets are sorted by index.
+		{GuardianSetIndex: uint32(len(mainnet)), Keys: []repository.GuardianSetKeyDoc{key(1, 2), key(0, 1)}},
+		{GuardianSetIndex: 0, Keys: []repository.GuardianSetKeyDoc{key(0, 9)}},
+		// after a gap.
+		{GuardianSetIndex: uint32(len(mainnet) + 2), Keys: []repository.GuardianSetKeyDoc{key(0, 3)}},
+	})
+
+	require.Len(t, gs.byIndex, len(mainnet)+1)
+	assert.Equal(t, []eth_common.Address{eth_common.BytesToAddress([]byte{9})}, gs.byIndex[0].Keys)
+	assert.Equal(t, mainnet[1].Keys, gs.byIndex[1].Keys)
+	assert.Equal(t, []eth_common.Address{eth_common.BytesToAddress([]byte{1}), eth_common.BytesToAddress([]byte{2})},
+		gs.byIndex[len(mainnet)].Keys)
+}
@@ -0,0 +1,61 @@
+package audit
+
+import (
+	"sort"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// guardianSets are the guardian sets of the network, sorted by index.
+type guardianSets struct {
+	byIndex []common.GuardianSet
+}
+
+// newGuardianSets creates the guardian sets of a network. The guardian sets stored by fly replace the known
+// guardian sets of the network, so the sets added after the release of the job are also verified.
+func newGuardianSets(p2pNetwork string, docs []*repository.GuardianSetDoc) *guardianSets {
+	var byIndex []common.GuardianSet
+	switch p2pNetwork {
+	case domain.P2pTestNet:
+		byIndex, _ = domain.GetTestnetGuardianSet()
+	default:
+		byIndex, _ = domain.GetMainnetGuardianSet()
+	}
+
+	sort.Slice(docs, func(i, k int) bool { return docs[i].GuardianSetIndex < docs[k].GuardianSetIndex })
+	for _, doc := range docs {
+		gs, ok := toGuardianSet(doc)
+		// the guardian sets are found by position, so the sets after a gap or a malformed set are ignored.
+		if !ok || int(doc.GuardianSetIndex) > len(byIndex) {
+			break
+		}
+		if int(doc.GuardianSetIndex) < len(byIndex) {
+			byIndex[doc.GuardianSetIndex] = gs
+		} else {
+			byIndex = append(byIndex, gs)
+		}
+	}
+	return &guardianSets{byIndex: byIndex}
+}
+
+// toGuardianSet converts a guardian set document, placing each key at its index.
+func toGuardianSet(doc *repository.GuardianSetDoc) (common.GuardianSet, bool) {
+	keys := make([]eth_common.Address, len(doc.Keys))
+	for _, k := range doc.Keys {
+		if int(k.Index) >= len(keys) {
+			return common.GuardianSet{}, false
+		}
+		keys[k.Index] = eth_common.BytesToAddress(k.Address)
+	}
+	return common.GuardianSet{Index: doc.GuardianSetIndex, Keys: keys}, true
+}
+
+// verify validates the guardian signatures of a VAA and checks the VAA has a quorum of signatures,
+// with the same verifier fly uses on ingestion.
+func (g *guardianSets) verify(v *sdk.VAA) error {
+	return domain.VerifyVaa(v, g.byIndex)
+}
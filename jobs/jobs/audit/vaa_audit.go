@@ -0,0 +1,266 @@
+// Package audit re-verifies the VAAs stored in the vaas and duplicateVaas collections. fly verifies the
+// VAAs on ingestion, but the backfillers store them without verification, so the job checks the
+// signatures, the digest and the fields stored with each VAA, and keeps the issues found in the
+// vaaAuditFindings collection.
+package audit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wormhole-foundation/wormhole-explorer/common/backfill"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	jobsAlert "github.com/wormhole-foundation/wormhole-explorer/jobs/internal/alert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// finding is a document of the vaaAuditFindings collection, with the issues of a stored VAA.
+// The runId is the last run that found the issues, the firstRunId of the document is the first one.
+type finding struct {
+	Collection string     `bson:"collection"`
+	DocumentID string     `bson:"documentId"`
+	Issues     []Issue    `bson:"issues"`
+	TxHash     string     `bson:"txHash"`
+	Timestamp  *time.Time `bson:"timestamp"`
+	RunID      string     `bson:"runId"`
+	AuditedAt  time.Time  `bson:"auditedAt"`
+}
+
+// collectionReport is the summary of the audit of a collection.
+type collectionReport struct {
+	Audited    int64 `bson:"audited"`
+	WithIssues int64 `bson:"withIssues"`
+	// New is the number of VAAs with issues not found by a previous run.
+	New    int64               `bson:"new"`
+	Issues map[IssueCode]int64 `bson:"issues"`
+}
+
+// report is a document of the vaaAuditReports collection, with the summary of a run of the job.
+type report struct {
+	mu          sync.Mutex
+	ID          string                       `bson:"_id"`
+	From        time.Time                    `bson:"from"`
+	To          time.Time                    `bson:"to"`
+	StartedAt   time.Time                    `bson:"startedAt"`
+	FinishedAt  time.Time                    `bson:"finishedAt"`
+	Collections map[string]*collectionReport `bson:"collections"`
+}
+
+// add counts a VAA audited and its issues. The VAAs are audited concurrently.
+func (r *report) add(collection string, issues []Issue) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.Collections[collection]
+	if !ok {
+		c = &collectionReport{Issues: make(map[IssueCode]int64)}
+		r.Collections[collection] = c
+	}
+	c.Audited++
+	if len(issues) > 0 {
+		c.WithIssues++
+	}
+	for _, issue := range issues {
+		c.Issues[issue.Code]++
+	}
+}
+
+// addNew counts a VAA with issues not found by a previous run.
+func (r *report) addNew(collection string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Collections[collection].New++
+}
+
+// withIssues returns the number of VAAs with issues of all the collections.
+func (r *report) withIssues() int64 {
+	var total int64
+	for _, c := range r.Collections {
+		total += c.WithIssues
+	}
+	return total
+}
+
+// newIssues returns the number of VAAs with issues not found by a previous run of all the collections.
+func (r *report) newIssues() int64 {
+	var total int64
+	for _, c := range r.Collections {
+		total += c.New
+	}
+	return total
+}
+
+// VaaAuditJob is the job to audit the integrity of the VAAs stored in a time range.
+type VaaAuditJob struct {
+	db          *mongo.Database
+	p2pNetwork  string
+	alertClient alert.AlertClient
+	from        time.Time
+	to          time.Time
+	pageSize    int64
+	workers     int
+	resume      bool
+	collections struct {
+		findings *mongo.Collection
+		reports  *mongo.Collection
+	}
+	logger *zap.Logger
+}
+
+// NewVaaAuditJob creates a new VAA audit job for the VAAs stored between from and to.
+// If resume is true, the job continues from the checkpoint of a previous run over the same range.
+func NewVaaAuditJob(
+	db *mongo.Database,
+	p2pNetwork string,
+	alertClient alert.AlertClient,
+	from, to time.Time,
+	pageSize int64,
+	workers int,
+	resume bool,
+	logger *zap.Logger) *VaaAuditJob {
+	j := &VaaAuditJob{
+		db:          db,
+		p2pNetwork:  p2pNetwork,
+		alertClient: alertClient,
+		from:        from,
+		to:          to,
+		pageSize:    pageSize,
+		workers:     workers,
+		resume:      resume,
+		logger:      logger.With(zap.String("module", "VaaAuditJob")),
+	}
+	j.collections.findings = db.Collection(repository.VaaAuditFindings)
+	j.collections.reports = db.Collection(repository.VaaAuditReports)
+	return j
+}
+
+// Run runs the VAA audit job.
+func (j *VaaAuditJob) Run(ctx context.Context) error {
+	docs, err := repository.NewGuardianSetRepository(j.db, j.logger).FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get guardian sets: %w", err)
+	}
+	gs := newGuardianSets(j.p2pNetwork, docs)
+
+	r := &report{
+		ID:          uuid.New().String(),
+		From:        j.from,
+		To:          j.to,
+		StartedAt:   time.Now(),
+		Collections: make(map[string]*collectionReport),
+	}
+	j.logger.Info("Auditing vaas",
+		zap.String("runId", r.ID),
+		zap.Time("from", j.from),
+		zap.Time("to", j.to))
+
+	// only the resumable runs store checkpoints, the periodic runs over the recent vaas start from scratch.
+	// When a run is resumed, the report only counts the vaas audited after the checkpoint.
+	var checkpoints backfill.CheckpointStore
+	if j.resume {
+		checkpoints = backfill.NewMongoCheckpointStore(j.db)
+	}
+	sources := []struct {
+		collection string
+		repository *repository.VaaRepository
+	}{
+		{repository.Vaas, repository.NewVaaRepository(j.db, j.logger)},
+		{repository.DuplicateVaas, repository.NewDuplicateVaaRepository(j.db, j.logger)},
+	}
+	for _, s := range sources {
+		collection := s.collection
+		cfg := backfill.Config{
+			Name:     fmt.Sprintf("vaa-audit-%s-%s-%s", collection, j.from.Format(time.RFC3339), j.to.Format(time.RFC3339)),
+			PageSize: j.pageSize,
+			Workers:  j.workers,
+			Resume:   j.resume,
+		}
+		query := repository.VaaQuery{StartTime: &j.from, EndTime: &j.to}
+		strategy := backfill.StrategyFunc[*repository.VaaDoc](func(ctx context.Context, doc *repository.VaaDoc) error {
+			return j.audit(ctx, r, collection, gs, doc)
+		})
+		job := backfill.NewJob[*repository.VaaDoc](cfg, backfill.NewVaaSource(s.repository, query), strategy, checkpoints, j.logger)
+		if _, err := job.Run(ctx); err != nil {
+			return err
+		}
+	}
+
+	r.FinishedAt = time.Now()
+	if _, err := j.collections.reports.InsertOne(ctx, r); err != nil {
+		return fmt.Errorf("failed to insert vaa audit report %s: %w", r.ID, err)
+	}
+	for collection, c := range r.Collections {
+		j.logger.Info("Vaa audit completed",
+			zap.String("runId", r.ID),
+			zap.String("collection", collection),
+			zap.Int64("audited", c.Audited),
+			zap.Int64("withIssues", c.WithIssues),
+			zap.Int64("new", c.New),
+			zap.Any("issues", c.Issues))
+	}
+	// the time ranges of the periodic runs overlap, so only the issues not found by a previous run are alerted.
+	if r.newIssues() > 0 {
+		j.alert(ctx, r)
+	}
+	return nil
+}
+
+// audit checks a stored VAA and stores its issues, if any.
+func (j *VaaAuditJob) audit(ctx context.Context, r *report, collection string, gs *guardianSets, doc *repository.VaaDoc) error {
+	issues := checkVaa(collection, doc, gs)
+	r.add(collection, issues)
+	if len(issues) == 0 {
+		return nil
+	}
+
+	id := fmt.Sprintf("%s/%s", collection, doc.ID)
+	f := finding{
+		Collection: collection,
+		DocumentID: doc.ID,
+		Issues:     issues,
+		TxHash:     doc.TxHash,
+		Timestamp:  doc.Timestamp,
+		RunID:      r.ID,
+		AuditedAt:  time.Now(),
+	}
+	update := bson.M{"$set": f, "$setOnInsert": bson.M{"firstRunId": r.ID}}
+	res, err := j.collections.findings.UpdateOne(ctx, bson.M{"_id": id}, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to upsert vaa audit finding %s: %w", id, err)
+	}
+	if res.UpsertedCount > 0 {
+		r.addNew(collection)
+	}
+	return nil
+}
+
+// alert sends an alert with the summary of a run that found issues.
+func (j *VaaAuditJob) alert(ctx context.Context, r *report) {
+	details := map[string]string{
+		"runId":      r.ID,
+		"from":       r.From.Format(time.RFC3339),
+		"to":         r.To.Format(time.RFC3339),
+		"withIssues": fmt.Sprint(r.withIssues()),
+		"new":        fmt.Sprint(r.newIssues()),
+	}
+	for collection, c := range r.Collections {
+		counts := make([]string, 0, len(c.Issues))
+		for code, count := range c.Issues {
+			counts = append(counts, fmt.Sprintf("%s=%d", code, count))
+		}
+		sort.Strings(counts)
+		details[collection] = strings.Join(counts, ", ")
+	}
+
+	if err := j.alertClient.CreateAndSend(ctx, jobsAlert.VaaAuditFindings, alert.AlertContext{Details: details}); err != nil {
+		j.logger.Error("Failed to send vaa audit alert", zap.String("runId", r.ID), zap.Error(err))
+	}
+}
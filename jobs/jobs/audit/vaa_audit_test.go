@@ -0,0 +1,41 @@
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.uber.org/zap"
+)
+
+func TestVaaAuditJob_Audit(t *testing.T) {
+	m := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer m.Close()
+
+	m.Run("new findings", func(mt *mtest.T) {
+		_, gs := newGuardians(t, 1)
+		j := NewVaaAuditJob(mt.DB, "mainnet", nil, time.Time{}, time.Time{}, 100, 1, false, zap.NewNop())
+		r := &report{ID: "run", Collections: make(map[string]*collectionReport)}
+		malformed := &repository.VaaDoc{ID: "1/0001/1", Vaa: []byte{0x01}}
+
+		// the first finding of a vaa is inserted, the next ones of the same vaa are updated.
+		upserted := bson.A{bson.D{{Key: "index", Value: 0}, {Key: "_id", Value: "vaas/1/0001/1"}}}
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 0}, bson.E{Key: "upserted", Value: upserted}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+		)
+		require.NoError(t, j.audit(context.Background(), r, repository.Vaas, gs, malformed))
+		require.NoError(t, j.audit(context.Background(), r, repository.Vaas, gs, malformed))
+
+		c := r.Collections[repository.Vaas]
+		assert.Equal(t, int64(2), c.Audited)
+		assert.Equal(t, int64(2), c.WithIssues)
+		assert.Equal(t, int64(1), c.New)
+		assert.Equal(t, int64(1), r.newIssues())
+	})
+}
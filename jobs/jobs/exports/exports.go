@@ -0,0 +1,135 @@
+// Package exports contains the job that runs the exports of operations requested through the api.
+package exports
+
+import (
+	"context"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/export"
+	"go.uber.org/zap"
+)
+
+// exportRepository is the subset of the export repository used by the job.
+type exportRepository interface {
+	ClaimPending(ctx context.Context, now time.Time) (*export.Export, error)
+	Requeue(ctx context.Context, startedBefore time.Time) (int64, error)
+	Complete(ctx context.Context, id string, f *export.File, now time.Time) error
+	Fail(ctx context.Context, id string, cause error, now time.Time) error
+	FindExpired(ctx context.Context, before time.Time, limit int64) ([]*export.Export, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// fileStorage deletes the files of the exports.
+type fileStorage interface {
+	Delete(ctx context.Context, key string) error
+}
+
+// expiredPageSize is the number of expired exports deleted at a time.
+const expiredPageSize = 100
+
+// exporter writes the file of an export.
+type exporter interface {
+	Run(ctx context.Context, e *export.Export) (*export.File, error)
+}
+
+// ExportsJob runs the pending exports, one at a time, until there are no pending exports left, and
+// deletes the expired exports and their files.
+type ExportsJob struct {
+	repository     exportRepository
+	exporter       exporter
+	storage        fileStorage
+	requeueTimeout time.Duration
+	ttl            time.Duration
+	logger         *zap.Logger
+}
+
+// NewExportsJob creates a new exports job. The exports running for longer than requeueTimeout are
+// considered interrupted and run again. The exports completed or failed for longer than ttl are
+// deleted with their files, a ttl of zero keeps them.
+func NewExportsJob(repository exportRepository, exporter exporter, storage fileStorage, requeueTimeout, ttl time.Duration, logger *zap.Logger) *ExportsJob {
+	return &ExportsJob{
+		repository:     repository,
+		exporter:       exporter,
+		storage:        storage,
+		requeueTimeout: requeueTimeout,
+		ttl:            ttl,
+		logger:         logger.With(zap.String("module", "ExportsJob")),
+	}
+}
+
+// Run runs the exports job.
+func (j *ExportsJob) Run(ctx context.Context) error {
+	requeued, err := j.repository.Requeue(ctx, time.Now().Add(-j.requeueTimeout))
+	if err != nil {
+		return err
+	}
+	if requeued > 0 {
+		j.logger.Warn("Interrupted exports requeued", zap.Int64("count", requeued))
+	}
+
+	var completed, failed int
+	for {
+		e, err := j.repository.ClaimPending(ctx, time.Now())
+		if err != nil {
+			return err
+		}
+		if e == nil {
+			break
+		}
+
+		log := j.logger.With(zap.String("exportId", e.ID))
+		log.Info("Running export", zap.String("format", string(e.Format)))
+
+		// a failed export does not stop the job, it is reported in the status of the export.
+		f, err := j.exporter.Run(ctx, e)
+		if err != nil {
+			log.Error("Export failed", zap.Error(err))
+			if err := j.repository.Fail(ctx, e.ID, err, time.Now()); err != nil {
+				return err
+			}
+			failed++
+			continue
+		}
+		if err := j.repository.Complete(ctx, e.ID, f, time.Now()); err != nil {
+			return err
+		}
+		completed++
+	}
+
+	j.logger.Info("Exports finished", zap.Int("completed", completed), zap.Int("failed", failed))
+
+	if j.ttl > 0 {
+		deleted, err := j.deleteExpired(ctx, time.Now().Add(-j.ttl))
+		if err != nil {
+			return err
+		}
+		j.logger.Info("Expired exports deleted", zap.Int("count", deleted))
+	}
+	return nil
+}
+
+// deleteExpired deletes the exports finished before a time, and their files. The file is deleted
+// first, so a file is never left without its export and an interrupted deletion is completed by the next run.
+func (j *ExportsJob) deleteExpired(ctx context.Context, before time.Time) (int, error) {
+	var deleted int
+	for {
+		expired, err := j.repository.FindExpired(ctx, before, expiredPageSize)
+		if err != nil {
+			return deleted, err
+		}
+		for _, e := range expired {
+			if e.Key != "" {
+				if err := j.storage.Delete(ctx, e.Key); err != nil {
+					return deleted, err
+				}
+			}
+			if err := j.repository.Delete(ctx, e.ID); err != nil {
+				return deleted, err
+			}
+			deleted++
+		}
+		if len(expired) < expiredPageSize {
+			return deleted, nil
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package exports
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole-explorer/common/export"
+	"go.uber.org/zap"
+)
+
+// memoryRepository keeps the exports in memory, claimed in the order they were created.
+type memoryRepository struct {
+	exports       []*export.Export
+	startedBefore time.Time
+}
+
+func (r *memoryRepository) ClaimPending(_ context.Context, now time.Time) (*export.Export, error) {
+	for _, e := range r.exports {
+		if e.Status == export.StatusPending {
+			e.Status = export.StatusRunning
+			e.StartedAt = &now
+			return e, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryRepository) Requeue(_ context.Context, startedBefore time.Time) (int64, error) {
+	r.startedBefore = startedBefore
+	var count int64
+	for _, e := range r.exports {
+		if e.Status == export.StatusRunning && e.StartedAt.Before(startedBefore) {
+			e.Status = export.StatusPending
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *memoryRepository) Complete(_ context.Context, id string, f *export.File, _ time.Time) error {
+	e := r.find(id)
+	e.Status, e.Key, e.Rows, e.Size = export.StatusCompleted, f.Key, f.Rows, f.Size
+	return nil
+}
+
+func (r *memoryRepository) Fail(_ context.Context, id string, cause error, _ time.Time) error {
+	e := r.find(id)
+	e.Status, e.Error = export.StatusFailed, cause.Error()
+	return nil
+}
+
+func (r *memoryRepository) FindExpired(_ context.Context, before time.Time, limit int64) ([]*export.Export, error) {
+	var expired []*export.Export
+	for _, e := range r.exports {
+		finished := e.Status == export.StatusCompleted || e.Status == export.StatusFailed
+		if finished && e.CompletedAt != nil && e.CompletedAt.Before(before) && int64(len(expired)) < limit {
+			expired = append(expired, e)
+		}
+	}
+	return expired, nil
+}
+
+func (r *memoryRepository) Delete(_ context.Context, id string) error {
+	for i, e := range r.exports {
+		if e.ID == id {
+			r.exports = append(r.exports[:i], r.exports[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (r *memoryRepository) find(id string) *export.Export {
+	for _, e := range r.exports {
+		if e.ID == id {
+			return e
+		}
+	}
+	return nil
+}
+
+// memoryStorage records the files deleted.
+type memoryStorage struct {
+	deleted []string
+}
+
+func (s *memoryStorage) Delete(_ context.Context, key string) error {
+	s.deleted = append(s.deleted, key)
+	return nil
+}
+
+// fakeExporter fails the exports in the csv format.
+type fakeExporter struct {
+	ran []string
+}
+
+func (f *fakeExporter) Run(_ context.Context, e *export.Export) (*export.File, error) {
+	f.ran = append(f.ran, e.ID)
+	if e.Format == export.FormatCSV {
+		return nil, errors.New("storage unavailable")
+	}
+	return &export.File{Key: "exports/" + e.ID, Rows: 10, Size: 100}, nil
+}
+
+func TestExportsJob(t *testing.T) {
+	startedAt := time.Now().Add(-2 * time.Hour)
+	recent := time.Now().Add(-time.Minute)
+	repository := &memoryRepository{exports: []*export.Export{
+		{ID: "1", Format: export.FormatParquet, Status: export.StatusPending},
+		{ID: "2", Format: export.FormatCSV, Status: export.StatusPending},
+		{ID: "3", Format: export.FormatNDJSON, Status: export.StatusCompleted},
+		{ID: "4", Format: export.FormatNDJSON, Status: export.StatusRunning, StartedAt: &startedAt},
+		{ID: "5", Format: export.FormatNDJSON, Status: export.StatusRunning, StartedAt: &recent},
+	}}
+	exporter := &fakeExporter{}
+
+	job := NewExportsJob(repository, exporter, &memoryStorage{}, time.Hour, 0, zap.NewNop())
+	require.NoError(t, job.Run(context.Background()))
+
+	assert.Equal(t, []string{"1", "2", "4"}, exporter.ran)
+	assert.WithinDuration(t, time.Now().Add(-time.Hour), repository.startedBefore, time.Minute)
+
+	expected := []export.Status{
+		export.StatusCompleted,
+		export.StatusFailed,
+		export.StatusCompleted,
+		export.StatusCompleted,
+		export.StatusRunning,
+	}
+	for i, e := range repository.exports {
+		assert.Equal(t, expected[i], e.Status, e.ID)
+	}
+	assert.Equal(t, "exports/1", repository.exports[0].Key)
+	assert.Equal(t, "storage unavailable", repository.exports[1].Error)
+}
+
+func TestExportsJob_DeleteExpired(t *testing.T) {
+	expired := time.Now().Add(-48 * time.Hour)
+	recent := time.Now().Add(-time.Hour)
+	repository := &memoryRepository{exports: []*export.Export{
+		{ID: "1", Status: export.StatusCompleted, Key: "exports/1", CompletedAt: &expired},
+		{ID: "2", Status: export.StatusFailed, CompletedAt: &expired},
+		{ID: "3", Status: export.StatusCompleted, Key: "exports/3", CompletedAt: &recent},
+		{ID: "4", Status: export.StatusRunning, StartedAt: &recent},
+	}}
+	storage := &memoryStorage{}
+
+	job := NewExportsJob(repository, &fakeExporter{}, storage, 2*time.Hour, 24*time.Hour, zap.NewNop())
+	require.NoError(t, job.Run(context.Background()))
+
+	assert.Equal(t, []string{"exports/1"}, storage.deleted)
+	require.Len(t, repository.exports, 2)
+	assert.Equal(t, "3", repository.exports[0].ID)
+	assert.Equal(t, "4", repository.exports[1].ID)
+}
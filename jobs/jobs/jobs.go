@@ -15,6 +15,10 @@ const (
 	JobIDNTTTopHolderStats     = "JOB_NTT_TOP_HOLDER_STATS"
 	JobIDNTTMedianStats        = "JOB_NTT_MEDIAN_STATS"
 	JobIDMigrationNativeTxHash = "JOB_MIGRATE_NATIVE_TX_HASH"
+	JobIDAddressActivity       = "JOB_ADDRESS_ACTIVITY"
+	JobIDStuckTransfers        = "JOB_STUCK_TRANSFERS"
+	JobIDVaaAudit              = "JOB_VAA_AUDIT"
+	JobIDExports               = "JOB_EXPORTS"
 )
 
 // Job is the interface for jobs.
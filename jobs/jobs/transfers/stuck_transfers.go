@@ -0,0 +1,103 @@
+// Package transfers contains the jobs over the lifecycle of the transfers.
+package transfers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/lifecycle"
+	jobsAlert "github.com/wormhole-foundation/wormhole-explorer/jobs/internal/alert"
+	"go.uber.org/zap"
+)
+
+// lifecycleRepository is the subset of the lifecycle repository used by the job.
+type lifecycleRepository interface {
+	FindStuck(ctx context.Context, signedAfter, signedBefore time.Time, limit int64) ([]*lifecycle.Lifecycle, error)
+	MarkStuck(ctx context.Context, vaaIDs []string, at time.Time) error
+}
+
+// StuckTransfersJob flags the transfers signed but not redeemed after a period of time as stuck,
+// and sends an alert for each of them.
+type StuckTransfersJob struct {
+	repository  lifecycleRepository
+	alertClient alert.AlertClient
+	stuckAfter  time.Duration
+	maxAge      time.Duration
+	pageSize    int64
+	logger      *zap.Logger
+}
+
+// NewStuckTransfersJob creates a new stuck transfers job.
+func NewStuckTransfersJob(
+	repository lifecycleRepository,
+	alertClient alert.AlertClient,
+	stuckAfter, maxAge time.Duration,
+	pageSize int64,
+	logger *zap.Logger) *StuckTransfersJob {
+	return &StuckTransfersJob{
+		repository:  repository,
+		alertClient: alertClient,
+		stuckAfter:  stuckAfter,
+		maxAge:      maxAge,
+		pageSize:    pageSize,
+		logger:      logger.With(zap.String("module", "StuckTransfersJob")),
+	}
+}
+
+// Run runs the stuck transfers job.
+func (j *StuckTransfersJob) Run(ctx context.Context) error {
+	now := time.Now()
+	signedBefore := now.Add(-j.stuckAfter)
+	signedAfter := now.Add(-j.maxAge)
+
+	var total int
+	for {
+		// the transfers flagged are not returned again, so each page continues where the previous one ended.
+		stuck, err := j.repository.FindStuck(ctx, signedAfter, signedBefore, j.pageSize)
+		if err != nil {
+			return err
+		}
+		if len(stuck) == 0 {
+			break
+		}
+
+		ids := make([]string, 0, len(stuck))
+		for _, l := range stuck {
+			ids = append(ids, l.ID)
+		}
+		if err := j.repository.MarkStuck(ctx, ids, now); err != nil {
+			return err
+		}
+		for _, l := range stuck {
+			j.alert(ctx, l, now)
+		}
+		total += len(stuck)
+	}
+
+	j.logger.Info("Stuck transfers flagged", zap.Int("total", total), zap.Time("signedBefore", signedBefore))
+	return nil
+}
+
+// alert sends an alert for a stuck transfer. The alias identifies the transfer, so each transfer alerts once.
+func (j *StuckTransfersJob) alert(ctx context.Context, l *lifecycle.Lifecycle, now time.Time) {
+	details := map[string]string{
+		"vaaId":        l.ID,
+		"emitterChain": l.EmitterChain.String(),
+	}
+	if l.SignedAt != nil {
+		details["signedAt"] = l.SignedAt.Format(time.RFC3339)
+		details["pendingFor"] = now.Sub(*l.SignedAt).Round(time.Minute).String()
+	}
+
+	a, err := j.alertClient.CreateAlert(jobsAlert.StuckTransfer, alert.AlertContext{Details: details})
+	if err != nil {
+		j.logger.Debug("Stuck transfer alert not created", zap.String("vaaId", l.ID), zap.Error(err))
+		return
+	}
+	a.Alias = fmt.Sprintf("%s-%s", jobsAlert.StuckTransfer, l.ID)
+	if err := j.alertClient.Send(ctx, a); err != nil {
+		j.logger.Error("Failed to send stuck transfer alert", zap.String("vaaId", l.ID), zap.Error(err))
+	}
+}
@@ -0,0 +1,91 @@
+package transfers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/lifecycle"
+	"go.uber.org/zap"
+)
+
+// memoryRepository returns the signed transfers in pages and flags them as stuck.
+type memoryRepository struct {
+	signed []*lifecycle.Lifecycle
+	stuck  map[string]time.Time
+	after  time.Time
+	before time.Time
+}
+
+func (r *memoryRepository) FindStuck(_ context.Context, signedAfter, signedBefore time.Time, limit int64) ([]*lifecycle.Lifecycle, error) {
+	r.after, r.before = signedAfter, signedBefore
+	var result []*lifecycle.Lifecycle
+	for _, l := range r.signed {
+		if _, ok := r.stuck[l.ID]; ok || l.SignedAt.Before(signedAfter) || !l.SignedAt.Before(signedBefore) {
+			continue
+		}
+		result = append(result, l)
+		if int64(len(result)) == limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryRepository) MarkStuck(_ context.Context, vaaIDs []string, at time.Time) error {
+	for _, id := range vaaIDs {
+		r.stuck[id] = at
+	}
+	return nil
+}
+
+// recorderAlertClient records the aliases of the alerts sent.
+type recorderAlertClient struct {
+	alert.DummyClient
+	aliases []string
+}
+
+func (c *recorderAlertClient) Send(_ context.Context, a alert.Alert) error {
+	c.aliases = append(c.aliases, a.Alias)
+	return nil
+}
+
+func signedAt(id string, at time.Time) *lifecycle.Lifecycle {
+	return &lifecycle.Lifecycle{ID: id, Status: lifecycle.StatusSigned, SignedAt: &at}
+}
+
+func TestStuckTransfersJob(t *testing.T) {
+	now := time.Now()
+	repo := &memoryRepository{
+		signed: []*lifecycle.Lifecycle{
+			signedAt("2/a/1", now.Add(-30*time.Hour)),
+			signedAt("2/a/2", now.Add(-26*time.Hour)),
+			signedAt("2/a/3", now.Add(-25*time.Hour)),
+			// signed recently.
+			signedAt("2/a/4", now.Add(-time.Hour)),
+			// older than the max age.
+			signedAt("2/a/5", now.Add(-200*time.Hour)),
+		},
+		stuck: map[string]time.Time{},
+	}
+	alerts := &recorderAlertClient{}
+
+	job := NewStuckTransfersJob(repo, alerts, 24*time.Hour, 168*time.Hour, 2, zap.NewNop())
+	require.NoError(t, job.Run(context.Background()))
+
+	assert.Len(t, repo.stuck, 3)
+	assert.Contains(t, repo.stuck, "2/a/1")
+	assert.Contains(t, repo.stuck, "2/a/2")
+	assert.Contains(t, repo.stuck, "2/a/3")
+	assert.Equal(t, []string{"STUCK_TRANSFER-2/a/1", "STUCK_TRANSFER-2/a/2", "STUCK_TRANSFER-2/a/3"}, alerts.aliases)
+	assert.WithinDuration(t, now.Add(-24*time.Hour), repo.before, time.Second)
+	assert.WithinDuration(t, now.Add(-168*time.Hour), repo.after, time.Second)
+
+	// the transfers already flagged are not alerted again.
+	alerts.aliases = nil
+	require.NoError(t, job.Run(context.Background()))
+	assert.Empty(t, alerts.aliases)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	health "github.com/wormhole-foundation/wormhole-explorer/common/health"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/tokenregistry"
 	"github.com/wormhole-foundation/wormhole-explorer/notional/config"
 	"github.com/wormhole-foundation/wormhole-explorer/notional/http/infrastructure"
 	"github.com/wormhole-foundation/wormhole-explorer/notional/prices"
@@ -69,7 +70,11 @@ func Run() {
 	}
 
 	// create token provider
-	tokenProvider := domain.NewTokenProvider(config.P2pNetwork)
+	tokenRegistry := tokenregistry.NewRegistry(tokenregistry.NewRepository(db.Database, logger), tokenregistry.DefaultRefreshInterval, logger)
+	if err := tokenRegistry.Start(rootCtx); err != nil {
+		logger.Fatal("failed to load token registry", zap.Error(err))
+	}
+	tokenProvider := domain.NewTokenProvider(config.P2pNetwork, domain.WithTokenRegistry(tokenRegistry))
 
 	//create repositories
 	repository := prices.NewPriceRepository(db.Database, logger)
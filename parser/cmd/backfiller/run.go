@@ -3,14 +3,20 @@ package backfiller
 import (
 	"context"
 	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/backfill"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
 	vaaPayloadParser "github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
 	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"github.com/wormhole-foundation/wormhole-explorer/common/tokenregistry"
+	"github.com/wormhole-foundation/wormhole-explorer/common/vaaparser"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/config"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/parser"
@@ -20,29 +26,16 @@ import (
 
 func Run(config *config.BackfillerConfiguration) {
 
-	rootCtx := context.Background()
+	rootCtx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
 	logger := logger.New("wormhole-explorer-parser", logger.WithLevel(config.LogLevel))
 
 	logger.Info("Starting wormhole-explorer-parser as backfiller ...")
 
-	startTime, err := time.Parse(time.RFC3339, config.StartTime)
+	startTime, endTime, err := backfill.ParseTimeRange(config.StartTime, config.EndTime)
 	if err != nil {
-		logger.Fatal("failed to parse start time", zap.Error(err))
-	}
-
-	endTime := time.Now()
-	if config.EndTime != "" {
-		endTime, err = time.Parse(time.RFC3339, config.EndTime)
-		if err != nil {
-			logger.Fatal("Failed to parse end time", zap.Error(err))
-		}
-	}
-
-	if startTime.After(endTime) {
-		logger.Fatal("Start time should be before end time",
-			zap.String("start_time", startTime.Format(time.RFC3339)),
-			zap.String("end_time", endTime.Format(time.RFC3339)))
+		logger.Fatal("Invalid time range", zap.Error(err))
 	}
 
 	//setup DB connection
@@ -51,25 +44,25 @@ func Run(config *config.BackfillerConfiguration) {
 		logger.Fatal("Failed to connect MongoDB", zap.Error(err))
 	}
 
-	parserVAAAPIClient, err := vaaPayloadParser.NewParserVAAAPIClient(config.VaaPayloadParserTimeout, config.VaaPayloadParserURL, logger)
-	if err != nil {
-		logger.Fatal("Failed to create parse vaa api client")
+	var vaaParser vaaPayloadParser.VaaParser
+	if config.VaaPayloadParserURL == "" {
+		vaaParser = vaaparser.New(config.P2pNetwork, nil, logger)
+	} else {
+		parserVAAAPIClient, err := vaaPayloadParser.NewParserVAAAPIClient(config.VaaPayloadParserTimeout, config.VaaPayloadParserURL, logger)
+		if err != nil {
+			logger.Fatal("Failed to create parse vaa api client")
+		}
+		vaaParser = vaaparser.New(config.P2pNetwork, &parserVAAAPIClient, logger)
 	}
 
 	query := repository.VaaQuery{
 		StartTime:      &startTime,
-		EndTime:        &endTime,
+		EndTime:        endTime,
 		EmitterChainID: config.EmitterChainID,
 		EmitterAddress: config.EmitterAddress,
 		Sequence:       config.Sequence,
 	}
 
-	pagination := repository.Pagination{
-		Page:     0,
-		PageSize: config.PageSize,
-		SortAsc:  config.SortAsc,
-	}
-
 	parserRepository := parser.NewRepository(db.Database, logger)
 	vaaRepository := repository.NewVaaRepository(db.Database, logger)
 
@@ -77,36 +70,44 @@ func Run(config *config.BackfillerConfiguration) {
 	tokenProvider := domain.NewTokenProvider(config.P2pNetwork)
 
 	//create a processor
-	eventProcessor := processor.New(parserVAAAPIClient, parserRepository, alert.NewDummyClient(), metrics.NewDummyMetrics(), tokenProvider, logger)
+	tokenRegistryRepository := tokenregistry.NewRepository(db.Database, logger)
+	eventProcessor := processor.New(vaaParser, parserRepository, alert.NewDummyClient(), metrics.NewDummyMetrics(), tokenProvider, tokenRegistryRepository, logger)
 
 	logger.Info("Started wormhole-explorer-parser as backfiller")
 
-	for {
-		logger.Info("Processing page", zap.Any("pagination", pagination), zap.Any("query", query))
-
-		vaas, err := vaaRepository.FindPage(rootCtx, query, pagination)
-		if err != nil {
-			logger.Error("Failed to get vaas", zap.Error(err))
-			break
-		}
+	strategy := backfill.StrategyFunc[*repository.VaaDoc](func(ctx context.Context, v *repository.VaaDoc) error {
+		logger.Debug("Processing vaa", zap.String("id", v.ID))
+		p := &processor.Params{Vaa: v.Vaa, TrackID: fmt.Sprintf("backfiller-%s", v.ID)}
+		_, err := eventProcessor.Process(ctx, p)
+		return err
+	})
+
+	jobConfig := backfill.Config{
+		Name:              config.JobName,
+		PageSize:          config.PageSize,
+		Workers:           config.NumWorkers,
+		RequestsPerMinute: config.RequestsPerMinute,
+		Resume:            config.Resume,
+		DryRun:            config.DryRun,
+		FailuresFile:      config.FailuresFile,
+	}
+	job := backfill.NewJob[*repository.VaaDoc](jobConfig, backfill.NewVaaSource(vaaRepository, query), strategy,
+		backfill.NewMongoCheckpointStore(db.Database), logger)
 
-		if len(vaas) == 0 {
-			logger.Info("Empty page", zap.Int64("page", pagination.Page))
-			break
-		}
-		for _, v := range vaas {
-			logger.Debug("Processing vaa", zap.String("id", v.ID))
-			p := &processor.Params{Vaa: v.Vaa, TrackID: fmt.Sprintf("backfiller-%s", v.ID)}
-			_, err := eventProcessor.Process(rootCtx, p)
-			if err != nil {
-				logger.Error("Failed to process vaa", zap.String("id", v.ID), zap.Error(err))
-			}
-		}
-		pagination.Page++
+	checkpoint, err := job.Run(rootCtx)
+	if err != nil {
+		logger.Error("Backfill interrupted, run it again with --resume to continue", zap.Error(err))
 	}
 
 	logger.Info("closing MongoDB connection...")
 	db.DisconnectWithTimeout(10 * time.Second)
 
-	logger.Info("Finish wormhole-explorer-parser as backfiller")
+	if checkpoint != nil {
+		logger.Info("Finish wormhole-explorer-parser as backfiller",
+			zap.Uint64("processed", checkpoint.Processed),
+			zap.Uint64("failed", checkpoint.Failed))
+	}
+	if err != nil {
+		os.Exit(1)
+	}
 }
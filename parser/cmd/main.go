@@ -1,8 +1,6 @@
 package main
 
 import (
-	"strings"
-
 	"github.com/spf13/cobra"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/cmd/backfiller"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/cmd/service"
@@ -43,13 +41,12 @@ func addServiceCommand(root *cobra.Command) {
 
 func addBackfiller(root *cobra.Command) {
 	var mongoUri, mongoDb, p2pNetwork, vaaPayloadParserURL, logLevel, startTime, endTime, sort, emitterAddress, sequence string
-	var vaaPayloadParserTimeout, pageSize int64
+	var jobName, failuresFile string
+	var vaaPayloadParserTimeout, pageSize, requestsPerMinute int64
 	var emitterChainID uint16
+	var numWorkers int
+	var resume, dryRun bool
 
-	sortAsc := false
-	if strings.ToLower(sort) == "asc" {
-		sortAsc = true
-	}
 	backfillerCommand := &cobra.Command{
 		Use:   "backfiller",
 		Short: "Run backfiller to backfill data",
@@ -64,7 +61,12 @@ func addBackfiller(root *cobra.Command) {
 				StartTime:               startTime,
 				EndTime:                 endTime,
 				PageSize:                pageSize,
-				SortAsc:                 sortAsc,
+				NumWorkers:              numWorkers,
+				RequestsPerMinute:       requestsPerMinute,
+				JobName:                 jobName,
+				Resume:                  resume,
+				DryRun:                  dryRun,
+				FailuresFile:            failuresFile,
 			}
 
 			if emitterChainID != 0 {
@@ -84,20 +86,26 @@ func addBackfiller(root *cobra.Command) {
 	backfillerCommand.Flags().StringVar(&mongoUri, "mongo-uri", "", "Mongo connection")
 	backfillerCommand.Flags().StringVar(&mongoDb, "mongo-database", "", "Mongo database")
 	backfillerCommand.Flags().StringVar(&p2pNetwork, "p2p-network", "", "P2P network")
-	backfillerCommand.Flags().StringVar(&vaaPayloadParserURL, "vaa-payload-parser-url", "", "VAA payload parser service URL")
+	backfillerCommand.Flags().StringVar(&vaaPayloadParserURL, "vaa-payload-parser-url", "", "VAA payload parser service URL, used as fallback for protocols not supported by the native parser")
 	backfillerCommand.Flags().Int64Var(&vaaPayloadParserTimeout, "vaa-payload-parser-timeout", 10, "maximum waiting time in call to VAA payload service in seconds")
 	backfillerCommand.Flags().StringVar(&startTime, "start-time", "1970-01-01T00:00:00Z", "minimum VAA timestamp to process")
-	backfillerCommand.Flags().StringVar(&endTime, "end-time", "", "maximum VAA timestamp to process (default now)")
+	backfillerCommand.Flags().StringVar(&endTime, "end-time", "", "maximum VAA timestamp to process (default now, kept when the job is resumed)")
 	backfillerCommand.Flags().Int64Var(&pageSize, "page-size", 100, "number of documents retrieved at a time")
 	backfillerCommand.Flags().StringVar(&sort, "sort", "desc", "process VAA in asc/desc order of timestamp")
 	backfillerCommand.Flags().Uint16Var(&emitterChainID, "emitter-chain", 0, "emitter chain id")
 	backfillerCommand.Flags().StringVar(&emitterAddress, "emitter-address", "", "emitter address")
 	backfillerCommand.Flags().StringVar(&sequence, "sequence", "", "sequence")
+	backfillerCommand.Flags().IntVar(&numWorkers, "num-workers", 1, "number of workers to process VAA documents concurrently")
+	backfillerCommand.Flags().Int64Var(&requestsPerMinute, "requests-per-minute", 0, "maximum number of VAA documents processed per minute (default no limit)")
+	backfillerCommand.Flags().StringVar(&jobName, "job-name", "parser-vaas", "name of the job used to store its checkpoint")
+	backfillerCommand.Flags().BoolVar(&resume, "resume", false, "resume the job from its last checkpoint")
+	backfillerCommand.Flags().BoolVar(&dryRun, "dry-run", false, "read the VAA documents without processing them")
+	backfillerCommand.Flags().StringVar(&failuresFile, "failures-file", "", "csv file where the VAA documents that failed are appended")
+	backfillerCommand.Flags().MarkDeprecated("sort", "VAAs are processed in ascending order of timestamp so the backfill can be resumed")
 
 	backfillerCommand.MarkFlagRequired("mongo-uri")
 	backfillerCommand.MarkFlagRequired("mongo-database")
 	backfillerCommand.MarkFlagRequired("p2p-network")
-	backfillerCommand.MarkFlagRequired("vaa-payload-parser-url")
 	backfillerCommand.MarkFlagRequired("start-time")
 
 	root.AddCommand(backfillerCommand)
@@ -11,19 +11,24 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/go-redis/redis/v8"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
 	vaaPayloadParser "github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/sqs"
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/common/health"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
+	"github.com/wormhole-foundation/wormhole-explorer/common/tokenregistry"
+	"github.com/wormhole-foundation/wormhole-explorer/common/vaaparser"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/config"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/consumer"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/http/infrastructure"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/http/tokens"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/http/vaa"
 	parserAlert "github.com/wormhole-foundation/wormhole-explorer/parser/internal/alert"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/internal/metrics"
-	"github.com/wormhole-foundation/wormhole-explorer/parser/internal/sqs"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/migration"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/parser"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/processor"
@@ -78,11 +83,10 @@ func Run() {
 	// create a metrics
 	metrics := newMetrics(config)
 
-	// create a parserVAAAPIClient
-	parserVAAAPIClient, err := vaaPayloadParser.NewParserVAAAPIClient(config.VaaPayloadParserTimeout,
-		config.VaaPayloadParserURL, logger)
+	// create a vaa parser
+	vaaParser, err := newVaaParser(config, logger)
 	if err != nil {
-		logger.Fatal("failed to create parse vaa api client")
+		logger.Fatal("failed to create vaa parser", zap.Error(err))
 	}
 
 	// get vaa consumer function.
@@ -100,11 +104,18 @@ func Run() {
 	if err != nil {
 		logger.Fatal("failed to create health checks", zap.Error(err))
 	}
+	// create and load the token registry
+	tokenRegistryRepository := tokenregistry.NewRepository(db.Database, logger)
+	tokenRegistry := tokenregistry.NewRegistry(tokenRegistryRepository, time.Duration(config.TokenRegistryRefresh)*time.Second, logger)
+	if err := tokenRegistry.Start(rootCtx); err != nil {
+		logger.Fatal("failed to load token registry", zap.Error(err))
+	}
+
 	// create a token provider
-	tokenProvider := domain.NewTokenProvider(config.P2pNetwork)
+	tokenProvider := domain.NewTokenProvider(config.P2pNetwork, domain.WithTokenRegistry(tokenRegistry))
 
 	//create a processor
-	processor := processor.New(parserVAAAPIClient, repository, alertClient, metrics, tokenProvider, logger)
+	processor := processor.New(vaaParser, repository, alertClient, metrics, tokenProvider, tokenRegistryRepository, logger)
 
 	// create and start a vaaConsumer
 	vaaConsumer := consumer.New(vaaConsumeFunc, processor.Process, metrics, logger)
@@ -116,7 +127,8 @@ func Run() {
 
 	vaaRepository := vaa.NewRepository(db.Database, logger)
 	vaaController := vaa.NewController(vaaRepository, processor.Process, logger)
-	server := infrastructure.NewServer(logger, config.Port, config.PprofEnabled, vaaController, healthChecks...)
+	tokensController := tokens.NewController(tokenRegistryRepository, logger)
+	server := infrastructure.NewServer(logger, config.Port, config.PprofEnabled, vaaController, tokensController, healthChecks...)
 	server.Start()
 
 	logger.Info("Started wormhole-explorer-parser")
@@ -171,37 +183,51 @@ func newAwsConfig(appCtx context.Context, cfg *config.ServiceConfiguration) (aws
 }
 
 func newVAAConsume(appCtx context.Context, config *config.ServiceConfiguration, metrics metrics.Metrics, logger *zap.Logger) queue.ConsumeFunc {
-	sqsConsumer, err := newSQSConsumer(appCtx, config, config.PipelineSQSUrl)
+	consumer, err := newConsumer(appCtx, config, config.PipelineSQSUrl, config.PipelineStream)
 	if err != nil {
-		logger.Fatal("failed to create sqs consumer", zap.Error(err))
+		logger.Fatal("failed to create pipeline consumer", zap.Error(err))
 	}
 
 	filterConsumeFunc := newFilterFunc(config)
-	vaaQueue := queue.NewEventSQS(sqsConsumer, queue.NewVaaConverter(logger), filterConsumeFunc, metrics, logger)
+	vaaQueue := queue.NewEventQueue(consumer, queue.NewVaaConverter(logger), filterConsumeFunc, metrics, logger)
 	return vaaQueue.Consume
 }
 
 func newNotificationConsume(appCtx context.Context, config *config.ServiceConfiguration, metrics metrics.Metrics, logger *zap.Logger) queue.ConsumeFunc {
-	sqsConsumer, err := newSQSConsumer(appCtx, config, config.NotificationsSQSUrl)
+	consumer, err := newConsumer(appCtx, config, config.NotificationsSQSUrl, config.NotificationsStream)
 	if err != nil {
-		logger.Fatal("failed to create sqs consumer", zap.Error(err))
+		logger.Fatal("failed to create notifications consumer", zap.Error(err))
 	}
 
 	filterConsumeFunc := newFilterFunc(config)
-	vaaQueue := queue.NewEventSQS(sqsConsumer, queue.NewNotificationEvent(logger), filterConsumeFunc, metrics, logger)
+	vaaQueue := queue.NewEventQueue(consumer, queue.NewNotificationEvent(logger), filterConsumeFunc, metrics, logger)
 	return vaaQueue.Consume
 }
 
-// Create a new SQS consumer.
-func newSQSConsumer(appCtx context.Context, config *config.ServiceConfiguration, sqsUrl string) (*sqs.Consumer, error) {
+// Create a new consumer of the SQS queue or the Redis stream depending on the message bus backend.
+func newConsumer(appCtx context.Context, config *config.ServiceConfiguration, sqsUrl, stream string) (messagebus.Consumer, error) {
+	if config.MessageBus == messagebus.BackendRedis {
+		return messagebus.NewRedisStreamConsumer(appCtx, newMessageBusRedisClient(config), stream, config.MessageBusConsumerGroup,
+			messagebus.WithRedisMaxMessages(10),
+			messagebus.WithRedisVisibilityTimeout(120*time.Second))
+	}
+
 	awsconfig, err := newAwsConfig(appCtx, config)
 	if err != nil {
 		return nil, err
 	}
 
-	return sqs.NewConsumer(awsconfig, sqsUrl,
+	sqsConsumer, err := sqs.NewConsumer(awsconfig, sqsUrl,
 		sqs.WithMaxMessages(10),
 		sqs.WithVisibilityTimeout(120))
+	if err != nil {
+		return nil, err
+	}
+	return messagebus.NewSQSConsumer(sqsConsumer, messagebus.WithSNSEnvelope()), nil
+}
+
+func newMessageBusRedisClient(config *config.ServiceConfiguration) *redis.Client {
+	return redis.NewClient(&redis.Options{Addr: config.MessageBusRedisURI})
 }
 
 // Creates a filter depending on whether the execution is local (dummy filter) or not (Pyth filter)
@@ -234,12 +260,34 @@ func newAlertClient(cfg *config.ServiceConfiguration) (alert.AlertClient, error)
 	return alert.NewAlertService(alertConfig, parserAlert.LoadAlerts)
 }
 
+// newVaaParser creates the in-process vaa parser, using the vaa-payload-parser api
+// as fallback for unsupported protocols when it is configured.
+func newVaaParser(cfg *config.ServiceConfiguration, logger *zap.Logger) (vaaPayloadParser.VaaParser, error) {
+	if cfg.VaaPayloadParserURL == "" {
+		return vaaparser.New(cfg.P2pNetwork, nil, logger), nil
+	}
+
+	parserVAAAPIClient, err := vaaPayloadParser.NewParserVAAAPIClient(cfg.VaaPayloadParserTimeout,
+		cfg.VaaPayloadParserURL, logger)
+	if err != nil {
+		return nil, err
+	}
+	return vaaparser.New(cfg.P2pNetwork, &parserVAAAPIClient, logger), nil
+}
+
 func newHealthChecks(
 	ctx context.Context,
 	config *config.ServiceConfiguration,
 	db *mongo.Database,
 ) ([]health.Check, error) {
 
+	if config.MessageBus == messagebus.BackendRedis {
+		return []health.Check{
+			health.Redis(newMessageBusRedisClient(config)),
+			health.Mongo(db),
+		}, nil
+	}
+
 	awsConfig, err := newAwsConfig(ctx, config)
 	if err != nil {
 		return nil, err
@@ -5,6 +5,7 @@ import (
 
 	"github.com/joho/godotenv"
 	"github.com/sethvargo/go-envconfig"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
@@ -29,13 +30,19 @@ type ServiceConfiguration struct {
 	AwsRegion               string `env:"AWS_REGION"`
 	PipelineSQSUrl          string `env:"PIPELINE_SQS_URL"`
 	NotificationsSQSUrl     string `env:"NOTIFICATIONS_SQS_URL"`
-	VaaPayloadParserURL     string `env:"VAA_PAYLOAD_PARSER_URL, required"`
-	VaaPayloadParserTimeout int64  `env:"VAA_PAYLOAD_PARSER_TIMEOUT, required"`
+	MessageBus              string `env:"MESSAGE_BUS,default=sqs"`
+	MessageBusRedisURI      string `env:"MESSAGE_BUS_REDIS_URI"`
+	MessageBusConsumerGroup string `env:"MESSAGE_BUS_CONSUMER_GROUP,default=parser"`
+	PipelineStream          string `env:"PIPELINE_STREAM,default=pipeline"`
+	NotificationsStream     string `env:"NOTIFICATIONS_STREAM,default=notifications"`
+	VaaPayloadParserURL     string `env:"VAA_PAYLOAD_PARSER_URL"`
+	VaaPayloadParserTimeout int64  `env:"VAA_PAYLOAD_PARSER_TIMEOUT,default=10"`
 	PprofEnabled            bool   `env:"PPROF_ENABLED,default=false"`
 	P2pNetwork              string `env:"P2P_NETWORK,required"`
 	AlertEnabled            bool   `env:"ALERT_ENABLED,default=false"`
 	AlertApiKey             string `env:"ALERT_API_KEY"`
 	MetricsEnabled          bool   `env:"METRICS_ENABLED,default=false"`
+	TokenRegistryRefresh    int64  `env:"TOKEN_REGISTRY_REFRESH_SECONDS,default=300"`
 }
 
 // BackfillerConfiguration represents the application configuration when running as backfiller with default values.
@@ -55,7 +62,12 @@ type BackfillerConfiguration struct {
 	EmitterChainID          *sdk.ChainID
 	EmitterAddress          *string
 	Sequence                *string
-	SortAsc                 bool
+	NumWorkers              int
+	RequestsPerMinute       int64
+	JobName                 string
+	Resume                  bool
+	DryRun                  bool
+	FailuresFile            string
 }
 
 // New creates a configuration with the values from .env file and environment variables.
@@ -66,6 +78,9 @@ func New(ctx context.Context) (*ServiceConfiguration, error) {
 	if err := envconfig.Process(ctx, &configuration); err != nil {
 		return nil, err
 	}
+	if err := messagebus.ValidateBackend(configuration.MessageBus); err != nil {
+		return nil, err
+	}
 
 	return &configuration, nil
 }
@@ -5,6 +5,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/pprof"
 	"github.com/wormhole-foundation/wormhole-explorer/common/health"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/http/tokens"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/http/vaa"
 	"go.uber.org/zap"
 )
@@ -15,7 +16,7 @@ type Server struct {
 	logger *zap.Logger
 }
 
-func NewServer(logger *zap.Logger, port string, pprofEnabled bool, vaaController *vaa.Controller, checks ...health.Check) *Server {
+func NewServer(logger *zap.Logger, port string, pprofEnabled bool, vaaController *vaa.Controller, tokensController *tokens.Controller, checks ...health.Check) *Server {
 	ctrl := health.NewController(checks, logger)
 	app := fiber.New(fiber.Config{DisableStartupMessage: true})
 
@@ -34,6 +35,11 @@ func NewServer(logger *zap.Logger, port string, pprofEnabled bool, vaaController
 
 	api.Post("/vaa/parse", vaaController.Parse)
 
+	// token registry operator endpoints.
+	api.Get("/tokens/:chain/:address", tokensController.FindByAddress)
+	api.Put("/tokens/:chain/:address/coingecko", tokensController.SetCoingeckoID)
+	api.Post("/tokens/:chain/:address/wrapped", tokensController.AddWrappedAsset)
+
 	return &Server{
 		app:    app,
 		port:   port,
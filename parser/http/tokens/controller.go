@@ -0,0 +1,152 @@
+package tokens
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/tokenregistry"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// Controller exposes the operator endpoints of the token registry.
+type Controller struct {
+	repository *tokenregistry.Repository
+	logger     *zap.Logger
+}
+
+// NewController creates a Controller instance.
+func NewController(repository *tokenregistry.Repository, logger *zap.Logger) *Controller {
+	return &Controller{repository: repository, logger: logger}
+}
+
+// SetCoingeckoIDRequest is the request to map a token to a coingecko ID.
+type SetCoingeckoIDRequest struct {
+	CoingeckoID string `json:"coingeckoId"`
+}
+
+// AddWrappedAssetRequest is the request to add a wrapped asset to a token.
+type AddWrappedAssetRequest struct {
+	ChainID uint16 `json:"chainId"`
+	Address string `json:"address"`
+}
+
+// FindByAddress returns a token of the registry.
+func (c *Controller) FindByAddress(ctx *fiber.Ctx) error {
+	tokenChain, tokenAddress, err := tokenParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	token, err := c.repository.FindByAddress(ctx.Context(), tokenChain, tokenAddress)
+	if errors.Is(err, tokenregistry.ErrTokenNotFound) {
+		return fiber.ErrNotFound
+	}
+	if err != nil {
+		c.logger.Error("error finding token", zap.Error(err))
+		return err
+	}
+	return ctx.JSON(token)
+}
+
+// SetCoingeckoID maps a token of the registry to a coingecko ID.
+func (c *Controller) SetCoingeckoID(ctx *fiber.Ctx) error {
+	tokenChain, tokenAddress, err := tokenParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	var request SetCoingeckoIDRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+	}
+	coingeckoID := strings.TrimSpace(request.CoingeckoID)
+	if coingeckoID == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "coingeckoId is required")
+	}
+
+	token, err := c.repository.SetCoingeckoID(ctx.Context(), tokenChain, tokenAddress, coingeckoID)
+	if errors.Is(err, tokenregistry.ErrTokenNotFound) {
+		return fiber.ErrNotFound
+	}
+	if err != nil {
+		c.logger.Error("error setting coingecko id", zap.Error(err))
+		return err
+	}
+
+	c.logger.Info("token coingecko id updated", zap.String("id", token.ID), zap.String("coingeckoId", coingeckoID))
+	return ctx.JSON(token)
+}
+
+// AddWrappedAsset adds the address of a wrapped asset to a token of the registry.
+func (c *Controller) AddWrappedAsset(ctx *fiber.Ctx) error {
+	tokenChain, tokenAddress, err := tokenParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	var request AddWrappedAssetRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+	}
+	wrappedChain := sdk.ChainID(request.ChainID)
+	if wrappedChain == sdk.ChainIDUnset || wrappedChain == tokenChain {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid chainId")
+	}
+	wrappedAddress, err := normalizeAddress(wrappedChain, request.Address)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid address")
+	}
+
+	wrapped := tokenregistry.WrappedAsset{Chain: wrappedChain, Address: wrappedAddress}
+	token, err := c.repository.AddWrappedAsset(ctx.Context(), tokenChain, tokenAddress, wrapped)
+	if errors.Is(err, tokenregistry.ErrTokenNotFound) {
+		return fiber.ErrNotFound
+	}
+	if err != nil {
+		c.logger.Error("error adding wrapped asset", zap.Error(err))
+		return err
+	}
+
+	c.logger.Info("token wrapped asset added", zap.String("id", token.ID),
+		zap.Uint16("wrappedChain", uint16(wrappedChain)), zap.String("wrappedAddress", wrappedAddress))
+	return ctx.JSON(token)
+}
+
+// tokenParams returns the origin chain and the 32-byte hex address of the token of the request.
+func tokenParams(ctx *fiber.Ctx) (sdk.ChainID, string, error) {
+	chain, err := strconv.ParseUint(ctx.Params("chain"), 10, 16)
+	if err != nil || sdk.ChainID(chain) == sdk.ChainIDUnset {
+		return sdk.ChainIDUnset, "", fiber.NewError(fiber.StatusBadRequest, "invalid chain")
+	}
+	tokenChain := sdk.ChainID(chain)
+
+	tokenAddress, err := normalizeAddress(tokenChain, ctx.Params("address"))
+	if err != nil {
+		return sdk.ChainIDUnset, "", fiber.NewError(fiber.StatusBadRequest, "invalid address")
+	}
+	return tokenChain, tokenAddress, nil
+}
+
+// normalizeAddress converts a native or hex address into the 32-byte hex format used by the registry.
+func normalizeAddress(chainID sdk.ChainID, address string) (string, error) {
+	// 32-byte hex addresses are used as is.
+	if hexAddress := strings.TrimPrefix(address, "0x"); len(hexAddress) == 64 {
+		if addr, err := sdk.StringToAddress(hexAddress); err == nil {
+			return addr.String(), nil
+		}
+	}
+
+	nativeHex, err := domain.DecodeNativeAddressToHex(chainID, address)
+	if err != nil {
+		return "", err
+	}
+	addr, err := sdk.StringToAddress(nativeHex)
+	if err != nil {
+		return "", err
+	}
+	return addr.String(), nil
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/common/lifecycle"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -20,6 +22,7 @@ const ParsedVAACollection = "parsedVaa"
 type Repository struct {
 	db          *mongo.Database
 	log         *zap.Logger
+	lifecycle   *lifecycle.Repository
 	collections struct {
 		parsedVaa *mongo.Collection
 	}
@@ -27,7 +30,7 @@ type Repository struct {
 
 // NewRepository create a new respository instance.
 func NewRepository(db *mongo.Database, log *zap.Logger) *Repository {
-	return &Repository{db, log, struct {
+	return &Repository{db, log, lifecycle.NewRepository(db), struct {
 		parsedVaa *mongo.Collection
 	}{
 		parsedVaa: db.Collection(ParsedVAACollection),
@@ -48,6 +51,11 @@ func (s *Repository) UpsertParsedVaa(ctx context.Context, parsedVAA ParsedVaaUpd
 	return err
 }
 
+// RecordLifecycleTarget records the target chain of an operation in its lifecycle.
+func (s *Repository) RecordLifecycleTarget(ctx context.Context, vaaID string, toChain sdk.ChainID) error {
+	return s.lifecycle.RecordTarget(ctx, vaaID, toChain)
+}
+
 func indexedAt(t time.Time) IndexingTimestamps {
 	return IndexingTimestamps{
 		IndexedAt: t,
@@ -5,12 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
 	vaaPayloadParser "github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/tokenregistry"
+	"github.com/wormhole-foundation/wormhole-explorer/common/vaaparser"
 	parserAlert "github.com/wormhole-foundation/wormhole-explorer/parser/internal/alert"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/parser"
@@ -19,21 +22,23 @@ import (
 )
 
 type Processor struct {
-	parser        vaaPayloadParser.ParserVAAAPIClient
+	parser        vaaPayloadParser.VaaParser
 	repository    *parser.Repository
 	alert         alert.AlertClient
 	metrics       metrics.Metrics
 	tokenProvider *domain.TokenProvider
+	tokenRegistry *tokenregistry.Repository
 	logger        *zap.Logger
 }
 
-func New(parser vaaPayloadParser.ParserVAAAPIClient, repository *parser.Repository, alert alert.AlertClient, metrics metrics.Metrics, tokenProvider *domain.TokenProvider, logger *zap.Logger) *Processor {
+func New(parser vaaPayloadParser.VaaParser, repository *parser.Repository, alert alert.AlertClient, metrics metrics.Metrics, tokenProvider *domain.TokenProvider, tokenRegistry *tokenregistry.Repository, logger *zap.Logger) *Processor {
 	return &Processor{
 		parser:        parser,
 		repository:    repository,
 		alert:         alert,
 		metrics:       metrics,
 		tokenProvider: tokenProvider,
+		tokenRegistry: tokenRegistry,
 		logger:        logger,
 	}
 }
@@ -45,7 +50,7 @@ func (p *Processor) Process(ctx context.Context, params *Params) (*parser.Parsed
 		return nil, err
 	}
 
-	// call vaa-payload-parser api to parse a VAA.
+	// parse the VAA payload, falling back to the vaa-payload-parser api for unsupported protocols.
 	chainID := uint16(vaa.EmitterChain)
 	emitterAddress := vaa.EmitterAddress.String()
 	sequence := fmt.Sprintf("%d", vaa.Sequence)
@@ -124,10 +129,46 @@ func (p *Processor) Process(ctx context.Context, params *Params) (*parser.Parsed
 	}
 	p.metrics.IncVaaParsedInserted(chainID)
 
+	// record the tokens attested by the Token Bridge in the token registry.
+	if err := p.recordAttestation(ctx, vaa, standardizedProperties.AppIds); err != nil {
+		p.logger.Error("Error recording token attestation",
+			zap.String("trackId", params.TrackID),
+			zap.String("id", vaaParsed.ID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	// record the target chain in the lifecycle of the operation, used to find the operations not redeemed.
+	if standardizedProperties.ToChain != 0 {
+		if err := p.repository.RecordLifecycleTarget(ctx, vaaParsed.ID, standardizedProperties.ToChain); err != nil {
+			p.logger.Warn("Error recording operation lifecycle target",
+				zap.String("trackId", params.TrackID),
+				zap.String("id", vaaParsed.ID),
+				zap.Error(err))
+		}
+	}
+
 	p.logger.Info("parsed VAA was successfully persisted", zap.String("trackId", params.TrackID), zap.String("id", vaaParsed.ID))
 	return &vaaParsed, nil
 }
 
+// recordAttestation upserts the token of a Token Bridge attestation in the token registry.
+func (p *Processor) recordAttestation(ctx context.Context, vaa *sdk.VAA, appIDs []string) error {
+	if p.tokenRegistry == nil || !slices.Contains(appIDs, domain.AppIdPortalTokenBridge) {
+		return nil
+	}
+	if !vaaparser.IsTokenBridgeAttestation(vaa.Payload) {
+		return nil
+	}
+
+	attestation, err := vaaparser.ParseTokenBridgeAttestation(vaa.Payload)
+	if err != nil {
+		p.logger.Warn("Token attestation cannot be decoded", zap.String("id", vaa.MessageID()), zap.Error(err))
+		return nil
+	}
+	return p.tokenRegistry.UpsertAttestation(ctx, vaa, attestation)
+}
+
 // transformStandarizedProperties transform amount and fee amount.
 func (p *Processor) transformStandarizedProperties(trackID, vaaID string, sp vaaPayloadParser.StandardizedProperties) vaaPayloadParser.StandardizedProperties {
 	// transform amount.
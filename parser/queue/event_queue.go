@@ -0,0 +1,154 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/internal/metrics"
+	"go.uber.org/zap"
+)
+
+// EventQueueOption represents an event queue option function.
+type EventQueueOption func(*EventQueue)
+
+// EventQueue represents a VAA queue consumed from the message bus.
+type EventQueue struct {
+	consumer      messagebus.Consumer
+	ch            chan ConsumerMessage
+	chSize        int
+	wg            sync.WaitGroup
+	filterConsume FilterConsumeFunc
+	converter     ConverterFunc
+	metrics       metrics.Metrics
+	logger        *zap.Logger
+}
+
+// FilterConsumeFunc filter vaaa func definition.
+type FilterConsumeFunc func(*Event) bool
+
+// ConverterFunc converts a message from the message bus.
+type ConverterFunc func(string) (*Event, error)
+
+// NewEventQueue creates a VAA queue instance.
+func NewEventQueue(consumer messagebus.Consumer, converter ConverterFunc, filterConsume FilterConsumeFunc, metrics metrics.Metrics, logger *zap.Logger, opts ...EventQueueOption) *EventQueue {
+	s := &EventQueue{
+		consumer:      consumer,
+		chSize:        10,
+		converter:     converter,
+		filterConsume: filterConsume,
+		metrics:       metrics,
+		logger:        logger.With(zap.String("queue", consumer.Name())),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.ch = make(chan ConsumerMessage, s.chSize)
+	return s
+}
+
+// WithChannelSize allows to specify an channel size when setting a value.
+func WithChannelSize(size int) EventQueueOption {
+	return func(d *EventQueue) {
+		d.chSize = size
+	}
+}
+
+// Consume returns the channel with the received messages from the queue.
+func (q *EventQueue) Consume(ctx context.Context) <-chan ConsumerMessage {
+	go func() {
+		for {
+			messages, err := q.consumer.Receive(ctx)
+			if err != nil {
+				q.logger.Error("Error getting messages from queue", zap.Error(err))
+				continue
+			}
+			q.logger.Debug("Received messages from queue", zap.Int("count", len(messages)))
+			expiredAt := time.Now().Add(q.consumer.VisibilityTimeout())
+			for _, msg := range messages {
+
+				// unmarshal message to event
+				event, err := q.converter(string(msg.Body))
+				if err != nil {
+					q.logger.Error("Error converting event message", zap.Error(err))
+					if err = q.consumer.Ack(ctx, msg); err != nil {
+						q.logger.Error("Error deleting message from queue", zap.Error(err))
+					}
+					continue
+				}
+
+				if event == nil {
+					q.logger.Warn("Can not handle message", zap.ByteString("body", msg.Body))
+					if err = q.consumer.Ack(ctx, msg); err != nil {
+						q.logger.Error("Error deleting message from queue", zap.Error(err))
+					}
+					continue
+				}
+
+				q.metrics.IncVaaConsumedQueue(event.ChainID)
+
+				// filter vaaEvent by p2p net.
+				if q.filterConsume(event) {
+					if err := q.consumer.Ack(ctx, msg); err != nil {
+						q.logger.Error("Error deleting message from queue", zap.Error(err))
+					}
+					continue
+				}
+				q.metrics.IncVaaUnfiltered(event.ChainID)
+
+				q.wg.Add(1)
+				q.ch <- &consumerMessage{
+					msg:       msg,
+					data:      event,
+					wg:        &q.wg,
+					logger:    q.logger,
+					consumer:  q.consumer,
+					expiredAt: expiredAt,
+					ctx:       ctx,
+				}
+			}
+			q.wg.Wait()
+		}
+
+	}()
+	return q.ch
+}
+
+// Close closes all consumer resources.
+func (q *EventQueue) Close() {
+	close(q.ch)
+}
+
+type consumerMessage struct {
+	msg       *messagebus.Message
+	data      *Event
+	consumer  messagebus.Consumer
+	wg        *sync.WaitGroup
+	logger    *zap.Logger
+	expiredAt time.Time
+	ctx       context.Context
+}
+
+func (m *consumerMessage) Data() *Event {
+	return m.data
+}
+
+func (m *consumerMessage) Done() {
+	if err := m.consumer.Ack(m.ctx, m.msg); err != nil {
+		m.logger.Error("Error deleting message from queue", zap.Error(err))
+	}
+	m.wg.Done()
+}
+
+func (m *consumerMessage) Failed() {
+	m.wg.Done()
+}
+
+func (m *consumerMessage) IsExpired() bool {
+	return m.expiredAt.Before(time.Now())
+}
+
+func (m *consumerMessage) SentTimestamp() *time.Time {
+	return m.msg.SentTimestamp
+}
@@ -7,8 +7,8 @@ import (
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/metrics"
-	"github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/sns"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/topic"
 	"go.uber.org/zap"
 )
@@ -47,10 +47,6 @@ func NewTopicProducer(ctx context.Context, region, snsUrl string, accessKeyID, s
 		return nil, err
 	}
 
-	snsProducer, err := sns.NewProducer(awsConfig, snsUrl)
-	if err != nil {
-		return nil, err
-	}
-
-	return topic.NewVAASNS(snsProducer, alertClient, metrics, logger).Publish, nil
+	snsProducer := messagebus.NewSNSProducer(awsConfig, snsUrl)
+	return topic.NewVAATopic(snsProducer, alertClient, metrics, logger).Publish, nil
 }
@@ -41,8 +41,10 @@ func addServiceCommand(root *cobra.Command) {
 func addBackfiller(root *cobra.Command) {
 	var mongoUri, mongoDb, snsUrl, logLevel, awsRegion, startTime, endTime string
 	var awsEndpoint, awsAccessKeyID, awsSecretAccessKey string
+	var jobName, failuresFile string
 	var pageSize, requestsPerSecond int64
 	var numWorkers int
+	var resume, dryRun bool
 
 	backfillerCommand := &cobra.Command{
 		Use:   "backfiller",
@@ -62,6 +64,10 @@ func addBackfiller(root *cobra.Command) {
 				EndTime:            endTime,
 				PageSize:           pageSize,
 				NumWorkers:         numWorkers,
+				JobName:            jobName,
+				Resume:             resume,
+				DryRun:             dryRun,
+				FailuresFile:       failuresFile,
 			}
 			backfiller.Run(cfg)
 		},
@@ -75,10 +81,14 @@ func addBackfiller(root *cobra.Command) {
 	backfillerCommand.Flags().StringVar(&awsAccessKeyID, "aws-access-key-id", "", "Aws access key id")
 	backfillerCommand.Flags().StringVar(&awsSecretAccessKey, "aws-secret-access-key", "", "Aws secret access key")
 	backfillerCommand.Flags().StringVar(&startTime, "start-time", "1970-01-01T00:00:00Z", "minimum VAA timestamp to process")
-	backfillerCommand.Flags().StringVar(&endTime, "end-time", "", "maximum VAA timestamp to process (default now)")
+	backfillerCommand.Flags().StringVar(&endTime, "end-time", "", "maximum VAA timestamp to process (default now, kept when the job is resumed)")
 	backfillerCommand.Flags().Int64Var(&pageSize, "page-size", 100, "number of documents retrieved at a time")
 	backfillerCommand.Flags().Int64Var(&requestsPerSecond, "requests-per-second", 100, "maximum number of requests per second to publish to sns topic")
 	backfillerCommand.Flags().IntVar(&numWorkers, "num-workers", 5, "number of workers to publish vaas")
+	backfillerCommand.Flags().StringVar(&jobName, "job-name", "pipeline-vaas", "name of the job used to store its checkpoint")
+	backfillerCommand.Flags().BoolVar(&resume, "resume", false, "resume the job from its last checkpoint")
+	backfillerCommand.Flags().BoolVar(&dryRun, "dry-run", false, "read the vaas without publishing them")
+	backfillerCommand.Flags().StringVar(&failuresFile, "failures-file", "", "csv file where the vaas that failed are appended")
 
 	backfillerCommand.MarkFlagRequired("mongo-uri")
 	backfillerCommand.MarkFlagRequired("mongo-database")
@@ -11,15 +11,16 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/go-redis/redis/v8"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/config"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/healthcheck"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/http/infrastructure"
 	pipelineAlert "github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/alert"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/metrics"
-	"github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/sns"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/pipeline"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/topic"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/watcher"
@@ -155,20 +156,29 @@ func newAwsConfig(appCtx context.Context, cfg *config.Configuration) (aws.Config
 }
 
 func newTopicProducer(appCtx context.Context, config *config.Configuration, alertClient alert.AlertClient, metrics metrics.Metrics, logger *zap.Logger) (topic.PushFunc, error) {
-	awsConfig, err := newAwsConfig(appCtx, config)
-	if err != nil {
-		return nil, err
+	var producer messagebus.Producer
+	if config.MessageBus == messagebus.BackendRedis {
+		producer = messagebus.NewRedisStreamProducer(newMessageBusRedisClient(config), config.PipelineStream)
+	} else {
+		awsConfig, err := newAwsConfig(appCtx, config)
+		if err != nil {
+			return nil, err
+		}
+		producer = messagebus.NewSNSProducer(awsConfig, config.SNSUrl)
 	}
 
-	snsProducer, err := sns.NewProducer(awsConfig, config.SNSUrl)
-	if err != nil {
-		return nil, err
-	}
+	return topic.NewVAATopic(producer, alertClient, metrics, logger).Publish, nil
+}
 
-	return topic.NewVAASNS(snsProducer, alertClient, metrics, logger).Publish, nil
+func newMessageBusRedisClient(config *config.Configuration) *redis.Client {
+	return redis.NewClient(&redis.Options{Addr: config.MessageBusRedisURI})
 }
 
 func newHealthChecks(ctx context.Context, config *config.Configuration, db *mongo.Database) ([]healthcheck.Check, error) {
+	if config.MessageBus == messagebus.BackendRedis {
+		return []healthcheck.Check{healthcheck.Mongo(db), healthcheck.Redis(newMessageBusRedisClient(config))}, nil
+	}
+
 	awsConfig, err := newAwsConfig(ctx, config)
 	if err != nil {
 		return nil, err
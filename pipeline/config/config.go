@@ -5,6 +5,7 @@ import (
 
 	"github.com/joho/godotenv"
 	"github.com/sethvargo/go-envconfig"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
 )
 
 // Configuration represents the application configuration with the default values.
@@ -20,6 +21,9 @@ type Configuration struct {
 	AwsSecretAccessKey string `env:"AWS_SECRET_ACCESS_KEY"`
 	AwsRegion          string `env:"AWS_REGION"`
 	SNSUrl             string `env:"SNS_URL"`
+	MessageBus         string `env:"MESSAGE_BUS,default=sqs"`
+	MessageBusRedisURI string `env:"MESSAGE_BUS_REDIS_URI"`
+	PipelineStream     string `env:"PIPELINE_STREAM,default=pipeline"`
 	PprofEnabled       bool   `env:"PPROF_ENABLED,default=false"`
 	AlertEnabled       bool   `env:"ALERT_ENABLED,default=false"`
 	AlertApiKey        string `env:"ALERT_API_KEY"`
@@ -40,6 +44,10 @@ type Backfiller struct {
 	EndTime            string
 	PageSize           int64
 	NumWorkers         int
+	JobName            string
+	Resume             bool
+	DryRun             bool
+	FailuresFile       string
 }
 
 // New creates a configuration with the values from .env file and environment variables.
@@ -50,6 +58,9 @@ func New(ctx context.Context) (*Configuration, error) {
 	if err := envconfig.Process(ctx, &configuration); err != nil {
 		return nil, err
 	}
+	if err := messagebus.ValidateBackend(configuration.MessageBus); err != nil {
+		return nil, err
+	}
 
 	return &configuration, nil
 }
@@ -0,0 +1,14 @@
+package healthcheck
+
+import (
+	"context"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Redis does a ping.
+func Redis(client *redis.Client) Check {
+	return func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	}
+}
@@ -3,25 +3,26 @@ package topic
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
 	pipelineAlert "github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/alert"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/metrics"
-	"github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/sns"
 	"go.uber.org/zap"
 )
 
-// SQS represents a VAA queue in SNS.
-type SNS struct {
-	producer    *sns.Producer
+// VAATopic represents a VAA topic in the message bus.
+type VAATopic struct {
+	producer    messagebus.Producer
 	alertClient alert.AlertClient
 	metrics     metrics.Metrics
 	logger      *zap.Logger
 }
 
-// NewVAASNS creates a VAA topic in SNS instances.
-func NewVAASNS(producer *sns.Producer, alertClient alert.AlertClient, metrics metrics.Metrics, logger *zap.Logger) *SNS {
-	s := &SNS{
+// NewVAATopic creates a VAA topic instance.
+func NewVAATopic(producer messagebus.Producer, alertClient alert.AlertClient, metrics metrics.Metrics, logger *zap.Logger) *VAATopic {
+	s := &VAATopic{
 		producer:    producer,
 		alertClient: alertClient,
 		metrics:     metrics,
@@ -30,15 +31,20 @@ func NewVAASNS(producer *sns.Producer, alertClient alert.AlertClient, metrics me
 	return s
 }
 
-// Publish sends the message to a SNS topic.
-func (s *SNS) Publish(ctx context.Context, message *Event) error {
+// Publish sends the message to the topic.
+func (s *VAATopic) Publish(ctx context.Context, message *Event) error {
 	body, err := json.Marshal(message)
 	if err != nil {
 		return err
 	}
 
 	s.logger.Debug("Publishing message", zap.String("groupID", message.ID))
-	err = s.producer.SendMessage(ctx, message.ChainID, message.ID, message.ID, string(body))
+	err = s.producer.Publish(ctx, &messagebus.OutgoingMessage{
+		GroupID:         message.ID,
+		DeduplicationID: message.ID,
+		Body:            body,
+		Attributes:      map[string]string{"chainId": fmt.Sprintf("%d", message.ChainID)},
+	})
 	if err == nil {
 		s.metrics.IncVaaSendNotification(message.ChainID)
 	} else {
@@ -6,15 +6,20 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 	"github.com/go-redis/redis/v8"
+	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/health"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/vaaparser"
 	"github.com/wormhole-foundation/wormhole-explorer/spy/config"
 	"github.com/wormhole-foundation/wormhole-explorer/spy/grpc"
 	"github.com/wormhole-foundation/wormhole-explorer/spy/http/infraestructure"
 	"github.com/wormhole-foundation/wormhole-explorer/spy/source"
+	"github.com/wormhole-foundation/wormhole-explorer/spy/storage"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
 
@@ -32,14 +37,30 @@ func handleExit() {
 func newHealthChecks(
 	ctx context.Context,
 	client *redis.Client,
+	db *dbutil.Session,
 ) ([]health.Check, error) {
 
 	healthChecks := []health.Check{
 		health.Redis(client),
 	}
+	if db != nil {
+		healthChecks = append(healthChecks, health.Mongo(db.Database))
+	}
 	return healthChecks, nil
 }
 
+// newAppIDsFunc resolves the appIds of a VAA with the in-process payload parser.
+func newAppIDsFunc(p2pNetwork string, logger *zap.Logger) grpc.AppIDsFunc {
+	parser := vaaparser.New(p2pNetwork, nil, logger)
+	return func(v *vaa.VAA) []string {
+		response, err := parser.ParseVaaWithStandarizedProperties(v)
+		if err != nil {
+			return nil
+		}
+		return response.StandardizedProperties.AppIds
+	}
+}
+
 func main() {
 
 	defer handleExit()
@@ -55,10 +76,21 @@ func main() {
 
 	logger.Info("Starting wormhole-explorer-spy ...")
 
-	svs := grpc.NewSignedVaaSubscribers(logger)
+	svs := grpc.NewSignedVaaSubscribers(logger, grpc.WithAppIDsFunc(newAppIDsFunc(config.P2pNetwork, logger)))
 	go svs.Start(rootCtx)
 
-	handler := grpc.NewHandler(svs, logger)
+	// the replay of stored VAAs is only enabled when MongoDB is configured.
+	var handlerOpts []grpc.HandlerOption
+	var db *dbutil.Session
+	if config.MongoURI != "" {
+		db, err = dbutil.Connect(rootCtx, logger, config.MongoURI, config.MongoDatabase, false)
+		if err != nil {
+			logger.Fatal("failed to connect MongoDB", zap.Error(err))
+		}
+		handlerOpts = append(handlerOpts, grpc.WithReplayRepository(storage.NewRepository(db.Database, logger)))
+	}
+
+	handler := grpc.NewHandler(svs, logger, handlerOpts...)
 
 	grpcServer, err := grpc.NewServer(handler, logger, config.GrpcAddress)
 	if err != nil {
@@ -91,7 +123,7 @@ func main() {
 	}
 	// get health check functions.
 	logger.Info("creating health check functions...")
-	healthChecks, err := newHealthChecks(rootCtx, client)
+	healthChecks, err := newHealthChecks(rootCtx, client, db)
 	if err != nil {
 		logger.Fatal("failed to create health checks", zap.Error(err))
 	}
@@ -125,6 +157,11 @@ func main() {
 		logger.Error("Error closing redis client", zap.Error(err))
 	}
 
+	if db != nil {
+		logger.Info("Closing MongoDB connection...")
+		db.DisconnectWithTimeout(10 * time.Second)
+	}
+
 	logger.Info("Closing Http server ...")
 	server.Stop()
 	logger.Info("Finished wormhole-explorer-spy")
@@ -17,6 +17,10 @@ type Configuration struct {
 	RedisPrefix  string `env:"REDIS_PREFIX,required"`
 	RedisChannel string `env:"REDIS_VAA_CHANNEL,required"`
 	PprofEnabled bool   `env:"PPROF_ENABLED,default=false"`
+	P2pNetwork   string `env:"P2P_NETWORK,default=mainnet"`
+	// MongoDB is optional, it is only used to replay the stored VAAs to the subscribers.
+	MongoURI      string `env:"MONGODB_URI"`
+	MongoDatabase string `env:"MONGODB_DATABASE"`
 }
 
 // New creates a configuration with the values from .env file and environment variables.
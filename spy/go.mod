@@ -17,11 +17,14 @@ require (
 )
 
 require (
+	github.com/cosmos/btcutil v1.0.5 // indirect
 	github.com/go-redis/redis/v8 v8.11.5
 	github.com/wormhole-foundation/wormhole-explorer/common v0.0.0-00010101000000-000000000000
 )
 
 require (
+	github.com/algorand/go-algorand-sdk v1.23.0 // indirect
+	github.com/algorand/go-codec/codec v1.1.8 // indirect
 	github.com/andybalholm/brotli v1.0.5 // indirect
 	github.com/aws/aws-sdk-go-v2 v1.17.4 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/configsources v1.1.28 // indirect
@@ -1,28 +1,87 @@
 package grpc
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	spyv1 "github.com/certusone/wormhole/node/pkg/proto/spy/v1"
+	"github.com/wormhole-foundation/wormhole-explorer/spy/storage"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// Metadata keys of the subscription options that are not part of the spy protocol.
+// Values with several items are separated by comma.
+const (
+	// MetadataPayloadTypes filters the VAAs by the first byte of the payload, e.g. "1,3".
+	MetadataPayloadTypes = "x-spy-payload-types"
+	// MetadataAppIDs filters the VAAs by the protocol that emitted them, e.g. "PORTAL_TOKEN_BRIDGE".
+	MetadataAppIDs = "x-spy-app-ids"
+	// MetadataSequenceFrom filters the VAAs with a sequence greater or equal than the value.
+	MetadataSequenceFrom = "x-spy-sequence-from"
+	// MetadataSequenceTo filters the VAAs with a sequence less or equal than the value.
+	MetadataSequenceTo = "x-spy-sequence-to"
+	// MetadataReplayFromTimestamp replays the stored VAAs from a RFC3339 timestamp before switching to live.
+	MetadataReplayFromTimestamp = "x-spy-replay-from-timestamp"
+	// MetadataReplayFromVaa replays the stored VAAs after a VAA id (chain/emitter/sequence) before switching to live.
+	MetadataReplayFromVaa = "x-spy-replay-from-vaa"
+)
+
+// replayBufferSize is the number of live VAAs buffered while the stored VAAs are replayed.
+const replayBufferSize = 10000
+
+// replayDedupWindow is the time before the subscription during which replayed VAAs
+// may also be received live. Those VAAs are only sent once.
+const replayDedupWindow = time.Minute
+
+// ReplayRepository finds the stored VAAs to replay.
+type ReplayRepository interface {
+	FindVaaTimestamp(ctx context.Context, id string) (*time.Time, error)
+	ReplayVaas(ctx context.Context, from time.Time, filter storage.ReplayFilter, handler func(*storage.VaaDoc) error) error
+}
+
+// HandlerOption represents a handler option function.
+type HandlerOption func(*Handler)
+
+// WithReplayRepository enables the replay of stored VAAs.
+func WithReplayRepository(repository ReplayRepository) HandlerOption {
+	return func(h *Handler) {
+		h.replayRepository = repository
+	}
+}
+
 // Handler represents a GRPC subscription service handler.
 type Handler struct {
 	spyv1.UnimplementedSpyRPCServiceServer
-	svs    *SignedVaaSubscribers
-	logger *zap.Logger
+	svs              *SignedVaaSubscribers
+	replayRepository ReplayRepository
+	logger           *zap.Logger
 }
 
 // NewHandler creates a new handler of suscriptions.
-func NewHandler(svs *SignedVaaSubscribers, logger *zap.Logger) *Handler {
-	return &Handler{
+func NewHandler(svs *SignedVaaSubscribers, logger *zap.Logger, opts ...HandlerOption) *Handler {
+	h := &Handler{
 		svs:    svs,
 		logger: logger,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// replayRequest defines the stored VAAs to send before the live ones.
+type replayRequest struct {
+	from time.Time
+	// afterVaaID is excluded from the replay, since the subscriber already has it.
+	afterVaaID string
 }
 
 // SubscribeSignedVAA implements the suscriptions of signed VAA.
@@ -49,15 +108,50 @@ func (h *Handler) SubscribeSignedVAA(req *spyv1.SubscribeSignedVAARequest, resp
 		}
 	}
 
-	subscriber := h.svs.Register(fi)
+	md, _ := metadata.FromIncomingContext(resp.Context())
+	opts, err := h.parseSubscriptionOptions(md)
+	if err != nil {
+		return err
+	}
+	replay, err := h.parseReplayRequest(resp.Context(), md)
+	if err != nil {
+		return err
+	}
+	if replay != nil {
+		opts = append(opts, withReplayBuffer(replayBufferSize))
+	}
+
+	registeredAt := time.Now()
+	subscriber := h.svs.Register(fi, opts...)
 	defer h.svs.Unregister(subscriber)
 
+	// replayed contains the recent replayed VAAs, that could also be received live.
+	var replayed map[string]struct{}
+	if replay != nil {
+		replayed, err = h.replay(resp, subscriber, replay, registeredAt)
+		if err != nil {
+			return err
+		}
+	}
+
 	for {
 		select {
 		case <-resp.Context().Done():
 			h.logger.Error("Context done", zap.String("id", subscriber.id), zap.Error(resp.Context().Err()))
 			return resp.Context().Err()
 		case msg := <-subscriber.ch:
+			if subscriber.overflowed.Load() {
+				h.logger.Warn("Subscriber fell behind while replaying", zap.String("id", subscriber.id))
+				return status.Error(codes.ResourceExhausted, "subscriber fell behind while replaying, resubscribe from the last received vaa")
+			}
+			if len(replayed) > 0 {
+				if v, err := vaa.Unmarshal(msg.vaaBytes); err == nil {
+					if _, ok := replayed[v.MessageID()]; ok {
+						delete(replayed, v.MessageID())
+						continue
+					}
+				}
+			}
 			if err := resp.Send(&spyv1.SubscribeSignedVAAResponse{
 				VaaBytes: msg.vaaBytes,
 			}); err != nil {
@@ -67,3 +161,184 @@ func (h *Handler) SubscribeSignedVAA(req *spyv1.SubscribeSignedVAARequest, resp
 		}
 	}
 }
+
+// replay sends the stored VAAs that match the subscription filters.
+// The filters are applied in the query of the stored VAAs, and checked again for the payload types and the VAAs not parsed yet.
+// It returns the ids of the replayed VAAs indexed close to the subscription time.
+func (h *Handler) replay(
+	resp spyv1.SpyRPCService_SubscribeSignedVAAServer,
+	subscriber *subscriptionSignedVaa,
+	replay *replayRequest,
+	registeredAt time.Time,
+) (map[string]struct{}, error) {
+
+	h.logger.Info("Replaying signed VAAs", zap.String("id", subscriber.id), zap.Time("from", replay.from))
+
+	replayed := make(map[string]struct{})
+	count := 0
+	err := h.replayRepository.ReplayVaas(resp.Context(), replay.from, subscriber.replayFilter(), func(doc *storage.VaaDoc) error {
+		if doc.ID == replay.afterVaaID {
+			return nil
+		}
+		v, err := vaa.Unmarshal(doc.Vaas)
+		if err != nil {
+			h.logger.Error("Unmarshal vaa to replay", zap.String("vaaId", doc.ID), zap.Error(err))
+			return nil
+		}
+		if subscriber.hasFilters() && !subscriber.match(v, func() []string { return h.svs.appIDs(v) }) {
+			return nil
+		}
+		if err := resp.Send(&spyv1.SubscribeSignedVAAResponse{VaaBytes: doc.Vaas}); err != nil {
+			return err
+		}
+		count++
+		if doc.IndexedAt == nil || doc.IndexedAt.After(registeredAt.Add(-replayDedupWindow)) {
+			replayed[doc.ID] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		h.logger.Error("Replaying signed VAAs", zap.String("id", subscriber.id), zap.Error(err))
+		if status.Code(err) != codes.Unknown {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to replay signed VAAs")
+	}
+
+	h.logger.Info("Replayed signed VAAs", zap.String("id", subscriber.id), zap.Int("count", count))
+	return replayed, nil
+}
+
+// parseSubscriptionOptions parses the filters sent in the request metadata.
+func (h *Handler) parseSubscriptionOptions(md metadata.MD) ([]SubscriptionOption, error) {
+	var opts []SubscriptionOption
+
+	if values := metadataValues(md, MetadataPayloadTypes); len(values) > 0 {
+		payloadTypes := make([]uint8, 0, len(values))
+		for _, value := range values {
+			t, err := strconv.ParseUint(value, 10, 8)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid payload type %s", value))
+			}
+			payloadTypes = append(payloadTypes, uint8(t))
+		}
+		opts = append(opts, withPayloadTypes(payloadTypes))
+	}
+
+	if appIDs := metadataValues(md, MetadataAppIDs); len(appIDs) > 0 {
+		if h.svs.appIDsFunc == nil {
+			return nil, status.Error(codes.Unimplemented, "appId filter is not enabled")
+		}
+		opts = append(opts, withAppIDs(appIDs))
+	}
+
+	from, err := metadataUint64(md, MetadataSequenceFrom)
+	if err != nil {
+		return nil, err
+	}
+	to, err := metadataUint64(md, MetadataSequenceTo)
+	if err != nil {
+		return nil, err
+	}
+	if from != nil || to != nil {
+		if from != nil && to != nil && *from > *to {
+			return nil, status.Error(codes.InvalidArgument, "invalid sequence range")
+		}
+		opts = append(opts, withSequenceRange(&sequenceRange{from: from, to: to}))
+	}
+
+	return opts, nil
+}
+
+// parseReplayRequest parses the replay options sent in the request metadata.
+// It returns nil when the subscriber does not request a replay.
+func (h *Handler) parseReplayRequest(ctx context.Context, md metadata.MD) (*replayRequest, error) {
+	timestamp := metadataValue(md, MetadataReplayFromTimestamp)
+	vaaID := metadataValue(md, MetadataReplayFromVaa)
+	if timestamp == "" && vaaID == "" {
+		return nil, nil
+	}
+	if timestamp != "" && vaaID != "" {
+		return nil, status.Error(codes.InvalidArgument, "replay from timestamp and from vaa can not be combined")
+	}
+	if h.replayRepository == nil {
+		return nil, status.Error(codes.Unimplemented, "replay is not enabled")
+	}
+
+	if timestamp != "" {
+		from, err := time.Parse(time.RFC3339, timestamp)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid replay timestamp %s", timestamp))
+		}
+		return &replayRequest{from: from}, nil
+	}
+
+	id, err := normalizeVaaID(vaaID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid replay vaa %s", vaaID))
+	}
+	from, err := h.replayRepository.FindVaaTimestamp(ctx, id)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("vaa %s not found", vaaID))
+	}
+	if err != nil {
+		h.logger.Error("Finding vaa to replay from", zap.String("vaaId", id), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to find the vaa to replay from")
+	}
+	return &replayRequest{from: *from, afterVaaID: id}, nil
+}
+
+// normalizeVaaID formats a chain/emitter/sequence id as it is stored.
+func normalizeVaaID(id string) (string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 {
+		return "", errors.New("invalid vaa id")
+	}
+	chainID, err := strconv.ParseUint(parts[0], 10, 16)
+	if err != nil {
+		return "", err
+	}
+	addr, err := vaa.StringToAddress(parts[1])
+	if err != nil {
+		return "", err
+	}
+	sequence, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d/%s/%d", chainID, addr.String(), sequence), nil
+}
+
+// metadataValues returns the comma separated values of a metadata key.
+func metadataValues(md metadata.MD, key string) []string {
+	var values []string
+	for _, value := range md.Get(key) {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
+// metadataValue returns the first value of a metadata key.
+func metadataValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(values[0])
+}
+
+func metadataUint64(md metadata.MD, key string) (*uint64, error) {
+	value := metadataValue(md, key)
+	if value == "" {
+		return nil, nil
+	}
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid %s %s", key, value))
+	}
+	return &n, nil
+}
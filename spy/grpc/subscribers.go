@@ -2,8 +2,11 @@ package grpc
 
 import (
 	"context"
+	"strings"
+	"sync/atomic"
 
 	"github.com/google/uuid"
+	"github.com/wormhole-foundation/wormhole-explorer/spy/storage"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
@@ -16,43 +19,192 @@ type filterSignedVaa struct {
 	chainId     vaa.ChainID
 	emitterAddr vaa.Address
 }
+
+// sequenceRange is an inclusive range of VAA sequences. A nil bound is unbounded.
+type sequenceRange struct {
+	from *uint64
+	to   *uint64
+}
+
+func (r *sequenceRange) contains(sequence uint64) bool {
+	return (r.from == nil || sequence >= *r.from) && (r.to == nil || sequence <= *r.to)
+}
+
 type subscriptionSignedVaa struct {
-	id      string
-	filters []filterSignedVaa
-	ch      chan message
+	id           string
+	filters      []filterSignedVaa
+	payloadTypes []uint8
+	appIDs       []string
+	sequences    *sequenceRange
+	ch           chan message
+	// strict subscriptions can not lose messages (e.g. while they are replaying),
+	// so they are flagged as overflowed instead of silently dropping messages.
+	strict     bool
+	overflowed atomic.Bool
+}
+
+// SubscriptionOption represents a signed VAA subscription option function.
+type SubscriptionOption func(*subscriptionSignedVaa)
+
+// withPayloadTypes only sends the VAAs whose payload starts with one of the given types.
+func withPayloadTypes(payloadTypes []uint8) SubscriptionOption {
+	return func(s *subscriptionSignedVaa) {
+		s.payloadTypes = payloadTypes
+	}
+}
+
+// withAppIDs only sends the VAAs that belong to one of the given protocols.
+func withAppIDs(appIDs []string) SubscriptionOption {
+	return func(s *subscriptionSignedVaa) {
+		s.appIDs = appIDs
+	}
+}
+
+// withSequenceRange only sends the VAAs whose sequence is in the range.
+func withSequenceRange(r *sequenceRange) SubscriptionOption {
+	return func(s *subscriptionSignedVaa) {
+		s.sequences = r
+	}
+}
+
+// withReplayBuffer buffers the live VAAs while the stored VAAs are being replayed.
+func withReplayBuffer(size int) SubscriptionOption {
+	return func(s *subscriptionSignedVaa) {
+		s.ch = make(chan message, size)
+		s.strict = true
+	}
+}
+
+// hasFilters returns true when the VAA must be decoded to apply the subscription filters.
+func (s *subscriptionSignedVaa) hasFilters() bool {
+	return len(s.filters) > 0 || len(s.payloadTypes) > 0 || len(s.appIDs) > 0 || s.sequences != nil
+}
+
+// match returns true when the VAA passes all the filters of the subscription.
+// The appIDs function is only called when the subscription filters by appId.
+func (s *subscriptionSignedVaa) match(v *vaa.VAA, appIDs func() []string) bool {
+	if len(s.filters) > 0 {
+		found := false
+		for _, fi := range s.filters {
+			if fi.chainId == v.EmitterChain && fi.emitterAddr == v.EmitterAddress {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if s.sequences != nil && !s.sequences.contains(v.Sequence) {
+		return false
+	}
+	if len(s.payloadTypes) > 0 {
+		if len(v.Payload) == 0 || !containsPayloadType(s.payloadTypes, v.Payload[0]) {
+			return false
+		}
+	}
+	if len(s.appIDs) > 0 && !containsAnyFold(s.appIDs, appIDs()) {
+		return false
+	}
+	return true
+}
+
+// replayFilter returns the filters of the subscription that can be applied in the query of the stored VAAs.
+func (s *subscriptionSignedVaa) replayFilter() storage.ReplayFilter {
+	var filter storage.ReplayFilter
+	for _, fi := range s.filters {
+		filter.Emitters = append(filter.Emitters, storage.Emitter{ChainID: fi.chainId, Address: fi.emitterAddr.String()})
+	}
+	if s.sequences != nil {
+		filter.SequenceFrom, filter.SequenceTo = s.sequences.from, s.sequences.to
+	}
+	filter.AppIDs = s.appIDs
+	return filter
+}
+
+// send delivers the message without blocking.
+func (s *subscriptionSignedVaa) send(msg message) {
+	select {
+	case s.ch <- msg:
+	default:
+		if s.strict {
+			s.overflowed.Store(true)
+		}
+	}
+}
+
+func containsPayloadType(payloadTypes []uint8, payloadType uint8) bool {
+	for _, t := range payloadTypes {
+		if t == payloadType {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAnyFold(values []string, others []string) bool {
+	for _, v := range values {
+		for _, o := range others {
+			if strings.EqualFold(v, o) {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func subscriptionId() string {
 	return uuid.New().String()
 }
 
+// AppIDsFunc returns the appIds of the protocols a VAA belongs to.
+type AppIDsFunc func(v *vaa.VAA) []string
+
+// SignedVaaSubscribersOption represents a signed VAA subscribers option function.
+type SignedVaaSubscribersOption func(*SignedVaaSubscribers)
+
+// WithAppIDsFunc allows to filter the VAAs by appId.
+func WithAppIDsFunc(appIDsFunc AppIDsFunc) SignedVaaSubscribersOption {
+	return func(s *SignedVaaSubscribers) {
+		s.appIDsFunc = appIDsFunc
+	}
+}
+
 // SignedVaaSubscribers represents signed VAA subscribers.
 type SignedVaaSubscribers struct {
 	source           chan []byte
 	subscribers      map[string]*subscriptionSignedVaa
 	addSubscriber    chan *subscriptionSignedVaa
 	removeSubscriber chan *subscriptionSignedVaa
+	appIDsFunc       AppIDsFunc
 	logger           *zap.Logger
 }
 
 // NewSignedVaaSubscribers creates a signed VAA subscribers.
-func NewSignedVaaSubscribers(logger *zap.Logger) *SignedVaaSubscribers {
-	return &SignedVaaSubscribers{
+func NewSignedVaaSubscribers(logger *zap.Logger, opts ...SignedVaaSubscribersOption) *SignedVaaSubscribers {
+	s := &SignedVaaSubscribers{
 		subscribers:      make(map[string]*subscriptionSignedVaa),
 		addSubscriber:    make(chan *subscriptionSignedVaa, 1),
 		removeSubscriber: make(chan *subscriptionSignedVaa, 1),
 		source:           make(chan []byte, 1),
 		logger:           logger,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // Register registers a new subscriber with a list of filters.
-func (s *SignedVaaSubscribers) Register(fi []filterSignedVaa) *subscriptionSignedVaa {
+func (s *SignedVaaSubscribers) Register(fi []filterSignedVaa, opts ...SubscriptionOption) *subscriptionSignedVaa {
 	sub := &subscriptionSignedVaa{
 		id:      subscriptionId(),
 		ch:      make(chan message, 1),
 		filters: fi,
 	}
+	for _, opt := range opts {
+		opt(sub)
+	}
 	s.logger.Info("Registering subscriber in signed VAAs ...", zap.String("id", sub.id))
 	s.addSubscriber <- sub
 	return sub
@@ -97,13 +249,17 @@ func (s *SignedVaaSubscribers) Start(ctx context.Context) {
 				break
 			}
 			var v *vaa.VAA
+			var appIDs []string
+			appIDsFunc := func() []string {
+				if appIDs == nil {
+					appIDs = s.appIDs(v)
+				}
+				return appIDs
+			}
 
 			for _, sub := range s.subscribers {
-				if len(sub.filters) == 0 {
-					select {
-					case sub.ch <- message{vaaBytes: vaas}:
-					default:
-					}
+				if !sub.hasFilters() {
+					sub.send(message{vaaBytes: vaas})
 					continue
 				}
 
@@ -116,16 +272,22 @@ func (s *SignedVaaSubscribers) Start(ctx context.Context) {
 					}
 				}
 
-				for _, fi := range sub.filters {
-					if fi.chainId == v.EmitterChain && fi.emitterAddr == v.EmitterAddress {
-						select {
-						case sub.ch <- message{vaaBytes: vaas}:
-						default:
-						}
-					}
+				if sub.match(v, appIDsFunc) {
+					sub.send(message{vaaBytes: vaas})
 				}
-
 			}
 		}
 	}
 }
+
+// appIDs returns the appIds of a VAA, or an empty list if they can not be resolved.
+func (s *SignedVaaSubscribers) appIDs(v *vaa.VAA) []string {
+	if s.appIDsFunc == nil {
+		return []string{}
+	}
+	appIDs := s.appIDsFunc(v)
+	if appIDs == nil {
+		return []string{}
+	}
+	return appIDs
+}
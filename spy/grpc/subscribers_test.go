@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/spy/storage"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap/zaptest"
 )
@@ -97,3 +98,83 @@ func TestSignedVaaSubscribers_HandleVAA(t *testing.T) {
 		assert.Equal(t, 0, len(sub.ch))
 	})
 }
+
+func TestSubscriptionSignedVaa_Match(t *testing.T) {
+	from, to := uint64(1), uint64(5)
+	noAppIDs := func() []string { return nil }
+	tokenBridge := func() []string { return []string{"PORTAL_TOKEN_BRIDGE"} }
+
+	t.Run("payload type", func(t *testing.T) {
+		sub := &subscriptionSignedVaa{}
+		withPayloadTypes([]uint8{1, 3})(sub)
+		v := createVAA(vaa.ChainIDEthereum, emitterAddr)
+		v.Payload = []byte{3, 0, 0}
+		assert.True(t, sub.match(v, noAppIDs))
+		v.Payload = []byte{2, 0, 0}
+		assert.False(t, sub.match(v, noAppIDs))
+		v.Payload = nil
+		assert.False(t, sub.match(v, noAppIDs))
+	})
+
+	t.Run("sequence range", func(t *testing.T) {
+		sub := &subscriptionSignedVaa{}
+		withSequenceRange(&sequenceRange{from: &from, to: &to})(sub)
+		v := createVAA(vaa.ChainIDEthereum, emitterAddr)
+		v.Sequence = 5
+		assert.True(t, sub.match(v, noAppIDs))
+		v.Sequence = 6
+		assert.False(t, sub.match(v, noAppIDs))
+		v.Sequence = 0
+		assert.False(t, sub.match(v, noAppIDs))
+	})
+
+	t.Run("app ids", func(t *testing.T) {
+		sub := &subscriptionSignedVaa{}
+		withAppIDs([]string{"portal_token_bridge"})(sub)
+		v := createVAA(vaa.ChainIDEthereum, emitterAddr)
+		assert.True(t, sub.match(v, tokenBridge))
+		assert.False(t, sub.match(v, noAppIDs))
+	})
+
+	t.Run("all filters must match", func(t *testing.T) {
+		sub := &subscriptionSignedVaa{
+			filters: []filterSignedVaa{{chainId: vaa.ChainIDEthereum, emitterAddr: emitterAddr}},
+		}
+		withSequenceRange(&sequenceRange{from: &from})(sub)
+		v := createVAA(vaa.ChainIDEthereum, emitterAddr)
+		assert.True(t, sub.match(v, noAppIDs))
+		v.Sequence = 0
+		assert.False(t, sub.match(v, noAppIDs))
+		v = createVAA(vaa.ChainIDSolana, emitterAddr)
+		assert.False(t, sub.match(v, noAppIDs))
+	})
+
+	t.Run("app ids are resolved lazily", func(t *testing.T) {
+		sub := &subscriptionSignedVaa{}
+		withPayloadTypes([]uint8{1})(sub)
+		withAppIDs([]string{"PORTAL_TOKEN_BRIDGE"})(sub)
+		v := createVAA(vaa.ChainIDEthereum, emitterAddr)
+		v.Payload = []byte{2}
+		called := false
+		assert.False(t, sub.match(v, func() []string { called = true; return nil }))
+		assert.False(t, called)
+	})
+}
+
+func TestSubscriptionSignedVaa_ReplayFilter(t *testing.T) {
+	from := uint64(10)
+	sub := &subscriptionSignedVaa{
+		filters: []filterSignedVaa{{chainId: vaa.ChainIDEthereum, emitterAddr: emitterAddr}},
+	}
+	withSequenceRange(&sequenceRange{from: &from})(sub)
+	withAppIDs([]string{"PORTAL_TOKEN_BRIDGE"})(sub)
+	withPayloadTypes([]uint8{1})(sub)
+
+	filter := sub.replayFilter()
+	assert.Equal(t, []storage.Emitter{{ChainID: vaa.ChainIDEthereum, Address: emitterAddr.String()}}, filter.Emitters)
+	assert.Equal(t, &from, filter.SequenceFrom)
+	assert.Nil(t, filter.SequenceTo)
+	assert.Equal(t, []string{"PORTAL_TOKEN_BRIDGE"}, filter.AppIDs)
+
+	assert.Equal(t, storage.ReplayFilter{}, (&subscriptionSignedVaa{}).replayFilter())
+}
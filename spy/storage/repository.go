@@ -0,0 +1,160 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// ErrNotFound is returned when a VAA does not exist.
+var ErrNotFound = errors.New("vaa not found")
+
+// VaaDoc represents a stored signed VAA.
+type VaaDoc struct {
+	ID        string     `bson:"_id"`
+	Vaas      []byte     `bson:"vaas"`
+	Timestamp *time.Time `bson:"timestamp"`
+	IndexedAt *time.Time `bson:"indexedAt"`
+}
+
+// Repository reads the signed VAAs stored in the vaas collection.
+type Repository struct {
+	vaas   *mongo.Collection
+	logger *zap.Logger
+}
+
+// NewRepository creates a new VAA repository.
+func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
+	return &Repository{
+		vaas:   db.Collection("vaas"),
+		logger: logger,
+	}
+}
+
+// FindVaaTimestamp returns the timestamp of a VAA by id (chain/emitter/sequence).
+func (r *Repository) FindVaaTimestamp(ctx context.Context, id string) (*time.Time, error) {
+	var doc VaaDoc
+	opts := options.FindOne().SetProjection(bson.M{"timestamp": 1})
+	err := r.vaas.FindOne(ctx, bson.M{"_id": id}, opts).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if doc.Timestamp == nil {
+		return nil, ErrNotFound
+	}
+	return doc.Timestamp, nil
+}
+
+// Emitter identifies the emitter of VAAs, its address is the hex representation stored in the vaas collection.
+type Emitter struct {
+	ChainID sdk.ChainID
+	Address string
+}
+
+// ReplayFilter restricts the replayed VAAs. The empty fields do not filter.
+type ReplayFilter struct {
+	Emitters     []Emitter
+	SequenceFrom *uint64
+	SequenceTo   *uint64
+	// AppIDs filters the VAAs by the appIds of their parsed VAA. The VAAs not parsed yet are
+	// not filtered, so the caller must check them.
+	AppIDs []string
+}
+
+// ReplayVaas sends the VAAs with a timestamp greater or equal than from that match the filter to
+// the handler, in ascending timestamp order, until all of them are sent or the handler returns an error.
+func (r *Repository) ReplayVaas(ctx context.Context, from time.Time, filter ReplayFilter, handler func(*VaaDoc) error) error {
+	pipeline, err := replayPipeline(from, filter)
+	if err != nil {
+		return err
+	}
+	cur, err := r.vaas.Aggregate(ctx, pipeline, options.Aggregate().SetBatchSize(500))
+	if err != nil {
+		return err
+	}
+	defer cur.Close(context.Background())
+
+	for cur.Next(ctx) {
+		var doc VaaDoc
+		if err := cur.Decode(&doc); err != nil {
+			r.logger.Error("Error decoding vaa to replay", zap.Error(err))
+			continue
+		}
+		if err := handler(&doc); err != nil {
+			return err
+		}
+	}
+	return cur.Err()
+}
+
+// replayPipeline builds the aggregation that finds the VAAs to replay.
+func replayPipeline(from time.Time, filter ReplayFilter) (mongo.Pipeline, error) {
+	match := bson.D{{Key: "timestamp", Value: bson.M{"$gte": from}}}
+
+	if len(filter.Emitters) > 0 {
+		emitters := make(bson.A, 0, len(filter.Emitters))
+		for _, e := range filter.Emitters {
+			emitters = append(emitters, bson.M{"emitterChain": e.ChainID, "emitterAddr": e.Address})
+		}
+		match = append(match, bson.E{Key: "$or", Value: emitters})
+	}
+
+	// the sequences are stored as strings, so they are compared as decimals.
+	var sequence bson.A
+	if filter.SequenceFrom != nil {
+		from, err := primitive.ParseDecimal128(strconv.FormatUint(*filter.SequenceFrom, 10))
+		if err != nil {
+			return nil, err
+		}
+		sequence = append(sequence, bson.M{"$gte": bson.A{bson.M{"$toDecimal": "$sequence"}, from}})
+	}
+	if filter.SequenceTo != nil {
+		to, err := primitive.ParseDecimal128(strconv.FormatUint(*filter.SequenceTo, 10))
+		if err != nil {
+			return nil, err
+		}
+		sequence = append(sequence, bson.M{"$lte": bson.A{bson.M{"$toDecimal": "$sequence"}, to}})
+	}
+	if len(sequence) > 0 {
+		match = append(match, bson.E{Key: "$expr", Value: bson.M{"$and": sequence}})
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$sort", Value: bson.D{{Key: "timestamp", Value: 1}, {Key: "_id", Value: 1}}}},
+	}
+
+	if len(filter.AppIDs) > 0 {
+		appIDs := make([]string, 0, len(filter.AppIDs))
+		for _, appID := range filter.AppIDs {
+			appIDs = append(appIDs, strings.ToUpper(appID))
+		}
+		pipeline = append(pipeline,
+			bson.D{{Key: "$lookup", Value: bson.D{
+				{Key: "from", Value: "parsedVaa"},
+				{Key: "localField", Value: "_id"},
+				{Key: "foreignField", Value: "_id"},
+				{Key: "as", Value: "parsedVaa"},
+			}}},
+			bson.D{{Key: "$match", Value: bson.M{"$or": bson.A{
+				bson.M{"parsedVaa": bson.M{"$size": 0}},
+				bson.M{"parsedVaa.rawStandardizedProperties.appIds": bson.M{"$in": appIDs}},
+			}}}},
+		)
+	}
+
+	pipeline = append(pipeline, bson.D{{Key: "$project", Value: bson.M{"vaas": 1, "timestamp": 1, "indexedAt": 1}}})
+	return pipeline, nil
+}
@@ -4,22 +4,41 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"time"
 
+	"github.com/shopspring/decimal"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
 
+type algorandTransaction struct {
+	ID             string `json:"id"`
+	Sender         string `json:"sender"`
+	RoundTime      int    `json:"round-time"`
+	Fee            uint64 `json:"fee"`
+	Group          string `json:"group"`
+	ConfirmedRound uint64 `json:"confirmed-round"`
+}
+
 type algorandTransactionResponse struct {
-	Transaction struct {
-		ID        string `json:"id"`
-		Sender    string `json:"sender"`
-		RoundTime int    `json:"round-time"`
-	} `json:"transaction"`
+	Transaction algorandTransaction `json:"transaction"`
+}
+
+type algorandTransactionsResponse struct {
+	Transactions []algorandTransaction `json:"transactions"`
 }
 
-func FetchAlgorandTx(
+type apiAlgorand struct {
+	notionalCache *notional.NotionalCache
+	p2pNetwork    string
+}
+
+func (a *apiAlgorand) FetchAlgorandTx(
 	ctx context.Context,
 	pool *pool.Pool,
 	txHash string,
@@ -39,7 +58,9 @@ func FetchAlgorandTx(
 	for _, rpc := range rpcs {
 		// Wait for the RPC rate limiter
 		rpc.Wait(ctx)
-		txDetail, err = fetchAlgorandTx(ctx, rpc.Id, txHash)
+		start := time.Now()
+		txDetail, err = a.fetchAlgorandTx(ctx, rpc.Id, txHash)
+		notifyRpcEvent(&rpc, start, err)
 		if txDetail != nil {
 			metrics.IncCallRpcSuccess(uint16(sdk.ChainIDAlgorand), rpc.Description)
 			break
@@ -50,10 +71,20 @@ func FetchAlgorandTx(
 		}
 	}
 
+	if txDetail != nil && txDetail.FeeDetail != nil && a.p2pNetwork == domain.P2pMainNet {
+		gasPrice, errGasPrice := GetGasTokenNotional(sdk.ChainIDAlgorand, a.notionalCache)
+		if errGasPrice != nil {
+			logger.Error("Failed to get gas price", zap.Error(errGasPrice), zap.String("chainId", sdk.ChainIDAlgorand.String()), zap.String("txHash", txHash))
+		} else {
+			txDetail.FeeDetail.GasTokenNotional = gasPrice.NotionalUsd.String()
+			txDetail.FeeDetail.FeeUSD = gasPrice.NotionalUsd.Mul(decimal.RequireFromString(txDetail.FeeDetail.Fee)).String()
+		}
+	}
+
 	return txDetail, err
 }
 
-func fetchAlgorandTx(
+func (a *apiAlgorand) fetchAlgorandTx(
 	ctx context.Context,
 	baseUrl string,
 	txHash string,
@@ -63,8 +94,8 @@ func fetchAlgorandTx(
 	var response algorandTransactionResponse
 	{
 		// Perform the HTTP request
-		url := fmt.Sprintf("%s/v2/transactions/%s", baseUrl, txHash)
-		body, err := httpGet(ctx, url)
+		endpoint := fmt.Sprintf("%s/v2/transactions/%s", baseUrl, txHash)
+		body, err := httpGet(ctx, endpoint)
 		if err != nil {
 			return nil, fmt.Errorf("HTTP request to Algorand transactions endpoint failed: %w", err)
 		}
@@ -75,10 +106,64 @@ func fetchAlgorandTx(
 		}
 	}
 
+	// Wormhole messages are published by an application call, usually submitted
+	// in a group of transactions that share the fees. The fee of the operation is
+	// the fee paid by all the transactions of the group.
+	fee := response.Transaction.Fee
+	if response.Transaction.Group != "" && response.Transaction.ConfirmedRound != 0 {
+		groupFee, err := fetchAlgorandGroupFee(ctx, baseUrl, response.Transaction.Group, response.Transaction.ConfirmedRound)
+		if err != nil {
+			return nil, err
+		}
+		fee = groupFee
+	}
+
 	// Populate the result struct and return
 	txDetail := TxDetail{
 		NativeTxHash: response.Transaction.ID,
 		From:         response.Transaction.Sender,
+		FeeDetail: &FeeDetail{
+			RawFee: map[string]string{
+				"fee": fmt.Sprintf("%d", fee),
+			},
+			Fee: AlgorandCalculateFee(fee).String(),
+		},
 	}
 	return &txDetail, nil
 }
+
+// fetchAlgorandGroupFee returns the sum of the fees of the transactions of a group.
+func fetchAlgorandGroupFee(
+	ctx context.Context,
+	baseUrl string,
+	group string,
+	round uint64,
+) (uint64, error) {
+
+	// The group id is base64 encoded, it must be escaped to be used as query param.
+	query := url.Values{}
+	query.Set("group-id", group)
+	query.Set("round", fmt.Sprintf("%d", round))
+	endpoint := fmt.Sprintf("%s/v2/transactions?%s", baseUrl, query.Encode())
+	body, err := httpGet(ctx, endpoint)
+	if err != nil {
+		return 0, fmt.Errorf("HTTP request to Algorand transactions endpoint failed: %w", err)
+	}
+
+	var response algorandTransactionsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return 0, fmt.Errorf("failed to decode Algorand transactions response as JSON: %w", err)
+	}
+
+	var fee uint64
+	for _, tx := range response.Transactions {
+		fee += tx.Fee
+	}
+	return fee, nil
+}
+
+// AlgorandCalculateFee converts an amount of microAlgos into ALGO.
+func AlgorandCalculateFee(fee uint64) decimal.Decimal {
+	rawFee := decimal.NewFromUint64(fee)
+	return rawFee.DivRound(decimal.NewFromInt(1e6), 6)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
@@ -51,7 +52,9 @@ func FetchAptosTx(
 	for _, rpc := range rpcs {
 		// Wait for the RPC rate limiter
 		rpc.Wait(ctx)
+		start := time.Now()
 		events, err = fetchAptosAccountEvents(ctx, rpc.Id, aptosCoreContractAddress, creationNumber, 1)
+		notifyRpcEvent(&rpc, start, err)
 		if err != nil {
 			metrics.IncCallRpcError(uint16(sdk.ChainIDAptos), rpc.Description)
 			logger.Debug("Failed to fetch transaction from Aptos node", zap.String("url", rpc.Id), zap.Error(err))
@@ -82,7 +85,9 @@ func FetchAptosTx(
 	for _, rpc := range rpcs {
 		// Wait for the RPC rate limiter
 		rpc.Wait(ctx)
+		start := time.Now()
 		tx, err = fetchAptosTx(ctx, rpc.Id, events[0].Version)
+		notifyRpcEvent(&rpc, start, err)
 		if err != nil {
 			metrics.IncCallRpcError(uint16(sdk.ChainIDAptos), rpc.Description)
 			logger.Debug("Failed to fetch transaction from Aptos node", zap.String("url", rpc.Id), zap.Error(err))
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
@@ -56,7 +57,9 @@ func (c *apiCosmos) FetchCosmosTx(
 	for _, rpc := range rpcs {
 		// Wait for the RPC rate limiter
 		rpc.Wait(ctx)
+		start := time.Now()
 		txDetail, err = c.fetchCosmosTx(ctx, rpc.Id, txHash)
+		notifyRpcEvent(&rpc, start, err)
 		if err != nil {
 			metrics.IncCallRpcError(uint16(c.chainId), rpc.Description)
 			logger.Debug("Failed to fetch transaction from cosmos node", zap.String("url", rpc.Id), zap.Error(err))
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 	"math/big"
 	"strings"
+	"time"
 )
 
 const (
@@ -59,7 +60,9 @@ func (e *apiEvm) FetchEvmTx(
 	for _, rpc := range rpcs {
 		// Wait for the RPC rate limiter
 		rpc.Wait(ctx)
+		start := time.Now()
 		txDetail, err = e.fetchEvmTx(ctx, rpc.Id, txHash, methodEthTxReceipt)
+		notifyRpcEvent(&rpc, start, err)
 		if err != nil {
 			metrics.IncCallRpcError(uint16(e.chainId), rpc.Description)
 			logger.Debug("Failed to fetch transaction from evm node", zap.String("url", rpc.Id), zap.Error(err))
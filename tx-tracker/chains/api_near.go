@@ -0,0 +1,272 @@
+package chains
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/shopspring/decimal"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const (
+	// nearMainnetIndexerUrl is the url of the nearblocks api on Near mainnet.
+	nearMainnetIndexerUrl = "https://api.nearblocks.io"
+	// nearTestnetIndexerUrl is the url of the nearblocks api on Near testnet.
+	nearTestnetIndexerUrl = "https://api-testnet.nearblocks.io"
+	// nearIndexerRequestsPerMinute is the rate limit of the nearblocks api without api key.
+	nearIndexerRequestsPerMinute = 6
+)
+
+// NearIndexerConfig is the configuration of a nearblocks api.
+type NearIndexerConfig struct {
+	Url               string
+	ApiKey            string
+	Priority          uint8
+	RequestsPerMinute uint16
+}
+
+// NearIndexer is a pool of nearblocks apis, used to resolve the signer of the Near transactions.
+type NearIndexer struct {
+	pool    *pool.Pool
+	apiKeys map[string]string
+}
+
+// NewNearIndexer creates a new NearIndexer. Without configuration, the public nearblocks api of the
+// network is used.
+func NewNearIndexer(p2pNetwork string, cfgs []NearIndexerConfig, opts ...pool.Option) *NearIndexer {
+	if len(cfgs) == 0 {
+		url := nearTestnetIndexerUrl
+		if p2pNetwork == domain.P2pMainNet {
+			url = nearMainnetIndexerUrl
+		}
+		cfgs = []NearIndexerConfig{{Url: url}}
+	}
+	apiKeys := make(map[string]string)
+	poolConfigs := make([]pool.Config, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		requestsPerMinute := cfg.RequestsPerMinute
+		if requestsPerMinute == 0 {
+			requestsPerMinute = nearIndexerRequestsPerMinute
+		}
+		poolConfigs = append(poolConfigs, pool.Config{
+			Id:                cfg.Url,
+			Description:       strings.TrimPrefix(strings.TrimPrefix(cfg.Url, "https://"), "http://"),
+			Priority:          cfg.Priority,
+			RequestsPerMinute: requestsPerMinute,
+		})
+		if cfg.ApiKey != "" {
+			apiKeys[cfg.Url] = cfg.ApiKey
+		}
+	}
+	opts = append([]pool.Option{pool.WithName("near-indexer")}, opts...)
+	return &NearIndexer{pool: pool.NewPool(poolConfigs, opts...), apiKeys: apiKeys}
+}
+
+type nearOutcome struct {
+	Outcome struct {
+		TokensBurnt string `json:"tokens_burnt"`
+	} `json:"outcome"`
+}
+
+type nearTxStatusResponse struct {
+	Transaction struct {
+		Hash     string `json:"hash"`
+		SignerID string `json:"signer_id"`
+	} `json:"transaction"`
+	TransactionOutcome nearOutcome   `json:"transaction_outcome"`
+	ReceiptsOutcome    []nearOutcome `json:"receipts_outcome"`
+}
+
+type nearIndexerTxsResponse struct {
+	Txns []struct {
+		SignerAccountID string `json:"signer_account_id"`
+	} `json:"txns"`
+}
+
+type apiNear struct {
+	notionalCache *notional.NotionalCache
+	p2pNetwork    string
+	indexer       *NearIndexer
+}
+
+func (a *apiNear) FetchNearTx(
+	ctx context.Context,
+	pool *pool.Pool,
+	txHash string,
+	metrics metrics.Metrics,
+	logger *zap.Logger,
+) (*TxDetail, error) {
+
+	// get rpc sorted by score and priority.
+	rpcs := pool.GetItems()
+	if len(rpcs) == 0 {
+		return nil, ErrChainNotSupported
+	}
+
+	// The tx method requires the signer of the transaction, which is not part of the VAA,
+	// so it is resolved by the indexer.
+	signer, err := a.fetchNearSigner(ctx, txHash, metrics, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	var txDetail *TxDetail
+	for _, rpc := range rpcs {
+		// Wait for the RPC rate limiter
+		rpc.Wait(ctx)
+		start := time.Now()
+		txDetail, err = a.fetchNearTx(ctx, rpc.Id, txHash, signer)
+		notifyRpcEvent(&rpc, start, err)
+		if err != nil {
+			metrics.IncCallRpcError(uint16(sdk.ChainIDNear), rpc.Description)
+			logger.Debug("Failed to fetch transaction from Near node", zap.String("url", rpc.Id), zap.Error(err))
+			continue
+		}
+		metrics.IncCallRpcSuccess(uint16(sdk.ChainIDNear), rpc.Description)
+		break
+	}
+
+	if txDetail != nil && txDetail.FeeDetail != nil && a.p2pNetwork == domain.P2pMainNet {
+		gasPrice, errGasPrice := GetGasTokenNotional(sdk.ChainIDNear, a.notionalCache)
+		if errGasPrice != nil {
+			logger.Error("Failed to get gas price", zap.Error(errGasPrice), zap.String("chainId", sdk.ChainIDNear.String()), zap.String("txHash", txHash))
+		} else {
+			txDetail.FeeDetail.GasTokenNotional = gasPrice.NotionalUsd.String()
+			txDetail.FeeDetail.FeeUSD = gasPrice.NotionalUsd.Mul(decimal.RequireFromString(txDetail.FeeDetail.Fee)).String()
+		}
+	}
+
+	return txDetail, err
+}
+
+// fetchNearSigner returns the account that signed a transaction, trying the indexers of the pool in order.
+func (a *apiNear) fetchNearSigner(ctx context.Context, txHash string, metrics metrics.Metrics, logger *zap.Logger) (string, error) {
+	if a.indexer == nil {
+		return "", errors.New("near indexer not configured")
+	}
+	indexers := a.indexer.pool.GetItems()
+	if len(indexers) == 0 {
+		return "", errors.New("near indexer not configured")
+	}
+
+	var signer string
+	var err error
+	for _, indexer := range indexers {
+		// Wait for the indexer rate limiter
+		indexer.Wait(ctx)
+		start := time.Now()
+		signer, err = a.fetchNearSignerFromIndexer(ctx, indexer.Id, txHash)
+		notifyRpcEvent(&indexer, start, err)
+		if err != nil {
+			metrics.IncCallRpcError(uint16(sdk.ChainIDNear), indexer.Description)
+			logger.Debug("Failed to fetch transaction signer from Near indexer", zap.String("txHash", txHash), zap.String("url", indexer.Id), zap.Error(err))
+			continue
+		}
+		metrics.IncCallRpcSuccess(uint16(sdk.ChainIDNear), indexer.Description)
+		break
+	}
+	return signer, err
+}
+
+// fetchNearSignerFromIndexer returns the account that signed a transaction from a nearblocks api.
+func (a *apiNear) fetchNearSignerFromIndexer(ctx context.Context, baseUrl string, txHash string) (string, error) {
+	var headers map[string]string
+	if apiKey, ok := a.indexer.apiKeys[baseUrl]; ok {
+		headers = map[string]string{"Authorization": "Bearer " + apiKey}
+	}
+	body, err := httpGetWithHeaders(ctx, fmt.Sprintf("%s/v1/txns/%s", strings.TrimSuffix(baseUrl, "/"), txHash), headers)
+	if err != nil {
+		return "", fmt.Errorf("failed to get transaction from indexer: %w", err)
+	}
+	var response nearIndexerTxsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", fmt.Errorf("failed to unmarshal indexer response: %w", err)
+	}
+	if len(response.Txns) == 0 || response.Txns[0].SignerAccountID == "" {
+		return "", ErrTransactionNotFound
+	}
+	return response.Txns[0].SignerAccountID, nil
+}
+
+func (a *apiNear) fetchNearTx(
+	ctx context.Context,
+	baseUrl string,
+	txHash string,
+	signer string,
+) (*TxDetail, error) {
+
+	// Initialize RPC client
+	client, err := rpc.DialContext(ctx, baseUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize RPC client: %w", err)
+	}
+	defer client.Close()
+
+	// Query the transaction status, the nodes use the signer to route the query to its shard.
+	var reply nearTxStatusResponse
+	err = client.CallContext(ctx, &reply, "tx", txHash, signer)
+	if err != nil {
+		var dataErr rpc.DataError
+		if errors.As(err, &dataErr) && strings.Contains(fmt.Sprint(dataErr.ErrorData()), "doesn't exist") {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("failed to get tx status: %w", err)
+	}
+	if reply.Transaction.SignerID == "" {
+		return nil, ErrTransactionNotFound
+	}
+
+	// Populate the response struct and return
+	txDetail := TxDetail{
+		NativeTxHash: reply.Transaction.Hash,
+		From:         reply.Transaction.SignerID,
+	}
+
+	// The fee of a transaction is the amount of tokens burnt by the
+	// transaction itself and by all the receipts it generated.
+	tokensBurnt, err := nearTokensBurnt(&reply)
+	if err != nil {
+		return nil, err
+	}
+	txDetail.FeeDetail = &FeeDetail{
+		RawFee: map[string]string{
+			"tokensBurnt": tokensBurnt.String(),
+		},
+		Fee: NearCalculateFee(tokensBurnt).String(),
+	}
+	return &txDetail, nil
+}
+
+// nearTokensBurnt returns the amount of yoctoNEAR burnt by the transaction and its receipts.
+func nearTokensBurnt(reply *nearTxStatusResponse) (*big.Int, error) {
+	total := new(big.Int)
+	outcomes := append([]nearOutcome{reply.TransactionOutcome}, reply.ReceiptsOutcome...)
+	for _, o := range outcomes {
+		if o.Outcome.TokensBurnt == "" {
+			continue
+		}
+		burnt, ok := new(big.Int).SetString(o.Outcome.TokensBurnt, 10)
+		if !ok {
+			return nil, fmt.Errorf("failed to convert tokens burnt to big.Int: %s", o.Outcome.TokensBurnt)
+		}
+		total.Add(total, burnt)
+	}
+	return total, nil
+}
+
+// NearCalculateFee converts an amount of yoctoNEAR into NEAR.
+func NearCalculateFee(tokensBurnt *big.Int) decimal.Decimal {
+	rawFee := decimal.NewFromBigInt(tokensBurnt, 0)
+	return rawFee.DivRound(decimal.New(1, 24), 24)
+}
@@ -0,0 +1,118 @@
+package chains
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
+	"go.uber.org/zap"
+)
+
+const jsonNearTxStatusResponse = `
+{
+	"jsonrpc": "2.0",
+	"id": 1,
+	"result": {
+		"transaction": {
+			"hash": "6GAjgY6bY1Ptj6Aw2H8ZnPHz4SwN7u6KWJjW6ZJM5vkG",
+			"signer_id": "sender.near",
+			"receiver_id": "contract.portalbridge.near"
+		},
+		"transaction_outcome": {
+			"outcome": {"tokens_burnt": "242953433349100000000"}
+		},
+		"receipts_outcome": [
+			{"outcome": {"tokens_burnt": "1000000000000000000000"}},
+			{"outcome": {"tokens_burnt": "0"}}
+		]
+	}
+}`
+
+const jsonNearUnknownTxResponse = `
+{
+	"jsonrpc": "2.0",
+	"id": 1,
+	"error": {
+		"code": -32000,
+		"message": "Server error",
+		"data": "Transaction 6GAjgY6bY1Ptj6Aw2H8ZnPHz4SwN7u6KWJjW6ZJM5vkG doesn't exist"
+	}
+}`
+
+func newNearServer(t *testing.T, response string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.True(t, strings.Contains(string(body), `"method":"tx"`))
+		assert.True(t, strings.Contains(string(body), `"sender.near"`))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+}
+
+func TestFetchNearTx(t *testing.T) {
+	server := newNearServer(t, jsonNearTxStatusResponse)
+	defer server.Close()
+
+	api := &apiNear{p2pNetwork: domain.P2pMainNet}
+	txDetail, err := api.fetchNearTx(context.Background(), server.URL, "6GAjgY6bY1Ptj6Aw2H8ZnPHz4SwN7u6KWJjW6ZJM5vkG", "sender.near")
+	assert.NoError(t, err)
+	assert.Equal(t, "sender.near", txDetail.From)
+	assert.Equal(t, "6GAjgY6bY1Ptj6Aw2H8ZnPHz4SwN7u6KWJjW6ZJM5vkG", txDetail.NativeTxHash)
+	assert.Equal(t, "1242953433349100000000", txDetail.FeeDetail.RawFee["tokensBurnt"])
+	assert.Equal(t, "0.0012429534333491", txDetail.FeeDetail.Fee)
+}
+
+func TestFetchNearTx_NotFound(t *testing.T) {
+	server := newNearServer(t, jsonNearUnknownTxResponse)
+	defer server.Close()
+
+	api := &apiNear{p2pNetwork: domain.P2pMainNet}
+	_, err := api.fetchNearTx(context.Background(), server.URL, "6GAjgY6bY1Ptj6Aw2H8ZnPHz4SwN7u6KWJjW6ZJM5vkG", "sender.near")
+	assert.ErrorIs(t, err, ErrTransactionNotFound)
+}
+
+func TestFetchNearSigner(t *testing.T) {
+	// the first indexer is rate limited, the signer is resolved by the second one with its api key.
+	limited := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer limited.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/v1/txns/6GAjgY6bY1Ptj6Aw2H8ZnPHz4SwN7u6KWJjW6ZJM5vkG" {
+			_, _ = w.Write([]byte(`{"txns": []}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"txns": [{"transaction_hash": "6GAjgY6bY1Ptj6Aw2H8ZnPHz4SwN7u6KWJjW6ZJM5vkG", "signer_account_id": "sender.near"}]}`))
+	}))
+	defer server.Close()
+
+	indexer := NewNearIndexer(domain.P2pMainNet, []NearIndexerConfig{
+		{Url: limited.URL, Priority: 1, RequestsPerMinute: 600},
+		{Url: server.URL, ApiKey: "key", Priority: 2, RequestsPerMinute: 600},
+	})
+	api := &apiNear{p2pNetwork: domain.P2pMainNet, indexer: indexer}
+	signer, err := api.fetchNearSigner(context.Background(), "6GAjgY6bY1Ptj6Aw2H8ZnPHz4SwN7u6KWJjW6ZJM5vkG", metrics.NewDummyMetrics(), zap.NewNop())
+	assert.NoError(t, err)
+	assert.Equal(t, "sender.near", signer)
+
+	_, err = api.fetchNearSigner(context.Background(), "unknown", metrics.NewDummyMetrics(), zap.NewNop())
+	assert.ErrorIs(t, err, ErrTransactionNotFound)
+}
+
+func TestNewNearIndexer_Default(t *testing.T) {
+	indexer := NewNearIndexer(domain.P2pMainNet, nil)
+	items := indexer.pool.GetItems()
+	if assert.Len(t, items, 1) {
+		assert.Equal(t, nearMainnetIndexerUrl, items[0].Id)
+	}
+	assert.Empty(t, indexer.apiKeys)
+}
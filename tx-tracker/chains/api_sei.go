@@ -3,6 +3,7 @@ package chains
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
@@ -63,7 +64,9 @@ func (a *apiSei) FetchSeiTx(
 	for _, rpc := range wormchainRpcs {
 		// wait for the rpc to be available
 		rpc.Wait(ctx)
+		start := time.Now()
 		wormchainTx, err = fetchWormchainDetail(ctx, rpc.Id, txHash)
+		notifyRpcEvent(&rpc, start, err)
 		if err != nil {
 			metrics.IncCallRpcError(uint16(vaa.ChainIDWormchain), rpc.Description)
 			logger.Debug("Failed to fetch transaction from wormchain", zap.String("url", rpc.Id), zap.Error(err))
@@ -92,7 +95,9 @@ func (a *apiSei) FetchSeiTx(
 	for _, rpc := range seiRpcs {
 		// wait for the rpc to be available
 		rpc.Wait(ctx)
+		start := time.Now()
 		seiTx, err = fetchSeiDetail(ctx, rpc.Id, wormchainTx.sequence, wormchainTx.timestamp, wormchainTx.srcChannel, wormchainTx.dstChannel)
+		notifyRpcEvent(&rpc, start, err)
 		if err != nil {
 			metrics.IncCallRpcError(uint16(vaa.ChainIDSei), rpc.Description)
 			logger.Debug("Failed to fetch transaction from sei", zap.String("url", rpc.Id), zap.Error(err))
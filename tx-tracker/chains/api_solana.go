@@ -86,7 +86,9 @@ func (a *apiSolana) FetchSolanaTx(
 	for _, rpc := range rpcs {
 		// Wait for the RPC rate limiter
 		rpc.Wait(ctx)
+		start := time.Now()
 		txDetail, err = a.fetchSolanaTx(ctx, rpc.Id, txHash)
+		notifyRpcEvent(&rpc, start, err)
 		if txDetail != nil {
 			metrics.IncCallRpcSuccess(uint16(sdk.ChainIDSolana), rpc.Description)
 			break
@@ -0,0 +1,101 @@
+package chains
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// ChainIDStellar is the wormhole chain id of Stellar, not defined in the version of the sdk used by the tx-tracker.
+const ChainIDStellar sdk.ChainID = 61
+
+type stellarTransaction struct {
+	Hash          string `json:"hash"`
+	Successful    bool   `json:"successful"`
+	SourceAccount string `json:"source_account"`
+	FeeCharged    string `json:"fee_charged"`
+}
+
+// FetchStellarTx fetches a transaction from the Stellar Horizon API.
+func FetchStellarTx(
+	ctx context.Context,
+	pool *pool.Pool,
+	txHash string,
+	metrics metrics.Metrics,
+	logger *zap.Logger,
+) (*TxDetail, error) {
+
+	// get rpc sorted by score and priority.
+	rpcs := pool.GetItems()
+	if len(rpcs) == 0 {
+		return nil, ErrChainNotSupported
+	}
+
+	var txDetail *TxDetail
+	var err error
+	for _, rpc := range rpcs {
+		// Wait for the RPC rate limiter
+		rpc.Wait(ctx)
+		start := time.Now()
+		txDetail, err = fetchStellarTx(ctx, rpc.Id, txHash)
+		notifyRpcEvent(&rpc, start, err)
+		if errors.Is(err, ErrTransactionNotFound) {
+			break
+		}
+		if err != nil {
+			metrics.IncCallRpcError(uint16(ChainIDStellar), rpc.Description)
+			logger.Debug("Failed to fetch transaction from Stellar horizon", zap.String("url", rpc.Id), zap.Error(err))
+			continue
+		}
+		metrics.IncCallRpcSuccess(uint16(ChainIDStellar), rpc.Description)
+		break
+	}
+	return txDetail, err
+}
+
+func fetchStellarTx(ctx context.Context, baseUrl string, txHash string) (*TxDetail, error) {
+	body, err := httpGet(ctx, fmt.Sprintf("%s/transactions/%s", strings.TrimSuffix(baseUrl, "/"), txHash))
+	if err != nil {
+		if strings.Contains(err.Error(), "status code: 404") {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	var tx stellarTransaction
+	if err := json.Unmarshal(body, &tx); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal transaction: %w", err)
+	}
+
+	// the fee charged is in stroops.
+	feeCharged, err := strconv.ParseUint(tx.FeeCharged, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse fee charged %s: %w", tx.FeeCharged, err)
+	}
+
+	return &TxDetail{
+		From:         tx.SourceAccount,
+		NativeTxHash: tx.Hash,
+		FeeDetail: &FeeDetail{
+			RawFee: map[string]string{
+				"feeCharged": tx.FeeCharged,
+			},
+			Fee: StellarCalculateFee(feeCharged).String(),
+		},
+	}, nil
+}
+
+// StellarCalculateFee converts an amount of stroops into XLM.
+func StellarCalculateFee(stroops uint64) decimal.Decimal {
+	return decimal.NewFromInt(int64(stroops)).DivRound(decimal.New(1, 7), 7)
+}
@@ -0,0 +1,44 @@
+package chains
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const jsonStellarTransactionResponse = `
+{
+	"id": "5c2bd0c4a4f51b6a03d8b6a3e0d4cfc9e8b0a4e25ad02b7b0f1b4d6b0e2b1a3c",
+	"hash": "5c2bd0c4a4f51b6a03d8b6a3e0d4cfc9e8b0a4e25ad02b7b0f1b4d6b0e2b1a3c",
+	"successful": true,
+	"ledger": 52614270,
+	"source_account": "GCEXAMPLE5HWNK4AYSTEQ4UWDKHTCKADVS2AHF3UI2ZMO3DPUSM6Q4UG",
+	"fee_account": "GCEXAMPLE5HWNK4AYSTEQ4UWDKHTCKADVS2AHF3UI2ZMO3DPUSM6Q4UG",
+	"fee_charged": "1234567",
+	"max_fee": "2000000"
+}`
+
+func TestFetchStellarTx(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/transactions/5c2bd0c4a4f51b6a03d8b6a3e0d4cfc9e8b0a4e25ad02b7b0f1b4d6b0e2b1a3c" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(jsonStellarTransactionResponse))
+	}))
+	defer server.Close()
+
+	txDetail, err := fetchStellarTx(context.Background(), server.URL, "5c2bd0c4a4f51b6a03d8b6a3e0d4cfc9e8b0a4e25ad02b7b0f1b4d6b0e2b1a3c")
+	assert.NoError(t, err)
+	assert.Equal(t, "GCEXAMPLE5HWNK4AYSTEQ4UWDKHTCKADVS2AHF3UI2ZMO3DPUSM6Q4UG", txDetail.From)
+	assert.Equal(t, "5c2bd0c4a4f51b6a03d8b6a3e0d4cfc9e8b0a4e25ad02b7b0f1b4d6b0e2b1a3c", txDetail.NativeTxHash)
+	assert.Equal(t, "1234567", txDetail.FeeDetail.RawFee["feeCharged"])
+	assert.Equal(t, "0.1234567", txDetail.FeeDetail.Fee)
+
+	_, err = fetchStellarTx(context.Background(), server.URL, "unknown")
+	assert.ErrorIs(t, err, ErrTransactionNotFound)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
@@ -49,7 +50,9 @@ func FetchSuiTx(
 	for _, rpc := range rpcs {
 		// Wait for the RPC rate limiter
 		rpc.Wait(ctx)
+		start := time.Now()
 		txDetail, err = fetchSuiTx(ctx, rpc.Id, txHash)
+		notifyRpcEvent(&rpc, start, err)
 		if err != nil {
 			logger.Debug("Failed to fetch transaction from SUI node", zap.String("url", rpc.Id), zap.Error(err))
 			continue
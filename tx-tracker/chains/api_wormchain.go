@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
@@ -190,7 +191,9 @@ func (a *apiWormchain) fetchOsmosisDetail(ctx context.Context, pool *pool.Pool,
 
 	for _, rpc := range osmosisRpcs {
 		rpc.Wait(ctx)
+		start := time.Now()
 		osmosisTx, err := fetchOsmosisDetail(ctx, rpc.Id, sequence, timestamp, srcChannel, dstChannel)
+		notifyRpcEvent(&rpc, start, err)
 		if osmosisTx != nil {
 			metrics.IncCallRpcSuccess(uint16(sdk.ChainIDOsmosis), rpc.Description)
 			return osmosisTx, nil
@@ -292,7 +295,9 @@ func (a *apiWormchain) fetchEvmosDetail(ctx context.Context, pool *pool.Pool, se
 
 	for _, rpc := range evmosRpcs {
 		rpc.Wait(ctx)
+		start := time.Now()
 		evmosTx, err := fetchEvmosDetail(ctx, rpc.Id, sequence, timestamp, srcChannel, dstChannel)
+		notifyRpcEvent(&rpc, start, err)
 		if evmosTx != nil {
 			metrics.IncCallRpcSuccess(uint16(sdk.ChainIDEvmos), rpc.Description)
 			return evmosTx, nil
@@ -394,7 +399,9 @@ func (a *apiWormchain) fetchKujiraDetail(ctx context.Context, pool *pool.Pool, s
 	}
 	for _, rpc := range kujiraRpcs {
 		rpc.Wait(ctx)
+		start := time.Now()
 		kujiraTx, err := fetchKujiraDetail(ctx, rpc.Id, sequence, timestamp, srcChannel, dstChannel)
+		notifyRpcEvent(&rpc, start, err)
 		if kujiraTx != nil {
 			metrics.IncCallRpcSuccess(uint16(sdk.ChainIDKujira), rpc.Description)
 			return kujiraTx, nil
@@ -494,7 +501,9 @@ func (a *apiWormchain) fetchInjectiveDetail(ctx context.Context, pool *pool.Pool
 	}
 	for _, rpc := range injectiveRpcs {
 		rpc.Wait(ctx)
+		start := time.Now()
 		injectiveTx, err := fetchInjectiveDetail(ctx, rpc.Id, sequence, timestamp, srcChannel, dstChannel)
+		notifyRpcEvent(&rpc, start, err)
 		if injectiveTx != nil {
 			success := fmt.Sprintf("Successfully fetched transaction from injective: %s", rpc.Id)
 			fmt.Sprintln(success)
@@ -570,7 +579,9 @@ func (a *apiWormchain) FetchWormchainTx(
 	for _, rpc := range wormchainRpcs {
 		// wait for the rpc to be available
 		rpc.Wait(ctx)
+		start := time.Now()
 		wormchainTx, err = fetchWormchainDetail(ctx, rpc.Id, txHash)
+		notifyRpcEvent(&rpc, start, err)
 		if err != nil {
 			metrics.IncCallRpcError(uint16(sdk.ChainIDWormchain), rpc.Description)
 			logger.Debug("Failed to fetch transaction from wormchain", zap.String("url", rpc.Id), zap.Error(err))
@@ -46,6 +46,7 @@ func FetchTx(
 	ctx context.Context,
 	rpcPool map[sdk.ChainID]*pool.Pool,
 	wormchainRpcPool map[sdk.ChainID]*pool.Pool,
+	nearIndexer *NearIndexer,
 	chainId sdk.ChainID,
 	txHash string,
 	timestamp *time.Time,
@@ -65,7 +66,18 @@ func FetchTx(
 		}
 		fetchFunc = apiSolana.FetchSolanaTx
 	case sdk.ChainIDAlgorand:
-		fetchFunc = FetchAlgorandTx
+		apiAlgorand := &apiAlgorand{
+			notionalCache: notionalCache,
+			p2pNetwork:    p2pNetwork,
+		}
+		fetchFunc = apiAlgorand.FetchAlgorandTx
+	case sdk.ChainIDNear:
+		apiNear := &apiNear{
+			notionalCache: notionalCache,
+			p2pNetwork:    p2pNetwork,
+			indexer:       nearIndexer,
+		}
+		fetchFunc = apiNear.FetchNearTx
 	case sdk.ChainIDAptos:
 		fetchFunc = FetchAptosTx
 	case sdk.ChainIDSui:
@@ -101,7 +113,13 @@ func FetchTx(
 		sdk.ChainIDXLayer,
 		sdk.ChainIDMantle,
 		sdk.ChainIDPolygonSepolia, // polygon amoy
-		sdk.ChainIDSnaxchain:
+		sdk.ChainIDSnaxchain,
+		sdk.ChainIDGnosis,
+		sdk.ChainIDRootstock,
+		sdk.ChainIDLinea,
+		sdk.ChainIDBerachain,
+		sdk.ChainIDSeiEVM,
+		sdk.ChainIDHolesky:
 		apiEvm := &apiEvm{
 			chainId:       chainId,
 			notionalCache: notionalCache,
@@ -117,6 +135,8 @@ func FetchTx(
 			injectivePool: wormchainRpcPool[sdk.ChainIDInjective],
 		}
 		fetchFunc = apiWormchain.FetchWormchainTx
+	case ChainIDStellar:
+		fetchFunc = FetchStellarTx
 	case sdk.ChainIDSei:
 		apiSei := &apiSei{
 			p2pNetwork:    p2pNetwork,
@@ -139,3 +159,12 @@ func FetchTx(
 
 	return txDetail, nil
 }
+
+// notifyRpcEvent reports the result of a request to the rpc pool.
+// A transaction or a redemption that is not found is a valid response of a healthy rpc.
+func notifyRpcEvent(rpc *pool.Item, start time.Time, err error) {
+	if errors.Is(err, ErrTransactionNotFound) || errors.Is(err, ErrNotRedeemed) || errors.Is(err, ErrRedemptionTxNotFound) {
+		err = nil
+	}
+	rpc.NotifyEvent(start, err)
+}
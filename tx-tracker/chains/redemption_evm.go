@@ -0,0 +1,525 @@
+package chains
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const (
+	methodEthCall             = "eth_call"
+	methodEthGetLogs          = "eth_getLogs"
+	methodEthGetBlockByNumber = "eth_getBlockByNumber"
+
+	// maxCachedEvmBlocks is the maximum number of blocks kept by chain in an EvmBlockCache.
+	maxCachedEvmBlocks = 1024
+)
+
+var (
+	// ErrNotRedeemed is returned when the contract has not consumed the VAA yet.
+	ErrNotRedeemed = errors.New("vaa not redeemed")
+	// ErrRedemptionTxNotFound is returned when the contract consumed the VAA but the redemption log was not found.
+	ErrRedemptionTxNotFound = errors.New("redemption tx not found")
+)
+
+// RedemptionContract is a contract of an EVM chain that redeems the VAAs of a protocol.
+type RedemptionContract struct {
+	// Protocol is the appId of the protocol, see domain.AppIdPortalTokenBridge, domain.AppIdCCTPWormhole and domain.AppIdNTT.
+	Protocol string
+	Address  string
+}
+
+// Redemption is the transaction that redeemed a VAA in the target chain.
+type Redemption struct {
+	TxHash            string
+	BlockNumber       string
+	BlockTimestamp    *time.Time
+	From              string
+	To                string
+	GasUsed           string
+	EffectiveGasPrice string
+}
+
+// redemptionProtocol defines how to check that a VAA was consumed by the contract of a protocol,
+// and the event emitted when it is redeemed.
+type redemptionProtocol struct {
+	// consumedSelector is the selector of the view method that receives the VAA hash.
+	consumedSelector []byte
+	// topic is the topic of the event emitted when the VAA is redeemed.
+	topic string
+	// indexed is true if the emitter chain, emitter address and sequence are indexed topics of the event,
+	// otherwise they are the first words of the event data after a bytes32 digest.
+	indexed bool
+}
+
+var redemptionProtocols = map[string]redemptionProtocol{
+	domain.AppIdPortalTokenBridge: newRedemptionProtocol("isTransferCompleted(bytes32)", "TransferRedeemed(uint16,bytes32,uint64)", true),
+	domain.AppIdCCTPWormhole:      newRedemptionProtocol("isMessageConsumed(bytes32)", "Redeemed(uint16,bytes32,uint64)", true),
+	domain.AppIdNTT:               newRedemptionProtocol("isVAAConsumed(bytes32)", "ReceivedMessage(bytes32,uint16,bytes32,uint64)", false),
+}
+
+func newRedemptionProtocol(consumedMethod, event string, indexed bool) redemptionProtocol {
+	return redemptionProtocol{
+		consumedSelector: crypto.Keccak256([]byte(consumedMethod))[:4],
+		topic:            "0x" + hex.EncodeToString(crypto.Keccak256([]byte(event))),
+		indexed:          indexed,
+	}
+}
+
+// IsRedemptionProtocolSupported returns true if the redemption of the protocol can be looked up.
+func IsRedemptionProtocolSupported(protocol string) bool {
+	_, ok := redemptionProtocols[protocol]
+	return ok
+}
+
+type ethLog struct {
+	Address         string   `json:"address"`
+	Topics          []string `json:"topics"`
+	Data            string   `json:"data"`
+	BlockNumber     string   `json:"blockNumber"`
+	TransactionHash string   `json:"transactionHash"`
+	Removed         bool     `json:"removed"`
+}
+
+type ethBlock struct {
+	Number    string `json:"number"`
+	Timestamp string `json:"timestamp"`
+}
+
+type ethRedemptionReceipt struct {
+	BlockHash         string `json:"blockHash"`
+	From              string `json:"from"`
+	To                string `json:"to"`
+	EffectiveGasPrice string `json:"effectiveGasPrice"`
+	GasUsed           string `json:"gasUsed"`
+}
+
+// EvmRedemptionQuery defines the VAA to look up and the range of blocks scanned.
+type EvmRedemptionQuery struct {
+	ChainID  sdk.ChainID
+	Contract RedemptionContract
+	Vaa      *sdk.VAA
+	// SignedAt is the time the VAA was signed, the redemption can not happen before.
+	SignedAt time.Time
+	// BlockRange is the number of blocks requested in each eth_getLogs call.
+	BlockRange uint64
+	// MaxBlockRanges is the maximum number of eth_getLogs calls made to find the redemption.
+	MaxBlockRanges int
+	// Blocks keeps the blocks found by the previous queries, to narrow the search of the block of SignedAt.
+	// It is optional.
+	Blocks *EvmBlockCache
+}
+
+// evmBlockTime is the number and the timestamp of a block.
+type evmBlockTime struct {
+	number    uint64
+	timestamp uint64
+}
+
+// EvmBlockCache keeps the timestamps of the blocks of the EVM chains requested while looking up redemptions,
+// so the search of the block of a time starts from the closest known blocks. It is safe for concurrent use.
+type EvmBlockCache struct {
+	mu     sync.Mutex
+	blocks map[sdk.ChainID][]evmBlockTime
+}
+
+// NewEvmBlockCache creates a new EvmBlockCache.
+func NewEvmBlockCache() *EvmBlockCache {
+	return &EvmBlockCache{blocks: make(map[sdk.ChainID][]evmBlockTime)}
+}
+
+// bracket returns the closest known blocks before and at or after a timestamp, or nil if there are none.
+func (c *EvmBlockCache) bracket(chain sdk.ChainID, timestamp uint64) (*evmBlockTime, *evmBlockTime) {
+	if c == nil {
+		return nil, nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	blocks := c.blocks[chain]
+	i := sort.Search(len(blocks), func(i int) bool { return blocks[i].timestamp >= timestamp })
+	var before, after *evmBlockTime
+	if i > 0 {
+		b := blocks[i-1]
+		before = &b
+	}
+	if i < len(blocks) {
+		b := blocks[i]
+		after = &b
+	}
+	return before, after
+}
+
+// add adds a block, dropping the oldest block when the cache of the chain is full.
+func (c *EvmBlockCache) add(chain sdk.ChainID, block evmBlockTime) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	blocks := c.blocks[chain]
+	i := sort.Search(len(blocks), func(i int) bool { return blocks[i].number >= block.number })
+	if i < len(blocks) && blocks[i].number == block.number {
+		return
+	}
+	blocks = append(blocks, evmBlockTime{})
+	copy(blocks[i+1:], blocks[i:])
+	blocks[i] = block
+	if len(blocks) > maxCachedEvmBlocks {
+		blocks = blocks[1:]
+	}
+	c.blocks[chain] = blocks
+}
+
+// FindEvmRedemption looks up the transaction that redeemed a VAA in an EVM chain.
+//
+// It returns ErrNotRedeemed if the contract has not consumed the VAA, and ErrRedemptionTxNotFound
+// if the VAA was consumed but its redemption log is not in the range of blocks scanned.
+func FindEvmRedemption(
+	ctx context.Context,
+	pool *pool.Pool,
+	query *EvmRedemptionQuery,
+	metrics metrics.Metrics,
+	logger *zap.Logger,
+) (*Redemption, error) {
+	protocol, ok := redemptionProtocols[query.Contract.Protocol]
+	if !ok {
+		return nil, fmt.Errorf("redemption protocol not supported: %s", query.Contract.Protocol)
+	}
+
+	// get rpc sorted by score and priority.
+	rpcs := pool.GetItems()
+	if len(rpcs) == 0 {
+		return nil, ErrChainNotSupported
+	}
+
+	var redemption *Redemption
+	var err error
+	for _, rpc := range rpcs {
+		start := time.Now()
+		redemption, err = findEvmRedemption(ctx, &rpc, protocol, query)
+		notifyRpcEvent(&rpc, start, err)
+		if err != nil && !errors.Is(err, ErrNotRedeemed) && !errors.Is(err, ErrRedemptionTxNotFound) {
+			metrics.IncCallRpcError(uint16(query.ChainID), rpc.Description)
+			logger.Debug("Failed to find redemption in evm node", zap.String("url", rpc.Id), zap.Error(err))
+			continue
+		}
+		metrics.IncCallRpcSuccess(uint16(query.ChainID), rpc.Description)
+		break
+	}
+	return redemption, err
+}
+
+// evmRedemptionClient waits for the rate limiter of the rpc before each request.
+type evmRedemptionClient struct {
+	rpc    *pool.Item
+	client *rateLimitedRpcClient
+}
+
+func (c *evmRedemptionClient) call(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if err := c.rpc.Wait(ctx); err != nil {
+		return err
+	}
+	return c.client.CallContext(ctx, result, method, args...)
+}
+
+func findEvmRedemption(
+	ctx context.Context,
+	rpc *pool.Item,
+	protocol redemptionProtocol,
+	query *EvmRedemptionQuery,
+) (*Redemption, error) {
+
+	client, err := rpcDialContext(ctx, rpc.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize RPC client: %w", err)
+	}
+	defer client.Close()
+	c := &evmRedemptionClient{rpc: rpc, client: client}
+
+	// check the contract consumed the VAA before scanning the logs.
+	var consumed string
+	callMsg := map[string]string{
+		"to":   query.Contract.Address,
+		"data": encodeConsumedCall(protocol, query.Vaa.SigningDigest().Bytes()),
+	}
+	if err := c.call(ctx, &consumed, methodEthCall, callMsg, "latest"); err != nil {
+		return nil, fmt.Errorf("failed to call contract: %w", err)
+	}
+	if !parseEthBool(consumed) {
+		return nil, ErrNotRedeemed
+	}
+
+	latestBlock, err := c.block(ctx, "latest")
+	if err != nil {
+		return nil, err
+	}
+	latest := latestBlock.number
+
+	getBlock := func(ctx context.Context, number uint64) (evmBlockTime, error) {
+		return c.block(ctx, "0x"+strconv.FormatUint(number, 16))
+	}
+	fromBlock, err := findEvmBlockStart(ctx, getBlock, query.Blocks, query.ChainID, latestBlock, query.SignedAt, query.BlockRange)
+	if err != nil {
+		return nil, err
+	}
+
+	// scan the logs from the block of the signature until the redemption is found.
+	var found *ethLog
+	for i := 0; i < query.MaxBlockRanges && fromBlock <= latest && found == nil; i++ {
+		toBlock := fromBlock + query.BlockRange - 1
+		if toBlock > latest {
+			toBlock = latest
+		}
+		filter := map[string]interface{}{
+			"address":   query.Contract.Address,
+			"fromBlock": "0x" + strconv.FormatUint(fromBlock, 16),
+			"toBlock":   "0x" + strconv.FormatUint(toBlock, 16),
+			"topics":    redemptionTopics(protocol, query.Vaa),
+		}
+		var logs []ethLog
+		if err := c.call(ctx, &logs, methodEthGetLogs, filter); err != nil {
+			return nil, fmt.Errorf("failed to get logs: %w", err)
+		}
+		for j := range logs {
+			if matchRedemptionLog(protocol, &logs[j], query.Vaa) {
+				found = &logs[j]
+				break
+			}
+		}
+		fromBlock = toBlock + 1
+	}
+	if found == nil {
+		return nil, ErrRedemptionTxNotFound
+	}
+
+	var receipt ethRedemptionReceipt
+	if err := c.call(ctx, &receipt, methodEthTxReceipt, found.TransactionHash); err != nil {
+		return nil, fmt.Errorf("failed to get tx receipt: %w", err)
+	}
+	if receipt.BlockHash == "" {
+		return nil, ErrTransactionNotFound
+	}
+
+	var block ethBlock
+	if err := c.call(ctx, &block, methodEthGetBlockByNumber, found.BlockNumber, false); err != nil {
+		return nil, fmt.Errorf("failed to get block: %w", err)
+	}
+	blockTime, err := parseEthUint(block.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	blockTimestamp := time.Unix(int64(blockTime), 0).UTC()
+
+	blockNumber, err := parseEthUint(found.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Redemption{
+		TxHash:            strings.ToLower(found.TransactionHash),
+		BlockNumber:       strconv.FormatUint(blockNumber, 10),
+		BlockTimestamp:    &blockTimestamp,
+		From:              strings.ToLower(receipt.From),
+		To:                strings.ToLower(receipt.To),
+		GasUsed:           receipt.GasUsed,
+		EffectiveGasPrice: receipt.EffectiveGasPrice,
+	}, nil
+}
+
+// block returns the number and the timestamp of a block, by number or by tag.
+func (c *evmRedemptionClient) block(ctx context.Context, number string) (evmBlockTime, error) {
+	var block ethBlock
+	if err := c.call(ctx, &block, methodEthGetBlockByNumber, number, false); err != nil {
+		return evmBlockTime{}, fmt.Errorf("failed to get block %s: %w", number, err)
+	}
+	n, err := parseEthUint(block.Number)
+	if err != nil {
+		return evmBlockTime{}, err
+	}
+	timestamp, err := parseEthUint(block.Timestamp)
+	if err != nil {
+		return evmBlockTime{}, err
+	}
+	return evmBlockTime{number: n, timestamp: timestamp}, nil
+}
+
+// findEvmBlockStart returns the block to start scanning the logs from time t: a block at most precision
+// blocks before the first block with a timestamp greater or equal than t.
+//
+// The search starts from the closest blocks of the cache, or from the latest block. Without a known block
+// before t, it steps back by the number of blocks estimated from the block time of the known blocks. Then
+// it narrows the range interpolating the timestamps of its ends, with a bisection every other step so it
+// also converges when the block time is irregular. The blocks requested are added to the cache.
+func findEvmBlockStart(
+	ctx context.Context,
+	getBlock func(ctx context.Context, number uint64) (evmBlockTime, error),
+	cache *EvmBlockCache,
+	chain sdk.ChainID,
+	latest evmBlockTime,
+	t time.Time,
+	precision uint64,
+) (uint64, error) {
+	cache.add(chain, latest)
+	target := uint64(t.Unix())
+	if latest.timestamp < target {
+		return latest.number, nil
+	}
+	if precision == 0 {
+		precision = 1
+	}
+
+	before, after := cache.bracket(chain, target)
+	if after == nil || after.number > latest.number {
+		after = &latest
+	}
+	if before != nil && before.number >= after.number {
+		before = nil
+	}
+
+	// step back from the oldest block after t until a block before t is found.
+	next, step := latest, precision
+	for before == nil {
+		if next.number > after.number && next.timestamp > after.timestamp {
+			blockTime := float64(next.timestamp-after.timestamp) / float64(next.number-after.number)
+			step = uint64(float64(after.timestamp-target)/blockTime*1.25) + precision
+		} else {
+			step *= 2
+		}
+		if step >= after.number {
+			before = &evmBlockTime{}
+			break
+		}
+		block, err := getBlock(ctx, after.number-step)
+		if err != nil {
+			return 0, err
+		}
+		cache.add(chain, block)
+		if block.timestamp < target {
+			before = &block
+		} else {
+			next, after = *after, &block
+		}
+	}
+
+	// narrow the range between the blocks before and after t.
+	for i := 0; after.number-before.number > precision; i++ {
+		mid := before.number + (after.number-before.number)/2
+		if i%2 == 0 {
+			mid = before.number + (target-before.timestamp)*(after.number-before.number)/(after.timestamp-before.timestamp)
+		}
+		mid = max(before.number+1, min(mid, after.number-1))
+		block, err := getBlock(ctx, mid)
+		if err != nil {
+			return 0, err
+		}
+		cache.add(chain, block)
+		if block.timestamp < target {
+			before = &block
+		} else {
+			after = &block
+		}
+	}
+	return before.number + 1, nil
+}
+
+// encodeConsumedCall encodes the call to the view method that checks if the VAA hash was consumed.
+func encodeConsumedCall(protocol redemptionProtocol, vaaHash []byte) string {
+	return "0x" + hex.EncodeToString(protocol.consumedSelector) + hex.EncodeToString(vaaHash)
+}
+
+// redemptionTopics returns the topics of the eth_getLogs filter of the redemption of a VAA.
+func redemptionTopics(protocol redemptionProtocol, v *sdk.VAA) []interface{} {
+	if !protocol.indexed {
+		return []interface{}{protocol.topic}
+	}
+	return []interface{}{
+		protocol.topic,
+		"0x" + hex.EncodeToString(uint64Word(uint64(v.EmitterChain))),
+		"0x" + hex.EncodeToString(v.EmitterAddress.Bytes()),
+		"0x" + hex.EncodeToString(uint64Word(v.Sequence)),
+	}
+}
+
+// matchRedemptionLog returns true if the log is the redemption of the VAA.
+func matchRedemptionLog(protocol redemptionProtocol, log *ethLog, v *sdk.VAA) bool {
+	if log.Removed || len(log.Topics) == 0 || !strings.EqualFold(log.Topics[0], protocol.topic) {
+		return false
+	}
+
+	var chain, address, sequence []byte
+	if protocol.indexed {
+		if len(log.Topics) < 4 {
+			return false
+		}
+		var err error
+		if chain, err = decodeHexWord(log.Topics[1]); err != nil {
+			return false
+		}
+		if address, err = decodeHexWord(log.Topics[2]); err != nil {
+			return false
+		}
+		if sequence, err = decodeHexWord(log.Topics[3]); err != nil {
+			return false
+		}
+	} else {
+		// the data starts with the digest of the message, followed by the emitter chain, address and sequence.
+		data, err := hex.DecodeString(strings.TrimPrefix(log.Data, "0x"))
+		if err != nil || len(data) < 128 {
+			return false
+		}
+		chain, address, sequence = data[32:64], data[64:96], data[96:128]
+	}
+
+	return bytes.Equal(chain, uint64Word(uint64(v.EmitterChain))) &&
+		bytes.Equal(address, v.EmitterAddress.Bytes()) &&
+		bytes.Equal(sequence, uint64Word(v.Sequence))
+}
+
+// uint64Word encodes a number as a 32 bytes abi word.
+func uint64Word(n uint64) []byte {
+	word := make([]byte, 32)
+	binary.BigEndian.PutUint64(word[24:], n)
+	return word
+}
+
+func decodeHexWord(s string) ([]byte, error) {
+	word, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	if len(word) != 32 {
+		return nil, fmt.Errorf("invalid word length: %d", len(word))
+	}
+	return word, nil
+}
+
+// parseEthBool parses an abi encoded bool returned by eth_call.
+func parseEthBool(s string) bool {
+	n, ok := new(big.Int).SetString(strings.TrimPrefix(s, "0x"), 16)
+	return ok && n.Sign() != 0
+}
+
+// parseEthUint parses a hex quantity returned by the rpc.
+func parseEthUint(s string) (uint64, error) {
+	n, err := strconv.ParseUint(strings.TrimPrefix(s, "0x"), 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid hex quantity %q: %w", s, err)
+	}
+	return n, nil
+}
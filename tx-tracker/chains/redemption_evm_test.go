@@ -0,0 +1,125 @@
+package chains
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func newRedemptionVaa(t *testing.T) *sdk.VAA {
+	emitter, err := sdk.StringToAddress("0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585")
+	assert.NoError(t, err)
+	return &sdk.VAA{EmitterChain: sdk.ChainIDEthereum, EmitterAddress: emitter, Sequence: 107429}
+}
+
+func TestRedemptionProtocols(t *testing.T) {
+	tokenBridge := redemptionProtocols[domain.AppIdPortalTokenBridge]
+	assert.Equal(t, "0xcaf280c8cfeba144da67230d9b009c8f868a75bac9a528fa0474be1ba317c169", tokenBridge.topic)
+	assert.Equal(t, "aa4efa5b", hex.EncodeToString(tokenBridge.consumedSelector))
+
+	hash := make([]byte, 32)
+	hash[31] = 1
+	assert.Equal(t, "0xaa4efa5b0000000000000000000000000000000000000000000000000000000000000001", encodeConsumedCall(tokenBridge, hash))
+
+	assert.True(t, IsRedemptionProtocolSupported(domain.AppIdCCTPWormhole))
+	assert.True(t, IsRedemptionProtocolSupported(domain.AppIdNTT))
+	assert.False(t, IsRedemptionProtocolSupported(domain.AppIdGenericRelayer))
+}
+
+func TestMatchRedemptionLog(t *testing.T) {
+	v := newRedemptionVaa(t)
+	chain := "0x0000000000000000000000000000000000000000000000000000000000000002"
+	emitter := "0x0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585"
+	sequence := "0x000000000000000000000000000000000000000000000000000000000001a3a5"
+
+	tokenBridge := redemptionProtocols[domain.AppIdPortalTokenBridge]
+	assert.Equal(t, []interface{}{tokenBridge.topic, chain, emitter, sequence}, redemptionTopics(tokenBridge, v))
+	assert.True(t, matchRedemptionLog(tokenBridge, &ethLog{Topics: []string{tokenBridge.topic, chain, emitter, sequence}}, v))
+	assert.False(t, matchRedemptionLog(tokenBridge, &ethLog{Topics: []string{tokenBridge.topic, chain, emitter, chain}}, v))
+	assert.False(t, matchRedemptionLog(tokenBridge, &ethLog{Topics: []string{tokenBridge.topic, chain, emitter, sequence}, Removed: true}, v))
+
+	// the ntt transceiver event is not indexed, the data starts with the digest of the message.
+	ntt := redemptionProtocols[domain.AppIdNTT]
+	digest := "ab00000000000000000000000000000000000000000000000000000000000000"
+	data := "0x" + digest + chain[2:] + emitter[2:] + sequence[2:]
+	assert.Equal(t, []interface{}{ntt.topic}, redemptionTopics(ntt, v))
+	assert.True(t, matchRedemptionLog(ntt, &ethLog{Topics: []string{ntt.topic}, Data: data}, v))
+	assert.False(t, matchRedemptionLog(ntt, &ethLog{Topics: []string{ntt.topic}, Data: data[:130]}, v))
+	assert.False(t, matchRedemptionLog(ntt, &ethLog{Topics: []string{tokenBridge.topic}, Data: data}, v))
+}
+
+func TestParseEthValues(t *testing.T) {
+	assert.True(t, parseEthBool("0x0000000000000000000000000000000000000000000000000000000000000001"))
+	assert.False(t, parseEthBool("0x0000000000000000000000000000000000000000000000000000000000000000"))
+	assert.False(t, parseEthBool("0x"))
+
+	n, err := parseEthUint("0x1a3a5")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(107429), n)
+	_, err = parseEthUint("latest")
+	assert.Error(t, err)
+}
+
+func TestFindEvmBlockStart(t *testing.T) {
+	// a chain with a block every 12 seconds, and every 2 seconds after block 15_000_000.
+	blockTime := func(n uint64) uint64 {
+		if n <= 15_000_000 {
+			return 1_438_269_973 + n*12
+		}
+		return 1_438_269_973 + 15_000_000*12 + (n-15_000_000)*2
+	}
+	latest := evmBlockTime{number: 20_000_000, timestamp: blockTime(20_000_000)}
+	var calls int
+	getBlock := func(_ context.Context, n uint64) (evmBlockTime, error) {
+		calls++
+		return evmBlockTime{number: n, timestamp: blockTime(n)}, nil
+	}
+	// first block at or after t.
+	firstBlock := func(t time.Time) uint64 {
+		n := uint64(0)
+		for blockTime(n) < uint64(t.Unix()) {
+			n++
+		}
+		return n
+	}
+	const precision = 1000
+
+	cache := NewEvmBlockCache()
+	signedAt := time.Unix(int64(blockTime(19_950_000)), 0)
+	start, err := findEvmBlockStart(context.Background(), getBlock, cache, sdk.ChainIDEthereum, latest, signedAt, precision)
+	assert.NoError(t, err)
+	first := uint64(19_950_000)
+	assert.LessOrEqual(t, start, first)
+	assert.Greater(t, start+precision, first)
+	assert.LessOrEqual(t, calls, 8)
+
+	// a VAA signed a few minutes later starts from the blocks found by the previous search.
+	calls = 0
+	signedAt = signedAt.Add(10 * time.Minute)
+	start, err = findEvmBlockStart(context.Background(), getBlock, cache, sdk.ChainIDEthereum, latest, signedAt, precision)
+	assert.NoError(t, err)
+	first = firstBlock(signedAt)
+	assert.LessOrEqual(t, start, first)
+	assert.Greater(t, start+precision, first)
+	assert.LessOrEqual(t, calls, 3)
+
+	// a VAA signed before the change of block time.
+	calls = 0
+	signedAt = time.Unix(int64(blockTime(14_000_000)), 0)
+	start, err = findEvmBlockStart(context.Background(), getBlock, cache, sdk.ChainIDEthereum, latest, signedAt, precision)
+	assert.NoError(t, err)
+	first = 14_000_000
+	assert.LessOrEqual(t, start, first)
+	assert.Greater(t, start+precision, first)
+	assert.LessOrEqual(t, calls, 15)
+
+	// a VAA signed after the latest block starts from the latest block.
+	start, err = findEvmBlockStart(context.Background(), getBlock, nil, sdk.ChainIDEthereum, latest, time.Unix(int64(latest.timestamp)+1, 0), precision)
+	assert.NoError(t, err)
+	assert.Equal(t, latest.number, start)
+}
@@ -18,12 +18,20 @@ import (
 
 // httpGet is a helper function that performs an HTTP request.
 func httpGet(ctx context.Context, url string) ([]byte, error) {
+	return httpGetWithHeaders(ctx, url, nil)
+}
+
+// httpGetWithHeaders is a helper function that performs an HTTP request with the given headers.
+func httpGetWithHeaders(ctx context.Context, url string, headers map[string]string) ([]byte, error) {
 
 	// Build the HTTP request
 	request, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
 
 	// Send it
 	var client http.Client
@@ -141,7 +149,13 @@ func FormatTxHashByChain(chainId sdk.ChainID, txHash string) string {
 		sdk.ChainIDXLayer,
 		sdk.ChainIDMantle,
 		sdk.ChainIDPolygonSepolia,
-		sdk.ChainIDSnaxchain:
+		sdk.ChainIDSnaxchain,
+		sdk.ChainIDGnosis,
+		sdk.ChainIDRootstock,
+		sdk.ChainIDLinea,
+		sdk.ChainIDBerachain,
+		sdk.ChainIDSeiEVM,
+		sdk.ChainIDHolesky:
 		return txHashLowerCaseWith0x(txHash)
 	case sdk.ChainIDSei, sdk.ChainIDWormchain:
 		return txHashLowerCaseWith0x(txHash)
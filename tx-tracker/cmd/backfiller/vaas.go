@@ -3,23 +3,25 @@ package backfiller
 import (
 	"context"
 	"errors"
-	"github.com/go-redis/redis/v8"
-	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
 	"log"
-	"sync"
-	"sync/atomic"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
+	"github.com/go-redis/redis/v8"
+	"github.com/wormhole-foundation/wormhole-explorer/common/backfill"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
 	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/config"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/consumer"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
-	"go.uber.org/ratelimit"
 	"go.uber.org/zap"
 )
 
@@ -38,26 +40,30 @@ type VaasBackfiller struct {
 	PageSize          int64
 	NumWorkers        int
 	RpcProvidersPath  string
+	JobName           string
+	Resume            bool
+	DryRun            bool
+	FailuresFile      string
 }
 
-type vaasBackfillerParams struct {
-	logger                      *zap.Logger
-	rpcPool                     map[sdk.ChainID]*pool.Pool
-	wormchainRpcPool            map[sdk.ChainID]*pool.Pool
-	repository                  *consumer.Repository
-	queue                       chan *repository.VaaDoc
-	wg                          *sync.WaitGroup
-	processedDocumentsSuccess   *atomic.Uint64
-	processedDocumentsWithError *atomic.Uint64
-	p2pNetwork                  string
-	overwrite                   bool
-	disableDBUpsert             bool
-	limiter                     ratelimit.Limiter
+// vaaStrategy processes the source transaction of the vaas.
+type vaaStrategy struct {
+	logger           *zap.Logger
+	rpcPool          map[sdk.ChainID]*pool.Pool
+	wormchainRpcPool map[sdk.ChainID]*pool.Pool
+	nearIndexer      *chains.NearIndexer
+	repository       *consumer.Repository
+	notionalCache    *notional.NotionalCache
+	metrics          metrics.Metrics
+	p2pNetwork       string
+	overwrite        bool
+	disableDBUpsert  bool
 }
 
 func RunByVaas(backfillerConfig *VaasBackfiller) {
 
-	ctx := context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
 	// Load config
 	cfg, err := config.NewRpcProviderSettingJson(backfillerConfig.RpcProvidersPath)
@@ -66,7 +72,7 @@ func RunByVaas(backfillerConfig *VaasBackfiller) {
 	}
 
 	// create rpc pool
-	rpcPool, wormchainRpcPool, err := newRpcPool(cfg)
+	rpcPool, wormchainRpcPool, err := NewRpcPool(cfg)
 	if err != nil {
 		log.Fatal("Failed to initialize rpc pool: ", zap.Error(err))
 	}
@@ -75,23 +81,9 @@ func RunByVaas(backfillerConfig *VaasBackfiller) {
 
 	logger.Info("Starting wormhole-explorer-tx-tracker as vaas backfiller ...")
 
-	startTime, err := time.Parse(time.RFC3339, backfillerConfig.StartTime)
+	startTime, endTime, err := backfill.ParseTimeRange(backfillerConfig.StartTime, backfillerConfig.EndTime)
 	if err != nil {
-		logger.Fatal("failed to parse start time", zap.Error(err))
-	}
-
-	endTime := time.Now()
-	if backfillerConfig.EndTime != "" {
-		endTime, err = time.Parse(time.RFC3339, backfillerConfig.EndTime)
-		if err != nil {
-			logger.Fatal("Failed to parse end time", zap.Error(err))
-		}
-	}
-
-	if startTime.After(endTime) {
-		logger.Fatal("Start time should be before end time",
-			zap.String("start_time", startTime.Format(time.RFC3339)),
-			zap.String("end_time", endTime.Format(time.RFC3339)))
+		logger.Fatal("Invalid time range", zap.Error(err))
 	}
 
 	//setup DB connection
@@ -117,154 +109,84 @@ func RunByVaas(backfillerConfig *VaasBackfiller) {
 
 	query := repository.VaaQuery{
 		StartTime:      &startTime,
-		EndTime:        &endTime,
+		EndTime:        endTime,
 		EmitterChainID: backfillerConfig.EmitterChainID,
 		EmitterAddress: backfillerConfig.EmitterAddress,
 	}
 
-	limiter := ratelimit.New(int(backfillerConfig.RequestsPerMinute), ratelimit.Per(time.Minute))
-
-	pagination := repository.Pagination{
-		Page:     0,
-		PageSize: backfillerConfig.PageSize,
-		SortAsc:  true,
-	}
-
-	queue := make(chan *repository.VaaDoc, 5*backfillerConfig.PageSize)
-
-	var quantityProduced, quantityConsumedWithError, quantityConsumedSuccess atomic.Uint64
-
-	go getVaas(ctx, logger, pagination, query, vaaRepository, queue, &quantityProduced)
-
-	var wg sync.WaitGroup
-	wg.Add(backfillerConfig.NumWorkers)
-
-	for i := 0; i < backfillerConfig.NumWorkers; i++ {
-		p := vaasBackfillerParams{
-			wg:                          &wg,
-			logger:                      logger.With(zap.Int("worker", i)),
-			rpcPool:                     rpcPool,
-			queue:                       queue,
-			wormchainRpcPool:            wormchainRpcPool,
-			repository:                  globalTrxRepository,
-			p2pNetwork:                  backfillerConfig.P2pNetwork,
-			limiter:                     limiter,
-			overwrite:                   backfillerConfig.Overwrite,
-			disableDBUpsert:             backfillerConfig.DisableDBUpsert,
-			processedDocumentsSuccess:   &quantityConsumedSuccess,
-			processedDocumentsWithError: &quantityConsumedWithError,
-		}
-		go processVaa(ctx, &p, notionalCache)
+	strategy := &vaaStrategy{
+		logger:           logger,
+		rpcPool:          rpcPool,
+		wormchainRpcPool: wormchainRpcPool,
+		nearIndexer:      chains.NewNearIndexer(backfillerConfig.P2pNetwork, cfg.NearIndexerConfigs()),
+		repository:       globalTrxRepository,
+		notionalCache:    notionalCache,
+		metrics:          metrics.NewDummyMetrics(),
+		p2pNetwork:       backfillerConfig.P2pNetwork,
+		overwrite:        backfillerConfig.Overwrite,
+		disableDBUpsert:  backfillerConfig.DisableDBUpsert,
+	}
+
+	jobConfig := backfill.Config{
+		Name:              backfillerConfig.JobName,
+		PageSize:          backfillerConfig.PageSize,
+		Workers:           backfillerConfig.NumWorkers,
+		RequestsPerMinute: backfillerConfig.RequestsPerMinute,
+		Resume:            backfillerConfig.Resume,
+		DryRun:            backfillerConfig.DryRun,
+		FailuresFile:      backfillerConfig.FailuresFile,
+	}
+	job := backfill.NewJob[*repository.VaaDoc](jobConfig, backfill.NewVaaSource(vaaRepository, query), strategy,
+		backfill.NewMongoCheckpointStore(db.Database), logger)
+
+	checkpoint, err := job.Run(ctx)
+	if err != nil {
+		logger.Error("Backfill interrupted, run it again with --resume to continue", zap.Error(err))
 	}
 
-	logger.Info("Waiting for all workers to finish...")
-	wg.Wait()
-
 	logger.Info("closing MongoDB connection...")
 	db.DisconnectWithTimeout(10 * time.Second)
 
-	logger.Info("Finish wormhole-explorer-tx-tracker as vaas backfiller",
-		zap.Uint64("produced", quantityProduced.Load()),
-		zap.Uint64("consumer_success", quantityConsumedSuccess.Load()),
-		zap.Uint64("consumed_error", quantityConsumedWithError.Load()))
-}
-
-func getVaas(ctx context.Context, logger *zap.Logger, pagination repository.Pagination, query repository.VaaQuery,
-	vaaRepository *repository.VaaRepository, queue chan *repository.VaaDoc, quantityProduced *atomic.Uint64) {
-	defer close(queue)
-	for {
-		logger.Info("Processing page", zap.Any("pagination", pagination), zap.Any("query", query))
-
-		vaas, err := vaaRepository.FindPage(ctx, query, pagination)
-		if err != nil {
-			logger.Error("Failed to get vaas", zap.Error(err))
-			break
-		}
-
-		if len(vaas) == 0 {
-			logger.Info("Empty page", zap.Int64("page", pagination.Page))
-			break
-		}
-
-		for _, vaa := range vaas {
-			queue <- vaa
-			quantityProduced.Add(1)
-		}
-
-		pagination.Page++
+	if checkpoint != nil {
+		logger.Info("Finish wormhole-explorer-tx-tracker as vaas backfiller",
+			zap.Uint64("processed", checkpoint.Processed),
+			zap.Uint64("failed", checkpoint.Failed))
 	}
-	for {
-		select {
-		case <-time.After(10 * time.Second):
-			if len(queue) == 0 {
-				logger.Info("Closing, queue is empty")
-				return
-			}
-		case <-ctx.Done():
-			logger.Info("Closing due to cancelled context")
-			return
-		}
+	if err != nil {
+		os.Exit(1)
 	}
 }
 
-func processVaa(ctx context.Context, params *vaasBackfillerParams, cache *notional.NotionalCache) {
-	// Main loop: fetch global txs and process them
-	metrics := metrics.NewDummyMetrics()
-	defer params.wg.Done()
-	for {
-		select {
-
-		// Try to pop a globalTransaction from the queue
-		case v, ok := <-params.queue:
-			// If the channel was closed, exit immediately
-			if !ok {
-				params.logger.Info("Closing, channel was closed")
-				return
-			}
-
-			params.limiter.Take()
-
-			p := consumer.ProcessSourceTxParams{
-				TrackID:         "backfiller",
-				Timestamp:       v.Timestamp,
-				VaaId:           v.ID,
-				ChainId:         sdk.ChainID(v.ChainID),
-				Emitter:         v.EmitterAddress,
-				Sequence:        v.Sequence,
-				TxHash:          v.TxHash,
-				Overwrite:       params.overwrite,
-				Vaa:             v.Vaa,
-				IsVaaSigned:     true,
-				Metrics:         metrics,
-				DisableDBUpsert: params.disableDBUpsert,
-			}
-			_, err := consumer.ProcessSourceTx(ctx, params.logger, params.rpcPool, params.wormchainRpcPool, params.repository, &p, params.p2pNetwork, cache)
-			if err != nil {
-				if errors.Is(err, consumer.ErrAlreadyProcessed) {
-					params.logger.Info("Source tx was already processed", zap.String("vaaId", v.ID))
-					params.processedDocumentsSuccess.Add(1)
-					continue
-				}
-				params.logger.Error("Failed to process source tx",
-					zap.String("vaaId", v.ID),
-					zap.Error(err),
-				)
-				params.processedDocumentsWithError.Add(1)
-				continue
-			} else {
-				params.processedDocumentsSuccess.Add(1)
-				params.logger.Info("Processed source tx", zap.String("vaaId", v.ID))
-			}
-
-			// If the context was cancelled, exit immediately
-		case <-ctx.Done():
-			params.logger.Info("Closing due to cancelled context")
-			return
+// Process processes the source transaction of a vaa.
+func (s *vaaStrategy) Process(ctx context.Context, v *repository.VaaDoc) error {
+	p := consumer.ProcessSourceTxParams{
+		TrackID:         "backfiller",
+		Timestamp:       v.Timestamp,
+		VaaId:           v.ID,
+		ChainId:         sdk.ChainID(v.ChainID),
+		Emitter:         v.EmitterAddress,
+		Sequence:        v.Sequence,
+		TxHash:          v.TxHash,
+		Overwrite:       s.overwrite,
+		Vaa:             v.Vaa,
+		IsVaaSigned:     true,
+		Metrics:         s.metrics,
+		DisableDBUpsert: s.disableDBUpsert,
+	}
+	_, err := consumer.ProcessSourceTx(ctx, s.logger, s.rpcPool, s.wormchainRpcPool, s.nearIndexer, s.repository, &p, s.p2pNetwork, s.notionalCache)
+	if err != nil {
+		if errors.Is(err, consumer.ErrAlreadyProcessed) {
+			s.logger.Info("Source tx was already processed", zap.String("vaaId", v.ID))
+			return nil
 		}
+		return err
 	}
+	s.logger.Info("Processed source tx", zap.String("vaaId", v.ID))
+	return nil
 }
 
-func newRpcPool(cfg *config.RpcProviderSettingsJson) (map[sdk.ChainID]*pool.Pool, map[sdk.ChainID]*pool.Pool, error) {
+// NewRpcPool creates the rpc pools of the chains and of the wormchain chains from the rpc provider settings.
+func NewRpcPool(cfg *config.RpcProviderSettingsJson) (map[sdk.ChainID]*pool.Pool, map[sdk.ChainID]*pool.Pool, error) {
 
 	if cfg == nil {
 		return nil, nil, errors.New("rpc provider settings is nil")
@@ -297,13 +219,13 @@ func newRpcPool(cfg *config.RpcProviderSettingsJson) (map[sdk.ChainID]*pool.Pool
 	// create rpc pool
 	rpcPool := make(map[sdk.ChainID]*pool.Pool)
 	for chainID, rpcConfig := range rpcConfigMap {
-		rpcPool[chainID] = pool.NewPool(convertFn(rpcConfig))
+		rpcPool[chainID] = pool.NewPool(convertFn(rpcConfig), pool.WithName(chainID.String()))
 	}
 
 	// create wormchain rpc pool
 	wormchainRpcPool := make(map[sdk.ChainID]*pool.Pool)
 	for chainID, rpcConfig := range wormchainRpcConfigMap {
-		wormchainRpcPool[chainID] = pool.NewPool(convertFn(rpcConfig))
+		wormchainRpcPool[chainID] = pool.NewPool(convertFn(rpcConfig), pool.WithName("wormchain-"+chainID.String()))
 	}
 
 	return rpcPool, wormchainRpcPool, nil
@@ -1,8 +1,11 @@
 package main
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/cmd/backfiller"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/cmd/prober"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/cmd/service"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
@@ -23,6 +26,7 @@ func execute() error {
 
 	addServiceCommand(root)
 	addBackfiller(root)
+	addProber(root)
 
 	return root.Execute()
 }
@@ -49,10 +53,11 @@ func addBackfiller(parent *cobra.Command) {
 
 func addBackfillerByVaas(parent *cobra.Command) {
 	var mongoUri, mongoDb, logLevel, startTime, endTime, p2pNetwork, emitterAddress, rpcProvidersPath string
+	var jobName, failuresFile string
 	var numWorkers int
 	var emitterChainID uint16
 	var pageSize, requestsPerMinute int64
-	var overwrite, disableDBUpsert bool
+	var overwrite, disableDBUpsert, resume, dryRun bool
 
 	vaas := &cobra.Command{
 		Use:   "vaas",
@@ -71,6 +76,10 @@ func addBackfillerByVaas(parent *cobra.Command) {
 				Overwrite:         overwrite,
 				DisableDBUpsert:   disableDBUpsert,
 				RpcProvidersPath:  rpcProvidersPath,
+				JobName:           jobName,
+				Resume:            resume,
+				DryRun:            dryRun,
+				FailuresFile:      failuresFile,
 			}
 			if emitterChainID != 0 {
 				eci := sdk.ChainID(emitterChainID)
@@ -88,7 +97,7 @@ func addBackfillerByVaas(parent *cobra.Command) {
 	vaas.Flags().StringVar(&mongoUri, "mongo-uri", "", "Mongo connection")
 	vaas.Flags().StringVar(&mongoDb, "mongo-database", "", "Mongo database")
 	vaas.Flags().StringVar(&startTime, "start-time", "1970-01-01T00:00:00Z", "minimum VAA timestamp to process")
-	vaas.Flags().StringVar(&endTime, "end-time", "", "maximum VAA timestamp to process (default now)")
+	vaas.Flags().StringVar(&endTime, "end-time", "", "maximum VAA timestamp to process (default now, kept when the job is resumed)")
 	vaas.Flags().Int64Var(&pageSize, "page-size", 100, "number of documents retrieved at a time")
 	vaas.Flags().Int64Var(&requestsPerMinute, "requests-per-minute", 12, "maximum number of requests per minute to process VAA documents")
 	vaas.Flags().IntVar(&numWorkers, "num-workers", 1, "number of workers to process VAA documents concurrently")
@@ -97,6 +106,10 @@ func addBackfillerByVaas(parent *cobra.Command) {
 	vaas.Flags().BoolVar(&overwrite, "overwrite", false, "overwrite existing data")
 	vaas.Flags().BoolVar(&disableDBUpsert, "disable-db-upsert", false, "disable db upsert")
 	vaas.Flags().StringVar(&rpcProvidersPath, "rpc-providers-path", "", "path to rpc providers file")
+	vaas.Flags().StringVar(&jobName, "job-name", "tx-tracker-vaas", "name of the job used to store its checkpoint")
+	vaas.Flags().BoolVar(&resume, "resume", false, "resume the job from its last checkpoint")
+	vaas.Flags().BoolVar(&dryRun, "dry-run", false, "read the VAA documents without processing them")
+	vaas.Flags().StringVar(&failuresFile, "failures-file", "", "csv file where the VAA documents that failed are appended")
 
 	vaas.MarkFlagRequired("mongo-uri")
 	vaas.MarkFlagRequired("p2p-network")
@@ -106,3 +119,61 @@ func addBackfillerByVaas(parent *cobra.Command) {
 
 	parent.AddCommand(vaas)
 }
+
+func addProber(parent *cobra.Command) {
+	prober := &cobra.Command{
+		Use: "prober",
+	}
+
+	addRedemptionProber(prober)
+	parent.AddCommand(prober)
+}
+
+func addRedemptionProber(parent *cobra.Command) {
+	var mongoUri, mongoDb, logLevel, p2pNetwork, rpcProvidersPath, contractsPath string
+	var delay, maxAge time.Duration
+	var pageSize int64
+	var blockRange uint64
+	var maxBlockRanges int
+
+	redemptions := &cobra.Command{
+		Use:   "redemptions",
+		Short: "Find the redemptions of the signed VAAs without destination tx in the target chains",
+		Run: func(_ *cobra.Command, _ []string) {
+			cfg := &prober.RedemptionProber{
+				LogLevel:         logLevel,
+				P2pNetwork:       p2pNetwork,
+				MongoURI:         mongoUri,
+				MongoDatabase:    mongoDb,
+				RpcProvidersPath: rpcProvidersPath,
+				ContractsPath:    contractsPath,
+				Delay:            delay,
+				MaxAge:           maxAge,
+				PageSize:         pageSize,
+				BlockRange:       blockRange,
+				MaxBlockRanges:   maxBlockRanges,
+			}
+			prober.RunRedemptions(cfg)
+		},
+	}
+
+	redemptions.Flags().StringVar(&logLevel, "log-level", "INFO", "log level")
+	redemptions.Flags().StringVar(&p2pNetwork, "p2p-network", "", "P2P network to use")
+	redemptions.Flags().StringVar(&mongoUri, "mongo-uri", "", "Mongo connection")
+	redemptions.Flags().StringVar(&mongoDb, "mongo-database", "", "Mongo database")
+	redemptions.Flags().StringVar(&rpcProvidersPath, "rpc-providers-path", "", "path to rpc providers file")
+	redemptions.Flags().StringVar(&contractsPath, "contracts-path", "", "path to the redemption contracts file")
+	redemptions.Flags().DurationVar(&delay, "delay", time.Hour, "time after the signature of a VAA before probing its redemption")
+	redemptions.Flags().DurationVar(&maxAge, "max-age", 7*24*time.Hour, "maximum time after the signature of a VAA to probe its redemption")
+	redemptions.Flags().Int64Var(&pageSize, "page-size", 100, "number of VAAs retrieved at a time")
+	redemptions.Flags().Uint64Var(&blockRange, "block-range", 2000, "number of blocks requested in each eth_getLogs call")
+	redemptions.Flags().IntVar(&maxBlockRanges, "max-block-ranges", 50, "maximum number of eth_getLogs calls to find a redemption")
+
+	redemptions.MarkFlagRequired("mongo-uri")
+	redemptions.MarkFlagRequired("p2p-network")
+	redemptions.MarkFlagRequired("mongo-database")
+	redemptions.MarkFlagRequired("rpc-providers-path")
+	redemptions.MarkFlagRequired("contracts-path")
+
+	parent.AddCommand(redemptions)
+}
@@ -0,0 +1,126 @@
+package prober
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
+	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
+	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/cmd/backfiller"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/config"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/consumer"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/prober"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+type RedemptionProber struct {
+	P2pNetwork       string
+	LogLevel         string
+	MongoURI         string
+	MongoDatabase    string
+	RpcProvidersPath string
+	ContractsPath    string
+	Delay            time.Duration
+	MaxAge           time.Duration
+	PageSize         int64
+	BlockRange       uint64
+	MaxBlockRanges   int
+}
+
+func RunRedemptions(proberConfig *RedemptionProber) {
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Load config
+	cfg, err := config.NewRpcProviderSettingJson(proberConfig.RpcProvidersPath)
+	if err != nil {
+		log.Fatal("Failed to load config: ", err)
+	}
+
+	contractsCfg, err := config.NewRedemptionContractsSettings(proberConfig.ContractsPath)
+	if err != nil {
+		log.Fatal("Failed to load redemption contracts: ", err)
+	}
+	contracts, err := newRedemptionContracts(contractsCfg)
+	if err != nil {
+		log.Fatal("Invalid redemption contracts: ", err)
+	}
+
+	// create rpc pool
+	rpcPool, _, err := backfiller.NewRpcPool(cfg)
+	if err != nil {
+		log.Fatal("Failed to initialize rpc pool: ", zap.Error(err))
+	}
+
+	logger := logger.New("wormhole-explorer-tx-tracker", logger.WithLevel(proberConfig.LogLevel))
+
+	logger.Info("Starting wormhole-explorer-tx-tracker as redemption prober ...")
+
+	//setup DB connection
+	db, err := dbutil.Connect(ctx, logger, proberConfig.MongoURI, proberConfig.MongoDatabase, false)
+	if err != nil {
+		logger.Fatal("failed to connect MongoDB", zap.Error(err))
+	}
+
+	// create a consumer repository.
+	repository := consumer.NewRepository(logger, db.Database)
+
+	redisClient := redis.NewClient(&redis.Options{Addr: cfg.NotionalCacheURL})
+	notionalCache, errCache := notional.NewNotionalCache(ctx, redisClient, cfg.NotionalCachePrefix, cfg.NotionalCacheChannel, logger)
+	if errCache != nil {
+		logger.Fatal("Failed to create notional cache", zap.Error(errCache))
+	}
+	errCache = notionalCache.Init(ctx)
+	if errCache != nil {
+		logger.Fatal("Failed to initialize notional cache", zap.Error(errCache))
+	}
+
+	redemptionProber := prober.NewRedemptionProber(repository, rpcPool, contracts, notionalCache,
+		metrics.NewDummyMetrics(), proberConfig.P2pNetwork, prober.Config{
+			Delay:          proberConfig.Delay,
+			MaxAge:         proberConfig.MaxAge,
+			PageSize:       proberConfig.PageSize,
+			BlockRange:     proberConfig.BlockRange,
+			MaxBlockRanges: proberConfig.MaxBlockRanges,
+		}, logger)
+
+	err = redemptionProber.Run(ctx)
+	if err != nil {
+		logger.Error("Redemption prober failed", zap.Error(err))
+	}
+
+	logger.Info("closing MongoDB connection...")
+	db.DisconnectWithTimeout(10 * time.Second)
+
+	logger.Info("Finish wormhole-explorer-tx-tracker as redemption prober")
+	if err != nil {
+		os.Exit(1)
+	}
+}
+
+// newRedemptionContracts groups the redemption contracts by chain.
+func newRedemptionContracts(cfg *config.RedemptionContractsSettings) (map[sdk.ChainID][]chains.RedemptionContract, error) {
+	contracts := make(map[sdk.ChainID][]chains.RedemptionContract)
+	for _, c := range cfg.Contracts {
+		if !chains.IsRedemptionProtocolSupported(c.Protocol) {
+			return nil, fmt.Errorf("redemption protocol not supported: %s", c.Protocol)
+		}
+		chainID := sdk.ChainID(c.ChainId)
+		contracts[chainID] = append(contracts[chainID], chains.RedemptionContract{
+			Protocol: c.Protocol,
+			Address:  c.Address,
+		})
+	}
+	return contracts, nil
+}
@@ -21,13 +21,16 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/health"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
 	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/config"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/consumer"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/http/infrastructure"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/http/vaa"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/notifier"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/queue"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
@@ -51,11 +54,15 @@ func Run() {
 	logger.Info("Starting wormhole-explorer-tx-tracker ...")
 
 	// create rpc pool
-	rpcPool, wormchainRpcPool, err := newRpcPool(cfg)
+	poolMetrics := newPoolMetrics(cfg)
+	rpcPool, wormchainRpcPool, err := newRpcPool(cfg, poolMetrics)
 	if err != nil {
 		logger.Fatal("Failed to initialize rpc pool: ", zap.Error(err))
 	}
 
+	// create the near indexer, used to resolve the signer of the near transactions
+	nearIndexer := chains.NewNearIndexer(cfg.P2pNetwork, cfg.NearIndexerConfigs(), pool.WithMetrics(poolMetrics))
+
 	// initialize the database client
 	db, err := dbutil.Connect(rootCtx, logger, cfg.MongodbUri, cfg.MongodbDatabase, false)
 	if err != nil {
@@ -77,7 +84,7 @@ func Run() {
 	}
 
 	// create controller
-	vaaController := vaa.NewController(rpcPool, wormchainRpcPool, vaaRepository, repository, cfg.P2pNetwork, logger, notionalCache)
+	vaaController := vaa.NewController(rpcPool, wormchainRpcPool, nearIndexer, vaaRepository, repository, cfg.P2pNetwork, logger, notionalCache)
 
 	// start serving /health and /ready endpoints
 	healthChecks, err := makeHealthChecks(rootCtx, cfg, db.Database)
@@ -87,14 +94,17 @@ func Run() {
 	server := infrastructure.NewServer(logger, cfg.MonitoringPort, cfg.PprofEnabled, vaaController, healthChecks...)
 	server.Start()
 
+	// create the notifier of the source tx confirmed events.
+	notifyFunc := newNotifyFunc(rootCtx, cfg, logger)
+
 	// create and start a pipeline consumer.
 	vaaConsumeFunc := newVAAConsumeFunc(rootCtx, cfg, metrics, logger)
-	vaaConsumer := consumer.New(vaaConsumeFunc, rpcPool, wormchainRpcPool, logger, repository, metrics, cfg.P2pNetwork, cfg.ConsumerWorkersSize, notionalCache)
+	vaaConsumer := consumer.New(vaaConsumeFunc, rpcPool, wormchainRpcPool, nearIndexer, logger, repository, metrics, cfg.P2pNetwork, cfg.ConsumerWorkersSize, notionalCache, notifyFunc)
 	vaaConsumer.Start(rootCtx)
 
 	// create and start a notification consumer.
 	notificationConsumeFunc := newNotificationConsumeFunc(rootCtx, cfg, metrics, logger)
-	notificationConsumer := consumer.New(notificationConsumeFunc, rpcPool, wormchainRpcPool, logger, repository, metrics, cfg.P2pNetwork, cfg.ConsumerWorkersSize, notionalCache)
+	notificationConsumer := consumer.New(notificationConsumeFunc, rpcPool, wormchainRpcPool, nearIndexer, logger, repository, metrics, cfg.P2pNetwork, cfg.ConsumerWorkersSize, notionalCache, notifyFunc)
 	notificationConsumer.Start(rootCtx)
 
 	logger.Info("Started wormhole-explorer-tx-tracker")
@@ -129,12 +139,12 @@ func newVAAConsumeFunc(
 	logger *zap.Logger,
 ) queue.ConsumeFunc {
 
-	sqsConsumer, err := newSqsConsumer(ctx, cfg, cfg.PipelineSqsUrl)
+	consumer, err := newConsumer(ctx, cfg, cfg.PipelineSqsUrl, cfg.PipelineStream)
 	if err != nil {
-		logger.Fatal("failed to create sqs consumer", zap.Error(err))
+		logger.Fatal("failed to create pipeline consumer", zap.Error(err))
 	}
 
-	vaaQueue := queue.NewEventSqs(sqsConsumer, queue.NewVaaConverter(logger), metrics, logger)
+	vaaQueue := queue.NewEventQueue(consumer, queue.NewVaaConverter(logger), metrics, logger)
 	return vaaQueue.Consume
 }
 
@@ -145,16 +155,51 @@ func newNotificationConsumeFunc(
 	logger *zap.Logger,
 ) queue.ConsumeFunc {
 
-	sqsConsumer, err := newSqsConsumer(ctx, cfg, cfg.NotificationsSqsUrl)
+	consumer, err := newConsumer(ctx, cfg, cfg.NotificationsSqsUrl, cfg.NotificationsStream)
 	if err != nil {
-		logger.Fatal("failed to create sqs consumer", zap.Error(err))
+		logger.Fatal("failed to create notifications consumer", zap.Error(err))
 	}
 
-	vaaQueue := queue.NewEventSqs(sqsConsumer, queue.NewNotificationEvent(logger), metrics, logger)
+	vaaQueue := queue.NewEventQueue(consumer, queue.NewNotificationEvent(logger), metrics, logger)
 	return vaaQueue.Consume
 }
 
-func newSqsConsumer(ctx context.Context, cfg *config.ServiceSettings, sqsUrl string) (*sqs.Consumer, error) {
+func newNotifyFunc(
+	ctx context.Context,
+	cfg *config.ServiceSettings,
+	logger *zap.Logger,
+) notifier.NotifyFunc {
+
+	var producer messagebus.Producer
+	switch cfg.MessageBus {
+	case messagebus.BackendRedis:
+		if cfg.NotificationsPublishStream == "" {
+			return notifier.NewNoopNotifyFunc()
+		}
+		producer = messagebus.NewRedisStreamProducer(newMessageBusRedisClient(cfg), cfg.NotificationsPublishStream)
+	default:
+		if cfg.NotificationsSnsUrl == "" {
+			return notifier.NewNoopNotifyFunc()
+		}
+		awsConfig, err := newAwsConfig(ctx, cfg)
+		if err != nil {
+			logger.Fatal("failed to create aws config", zap.Error(err))
+		}
+		producer = messagebus.NewSNSProducer(awsConfig, cfg.NotificationsSnsUrl)
+	}
+
+	return notifier.NewNotifier(producer, logger).NotifySourceTxConfirmed
+}
+
+// newConsumer creates a consumer of the SQS queue or the Redis stream depending on the message bus backend.
+func newConsumer(ctx context.Context, cfg *config.ServiceSettings, sqsUrl, stream string) (messagebus.Consumer, error) {
+
+	if cfg.MessageBus == messagebus.BackendRedis {
+		return messagebus.NewRedisStreamConsumer(ctx, newMessageBusRedisClient(cfg), stream, cfg.MessageBusConsumerGroup,
+			messagebus.WithRedisMaxMessages(10),
+			messagebus.WithRedisVisibilityTimeout(60*time.Second),
+		)
+	}
 
 	awsconfig, err := newAwsConfig(ctx, cfg)
 	if err != nil {
@@ -167,7 +212,14 @@ func newSqsConsumer(ctx context.Context, cfg *config.ServiceSettings, sqsUrl str
 		sqs.WithMaxMessages(10),
 		sqs.WithVisibilityTimeout(60),
 	)
-	return consumer, err
+	if err != nil {
+		return nil, err
+	}
+	return messagebus.NewSQSConsumer(consumer, messagebus.WithSNSEnvelope()), nil
+}
+
+func newMessageBusRedisClient(cfg *config.ServiceSettings) *redis.Client {
+	return redis.NewClient(&redis.Options{Addr: cfg.MessageBusRedisUri})
 }
 
 func newAwsConfig(ctx context.Context, cfg *config.ServiceSettings) (aws.Config, error) {
@@ -207,6 +259,13 @@ func makeHealthChecks(
 	db *mongo.Database,
 ) ([]health.Check, error) {
 
+	if config.MessageBus == messagebus.BackendRedis {
+		return []health.Check{
+			health.Redis(newMessageBusRedisClient(config)),
+			health.Mongo(db),
+		}, nil
+	}
+
 	awsConfig, err := newAwsConfig(ctx, config)
 	if err != nil {
 		return nil, err
@@ -228,7 +287,14 @@ func newMetrics(cfg *config.ServiceSettings) metrics.Metrics {
 	return metrics.NewPrometheusMetrics(cfg.Environment)
 }
 
-func newRpcPool(cfg *config.ServiceSettings) (map[sdk.ChainID]*pool.Pool, map[sdk.ChainID]*pool.Pool, error) {
+func newPoolMetrics(cfg *config.ServiceSettings) pool.Metrics {
+	if !cfg.MetricsEnabled {
+		return pool.NewDummyMetrics()
+	}
+	return pool.NewPrometheusMetrics(cfg.Environment, "wormscan-tx-tracker")
+}
+
+func newRpcPool(cfg *config.ServiceSettings, poolMetrics pool.Metrics) (map[sdk.ChainID]*pool.Pool, map[sdk.ChainID]*pool.Pool, error) {
 	var rpcConfigMap map[sdk.ChainID][]config.RpcConfig
 	var wormchainRpcConfigMap map[sdk.ChainID][]config.RpcConfig
 	var err error
@@ -289,13 +355,17 @@ func newRpcPool(cfg *config.ServiceSettings) (map[sdk.ChainID]*pool.Pool, map[sd
 	// create rpc pool
 	rpcPool := make(map[sdk.ChainID]*pool.Pool)
 	for chainID, rpcConfig := range rpcConfigMap {
-		rpcPool[chainID] = pool.NewPool(convertFn(rpcConfig))
+		rpcPool[chainID] = pool.NewPool(convertFn(rpcConfig),
+			pool.WithName(chainID.String()),
+			pool.WithMetrics(poolMetrics))
 	}
 
 	// create wormchain rpc pool
 	wormchainRpcPool := make(map[sdk.ChainID]*pool.Pool)
 	for chainID, rpcConfig := range wormchainRpcConfigMap {
-		wormchainRpcPool[chainID] = pool.NewPool(convertFn(rpcConfig))
+		wormchainRpcPool[chainID] = pool.NewPool(convertFn(rpcConfig),
+			pool.WithName("wormchain-"+chainID.String()),
+			pool.WithMetrics(poolMetrics))
 	}
 
 	return rpcPool, wormchainRpcPool, nil
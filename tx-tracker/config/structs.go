@@ -10,6 +10,8 @@ import (
 
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
 
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
@@ -27,7 +29,10 @@ type ServiceSettings struct {
 	NotionalCacheURL     string `split_words:"true" required:"true"`
 	NotionalCachePrefix  string `split_words:"true" required:"true"`
 	NotionalCacheChannel string `split_words:"true" required:"true"`
+	// MessageBus is the backend of the queues and topics, sqs or redis.
+	MessageBus string `split_words:"true" default:"sqs"`
 	AwsSettings
+	RedisStreamSettings
 	MongodbSettings
 	*RpcProviderSettings        `required:"false"`
 	*WormchainProviderSettings  `required:"false"`
@@ -41,6 +46,16 @@ type RpcProviderSettingsJson struct {
 	NotionalCacheURL      string                     `json:"notional_cache_url"`
 	NotionalCachePrefix   string                     `json:"notional_cache_prefix"`
 	NotionalCacheChannel  string                     `json:"notional_cache_channel"`
+	// NearIndexers are the nearblocks apis used to resolve the signer of the Near transactions.
+	NearIndexers []NearIndexerSettings `json:"nearIndexers"`
+}
+
+// NearIndexerSettings is the configuration of a nearblocks api.
+type NearIndexerSettings struct {
+	Url              string `json:"url"`
+	ApiKey           string `json:"apiKey"`
+	RequestPerMinute uint16 `json:"requestPerMinute"`
+	Priority         uint8  `json:"priority"`
 }
 
 type ChainRpcProviderSettings struct {
@@ -59,9 +74,21 @@ type AwsSettings struct {
 	AwsEndpoint         string `split_words:"true" required:"false"`
 	AwsAccessKeyID      string `split_words:"true" required:"false"`
 	AwsSecretAccessKey  string `split_words:"true" required:"false"`
-	AwsRegion           string `split_words:"true" required:"true"`
-	PipelineSqsUrl      string `split_words:"true" required:"true"`
-	NotificationsSqsUrl string `split_words:"true" required:"true"`
+	AwsRegion           string `split_words:"true" required:"false"`
+	PipelineSqsUrl      string `split_words:"true" required:"false"`
+	NotificationsSqsUrl string `split_words:"true" required:"false"`
+	// NotificationsSnsUrl is the topic where the source tx confirmed events are published, optional.
+	NotificationsSnsUrl string `split_words:"true" required:"false"`
+}
+
+// RedisStreamSettings defines the streams used when the message bus backend is redis.
+type RedisStreamSettings struct {
+	MessageBusRedisUri      string `split_words:"true" required:"false"`
+	MessageBusConsumerGroup string `split_words:"true" default:"tx-tracker"`
+	PipelineStream          string `split_words:"true" default:"pipeline"`
+	NotificationsStream     string `split_words:"true" default:"notifications"`
+	// NotificationsPublishStream is the stream where the source tx confirmed events are published, optional.
+	NotificationsPublishStream string `split_words:"true" required:"false"`
 }
 
 type MongodbSettings struct {
@@ -138,6 +165,13 @@ type RpcProviderSettings struct {
 	MoonbeamRequestsPerMinute          uint16 `split_words:"true" required:"false"`
 	MoonbeamFallbackUrls               string `split_words:"true" required:"false"`
 	MoonbeamFallbackRequestsPerMinute  string `split_words:"true" required:"false"`
+	NearBaseUrl                        string `split_words:"true" required:"false"`
+	NearRequestsPerMinute              uint16 `split_words:"true" required:"false"`
+	NearFallbackUrls                   string `split_words:"true" required:"false"`
+	NearFallbackRequestsPerMinute      string `split_words:"true" required:"false"`
+	NearIndexerUrl                     string `split_words:"true" required:"false"`
+	NearIndexerApiKey                  string `split_words:"true" required:"false"`
+	NearIndexerRequestsPerMinute       uint16 `split_words:"true" required:"false"`
 	OasisBaseUrl                       string `split_words:"true" required:"false"`
 	OasisRequestsPerMinute             uint16 `split_words:"true" required:"false"`
 	OasisFallbackUrls                  string `split_words:"true" required:"false"`
@@ -158,6 +192,30 @@ type RpcProviderSettings struct {
 	SeiRequestsPerMinute               uint16 `split_words:"true" required:"false"`
 	SeiFallbackUrls                    string `split_words:"true" required:"false"`
 	SeiFallbackRequestsPerMinute       string `split_words:"true" required:"false"`
+	BerachainBaseUrl                   string `split_words:"true" required:"false"`
+	BerachainRequestsPerMinute         uint16 `split_words:"true" required:"false"`
+	BerachainFallbackUrls              string `split_words:"true" required:"false"`
+	BerachainFallbackRequestsPerMinute string `split_words:"true" required:"false"`
+	GnosisBaseUrl                      string `split_words:"true" required:"false"`
+	GnosisRequestsPerMinute            uint16 `split_words:"true" required:"false"`
+	GnosisFallbackUrls                 string `split_words:"true" required:"false"`
+	GnosisFallbackRequestsPerMinute    string `split_words:"true" required:"false"`
+	LineaBaseUrl                       string `split_words:"true" required:"false"`
+	LineaRequestsPerMinute             uint16 `split_words:"true" required:"false"`
+	LineaFallbackUrls                  string `split_words:"true" required:"false"`
+	LineaFallbackRequestsPerMinute     string `split_words:"true" required:"false"`
+	RootstockBaseUrl                   string `split_words:"true" required:"false"`
+	RootstockRequestsPerMinute         uint16 `split_words:"true" required:"false"`
+	RootstockFallbackUrls              string `split_words:"true" required:"false"`
+	RootstockFallbackRequestsPerMinute string `split_words:"true" required:"false"`
+	SeiEvmBaseUrl                      string `split_words:"true" required:"false"`
+	SeiEvmRequestsPerMinute            uint16 `split_words:"true" required:"false"`
+	SeiEvmFallbackUrls                 string `split_words:"true" required:"false"`
+	SeiEvmFallbackRequestsPerMinute    string `split_words:"true" required:"false"`
+	StellarBaseUrl                     string `split_words:"true" required:"false"`
+	StellarRequestsPerMinute           uint16 `split_words:"true" required:"false"`
+	StellarFallbackUrls                string `split_words:"true" required:"false"`
+	StellarFallbackRequestsPerMinute   string `split_words:"true" required:"false"`
 	SnaxchainBaseUrl                   string `split_words:"true" required:"false"`
 	SnaxchainRequestsPerMinute         uint16 `split_words:"true" required:"false"`
 	SnaxchainFallbackUrls              string `split_words:"true" required:"false"`
@@ -209,6 +267,10 @@ type WormchainProviderSettings struct {
 }
 
 type TestnetRpcProviderSettings struct {
+	HoleskyBaseUrl                           string `split_words:"true" required:"false"`
+	HoleskyRequestsPerMinute                 uint16 `split_words:"true" required:"false"`
+	HoleskyFallbackUrls                      string `split_words:"true" required:"false"`
+	HoleskyFallbackRequestsPerMinute         string `split_words:"true" required:"false"`
 	ArbitrumSepoliaBaseUrl                   string `split_words:"true" required:"false"`
 	ArbitrumSepoliaRequestsPerMinute         uint16 `split_words:"true" required:"false"`
 	ArbitrumSepoliaFallbackUrls              string `split_words:"true" required:"false"`
@@ -246,6 +308,34 @@ func NewRpcProviderSettingJson(path string) (*RpcProviderSettingsJson, error) {
 	return &rpcProviderSettingsJson, nil
 }
 
+// RedemptionContractsSettings defines the contracts of the target chains queried by the redemption prober.
+type RedemptionContractsSettings struct {
+	Contracts []RedemptionContractSettings `json:"contracts"`
+}
+
+// RedemptionContractSettings defines the contract that redeems the VAAs of a protocol in a target chain.
+type RedemptionContractSettings struct {
+	ChainId uint16 `json:"chainId"`
+	// Protocol is the appId of the VAAs redeemed by the contract.
+	Protocol string `json:"protocol"`
+	Address  string `json:"address"`
+}
+
+func NewRedemptionContractsSettings(path string) (*RedemptionContractsSettings, error) {
+
+	contractsJsonFile, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read redemption contracts from file: %w", err)
+	}
+
+	var redemptionContractsSettings RedemptionContractsSettings
+	err = json.Unmarshal(contractsJsonFile, &redemptionContractsSettings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal redemption contracts from file: %w", err)
+	}
+	return &redemptionContractsSettings, nil
+}
+
 func New() (*ServiceSettings, error) {
 	_ = godotenv.Load()
 	var settings ServiceSettings
@@ -255,6 +345,10 @@ func New() (*ServiceSettings, error) {
 		return nil, fmt.Errorf("failed to read config from environment: %w", err)
 	}
 
+	if err := settings.validateMessageBus(); err != nil {
+		return nil, err
+	}
+
 	if settings.RpcProviderPath != "" {
 		rpcJsonFile, err := os.ReadFile(settings.RpcProviderPath)
 		if err != nil {
@@ -281,6 +375,23 @@ func New() (*ServiceSettings, error) {
 	return &settings, nil
 }
 
+// validateMessageBus checks the settings required by the message bus backend.
+func (s *ServiceSettings) validateMessageBus() error {
+	if err := messagebus.ValidateBackend(s.MessageBus); err != nil {
+		return err
+	}
+	if s.MessageBus == messagebus.BackendRedis {
+		if s.MessageBusRedisUri == "" {
+			return errors.New("MESSAGE_BUS_REDIS_URI is required when the message bus is redis")
+		}
+		return nil
+	}
+	if s.AwsRegion == "" || s.PipelineSqsUrl == "" || s.NotificationsSqsUrl == "" {
+		return errors.New("AWS_REGION, PIPELINE_SQS_URL and NOTIFICATIONS_SQS_URL are required when the message bus is sqs")
+	}
+	return nil
+}
+
 func LoadFromEnv[T any]() (*T, error) {
 	_ = godotenv.Load()
 
@@ -328,6 +439,35 @@ func (s *ServiceSettings) MapRpcProviderToRpcConfig() (map[sdk.ChainID][]RpcConf
 	return nil, nil, errors.New("rpc provider settings not found")
 }
 
+// NearIndexerConfigs returns the configuration of the nearblocks apis, empty to use the public api of the network.
+func (s *ServiceSettings) NearIndexerConfigs() []chains.NearIndexerConfig {
+	if s.RpcProviderSettingsJson != nil {
+		return s.RpcProviderSettingsJson.NearIndexerConfigs()
+	}
+	if s.RpcProviderSettings != nil && s.RpcProviderSettings.NearIndexerUrl != "" {
+		return []chains.NearIndexerConfig{{
+			Url:               s.RpcProviderSettings.NearIndexerUrl,
+			ApiKey:            s.RpcProviderSettings.NearIndexerApiKey,
+			RequestsPerMinute: s.RpcProviderSettings.NearIndexerRequestsPerMinute,
+		}}
+	}
+	return nil
+}
+
+// NearIndexerConfigs returns the configuration of the nearblocks apis, empty to use the public api of the network.
+func (r RpcProviderSettingsJson) NearIndexerConfigs() []chains.NearIndexerConfig {
+	configs := make([]chains.NearIndexerConfig, 0, len(r.NearIndexers))
+	for _, indexer := range r.NearIndexers {
+		configs = append(configs, chains.NearIndexerConfig{
+			Url:               indexer.Url,
+			ApiKey:            indexer.ApiKey,
+			Priority:          indexer.Priority,
+			RequestsPerMinute: indexer.RequestPerMinute,
+		})
+	}
+	return configs
+}
+
 // ToMap converts the RpcProviderSettingsJson to a map of RpcConfig
 func (r RpcProviderSettingsJson) ToMap() (map[sdk.ChainID][]RpcConfig, error) {
 	rpcs := make(map[sdk.ChainID][]RpcConfig)
@@ -495,6 +635,72 @@ func (r RpcProviderSettings) ToMap() (map[sdk.ChainID][]RpcConfig, error) {
 	}
 	rpcs[sdk.ChainIDMantle] = mantleRpcConfigs
 
+	// add berachain rpcs
+	berachainRpcConfigs, err := addRpcConfig(
+		r.BerachainBaseUrl,
+		r.BerachainRequestsPerMinute,
+		r.BerachainFallbackUrls,
+		r.BerachainFallbackRequestsPerMinute)
+	if err != nil {
+		return nil, err
+	}
+	rpcs[sdk.ChainIDBerachain] = berachainRpcConfigs
+
+	// add gnosis rpcs
+	gnosisRpcConfigs, err := addRpcConfig(
+		r.GnosisBaseUrl,
+		r.GnosisRequestsPerMinute,
+		r.GnosisFallbackUrls,
+		r.GnosisFallbackRequestsPerMinute)
+	if err != nil {
+		return nil, err
+	}
+	rpcs[sdk.ChainIDGnosis] = gnosisRpcConfigs
+
+	// add linea rpcs
+	lineaRpcConfigs, err := addRpcConfig(
+		r.LineaBaseUrl,
+		r.LineaRequestsPerMinute,
+		r.LineaFallbackUrls,
+		r.LineaFallbackRequestsPerMinute)
+	if err != nil {
+		return nil, err
+	}
+	rpcs[sdk.ChainIDLinea] = lineaRpcConfigs
+
+	// add rootstock rpcs
+	rootstockRpcConfigs, err := addRpcConfig(
+		r.RootstockBaseUrl,
+		r.RootstockRequestsPerMinute,
+		r.RootstockFallbackUrls,
+		r.RootstockFallbackRequestsPerMinute)
+	if err != nil {
+		return nil, err
+	}
+	rpcs[sdk.ChainIDRootstock] = rootstockRpcConfigs
+
+	// add sei evm rpcs
+	seiEvmRpcConfigs, err := addRpcConfig(
+		r.SeiEvmBaseUrl,
+		r.SeiEvmRequestsPerMinute,
+		r.SeiEvmFallbackUrls,
+		r.SeiEvmFallbackRequestsPerMinute)
+	if err != nil {
+		return nil, err
+	}
+	rpcs[sdk.ChainIDSeiEVM] = seiEvmRpcConfigs
+
+	// add stellar rpcs
+	stellarRpcConfigs, err := addRpcConfig(
+		r.StellarBaseUrl,
+		r.StellarRequestsPerMinute,
+		r.StellarFallbackUrls,
+		r.StellarFallbackRequestsPerMinute)
+	if err != nil {
+		return nil, err
+	}
+	rpcs[chains.ChainIDStellar] = stellarRpcConfigs
+
 	// add snaxchain rpcs
 	snaxchainRpcConfigs, err := addRpcConfig(
 		r.SnaxchainBaseUrl,
@@ -616,6 +822,17 @@ func (r RpcProviderSettings) ToMap() (map[sdk.ChainID][]RpcConfig, error) {
 	}
 	rpcs[sdk.ChainIDMoonbeam] = moonbeamRpcConfigs
 
+	// add near rpcs
+	nearRpcConfigs, err := addRpcConfig(
+		r.NearBaseUrl,
+		r.NearRequestsPerMinute,
+		r.NearFallbackUrls,
+		r.NearFallbackRequestsPerMinute)
+	if err != nil {
+		return nil, err
+	}
+	rpcs[sdk.ChainIDNear] = nearRpcConfigs
+
 	// add oasis rpcs
 	oasisRpcConfigs, err := addRpcConfig(
 		r.OasisBaseUrl,
@@ -745,6 +962,17 @@ func (r RpcProviderSettings) ToMap() (map[sdk.ChainID][]RpcConfig, error) {
 func (r TestnetRpcProviderSettings) ToMap() (map[sdk.ChainID][]RpcConfig, error) {
 	rpcs := make(map[sdk.ChainID][]RpcConfig)
 
+	// add holesky rpcs
+	holeskyRpcConfigs, err := addRpcConfig(
+		r.HoleskyBaseUrl,
+		r.HoleskyRequestsPerMinute,
+		r.HoleskyFallbackUrls,
+		r.HoleskyFallbackRequestsPerMinute)
+	if err != nil {
+		return nil, err
+	}
+	rpcs[sdk.ChainIDHolesky] = holeskyRpcConfigs
+
 	// add arbitrum sepolia rpcs
 	arbitrumSepoliaRpcConfigs, err := addRpcConfig(
 		r.ArbitrumSepoliaBaseUrl,
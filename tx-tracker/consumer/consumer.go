@@ -6,9 +6,11 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
 	"time"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/notifier"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/queue"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -20,36 +22,42 @@ type Consumer struct {
 	consumeFunc      queue.ConsumeFunc
 	rpcpool          map[vaa.ChainID]*pool.Pool
 	wormchainRpcPool map[vaa.ChainID]*pool.Pool
+	nearIndexer      *chains.NearIndexer
 	logger           *zap.Logger
 	repository       *Repository
 	metrics          metrics.Metrics
 	p2pNetwork       string
 	workersSize      int
 	notionalCache    *notional.NotionalCache
+	notifyFunc       notifier.NotifyFunc
 }
 
 // New creates a new vaa consumer.
 func New(consumeFunc queue.ConsumeFunc,
 	rpcPool map[vaa.ChainID]*pool.Pool,
 	wormchainRpcPool map[vaa.ChainID]*pool.Pool,
+	nearIndexer *chains.NearIndexer,
 	logger *zap.Logger,
 	repository *Repository,
 	metrics metrics.Metrics,
 	p2pNetwork string,
 	workersSize int,
 	notionalCache *notional.NotionalCache,
+	notifyFunc notifier.NotifyFunc,
 ) *Consumer {
 
 	c := Consumer{
 		consumeFunc:      consumeFunc,
 		rpcpool:          rpcPool,
 		wormchainRpcPool: wormchainRpcPool,
+		nearIndexer:      nearIndexer,
 		logger:           logger,
 		repository:       repository,
 		metrics:          metrics,
 		p2pNetwork:       p2pNetwork,
 		workersSize:      workersSize,
 		notionalCache:    notionalCache,
+		notifyFunc:       notifyFunc,
 	}
 
 	return &c
@@ -94,12 +102,6 @@ func (c *Consumer) processSourceTx(ctx context.Context, msg queue.ConsumerMessag
 		return
 	}
 
-	if event.ChainID == sdk.ChainIDNear {
-		msg.Done()
-		c.logger.Warn("Skipping vaa from near", zap.String("trackId", event.TrackID), zap.String("vaaId", event.ID))
-		return
-	}
-
 	start := time.Now()
 
 	c.metrics.IncVaaUnfiltered(event.ChainID.String(), event.Source)
@@ -120,7 +122,7 @@ func (c *Consumer) processSourceTx(ctx context.Context, msg queue.ConsumerMessag
 		Source:        event.Source,
 		SentTimestamp: msg.SentTimestamp(),
 	}
-	_, err := ProcessSourceTx(ctx, c.logger, c.rpcpool, c.wormchainRpcPool, c.repository, &p, c.p2pNetwork, c.notionalCache)
+	txDetail, err := ProcessSourceTx(ctx, c.logger, c.rpcpool, c.wormchainRpcPool, c.nearIndexer, c.repository, &p, c.p2pNetwork, c.notionalCache)
 
 	// add vaa processing duration metrics
 	c.metrics.AddVaaProcessedDuration(uint16(event.ChainID), time.Since(start).Seconds())
@@ -157,6 +159,24 @@ func (c *Consumer) processSourceTx(ctx context.Context, msg queue.ConsumerMessag
 			elapsedLog,
 		)
 		c.metrics.IncOriginTxInserted(event.ChainID.String(), event.Source)
+
+		// Notify the source transaction is confirmed
+		confirmed := events.SourceTxConfirmed{
+			ID:             event.ID,
+			EmitterChain:   uint16(event.ChainID),
+			EmitterAddress: event.EmitterAddress,
+			Sequence:       event.Sequence,
+			TxHash:         txDetail.NativeTxHash,
+			From:           txDetail.From,
+			Timestamp:      event.Timestamp,
+		}
+		if err := c.notifyFunc(ctx, event.TrackID, &confirmed); err != nil {
+			c.logger.Error("Failed to notify source tx confirmed",
+				zap.String("trackId", event.TrackID),
+				zap.String("vaaId", event.ID),
+				zap.Error(err),
+			)
+		}
 	}
 }
 
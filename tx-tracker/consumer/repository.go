@@ -7,7 +7,10 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/vaa"
+	"github.com/wormhole-foundation/wormhole-explorer/common/backfill"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/lifecycle"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.mongodb.org/mongo-driver/bson"
@@ -51,6 +54,8 @@ type Repository struct {
 	globalTransactions *mongo.Collection
 	vaas               *mongo.Collection
 	vaaIdTxHash        *mongo.Collection
+	operationLifecycle *mongo.Collection
+	lifecycle          *lifecycle.Repository
 }
 
 // New creates a new repository.
@@ -61,6 +66,8 @@ func NewRepository(logger *zap.Logger, db *mongo.Database) *Repository {
 		globalTransactions: db.Collection("globalTransactions"),
 		vaas:               db.Collection("vaas"),
 		vaaIdTxHash:        db.Collection("vaaIdTxHash"),
+		operationLifecycle: db.Collection(repository.OperationLifecycle),
+		lifecycle:          lifecycle.NewRepository(db),
 	}
 
 	return &r
@@ -398,3 +405,77 @@ func (r *Repository) FindSourceTxById(ctx context.Context, id string) (*SourceTx
 	}
 	return &sourceTxDoc, err
 }
+
+// RecordLifecycle records the steps of an operation in its lifecycle.
+func (r *Repository) RecordLifecycle(ctx context.Context, vaaID string, events ...lifecycle.Event) {
+	if err := r.lifecycle.Record(ctx, vaaID, events...); err != nil {
+		r.logger.Warn("failed to record operation lifecycle", zap.String("vaaId", vaaID), zap.Error(err))
+	}
+}
+
+// UnredeemedVaa is a signed VAA without a destination transaction.
+type UnredeemedVaa struct {
+	ID       string      `bson:"_id"`
+	SignedAt time.Time   `bson:"signedAt"`
+	Vaa      []byte      `bson:"vaa"`
+	ToChain  sdk.ChainID `bson:"toChain"`
+	AppIds   []string    `bson:"appIds"`
+}
+
+// FindUnredeemedVaas returns the VAAs signed between signedAfter and signedBefore that have a target chain
+// and no destination transaction, sorted by the time they were signed and placed after the cursor.
+func (r *Repository) FindUnredeemedVaas(
+	ctx context.Context,
+	signedAfter, signedBefore time.Time,
+	cursor *backfill.TimeCursor,
+	limit int64,
+) ([]*UnredeemedVaa, error) {
+
+	match := bson.D{
+		{Key: "status", Value: lifecycle.StatusSigned},
+		{Key: "toChain", Value: bson.M{"$gt": 0}},
+		{Key: "signedAt", Value: bson.M{"$gte": signedAfter, "$lt": signedBefore}},
+	}
+	if cursor != nil {
+		match = append(match, bson.E{Key: "$or", Value: bson.A{
+			bson.M{"signedAt": bson.M{"$gt": cursor.Timestamp}},
+			bson.M{"signedAt": cursor.Timestamp, "_id": bson.M{"$gt": cursor.ID}},
+		}})
+	}
+
+	lookup := func(from string) bson.D {
+		return bson.D{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: from},
+			{Key: "localField", Value: "_id"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: from},
+		}}}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$sort", Value: bson.D{{Key: "signedAt", Value: 1}, {Key: "_id", Value: 1}}}},
+		lookup("globalTransactions"),
+		{{Key: "$match", Value: bson.M{"globalTransactions.destinationTx": bson.M{"$exists": false}}}},
+		{{Key: "$limit", Value: limit}},
+		lookup(repository.ParsedVaa),
+		lookup(repository.Vaas),
+		{{Key: "$project", Value: bson.D{
+			{Key: "signedAt", Value: 1},
+			{Key: "vaa", Value: bson.M{"$arrayElemAt": bson.A{"$" + repository.Vaas + ".vaas", 0}}},
+			{Key: "toChain", Value: 1},
+			{Key: "appIds", Value: bson.M{"$arrayElemAt": bson.A{"$" + repository.ParsedVaa + ".standardizedProperties.appIds", 0}}},
+		}}},
+	}
+
+	cur, err := r.operationLifecycle.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var vaas []*UnredeemedVaa
+	if err := cur.All(ctx, &vaas); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return vaas, nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/lifecycle"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
@@ -47,6 +48,7 @@ func ProcessSourceTx(
 	logger *zap.Logger,
 	rpcPool map[vaa.ChainID]*pool.Pool,
 	wormchainRpcPool map[vaa.ChainID]*pool.Pool,
+	nearIndexer *chains.NearIndexer,
 	repository *Repository,
 	params *ProcessSourceTxParams,
 	p2pNetwork string,
@@ -117,7 +119,7 @@ func ProcessSourceTx(
 	}
 
 	// Get transaction details from the emitter blockchain
-	txDetail, err = chains.FetchTx(ctx, rpcPool, wormchainRpcPool, params.ChainId, params.TxHash, params.Timestamp, p2pNetwork, params.Metrics, logger, notionalCache)
+	txDetail, err = chains.FetchTx(ctx, rpcPool, wormchainRpcPool, nearIndexer, params.ChainId, params.TxHash, params.Timestamp, p2pNetwork, params.Metrics, logger, notionalCache)
 	if err != nil {
 		errHandleFetchTx := handleFetchTxError(ctx, logger, repository, params, err)
 		if errHandleFetchTx == nil {
@@ -147,6 +149,10 @@ func ProcessSourceTx(
 		return nil, err
 	}
 
+	if params.Timestamp != nil {
+		repository.RecordLifecycle(ctx, params.VaaId, lifecycle.Event{Step: lifecycle.StepEmitted, At: *params.Timestamp})
+	}
+
 	params.Metrics.VaaProcessingDuration(params.ChainId.String(), params.SentTimestamp)
 
 	return txDetail, nil
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/lifecycle"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -86,10 +87,19 @@ func ProcessTargetTx(
 		return nil
 	}
 	err = repository.UpsertTargetTx(ctx, update)
-	if err == nil {
-		params.Metrics.IncDestinationTxInserted(params.ChainID.String(), params.Source)
+	if err != nil {
+		return err
 	}
-	return err
+	params.Metrics.IncDestinationTxInserted(params.ChainID.String(), params.Source)
+
+	if params.Status == domain.DstTxStatusConfirmed {
+		redeemedAt := now
+		if params.BlockTimestamp != nil {
+			redeemedAt = *params.BlockTimestamp
+		}
+		repository.RecordLifecycle(ctx, params.VaaId, lifecycle.Event{Step: lifecycle.StepRedeemed, At: redeemedAt})
+	}
+	return nil
 }
 
 func checkTxShouldBeUpdated(ctx context.Context, tx *TargetTxUpdate, repository *Repository) (bool, error) {
@@ -7,6 +7,7 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
 	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/consumer"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -20,6 +21,7 @@ type Controller struct {
 	logger           *zap.Logger
 	rpcPool          map[sdk.ChainID]*pool.Pool
 	wormchainRpcPool map[sdk.ChainID]*pool.Pool
+	nearIndexer      *chains.NearIndexer
 	vaaRepository    *Repository
 	repository       *consumer.Repository
 	metrics          metrics.Metrics
@@ -28,11 +30,12 @@ type Controller struct {
 }
 
 // NewController creates a Controller instance.
-func NewController(rpcPool map[sdk.ChainID]*pool.Pool, wormchainRpcPool map[sdk.ChainID]*pool.Pool, vaaRepository *Repository, repository *consumer.Repository, p2pNetwork string, logger *zap.Logger, notionalCache *notional.NotionalCache) *Controller {
+func NewController(rpcPool map[sdk.ChainID]*pool.Pool, wormchainRpcPool map[sdk.ChainID]*pool.Pool, nearIndexer *chains.NearIndexer, vaaRepository *Repository, repository *consumer.Repository, p2pNetwork string, logger *zap.Logger, notionalCache *notional.NotionalCache) *Controller {
 	return &Controller{
 		metrics:          metrics.NewDummyMetrics(),
 		rpcPool:          rpcPool,
 		wormchainRpcPool: wormchainRpcPool,
+		nearIndexer:      nearIndexer,
 		vaaRepository:    vaaRepository,
 		repository:       repository,
 		p2pNetwork:       p2pNetwork,
@@ -76,7 +79,7 @@ func (c *Controller) Process(ctx *fiber.Ctx) error {
 		P2pNetwork:  c.p2pNetwork,
 	}
 
-	result, err := consumer.ProcessSourceTx(ctx.Context(), c.logger, c.rpcPool, c.wormchainRpcPool, c.repository, p, c.p2pNetwork, c.notionalCache)
+	result, err := consumer.ProcessSourceTx(ctx.Context(), c.logger, c.rpcPool, c.wormchainRpcPool, c.nearIndexer, c.repository, p, c.p2pNetwork, c.notionalCache)
 	if err != nil {
 		return err
 	}
@@ -147,7 +150,7 @@ func (c *Controller) CreateTxHash(ctx *fiber.Ctx) error {
 		DisableDBUpsert: true,
 	}
 
-	result, err := consumer.ProcessSourceTx(ctx.Context(), c.logger, c.rpcPool, c.wormchainRpcPool, c.repository, p, c.p2pNetwork, c.notionalCache)
+	result, err := consumer.ProcessSourceTx(ctx.Context(), c.logger, c.rpcPool, c.wormchainRpcPool, c.nearIndexer, c.repository, p, c.p2pNetwork, c.notionalCache)
 	if err != nil {
 		return err
 	}
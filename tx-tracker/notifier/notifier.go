@@ -0,0 +1,54 @@
+// Package notifier publishes the events of the transactions processed by tx-tracker.
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
+	"go.uber.org/zap"
+)
+
+const source = "tx-tracker"
+
+// NotifyFunc publishes a source transaction confirmed event.
+type NotifyFunc func(ctx context.Context, trackID string, e *events.SourceTxConfirmed) error
+
+// NewNoopNotifyFunc returns a NotifyFunc that does not publish anything.
+func NewNoopNotifyFunc() NotifyFunc {
+	return func(context.Context, string, *events.SourceTxConfirmed) error {
+		return nil
+	}
+}
+
+// Notifier publishes tx-tracker events to a message bus topic.
+type Notifier struct {
+	producer messagebus.Producer
+	logger   *zap.Logger
+}
+
+// NewNotifier creates a new Notifier.
+func NewNotifier(producer messagebus.Producer, logger *zap.Logger) *Notifier {
+	return &Notifier{producer: producer, logger: logger}
+}
+
+// NotifySourceTxConfirmed publishes a source transaction confirmed event.
+func (n *Notifier) NotifySourceTxConfirmed(ctx context.Context, trackID string, e *events.SourceTxConfirmed) error {
+	event, err := events.NewNotificationEvent[events.SourceTxConfirmed](trackID, source, events.SourceTxConfirmedType, *e)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	deduplicationID := fmt.Sprintf("%s-%s", events.SourceTxConfirmedType, e.ID)
+	n.logger.Debug("Publishing source tx confirmed event", zap.String("vaaId", e.ID))
+	return n.producer.Publish(ctx, &messagebus.OutgoingMessage{
+		GroupID:         e.ID,
+		DeduplicationID: deduplicationID,
+		Body:            body,
+	})
+}
@@ -0,0 +1,216 @@
+// Package prober looks up in the target chains the redemptions that were not reported by the blockchain-watcher.
+package prober
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/backfill"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/consumer"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// Source is the source of the destination transactions found by the redemption prober.
+const Source = "redemption-prober"
+
+// Config defines which VAAs are probed and how the target chains are scanned.
+type Config struct {
+	// Delay is the time after the signature of a VAA before probing its redemption,
+	// to give the blockchain-watcher the chance to report it.
+	Delay time.Duration
+	// MaxAge is the maximum time after the signature of a VAA to keep probing its redemption.
+	MaxAge time.Duration
+	// PageSize is the number of VAAs read at a time.
+	PageSize int64
+	// BlockRange is the number of blocks requested in each eth_getLogs call.
+	BlockRange uint64
+	// MaxBlockRanges is the maximum number of eth_getLogs calls made to find a redemption.
+	MaxBlockRanges int
+}
+
+// RedemptionProber finds the redemption of the signed VAAs without destination transaction,
+// querying the contracts of the target chains, and stores it as their destination transaction.
+type RedemptionProber struct {
+	repository    *consumer.Repository
+	rpcPool       map[sdk.ChainID]*pool.Pool
+	contracts     map[sdk.ChainID][]chains.RedemptionContract
+	notionalCache *notional.NotionalCache
+	metrics       metrics.Metrics
+	p2pNetwork    string
+	cfg           Config
+	logger        *zap.Logger
+}
+
+// NewRedemptionProber creates a new redemption prober.
+func NewRedemptionProber(
+	repository *consumer.Repository,
+	rpcPool map[sdk.ChainID]*pool.Pool,
+	contracts map[sdk.ChainID][]chains.RedemptionContract,
+	notionalCache *notional.NotionalCache,
+	metrics metrics.Metrics,
+	p2pNetwork string,
+	cfg Config,
+	logger *zap.Logger,
+) *RedemptionProber {
+	return &RedemptionProber{
+		repository:    repository,
+		rpcPool:       rpcPool,
+		contracts:     contracts,
+		notionalCache: notionalCache,
+		metrics:       metrics,
+		p2pNetwork:    p2pNetwork,
+		cfg:           cfg,
+		logger:        logger.With(zap.String("module", "RedemptionProber")),
+	}
+}
+
+// Run probes the redemption of the VAAs signed between MaxAge and Delay ago.
+func (p *RedemptionProber) Run(ctx context.Context) error {
+	now := time.Now()
+	signedAfter := now.Add(-p.cfg.MaxAge)
+	signedBefore := now.Add(-p.cfg.Delay)
+
+	// the blocks found by the lookups of the run narrow the search of the start block of the next ones.
+	blocks := chains.NewEvmBlockCache()
+	var cursor *backfill.TimeCursor
+	var read, found, failed int
+	for {
+		vaas, err := p.repository.FindUnredeemedVaas(ctx, signedAfter, signedBefore, cursor, p.cfg.PageSize)
+		if err != nil {
+			return err
+		}
+		if len(vaas) == 0 {
+			break
+		}
+
+		for _, v := range vaas {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			ok, err := p.probe(ctx, v, blocks)
+			if err != nil {
+				failed++
+				p.logger.Error("Failed to probe redemption", zap.String("vaaId", v.ID), zap.Error(err))
+				continue
+			}
+			if ok {
+				found++
+			}
+		}
+		read += len(vaas)
+
+		last := vaas[len(vaas)-1]
+		cursor = &backfill.TimeCursor{Timestamp: last.SignedAt, ID: last.ID}
+	}
+
+	p.logger.Info("Redemptions probed",
+		zap.Int("read", read),
+		zap.Int("found", found),
+		zap.Int("failed", failed))
+	return nil
+}
+
+// probe looks up the redemption of a VAA and stores it. It returns true if the redemption was found.
+func (p *RedemptionProber) probe(ctx context.Context, v *consumer.UnredeemedVaa, blocks *chains.EvmBlockCache) (bool, error) {
+	contracts := p.findContracts(v.ToChain, v.AppIds)
+	if len(contracts) == 0 {
+		return false, nil
+	}
+	rpcPool, ok := p.rpcPool[v.ToChain]
+	if !ok {
+		return false, nil
+	}
+
+	vaa, err := sdk.Unmarshal(v.Vaa)
+	if err != nil {
+		return false, fmt.Errorf("failed to unmarshal vaa: %w", err)
+	}
+
+	// the transceiver that receives a NTT VAA is not part of the VAA, so every contract of the
+	// protocols of the VAA is queried until one of them has consumed it.
+	var redemption *chains.Redemption
+	for _, contract := range contracts {
+		query := &chains.EvmRedemptionQuery{
+			ChainID:        v.ToChain,
+			Contract:       contract,
+			Vaa:            vaa,
+			SignedAt:       v.SignedAt,
+			BlockRange:     p.cfg.BlockRange,
+			MaxBlockRanges: p.cfg.MaxBlockRanges,
+			Blocks:         blocks,
+		}
+		redemption, err = chains.FindEvmRedemption(ctx, rpcPool, query, p.metrics, p.logger)
+		if errors.Is(err, chains.ErrNotRedeemed) {
+			continue
+		}
+		if errors.Is(err, chains.ErrRedemptionTxNotFound) {
+			p.logger.Warn("VAA was redeemed but its redemption tx was not found",
+				zap.String("vaaId", v.ID),
+				zap.Stringer("chain", v.ToChain),
+				zap.String("protocol", contract.Protocol),
+				zap.String("contract", contract.Address))
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		break
+	}
+	if redemption == nil {
+		return false, nil
+	}
+
+	var evmFee *consumer.EvmFee
+	if redemption.GasUsed != "" && redemption.EffectiveGasPrice != "" {
+		evmFee = &consumer.EvmFee{
+			GasUsed:           redemption.GasUsed,
+			EffectiveGasPrice: redemption.EffectiveGasPrice,
+		}
+	}
+
+	params := &consumer.ProcessTargetTxParams{
+		Source:         Source,
+		TrackID:        fmt.Sprintf("%s-%s", Source, v.ID),
+		VaaId:          v.ID,
+		ChainID:        v.ToChain,
+		Emitter:        vaa.EmitterAddress.String(),
+		TxHash:         redemption.TxHash,
+		BlockTimestamp: redemption.BlockTimestamp,
+		BlockHeight:    redemption.BlockNumber,
+		From:           redemption.From,
+		To:             redemption.To,
+		Status:         domain.DstTxStatusConfirmed,
+		EvmFee:         evmFee,
+		Metrics:        p.metrics,
+		P2pNetwork:     p.p2pNetwork,
+	}
+	if err := consumer.ProcessTargetTx(ctx, p.logger, p.repository, params, p.notionalCache); err != nil {
+		return false, err
+	}
+
+	p.logger.Info("Redemption found",
+		zap.String("vaaId", v.ID),
+		zap.Stringer("chain", v.ToChain),
+		zap.String("txHash", redemption.TxHash))
+	return true, nil
+}
+
+// findContracts returns the contracts of the target chain that redeem the VAAs of one of the appIds.
+func (p *RedemptionProber) findContracts(chainID sdk.ChainID, appIds []string) []chains.RedemptionContract {
+	var contracts []chains.RedemptionContract
+	for _, contract := range p.contracts[chainID] {
+		if slices.Contains(appIds, contract.Protocol) {
+			contracts = append(contracts, contract)
+		}
+	}
+	return contracts
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/cmd/service"
+)
+
+func main() {
+	execute()
+}
+
+func execute() error {
+	root := &cobra.Command{
+		Use: "webhook",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				service.Run()
+			}
+		},
+	}
+
+	addServiceCommand(root)
+
+	return root.Execute()
+}
+
+func addServiceCommand(root *cobra.Command) {
+	serviceCommand := &cobra.Command{
+		Use:   "service",
+		Short: "Run webhook as service",
+		Run: func(_ *cobra.Command, _ []string) {
+			service.Run()
+		},
+	}
+	root.AddCommand(serviceCommand)
+}
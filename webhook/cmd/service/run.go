@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/go-redis/redis/v8"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/sqs"
+	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
+	"github.com/wormhole-foundation/wormhole-explorer/common/health"
+	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
+	"github.com/wormhole-foundation/wormhole-explorer/common/vaaparser"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/config"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/consumer"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/delivery"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/dispatcher"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/http/infrastructure"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/http/subscription"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/processor"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/queue"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/storage"
+)
+
+func Run() {
+	rootCtx, rootCtxCancel := context.WithCancel(context.Background())
+
+	// load config
+	cfg, err := config.New(rootCtx)
+	if err != nil {
+		log.Fatal("Error loading config: ", err)
+	}
+
+	// initialize metrics
+	metrics := newMetrics(cfg)
+
+	// build logger
+	logger := logger.New("wormholescan-webhook", logger.WithLevel(cfg.LogLevel))
+	logger.Info("Starting wormholescan-webhook ...")
+
+	// initialize the database client
+	db, err := dbutil.Connect(rootCtx, logger, cfg.MongoURI, cfg.MongoDatabase, false)
+	if err != nil {
+		log.Fatal("Failed to initialize MongoDB client: ", err)
+	}
+
+	// create a new repository
+	repository := storage.NewRepository(logger, db.Database)
+
+	// start serving /health and /ready endpoints
+	healthChecks, err := makeHealthChecks(rootCtx, cfg, db.Database)
+	if err != nil {
+		logger.Fatal("Failed to create health checks", zap.Error(err))
+	}
+	subscriptionCtrl := subscription.NewController(repository, cfg.DeliveryAllowPrivate, logger)
+	server := infrastructure.NewServer(logger, cfg.Port, subscriptionCtrl, cfg.ApiKeys, cfg.PprofEnabled, healthChecks...)
+	server.Start()
+
+	// create and start a notification event consumer.
+	eventProcessor := processor.NewProcessor(repository, newPropertiesFunc(cfg.P2pNetwork, logger), logger, metrics)
+	notificationConsumeFunc := newNotificationConsumeFunc(rootCtx, cfg, metrics, logger)
+	notificationConsumer := consumer.New(notificationConsumeFunc, eventProcessor.Process, logger, cfg.ConsumerWorkerSize)
+	notificationConsumer.Start(rootCtx)
+
+	// create and start the delivery dispatcher.
+	dispatcherCfg := dispatcher.Config{
+		WorkersSize:  cfg.DispatcherWorkerSize,
+		Interval:     cfg.DispatcherInterval,
+		MaxAttempts:  cfg.DeliveryMaxAttempts,
+		Backoff:      delivery.Backoff{Base: cfg.DeliveryBackoffBase, Max: cfg.DeliveryBackoffMax},
+		LockDuration: 2 * cfg.DeliveryTimeout,
+	}
+	sender := delivery.NewSender(cfg.DeliveryTimeout, cfg.DeliveryAllowPrivate)
+	deliveryDispatcher := dispatcher.New(dispatcherCfg, repository, sender, logger, metrics)
+	deliveryDispatcher.Start(rootCtx)
+
+	logger.Info("Started wormholescan-webhook")
+
+	// Waiting for signal
+	sigterm := make(chan os.Signal, 1)
+	signal.Notify(sigterm, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case <-rootCtx.Done():
+		logger.Warn("Terminating with root context cancelled.")
+	case signal := <-sigterm:
+		logger.Info("Terminating with signal.", zap.String("signal", signal.String()))
+	}
+
+	// graceful shutdown
+	logger.Info("Cancelling root context...")
+	rootCtxCancel()
+
+	logger.Info("Closing Http server...")
+	server.Stop()
+
+	logger.Info("Closing MongoDB connection...")
+	db.DisconnectWithTimeout(10 * time.Second)
+
+	logger.Info("Terminated wormholescan-webhook")
+}
+
+func newAwsConfig(ctx context.Context, cfg *config.ServiceConfiguration) (aws.Config, error) {
+
+	region := cfg.AwsRegion
+
+	if cfg.AwsAccessKeyID != "" && cfg.AwsSecretAccessKey != "" {
+
+		credentials := credentials.NewStaticCredentialsProvider(cfg.AwsAccessKeyID, cfg.AwsSecretAccessKey, "")
+
+		customResolver := aws.EndpointResolverFunc(func(service, region string) (aws.Endpoint, error) {
+			if cfg.AwsEndpoint != "" {
+				return aws.Endpoint{
+					PartitionID:   "aws",
+					URL:           cfg.AwsEndpoint,
+					SigningRegion: region,
+				}, nil
+			}
+
+			return aws.Endpoint{}, &aws.EndpointNotFoundError{}
+		})
+
+		awsCfg, err := awsconfig.LoadDefaultConfig(
+			ctx,
+			awsconfig.WithRegion(region),
+			awsconfig.WithEndpointResolver(customResolver),
+			awsconfig.WithCredentialsProvider(credentials),
+		)
+		return awsCfg, err
+	}
+	return awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(region))
+}
+
+func newConsumer(ctx context.Context, cfg *config.ServiceConfiguration, sqsUrl, stream string) (messagebus.Consumer, error) {
+	if cfg.MessageBus == messagebus.BackendRedis {
+		return messagebus.NewRedisStreamConsumer(ctx, newMessageBusRedisClient(cfg), stream, cfg.MessageBusConsumerGroup,
+			messagebus.WithRedisMaxMessages(10),
+			messagebus.WithRedisVisibilityTimeout(60*time.Second))
+	}
+
+	awsconfig, err := newAwsConfig(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	consumer, err := sqs.NewConsumer(
+		awsconfig,
+		sqsUrl,
+		sqs.WithMaxMessages(10),
+		sqs.WithVisibilityTimeout(60),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return messagebus.NewSQSConsumer(consumer, messagebus.WithSNSEnvelope()), nil
+}
+
+func newMessageBusRedisClient(cfg *config.ServiceConfiguration) *redis.Client {
+	return redis.NewClient(&redis.Options{Addr: cfg.MessageBusRedisURI})
+}
+
+func makeHealthChecks(
+	ctx context.Context,
+	cfg *config.ServiceConfiguration,
+	db *mongo.Database,
+) ([]health.Check, error) {
+
+	if cfg.MessageBus == messagebus.BackendRedis {
+		return []health.Check{
+			health.Redis(newMessageBusRedisClient(cfg)),
+			health.Mongo(db),
+		}, nil
+	}
+
+	awsConfig, err := newAwsConfig(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	plugins := []health.Check{
+		health.SQS(awsConfig, cfg.NotificationsSQSUrl),
+		health.Mongo(db),
+	}
+
+	return plugins, nil
+}
+
+// newPropertiesFunc decodes the standardized properties of a VAA with the in-process payload parser.
+func newPropertiesFunc(p2pNetwork string, logger *zap.Logger) processor.PropertiesFunc {
+	parser := vaaparser.New(p2pNetwork, nil, logger)
+	return func(v *vaa.VAA) *storage.StandardizedPropertiesDoc {
+		response, err := parser.ParseVaaWithStandarizedProperties(v)
+		if err != nil {
+			return nil
+		}
+		return &storage.StandardizedPropertiesDoc{
+			AppIDs:      response.StandardizedProperties.AppIds,
+			FromAddress: response.StandardizedProperties.FromAddress,
+			ToAddress:   response.StandardizedProperties.ToAddress,
+		}
+	}
+}
+
+func newMetrics(cfg *config.ServiceConfiguration) metrics.Metrics {
+	if !cfg.MetricsEnabled {
+		return metrics.NewDummyMetrics()
+	}
+	return metrics.NewPrometheusMetrics(cfg.Environment)
+}
+
+func newNotificationConsumeFunc(
+	ctx context.Context,
+	cfg *config.ServiceConfiguration,
+	metrics metrics.Metrics,
+	logger *zap.Logger,
+) queue.ConsumeFunc {
+
+	consumer, err := newConsumer(ctx, cfg, cfg.NotificationsSQSUrl, cfg.NotificationsStream)
+	if err != nil {
+		logger.Fatal("failed to create consumer", zap.Error(err))
+	}
+
+	notificationQueue := queue.NewEventQueue(consumer, queue.NewNotificationEventConverter(logger), metrics, logger)
+	return notificationQueue.Consume
+}
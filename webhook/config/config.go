@@ -0,0 +1,76 @@
+package config
+
+import (
+	"context"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/sethvargo/go-envconfig"
+	"github.com/wormhole-foundation/wormhole-explorer/common/messagebus"
+)
+
+const (
+	EnvironmentLocal = "local"
+)
+
+// p2p network constants.
+const (
+	P2pMainNet = "mainnet"
+	P2pTestNet = "testnet"
+	P2pDevNet  = "devnet"
+)
+
+// ServiceConfiguration represents the application configuration when running as service with default values.
+type ServiceConfiguration struct {
+	// Global configuration
+	Environment    string `env:"ENVIRONMENT,required"`
+	LogLevel       string `env:"LOG_LEVEL,default=INFO"`
+	Port           string `env:"PORT,default=8000"`
+	PprofEnabled   bool   `env:"PPROF_ENABLED,default=false"`
+	P2pNetwork     string `env:"P2P_NETWORK,required"`
+	MetricsEnabled bool   `env:"METRICS_ENABLED,default=false"`
+	// ApiKeys maps the owners of the subscriptions to their api key, e.g. owner1:key1,owner2:key2.
+	ApiKeys map[string]string `env:"API_KEYS,required"`
+	// Notification event consumer configuration
+	ConsumerWorkerSize int `env:"CONSUMER_WORKER_SIZE,default=1"`
+
+	// Database configuration
+	MongoURI      string `env:"MONGODB_URI,required"`
+	MongoDatabase string `env:"MONGODB_DATABASE,required"`
+	// Message bus configuration, the backend of the queues is sqs or redis.
+	MessageBus              string `env:"MESSAGE_BUS,default=sqs"`
+	MessageBusRedisURI      string `env:"MESSAGE_BUS_REDIS_URI"`
+	MessageBusConsumerGroup string `env:"MESSAGE_BUS_CONSUMER_GROUP,default=webhook"`
+	NotificationsStream     string `env:"NOTIFICATIONS_STREAM,default=notifications"`
+	// AWS configuration
+	AwsEndpoint         string `env:"AWS_ENDPOINT"`
+	AwsAccessKeyID      string `env:"AWS_ACCESS_KEY_ID"`
+	AwsSecretAccessKey  string `env:"AWS_SECRET_ACCESS_KEY"`
+	AwsRegion           string `env:"AWS_REGION"`
+	NotificationsSQSUrl string `env:"NOTIFICATIONS_SQS_URL"`
+
+	// Delivery configuration
+	DispatcherWorkerSize int           `env:"DISPATCHER_WORKER_SIZE,default=5"`
+	DispatcherInterval   time.Duration `env:"DISPATCHER_INTERVAL,default=1s"`
+	DeliveryTimeout      time.Duration `env:"DELIVERY_TIMEOUT,default=10s"`
+	DeliveryMaxAttempts  int           `env:"DELIVERY_MAX_ATTEMPTS,default=8"`
+	DeliveryBackoffBase  time.Duration `env:"DELIVERY_BACKOFF_BASE,default=5s"`
+	DeliveryBackoffMax   time.Duration `env:"DELIVERY_BACKOFF_MAX,default=1h"`
+	// DeliveryAllowPrivate allows webhook urls with private addresses, only for local environments.
+	DeliveryAllowPrivate bool `env:"DELIVERY_ALLOW_PRIVATE,default=false"`
+}
+
+// New creates a configuration with the values from .env file and environment variables.
+func New(ctx context.Context) (*ServiceConfiguration, error) {
+	_ = godotenv.Load(".env", "../.env")
+
+	var configuration ServiceConfiguration
+	if err := envconfig.Process(ctx, &configuration); err != nil {
+		return nil, err
+	}
+	if err := messagebus.ValidateBackend(configuration.MessageBus); err != nil {
+		return nil, err
+	}
+
+	return &configuration, nil
+}
@@ -0,0 +1,79 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/processor"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/queue"
+	"go.uber.org/zap"
+)
+
+// Consumer consumer struct definition.
+type Consumer struct {
+	consumeFunc queue.ConsumeFunc
+	processor   processor.ProcessorFunc
+	logger      *zap.Logger
+	workersSize int
+}
+
+// New creates a new notification event consumer.
+func New(
+	consumeFunc queue.ConsumeFunc,
+	processor processor.ProcessorFunc,
+	logger *zap.Logger,
+	workersSize int,
+) *Consumer {
+
+	c := Consumer{
+		consumeFunc: consumeFunc,
+		processor:   processor,
+		logger:      logger,
+		workersSize: workersSize,
+	}
+
+	return &c
+}
+
+// Start consumes messages from the notification queue and schedules the webhook deliveries.
+func (c *Consumer) Start(ctx context.Context) {
+	ch := c.consumeFunc(ctx)
+	for i := 0; i < c.workersSize; i++ {
+		go c.producerLoop(ctx, ch)
+	}
+}
+
+func (c *Consumer) producerLoop(ctx context.Context, ch <-chan queue.ConsumerMessage) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-ch:
+			c.processEvent(ctx, msg)
+		}
+	}
+}
+
+func (c *Consumer) processEvent(ctx context.Context, msg queue.ConsumerMessage) {
+	event := msg.Data()
+
+	logger := c.logger.With(
+		zap.String("trackId", event.TrackID),
+		zap.String("event", event.Type),
+		zap.String("vaaId", event.VaaID))
+
+	if msg.IsExpired() {
+		msg.Failed()
+		logger.Debug("event is expired")
+		return
+	}
+
+	err := c.processor(ctx, event)
+	if err != nil {
+		msg.Failed()
+		logger.Error("error processing event", zap.Error(err))
+		return
+	}
+
+	msg.Done()
+	logger.Debug("event processed")
+}
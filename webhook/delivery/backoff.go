@@ -0,0 +1,27 @@
+package delivery
+
+import "time"
+
+// Backoff computes the delay before the next attempt of a failed delivery.
+type Backoff struct {
+	Base time.Duration
+	Max  time.Duration
+}
+
+// Next returns the delay after the given number of failed attempts: base * 2^(attempts-1), capped at max.
+func (b Backoff) Next(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	delay := b.Base
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= b.Max || delay <= 0 {
+			return b.Max
+		}
+	}
+	if delay > b.Max {
+		return b.Max
+	}
+	return delay
+}
@@ -0,0 +1,152 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"event":"vaa-signed"}`)
+	signature := Sign("secret", 1700000000, body)
+
+	if signature != Sign("secret", 1700000000, body) {
+		t.Fatal("signature is not deterministic")
+	}
+	if !Verify("secret", 1700000000, body, signature) {
+		t.Fatal("valid signature was rejected")
+	}
+	if Verify("other-secret", 1700000000, body, signature) {
+		t.Fatal("signature with a different secret was accepted")
+	}
+	if Verify("secret", 1700000001, body, signature) {
+		t.Fatal("signature with a different timestamp was accepted")
+	}
+	if Verify("secret", 1700000000, []byte(`{}`), signature) {
+		t.Fatal("signature of a different body was accepted")
+	}
+}
+
+func TestBackoffNext(t *testing.T) {
+	b := Backoff{Base: time.Second, Max: 10 * time.Second}
+	tests := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{0, time.Second},
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 8 * time.Second},
+		{5, 10 * time.Second},
+		{100, 10 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := b.Next(tt.attempts); got != tt.expected {
+			t.Errorf("Next(%d) = %s, expected %s", tt.attempts, got, tt.expected)
+		}
+	}
+}
+
+func TestSenderSend(t *testing.T) {
+	body := []byte(`{"event":"transfer-redeemed"}`)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ := io.ReadAll(r.Body)
+		timestamp, err := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+		if err != nil || !Verify("secret", timestamp, received, r.Header.Get(HeaderSignature)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get(HeaderEvent) != "transfer-redeemed" || r.Header.Get(HeaderDelivery) != "delivery-1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sender := NewSender(time.Second, true)
+	request := Request{
+		DeliveryID: "delivery-1",
+		EventType:  "transfer-redeemed",
+		URL:        server.URL,
+		Secret:     "secret",
+		Body:       body,
+	}
+
+	result := sender.Send(context.Background(), &request)
+	if result.Err != nil || result.StatusCode != http.StatusNoContent {
+		t.Fatalf("unexpected result: %d %v", result.StatusCode, result.Err)
+	}
+
+	request.Secret = "wrong-secret"
+	result = sender.Send(context.Background(), &request)
+	if result.Err == nil || result.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected unauthorized failure, got: %d %v", result.StatusCode, result.Err)
+	}
+}
+
+func TestSenderSend_NonPublicAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sender := NewSender(time.Second, false)
+	result := sender.Send(context.Background(), &Request{URL: server.URL, Secret: "secret"})
+	if !errors.Is(result.Err, ErrNonPublicAddress) {
+		t.Fatalf("expected non public address error, got: %v", result.Err)
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip       string
+		expected bool
+	}{
+		{"8.8.8.8", true},
+		{"2606:4700:4700::1111", true},
+		{"127.0.0.1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"::1", false},
+		{"fd00::1", false},
+		{"fe80::1", false},
+		{"::ffff:127.0.0.1", false},
+	}
+	for _, tt := range tests {
+		if got := IsPublicIP(net.ParseIP(tt.ip)); got != tt.expected {
+			t.Errorf("IsPublicIP(%s) = %v, expected %v", tt.ip, got, tt.expected)
+		}
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	tests := []struct {
+		url   string
+		valid bool
+	}{
+		{"https://8.8.8.8/hook", true},
+		{"http://127.0.0.1:8080/hook", false},
+		{"http://localhost/hook", false},
+		{"http://[::1]/hook", false},
+		{"http://169.254.169.254/latest/meta-data", false},
+	}
+	for _, tt := range tests {
+		err := ValidateURL(context.Background(), tt.url)
+		if (err == nil) != tt.valid {
+			t.Errorf("ValidateURL(%s) = %v, expected valid %v", tt.url, err, tt.valid)
+		}
+	}
+}
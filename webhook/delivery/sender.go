@@ -0,0 +1,84 @@
+// Package delivery sends the signed webhook requests.
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxResponseBodySize is the size of the response body read to report failed deliveries.
+const maxResponseBodySize = 512
+
+// Request is a webhook delivery request.
+type Request struct {
+	DeliveryID string
+	EventType  string
+	URL        string
+	Secret     string
+	Body       []byte
+}
+
+// Result is the result of a delivery attempt.
+type Result struct {
+	StatusCode int
+	Err        error
+}
+
+// Sender sends webhook deliveries.
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender creates a new Sender. Unless allowPrivate is set, the connections to non public
+// addresses are rejected, including the redirects.
+func NewSender(timeout time.Duration, allowPrivate bool) *Sender {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = publicOnlyControl
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// the requests are not sent through a proxy, so the dialer checks the address of the webhook.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &Sender{
+		client: &http.Client{Timeout: timeout, Transport: transport},
+	}
+}
+
+// Send posts the signed body to the subscription url. Any response status other than 2xx is a failure.
+func (s *Sender) Send(ctx context.Context, r *Request) Result {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.URL, bytes.NewReader(r.Body))
+	if err != nil {
+		return Result{Err: err}
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "wormscan-webhook")
+	req.Header.Set(HeaderEvent, r.EventType)
+	req.Header.Set(HeaderDelivery, r.DeliveryID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(r.Secret, timestamp, r.Body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return Result{Err: err}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+		return Result{
+			StatusCode: resp.StatusCode,
+			Err:        fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body),
+		}
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return Result{StatusCode: resp.StatusCode}
+}
@@ -0,0 +1,37 @@
+package delivery
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+// Headers sent with each delivery.
+const (
+	HeaderSignature = "X-Wormscan-Signature"
+	HeaderEvent     = "X-Wormscan-Event"
+	HeaderDelivery  = "X-Wormscan-Delivery"
+	HeaderTimestamp = "X-Wormscan-Timestamp"
+)
+
+// signaturePrefix identifies the algorithm of the signature header.
+const signaturePrefix = "sha256="
+
+// Sign returns the signature of a delivery body sent at the given unix timestamp.
+//
+// The signed message is "<timestamp>.<body>", so receivers can reject replayed deliveries
+// by checking the X-Wormscan-Timestamp header.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of a delivery body.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	expected := Sign(secret, timestamp, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
@@ -0,0 +1,130 @@
+// Package dispatcher sends the scheduled webhook deliveries, retrying the failed ones with
+// exponential backoff and moving them to the dead letter collection after the last attempt.
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/delivery"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/storage"
+	"go.uber.org/zap"
+)
+
+// Config is the dispatcher configuration.
+type Config struct {
+	WorkersSize int
+	Interval    time.Duration
+	MaxAttempts int
+	Backoff     delivery.Backoff
+	// LockDuration is how long a delivery is reserved for a worker, it must be longer than the request timeout.
+	LockDuration time.Duration
+}
+
+// Dispatcher sends the pending deliveries.
+type Dispatcher struct {
+	cfg        Config
+	repository *storage.Repository
+	sender     *delivery.Sender
+	logger     *zap.Logger
+	metrics    metrics.Metrics
+}
+
+// New creates a new dispatcher.
+func New(
+	cfg Config,
+	repository *storage.Repository,
+	sender *delivery.Sender,
+	logger *zap.Logger,
+	metrics metrics.Metrics,
+) *Dispatcher {
+	return &Dispatcher{
+		cfg:        cfg,
+		repository: repository,
+		sender:     sender,
+		logger:     logger.With(zap.String("module", "dispatcher")),
+		metrics:    metrics,
+	}
+}
+
+// Start runs the dispatcher workers until the context is cancelled.
+func (d *Dispatcher) Start(ctx context.Context) {
+	for i := 0; i < d.cfg.WorkersSize; i++ {
+		go d.workerLoop(ctx)
+	}
+}
+
+func (d *Dispatcher) workerLoop(ctx context.Context) {
+	for {
+		// send the due deliveries until there are no more, then wait for the next interval.
+		sent, err := d.dispatchNext(ctx)
+		if err != nil {
+			d.logger.Error("error dispatching delivery", zap.Error(err))
+		}
+		if sent && err == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.cfg.Interval):
+		}
+	}
+}
+
+// dispatchNext sends the next due delivery. It returns false if there was no delivery to send.
+func (d *Dispatcher) dispatchNext(ctx context.Context) (bool, error) {
+	doc, err := d.repository.AcquireDelivery(ctx, d.cfg.LockDuration)
+	if err != nil || doc == nil {
+		return false, err
+	}
+
+	logger := d.logger.With(
+		zap.String("deliveryId", doc.ID),
+		zap.String("subscriptionId", doc.SubscriptionID),
+		zap.String("event", doc.EventType),
+		zap.String("vaaId", doc.VaaID))
+
+	subscription, err := d.repository.FindSubscriptionByID(ctx, doc.SubscriptionID)
+	if errors.Is(err, storage.ErrNotFound) || (err == nil && !subscription.Active) {
+		logger.Debug("subscription is not active, discarding delivery")
+		return true, d.repository.CompleteDelivery(ctx, doc.ID)
+	}
+	if err != nil {
+		return true, err
+	}
+
+	result := d.sender.Send(ctx, &delivery.Request{
+		DeliveryID: doc.ID,
+		EventType:  doc.EventType,
+		URL:        subscription.URL,
+		Secret:     subscription.Secret,
+		Body:       doc.Payload,
+	})
+	if result.Err == nil {
+		d.metrics.IncDeliverySucceeded(doc.EventType)
+		logger.Debug("delivery sent", zap.Int("attempts", doc.Attempts+1))
+		return true, d.repository.CompleteDelivery(ctx, doc.ID)
+	}
+
+	d.metrics.IncDeliveryFailed(doc.EventType)
+	doc.Attempts++
+	doc.LastError = result.Err.Error()
+	doc.LastStatusCode = result.StatusCode
+
+	if doc.Attempts >= d.cfg.MaxAttempts {
+		d.metrics.IncDeliveryDeadLetter(doc.EventType)
+		logger.Warn("delivery failed after the last attempt, moving it to dead letter",
+			zap.Int("attempts", doc.Attempts), zap.Error(result.Err))
+		return true, d.repository.MoveToDeadLetter(ctx, doc)
+	}
+
+	doc.NextAttemptAt = time.Now().Add(d.cfg.Backoff.Next(doc.Attempts))
+	logger.Info("delivery failed, scheduling retry",
+		zap.Int("attempts", doc.Attempts),
+		zap.Time("nextAttemptAt", doc.NextAttemptAt),
+		zap.Error(result.Err))
+	return true, d.repository.RescheduleDelivery(ctx, doc)
+}
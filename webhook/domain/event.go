@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// Event types that can be subscribed to.
+const (
+	// EventVaaSigned is sent when the guardians sign a VAA.
+	EventVaaSigned = "vaa-signed"
+	// EventSourceTxConfirmed is sent when the source transaction of a VAA is confirmed by the tx-tracker.
+	EventSourceTxConfirmed = "source-tx-confirmed"
+	// EventTransferRedeemed is sent when a VAA is redeemed on the target chain.
+	EventTransferRedeemed = "transfer-redeemed"
+)
+
+// IsValidEventType returns true if the event type can be subscribed to.
+func IsValidEventType(eventType string) bool {
+	switch eventType {
+	case EventVaaSigned, EventSourceTxConfirmed, EventTransferRedeemed:
+		return true
+	}
+	return false
+}
+
+// Event is a wormhole message lifecycle event delivered to the webhook subscribers.
+type Event struct {
+	TrackID        string      `json:"-"`
+	Type           string      `json:"type"`
+	VaaID          string      `json:"vaaId"`
+	EmitterChain   sdk.ChainID `json:"emitterChain"`
+	EmitterAddress string      `json:"emitterAddress"`
+	Sequence       string      `json:"sequence"`
+	Timestamp      *time.Time  `json:"timestamp,omitempty"`
+	// ChainID is the chain where the transaction of the event happened.
+	ChainID sdk.ChainID `json:"chainId"`
+	TxHash  string      `json:"txHash,omitempty"`
+	From    string      `json:"from,omitempty"`
+	To      string      `json:"to,omitempty"`
+	Status  string      `json:"status,omitempty"`
+	// Vaa is the signed VAA, only set in the vaa-signed events.
+	Vaa []byte `json:"-"`
+	// AppIDs, FromAddress and ToAddress are taken from the parsed VAA or decoded from the VAA payload.
+	AppIDs      []string `json:"appIds,omitempty"`
+	FromAddress string   `json:"fromAddress,omitempty"`
+	ToAddress   string   `json:"toAddress,omitempty"`
+}
+
+// Payload is the body of a webhook delivery.
+type Payload struct {
+	DeliveryID string    `json:"deliveryId"`
+	Event      string    `json:"event"`
+	CreatedAt  time.Time `json:"createdAt"`
+	Data       *Event    `json:"data"`
+}
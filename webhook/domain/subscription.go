@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// Subscription is a webhook registered to receive events.
+type Subscription struct {
+	ID        string     `bson:"_id" json:"id"`
+	Owner     string     `bson:"owner" json:"-"`
+	URL       string     `bson:"url" json:"url"`
+	Secret    string     `bson:"secret" json:"-"`
+	Events    []string   `bson:"events" json:"events"`
+	Filter    Filter     `bson:"filter" json:"filter"`
+	Active    bool       `bson:"active" json:"active"`
+	CreatedAt time.Time  `bson:"createdAt" json:"createdAt"`
+	UpdatedAt *time.Time `bson:"updatedAt" json:"updatedAt,omitempty"`
+}
+
+// Filter restricts the events sent to a subscription. Empty fields match every event.
+type Filter struct {
+	ChainIDs       []sdk.ChainID `bson:"chainIds,omitempty" json:"chainIds,omitempty"`
+	EmitterAddress string        `bson:"emitterAddress,omitempty" json:"emitterAddress,omitempty"`
+	AppID          string        `bson:"appId,omitempty" json:"appId,omitempty"`
+	FromAddress    string        `bson:"fromAddress,omitempty" json:"fromAddress,omitempty"`
+	ToAddress      string        `bson:"toAddress,omitempty" json:"toAddress,omitempty"`
+}
+
+// Match returns true when the subscription is active, subscribed to the event type and the event passes its filter.
+func (s *Subscription) Match(e *Event) bool {
+	if !s.Active || !contains(s.Events, e.Type) {
+		return false
+	}
+	f := s.Filter
+	if len(f.ChainIDs) > 0 && !containsChain(f.ChainIDs, e.EmitterChain) {
+		return false
+	}
+	if f.EmitterAddress != "" && !equalAddress(f.EmitterAddress, e.EmitterAddress) {
+		return false
+	}
+	if f.AppID != "" && !containsFold(e.AppIDs, f.AppID) {
+		return false
+	}
+	if f.FromAddress != "" && !equalAddress(f.FromAddress, e.FromAddress) && !equalAddress(f.FromAddress, e.From) {
+		return false
+	}
+	if f.ToAddress != "" && !equalAddress(f.ToAddress, e.ToAddress) && !equalAddress(f.ToAddress, e.To) {
+		return false
+	}
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsChain(chainIDs []sdk.ChainID, chainID sdk.ChainID) bool {
+	for _, c := range chainIDs {
+		if c == chainID {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// equalAddress compares hex addresses ignoring case and the 0x prefix, and any other
+// format (e.g. base58) as is, since it is case sensitive.
+func equalAddress(a, b string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+	if a == b {
+		return true
+	}
+	return isHex(a) && isHex(b) && strings.EqualFold(strings.TrimPrefix(a, "0x"), strings.TrimPrefix(b, "0x"))
+}
+
+func isHex(s string) bool {
+	s = strings.TrimPrefix(s, "0x")
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"testing"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestSubscriptionMatch(t *testing.T) {
+	event := &Event{
+		Type:           EventTransferRedeemed,
+		VaaID:          "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/107429",
+		EmitterChain:   sdk.ChainIDEthereum,
+		EmitterAddress: "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585",
+		Sequence:       "107429",
+		ChainID:        sdk.ChainIDSolana,
+		To:             "BrgQ9ovHGxGX8hgkDvktTZwKJbpZyR5QvH6ZVWoThBrU",
+		AppIDs:         []string{"PORTAL_TOKEN_BRIDGE"},
+		FromAddress:    "0xAbC0000000000000000000000000000000000001",
+	}
+
+	tests := []struct {
+		name     string
+		s        Subscription
+		expected bool
+	}{
+		{"no filter", Subscription{Active: true, Events: []string{EventTransferRedeemed}}, true},
+		{"inactive", Subscription{Active: false, Events: []string{EventTransferRedeemed}}, false},
+		{"other event", Subscription{Active: true, Events: []string{EventVaaSigned}}, false},
+		{"chain", Subscription{Active: true, Events: []string{EventTransferRedeemed}, Filter: Filter{ChainIDs: []sdk.ChainID{sdk.ChainIDEthereum}}}, true},
+		{"other chain", Subscription{Active: true, Events: []string{EventTransferRedeemed}, Filter: Filter{ChainIDs: []sdk.ChainID{sdk.ChainIDBSC}}}, false},
+		{"emitter", Subscription{Active: true, Events: []string{EventTransferRedeemed}, Filter: Filter{EmitterAddress: "0x0000000000000000000000003EE18B2214AFF97000D974CF647E7C347E8FA585"}}, true},
+		{"appId", Subscription{Active: true, Events: []string{EventTransferRedeemed}, Filter: Filter{AppID: "portal_token_bridge"}}, true},
+		{"other appId", Subscription{Active: true, Events: []string{EventTransferRedeemed}, Filter: Filter{AppID: "CCTP_WORMHOLE_INTEGRATION"}}, false},
+		{"from address", Subscription{Active: true, Events: []string{EventTransferRedeemed}, Filter: Filter{FromAddress: "0xabc0000000000000000000000000000000000001"}}, true},
+		{"to address", Subscription{Active: true, Events: []string{EventTransferRedeemed}, Filter: Filter{ToAddress: "BrgQ9ovHGxGX8hgkDvktTZwKJbpZyR5QvH6ZVWoThBrU"}}, true},
+		{"to address case sensitive", Subscription{Active: true, Events: []string{EventTransferRedeemed}, Filter: Filter{ToAddress: "brgq9ovhgxgx8hgkdvkttzwkjbpzyr5qvh6zvwothbru"}}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.s.Match(event); got != tt.expected {
+			t.Errorf("%s: Match() = %v, expected %v", tt.name, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Package auth authenticates the requests of the subscription api by api key.
+package auth
+
+import (
+	"crypto/subtle"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// HeaderApiKey is the header with the api key of the owner of the subscriptions.
+const HeaderApiKey = "X-API-KEY"
+
+// ownerKey is the key of the authenticated owner in the request locals.
+const ownerKey = "owner"
+
+// New creates a middleware that rejects the requests without a valid api key and
+// stores the owner of the key in the request. apiKeys maps each owner to its api key.
+func New(apiKeys map[string]string) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		owner, ok := findOwner(apiKeys, ctx.Get(HeaderApiKey))
+		if !ok {
+			return fiber.ErrUnauthorized
+		}
+		ctx.Locals(ownerKey, owner)
+		return ctx.Next()
+	}
+}
+
+// Owner returns the owner authenticated by the middleware.
+func Owner(ctx *fiber.Ctx) string {
+	owner, _ := ctx.Locals(ownerKey).(string)
+	return owner
+}
+
+// findOwner compares the api key with every configured key in constant time.
+func findOwner(apiKeys map[string]string, apiKey string) (string, bool) {
+	if apiKey == "" {
+		return "", false
+	}
+	var found string
+	for owner, key := range apiKeys {
+		if key != "" && subtle.ConstantTimeCompare([]byte(key), []byte(apiKey)) == 1 {
+			found = owner
+		}
+	}
+	return found, found != ""
+}
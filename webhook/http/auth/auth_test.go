@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestAuth(t *testing.T) {
+	app := fiber.New()
+	app.Use(New(map[string]string{"acme": "key-acme", "other": "key-other"}))
+	app.Get("/", func(ctx *fiber.Ctx) error {
+		return ctx.SendString(Owner(ctx))
+	})
+
+	tests := []struct {
+		name   string
+		apiKey string
+		status int
+		owner  string
+	}{
+		{"without key", "", fiber.StatusUnauthorized, ""},
+		{"invalid key", "key", fiber.StatusUnauthorized, ""},
+		{"valid key", "key-other", fiber.StatusOK, "other"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			if tt.apiKey != "" {
+				req.Header.Set(HeaderApiKey, tt.apiKey)
+			}
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != tt.status {
+				t.Fatalf("expected status %d, got %d", tt.status, resp.StatusCode)
+			}
+			if tt.owner == "" {
+				return
+			}
+			body, _ := io.ReadAll(resp.Body)
+			if string(body) != tt.owner {
+				t.Errorf("expected owner %s, got %s", tt.owner, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package subscription
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/delivery"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/http/auth"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/storage"
+	"go.uber.org/zap"
+)
+
+const maxPageSize = 100
+
+// Controller definition.
+type Controller struct {
+	logger       *zap.Logger
+	repository   *storage.Repository
+	allowPrivate bool
+}
+
+// NewController creates a Controller instance. Unless allowPrivate is set, the urls
+// of the subscriptions must resolve to public addresses.
+func NewController(repository *storage.Repository, allowPrivate bool, logger *zap.Logger) *Controller {
+	return &Controller{repository: repository, allowPrivate: allowPrivate, logger: logger}
+}
+
+// CreateSubscriptionRequest is the request to create a subscription.
+type CreateSubscriptionRequest struct {
+	URL    string        `json:"url"`
+	Events []string      `json:"events"`
+	Filter domain.Filter `json:"filter"`
+}
+
+// CreateSubscriptionResponse is the response of a created subscription.
+// The secret used to sign the deliveries is only returned here.
+type CreateSubscriptionResponse struct {
+	*domain.Subscription
+	Secret string `json:"secret"`
+}
+
+// Create registers a new subscription of the authenticated owner.
+func (c *Controller) Create(ctx *fiber.Ctx) error {
+	var request CreateSubscriptionRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+	}
+
+	if u, err := url.ParseRequestURI(request.URL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid url")
+	}
+	if !c.allowPrivate {
+		if err := delivery.ValidateURL(ctx.Context(), request.URL); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid url: "+err.Error())
+		}
+	}
+	if len(request.Events) == 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "events are required")
+	}
+	for _, e := range request.Events {
+		if !domain.IsValidEventType(e) {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid event "+e)
+		}
+	}
+
+	id, err := randomHex(16)
+	if err != nil {
+		return err
+	}
+	secret, err := randomHex(32)
+	if err != nil {
+		return err
+	}
+
+	s := &domain.Subscription{
+		ID:        id,
+		Owner:     auth.Owner(ctx),
+		URL:       request.URL,
+		Secret:    secret,
+		Events:    request.Events,
+		Filter:    request.Filter,
+		Active:    true,
+		CreatedAt: time.Now(),
+	}
+	if err := c.repository.InsertSubscription(ctx.Context(), s); err != nil {
+		c.logger.Error("error inserting subscription", zap.Error(err))
+		return err
+	}
+
+	c.logger.Info("subscription created", zap.String("id", s.ID), zap.String("owner", s.Owner), zap.Strings("events", s.Events))
+	return ctx.Status(fiber.StatusCreated).JSON(CreateSubscriptionResponse{Subscription: s, Secret: secret})
+}
+
+// FindAll returns the subscriptions of the authenticated owner.
+func (c *Controller) FindAll(ctx *fiber.Ctx) error {
+	page := ctx.QueryInt("page", 0)
+	pageSize := ctx.QueryInt("pageSize", 50)
+	if page < 0 || pageSize <= 0 || pageSize > maxPageSize {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid pagination")
+	}
+
+	subscriptions, err := c.repository.FindSubscriptions(ctx.Context(), auth.Owner(ctx), int64(page*pageSize), int64(pageSize))
+	if err != nil {
+		c.logger.Error("error finding subscriptions", zap.Error(err))
+		return err
+	}
+	return ctx.JSON(subscriptions)
+}
+
+// FindByID returns a subscription of the authenticated owner.
+func (c *Controller) FindByID(ctx *fiber.Ctx) error {
+	s, err := c.repository.FindOwnedSubscription(ctx.Context(), auth.Owner(ctx), ctx.Params("id"))
+	if errors.Is(err, storage.ErrNotFound) {
+		return fiber.ErrNotFound
+	}
+	if err != nil {
+		c.logger.Error("error finding subscription", zap.Error(err))
+		return err
+	}
+	return ctx.JSON(s)
+}
+
+// Delete removes a subscription of the authenticated owner and its pending deliveries.
+func (c *Controller) Delete(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	err := c.repository.DeleteSubscription(ctx.Context(), auth.Owner(ctx), id)
+	if errors.Is(err, storage.ErrNotFound) {
+		return fiber.ErrNotFound
+	}
+	if err != nil {
+		c.logger.Error("error deleting subscription", zap.Error(err))
+		return err
+	}
+	c.logger.Info("subscription deleted", zap.String("id", id))
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+// FindDeadLetters returns the deliveries of a subscription of the authenticated owner that failed after the last attempt.
+func (c *Controller) FindDeadLetters(ctx *fiber.Ctx) error {
+	page := ctx.QueryInt("page", 0)
+	pageSize := ctx.QueryInt("pageSize", 50)
+	if page < 0 || pageSize <= 0 || pageSize > maxPageSize {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid pagination")
+	}
+
+	s, err := c.repository.FindOwnedSubscription(ctx.Context(), auth.Owner(ctx), ctx.Params("id"))
+	if errors.Is(err, storage.ErrNotFound) {
+		return fiber.ErrNotFound
+	}
+	if err != nil {
+		c.logger.Error("error finding subscription", zap.Error(err))
+		return err
+	}
+
+	deadLetters, err := c.repository.FindDeadLetters(ctx.Context(), s.ID, int64(page*pageSize), int64(pageSize))
+	if err != nil {
+		c.logger.Error("error finding dead letters", zap.Error(err))
+		return err
+	}
+	return ctx.JSON(deadLetters)
+}
+
+func randomHex(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,30 @@
+package metrics
+
+// DummyMetrics is a dummy implementation of Metric interface.
+type DummyMetrics struct{}
+
+// NewDummyMetrics returns a new instance of DummyMetrics.
+func NewDummyMetrics() *DummyMetrics {
+	return &DummyMetrics{}
+}
+
+// IncEventConsumedQueue dummy implementation.
+func (d *DummyMetrics) IncEventConsumedQueue(eventType string) {}
+
+// IncEventProcessed dummy implementation.
+func (d *DummyMetrics) IncEventProcessed(eventType string) {}
+
+// IncEventFailed dummy implementation.
+func (d *DummyMetrics) IncEventFailed(eventType string) {}
+
+// IncDeliveryScheduled dummy implementation.
+func (d *DummyMetrics) IncDeliveryScheduled(eventType string) {}
+
+// IncDeliverySucceeded dummy implementation.
+func (d *DummyMetrics) IncDeliverySucceeded(eventType string) {}
+
+// IncDeliveryFailed dummy implementation.
+func (d *DummyMetrics) IncDeliveryFailed(eventType string) {}
+
+// IncDeliveryDeadLetter dummy implementation.
+func (d *DummyMetrics) IncDeliveryDeadLetter(eventType string) {}
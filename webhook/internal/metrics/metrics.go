@@ -0,0 +1,13 @@
+package metrics
+
+const serviceName = "wormscan-webhook"
+
+type Metrics interface {
+	IncEventConsumedQueue(eventType string)
+	IncEventProcessed(eventType string)
+	IncEventFailed(eventType string)
+	IncDeliveryScheduled(eventType string)
+	IncDeliverySucceeded(eventType string)
+	IncDeliveryFailed(eventType string)
+	IncDeliveryDeadLetter(eventType string)
+}
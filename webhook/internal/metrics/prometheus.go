@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// PrometheusMetrics is a Prometheus implementation of Metric interface.
+type PrometheusMetrics struct {
+	eventCount    *prometheus.CounterVec
+	deliveryCount *prometheus.CounterVec
+}
+
+// NewPrometheusMetrics returns a new instance of PrometheusMetrics.
+func NewPrometheusMetrics(environment string) *PrometheusMetrics {
+	return &PrometheusMetrics{
+		eventCount: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "wormscan_webhook_event_count",
+				Help: "The total number of notification events processed",
+				ConstLabels: map[string]string{
+					"environment": environment,
+					"service":     serviceName,
+				},
+			}, []string{"event", "type"}),
+		deliveryCount: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "wormscan_webhook_delivery_count",
+				Help: "The total number of webhook deliveries",
+				ConstLabels: map[string]string{
+					"environment": environment,
+					"service":     serviceName,
+				},
+			}, []string{"event", "type"}),
+	}
+}
+
+// IncEventConsumedQueue increments the total number of events consumed from the queue.
+func (m *PrometheusMetrics) IncEventConsumedQueue(eventType string) {
+	m.eventCount.WithLabelValues(eventType, "consumed_queue").Inc()
+}
+
+// IncEventProcessed increments the total number of events processed.
+func (m *PrometheusMetrics) IncEventProcessed(eventType string) {
+	m.eventCount.WithLabelValues(eventType, "processed").Inc()
+}
+
+// IncEventFailed increments the total number of events failed.
+func (m *PrometheusMetrics) IncEventFailed(eventType string) {
+	m.eventCount.WithLabelValues(eventType, "failed").Inc()
+}
+
+// IncDeliveryScheduled increments the total number of deliveries scheduled.
+func (m *PrometheusMetrics) IncDeliveryScheduled(eventType string) {
+	m.deliveryCount.WithLabelValues(eventType, "scheduled").Inc()
+}
+
+// IncDeliverySucceeded increments the total number of deliveries succeeded.
+func (m *PrometheusMetrics) IncDeliverySucceeded(eventType string) {
+	m.deliveryCount.WithLabelValues(eventType, "succeeded").Inc()
+}
+
+// IncDeliveryFailed increments the total number of delivery attempts failed.
+func (m *PrometheusMetrics) IncDeliveryFailed(eventType string) {
+	m.deliveryCount.WithLabelValues(eventType, "failed").Inc()
+}
+
+// IncDeliveryDeadLetter increments the total number of deliveries moved to the dead letter collection.
+func (m *PrometheusMetrics) IncDeliveryDeadLetter(eventType string) {
+	m.deliveryCount.WithLabelValues(eventType, "dead_letter").Inc()
+}
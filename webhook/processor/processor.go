@@ -0,0 +1,143 @@
+package processor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/storage"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// ProcessorFunc is a function to process a webhook event.
+type ProcessorFunc func(context.Context, *domain.Event) error
+
+// PropertiesFunc decodes the standardized properties from the payload of a VAA.
+// It returns nil when the protocol of the VAA is not supported.
+type PropertiesFunc func(*sdk.VAA) *storage.StandardizedPropertiesDoc
+
+// Processor schedules the deliveries of an event to the matching subscriptions.
+type Processor struct {
+	repository     *storage.Repository
+	propertiesFunc PropertiesFunc
+	logger         *zap.Logger
+	metrics        metrics.Metrics
+}
+
+// NewProcessor creates a new processor.
+func NewProcessor(
+	repository *storage.Repository,
+	propertiesFunc PropertiesFunc,
+	logger *zap.Logger,
+	metrics metrics.Metrics,
+) *Processor {
+	return &Processor{
+		repository:     repository,
+		propertiesFunc: propertiesFunc,
+		logger:         logger.With(zap.String("module", "processor")),
+		metrics:        metrics,
+	}
+}
+
+// Process finds the subscriptions that match the event and schedules a delivery for each one.
+func (p *Processor) Process(ctx context.Context, e *domain.Event) error {
+
+	subscriptions, err := p.repository.FindActiveSubscriptions(ctx, e.Type)
+	if err != nil {
+		return err
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	// add the appIds and addresses of the VAA to filter by them.
+	props, err := p.findProperties(ctx, e)
+	if err != nil {
+		return err
+	}
+	if props != nil {
+		e.AppIDs = props.AppIDs
+		e.FromAddress = props.FromAddress
+		e.ToAddress = props.ToAddress
+	}
+
+	now := time.Now()
+	var deliveries []storage.DeliveryDoc
+	for i := range subscriptions {
+		s := &subscriptions[i]
+		if !s.Match(e) {
+			continue
+		}
+		deliveryID := newDeliveryID(s.ID, e.Type, e.VaaID)
+		payload, err := json.Marshal(domain.Payload{
+			DeliveryID: deliveryID,
+			Event:      e.Type,
+			CreatedAt:  now,
+			Data:       e,
+		})
+		if err != nil {
+			return err
+		}
+		deliveries = append(deliveries, storage.DeliveryDoc{
+			ID:             deliveryID,
+			SubscriptionID: s.ID,
+			EventType:      e.Type,
+			VaaID:          e.VaaID,
+			Payload:        payload,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+		})
+	}
+
+	if err := p.repository.ScheduleDeliveries(ctx, deliveries); err != nil {
+		return err
+	}
+	for range deliveries {
+		p.metrics.IncDeliveryScheduled(e.Type)
+	}
+	p.logger.Debug("deliveries scheduled",
+		zap.String("trackId", e.TrackID),
+		zap.String("vaaId", e.VaaID),
+		zap.String("event", e.Type),
+		zap.Int("count", len(deliveries)))
+	return nil
+}
+
+// findProperties returns the standardized properties of the parsed VAA. The VAA is usually signed before
+// the parser stores them, so otherwise they are decoded from the payload of the VAA of the event or, for
+// the events without it, of the stored VAA.
+func (p *Processor) findProperties(ctx context.Context, e *domain.Event) (*storage.StandardizedPropertiesDoc, error) {
+	props, err := p.repository.FindStandardizedProperties(ctx, e.VaaID)
+	if err == nil || !errors.Is(err, storage.ErrNotFound) {
+		return props, err
+	}
+
+	data := e.Vaa
+	if len(data) == 0 {
+		data, err = p.repository.FindVaa(ctx, e.VaaID)
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	vaa, err := sdk.Unmarshal(data)
+	if err != nil {
+		p.logger.Warn("error unmarshalling vaa", zap.String("vaaId", e.VaaID), zap.Error(err))
+		return nil, nil
+	}
+	return p.propertiesFunc(vaa), nil
+}
+
+// newDeliveryID returns a deterministic id, so the same event is delivered once to each subscription.
+func newDeliveryID(subscriptionID, eventType, vaaID string) string {
+	h := sha256.Sum256([]byte(subscriptionID + "/" + eventType + "/" + vaaID))
+	return hex.EncodeToString(h[:16])
+}
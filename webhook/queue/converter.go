@@ -0,0 +1,135 @@
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// ConverterFunc converts a message from the message bus.
+type ConverterFunc func(string) (*domain.Event, error)
+
+// NewNotificationEventConverter converts the notification events published by fly, tx-tracker
+// and blockchain-watcher into webhook events. Events that can not be subscribed to are skipped.
+func NewNotificationEventConverter(log *zap.Logger) ConverterFunc {
+
+	return func(msg string) (*domain.Event, error) {
+		// unmarshal message to NotificationEvent
+		var notification events.NotificationEvent
+		err := json.Unmarshal([]byte(msg), &notification)
+		if err != nil {
+			return nil, err
+		}
+
+		switch notification.Event {
+		case events.SignedVaaType:
+			signedVaa, err := events.GetEventData[events.SignedVaa](&notification)
+			if err != nil {
+				log.Error("Error decoding signedVAA from notification event", zap.String("trackId", notification.TrackID), zap.Error(err))
+				return nil, nil
+			}
+			return &domain.Event{
+				TrackID:        notification.TrackID,
+				Type:           domain.EventVaaSigned,
+				VaaID:          signedVaa.ID,
+				EmitterChain:   sdk.ChainID(signedVaa.EmitterChain),
+				EmitterAddress: signedVaa.EmitterAddress,
+				Sequence:       strconv.FormatUint(signedVaa.Sequence, 10),
+				Timestamp:      &signedVaa.Timestamp,
+				ChainID:        sdk.ChainID(signedVaa.EmitterChain),
+				TxHash:         signedVaa.TxHash,
+				Vaa:            signedVaa.Vaa,
+			}, nil
+
+		case events.SourceTxConfirmedType:
+			confirmed, err := events.GetEventData[events.SourceTxConfirmed](&notification)
+			if err != nil {
+				log.Error("Error decoding sourceTxConfirmed from notification event", zap.String("trackId", notification.TrackID), zap.Error(err))
+				return nil, nil
+			}
+			return &domain.Event{
+				TrackID:        notification.TrackID,
+				Type:           domain.EventSourceTxConfirmed,
+				VaaID:          confirmed.ID,
+				EmitterChain:   sdk.ChainID(confirmed.EmitterChain),
+				EmitterAddress: confirmed.EmitterAddress,
+				Sequence:       confirmed.Sequence,
+				Timestamp:      confirmed.Timestamp,
+				ChainID:        sdk.ChainID(confirmed.EmitterChain),
+				TxHash:         confirmed.TxHash,
+				From:           confirmed.From,
+			}, nil
+
+		case events.EvmTransactionFoundType:
+			tr, err := events.GetEventData[events.EvmTransactionFound](&notification)
+			if err != nil {
+				log.Error("Error decoding evmTransactionFound from notification event", zap.String("trackId", notification.TrackID), zap.Error(err))
+				return nil, nil
+			}
+			if tr.Attributes.Name != events.EvmTransferRedeemedName {
+				log.Debug("Skip event because it is not transfer-redeemed", zap.String("trackId", notification.TrackID), zap.String("name", tr.Attributes.Name))
+				return nil, nil
+			}
+			return newTransferRedeemedEvent(notification.TrackID, tr.ChainID, tr.TxHash, tr.BlockTime,
+				tr.Attributes.EmitterChain, tr.Attributes.EmitterAddress, tr.Attributes.Sequence,
+				tr.Attributes.From, tr.Attributes.To, tr.Attributes.Status)
+
+		case events.TransferRedeemedType:
+			tr, err := events.GetEventData[events.TransferRedeemed](&notification)
+			if err != nil {
+				log.Error("Error decoding transferRedeemed from notification event", zap.String("trackId", notification.TrackID), zap.Error(err))
+				return nil, nil
+			}
+			return newTransferRedeemedEvent(notification.TrackID, tr.ChainID, tr.TxHash, tr.BlockTime,
+				tr.Attributes.EmitterChain, tr.Attributes.EmitterAddress, tr.Attributes.Sequence,
+				tr.Attributes.From, tr.Attributes.To, tr.Attributes.Status)
+
+		default:
+			log.Debug("Skip event type", zap.String("trackId", notification.TrackID), zap.String("type", notification.Event))
+			return nil, nil
+		}
+	}
+}
+
+func newTransferRedeemedEvent(
+	trackID string,
+	chainID int,
+	txHash string,
+	blockTime time.Time,
+	emitterChain int,
+	emitterAddress string,
+	sequence uint64,
+	from, to, status string,
+) (*domain.Event, error) {
+
+	address, err := sdk.StringToAddress(emitterAddress)
+	if err != nil {
+		return nil, fmt.Errorf("error converting emitter address [%s]: %w", emitterAddress, err)
+	}
+	vaa := sdk.VAA{
+		EmitterChain:   sdk.ChainID(emitterChain),
+		EmitterAddress: address,
+		Sequence:       sequence,
+	}
+
+	return &domain.Event{
+		TrackID:        trackID,
+		Type:           domain.EventTransferRedeemed,
+		VaaID:          vaa.MessageID(),
+		EmitterChain:   sdk.ChainID(emitterChain),
+		EmitterAddress: address.String(),
+		Sequence:       strconv.FormatUint(sequence, 10),
+		Timestamp:      &blockTime,
+		ChainID:        sdk.ChainID(chainID),
+		TxHash:         txHash,
+		From:           from,
+		To:             to,
+		Status:         status,
+	}, nil
+}
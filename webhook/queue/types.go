@@ -0,0 +1,21 @@
+package queue
+
+import (
+	"context"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/domain"
+)
+
+// ConsumerMessage defition.
+type ConsumerMessage interface {
+	Retry() uint8
+	Data() *domain.Event
+	Done()
+	Failed()
+	IsExpired() bool
+	SentTimestamp() *time.Time
+}
+
+// ConsumeFunc is a function to consume Event.
+type ConsumeFunc func(context.Context) <-chan ConsumerMessage
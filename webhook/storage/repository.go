@@ -0,0 +1,240 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	commonRepo "github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"github.com/wormhole-foundation/wormhole-explorer/webhook/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// ErrNotFound is returned when the requested document does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Repository exposes operations over the webhook collections.
+type Repository struct {
+	logger        *zap.Logger
+	subscriptions *mongo.Collection
+	deliveries    *mongo.Collection
+	deadLetters   *mongo.Collection
+	parsedVaa     *mongo.Collection
+	vaas          *mongo.Collection
+}
+
+// NewRepository creates a new repository.
+func NewRepository(logger *zap.Logger, db *mongo.Database) *Repository {
+	r := Repository{
+		logger:        logger,
+		subscriptions: db.Collection(commonRepo.WebhookSubscriptions),
+		deliveries:    db.Collection(commonRepo.WebhookDeliveries),
+		deadLetters:   db.Collection(commonRepo.WebhookDeadLetters),
+		parsedVaa:     db.Collection(commonRepo.ParsedVaa),
+		vaas:          db.Collection(commonRepo.Vaas),
+	}
+	return &r
+}
+
+// InsertSubscription inserts a new subscription.
+func (r *Repository) InsertSubscription(ctx context.Context, s *domain.Subscription) error {
+	_, err := r.subscriptions.InsertOne(ctx, s)
+	return err
+}
+
+// FindSubscriptionByID finds a subscription by id, regardless of its owner.
+func (r *Repository) FindSubscriptionByID(ctx context.Context, id string) (*domain.Subscription, error) {
+	var s domain.Subscription
+	err := r.subscriptions.FindOne(ctx, bson.M{"_id": id}).Decode(&s)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// FindOwnedSubscription finds a subscription by id, if it belongs to the owner.
+func (r *Repository) FindOwnedSubscription(ctx context.Context, owner, id string) (*domain.Subscription, error) {
+	var s domain.Subscription
+	err := r.subscriptions.FindOne(ctx, bson.M{"_id": id, "owner": owner}).Decode(&s)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// FindSubscriptions finds the subscriptions of an owner sorted by creation date.
+func (r *Repository) FindSubscriptions(ctx context.Context, owner string, skip, limit int64) ([]domain.Subscription, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}}).SetSkip(skip).SetLimit(limit)
+	cur, err := r.subscriptions.Find(ctx, bson.M{"owner": owner}, opts)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions := []domain.Subscription{}
+	if err := cur.All(ctx, &subscriptions); err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+// FindActiveSubscriptions finds the active subscriptions to an event type.
+func (r *Repository) FindActiveSubscriptions(ctx context.Context, eventType string) ([]domain.Subscription, error) {
+	cur, err := r.subscriptions.Find(ctx, bson.M{"active": true, "events": eventType})
+	if err != nil {
+		return nil, err
+	}
+	var subscriptions []domain.Subscription
+	if err := cur.All(ctx, &subscriptions); err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+// DeleteSubscription deletes a subscription of an owner and its pending deliveries.
+func (r *Repository) DeleteSubscription(ctx context.Context, owner, id string) error {
+	result, err := r.subscriptions.DeleteOne(ctx, bson.M{"_id": id, "owner": owner})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrNotFound
+	}
+	_, err = r.deliveries.DeleteMany(ctx, bson.M{"subscriptionId": id})
+	return err
+}
+
+// FindStandardizedProperties finds the standardized properties of a parsed VAA.
+func (r *Repository) FindStandardizedProperties(ctx context.Context, vaaID string) (*StandardizedPropertiesDoc, error) {
+	var doc parsedVaaDoc
+	opts := options.FindOne().SetProjection(bson.M{"standardizedProperties": 1})
+	err := r.parsedVaa.FindOne(ctx, bson.M{"_id": vaaID}, opts).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &doc.StandardizedProperties, nil
+}
+
+// FindVaa finds the signed VAA by id.
+func (r *Repository) FindVaa(ctx context.Context, vaaID string) ([]byte, error) {
+	var doc vaaDoc
+	opts := options.FindOne().SetProjection(bson.M{"vaas": 1})
+	err := r.vaas.FindOne(ctx, bson.M{"_id": vaaID}, opts).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return doc.Vaa, nil
+}
+
+// ScheduleDeliveries stores the deliveries to be sent by the dispatcher.
+// Deliveries that already exist are left untouched, so redelivered events are not sent twice.
+func (r *Repository) ScheduleDeliveries(ctx context.Context, deliveries []DeliveryDoc) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, 0, len(deliveries))
+	for i := range deliveries {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": deliveries[i].ID}).
+			SetUpdate(bson.M{"$setOnInsert": deliveries[i]}).
+			SetUpsert(true))
+	}
+	_, err := r.deliveries.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// AcquireDelivery locks the next due delivery for the given duration.
+// It returns nil when there are no due deliveries.
+func (r *Repository) AcquireDelivery(ctx context.Context, lockDuration time.Duration) (*DeliveryDoc, error) {
+	now := time.Now()
+	filter := bson.M{
+		"nextAttemptAt": bson.M{"$lte": now},
+		"$or": bson.A{
+			bson.M{"lockedUntil": nil},
+			bson.M{"lockedUntil": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"lockedUntil": now.Add(lockDuration)}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "nextAttemptAt", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var doc DeliveryDoc
+	err := r.deliveries.FindOneAndUpdate(ctx, filter, update, opts).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// CompleteDelivery removes a delivery that was sent successfully.
+func (r *Repository) CompleteDelivery(ctx context.Context, id string) error {
+	_, err := r.deliveries.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+// RescheduleDelivery records a failed attempt and schedules the next one.
+func (r *Repository) RescheduleDelivery(ctx context.Context, d *DeliveryDoc) error {
+	now := time.Now()
+	update := bson.M{"$set": bson.M{
+		"attempts":       d.Attempts,
+		"nextAttemptAt":  d.NextAttemptAt,
+		"lockedUntil":    nil,
+		"lastError":      d.LastError,
+		"lastStatusCode": d.LastStatusCode,
+		"updatedAt":      &now,
+	}}
+	_, err := r.deliveries.UpdateByID(ctx, d.ID, update)
+	return err
+}
+
+// MoveToDeadLetter stores a delivery in the dead letter collection and removes it from the pending deliveries.
+func (r *Repository) MoveToDeadLetter(ctx context.Context, d *DeliveryDoc) error {
+	deadLetter := DeadLetterDoc{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventType:      d.EventType,
+		VaaID:          d.VaaID,
+		Payload:        d.Payload,
+		Attempts:       d.Attempts,
+		LastError:      d.LastError,
+		LastStatusCode: d.LastStatusCode,
+		CreatedAt:      d.CreatedAt,
+		FailedAt:       time.Now(),
+	}
+	opts := options.Replace().SetUpsert(true)
+	if _, err := r.deadLetters.ReplaceOne(ctx, bson.M{"_id": d.ID}, deadLetter, opts); err != nil {
+		return err
+	}
+	return r.CompleteDelivery(ctx, d.ID)
+}
+
+// FindDeadLetters finds the dead letters of a subscription sorted by failure date.
+func (r *Repository) FindDeadLetters(ctx context.Context, subscriptionID string, skip, limit int64) ([]DeadLetterDoc, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "failedAt", Value: -1}}).SetSkip(skip).SetLimit(limit)
+	cur, err := r.deadLetters.Find(ctx, bson.M{"subscriptionId": subscriptionID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	deadLetters := []DeadLetterDoc{}
+	if err := cur.All(ctx, &deadLetters); err != nil {
+		return nil, err
+	}
+	return deadLetters, nil
+}
@@ -0,0 +1,50 @@
+package storage
+
+import "time"
+
+// DeliveryDoc is a pending delivery of an event to a webhook subscription.
+type DeliveryDoc struct {
+	ID             string     `bson:"_id"`
+	SubscriptionID string     `bson:"subscriptionId"`
+	EventType      string     `bson:"eventType"`
+	VaaID          string     `bson:"vaaId"`
+	Payload        []byte     `bson:"payload"`
+	Attempts       int        `bson:"attempts"`
+	NextAttemptAt  time.Time  `bson:"nextAttemptAt"`
+	LockedUntil    *time.Time `bson:"lockedUntil"`
+	LastError      string     `bson:"lastError,omitempty"`
+	LastStatusCode int        `bson:"lastStatusCode,omitempty"`
+	CreatedAt      time.Time  `bson:"createdAt"`
+	UpdatedAt      *time.Time `bson:"updatedAt"`
+}
+
+// DeadLetterDoc is a delivery that failed after the last attempt.
+type DeadLetterDoc struct {
+	ID             string    `bson:"_id" json:"id"`
+	SubscriptionID string    `bson:"subscriptionId" json:"subscriptionId"`
+	EventType      string    `bson:"eventType" json:"eventType"`
+	VaaID          string    `bson:"vaaId" json:"vaaId"`
+	Payload        []byte    `bson:"payload" json:"-"`
+	Attempts       int       `bson:"attempts" json:"attempts"`
+	LastError      string    `bson:"lastError" json:"lastError"`
+	LastStatusCode int       `bson:"lastStatusCode,omitempty" json:"lastStatusCode,omitempty"`
+	CreatedAt      time.Time `bson:"createdAt" json:"createdAt"`
+	FailedAt       time.Time `bson:"failedAt" json:"failedAt"`
+}
+
+// StandardizedPropertiesDoc contains the standardized properties of a parsed VAA.
+type StandardizedPropertiesDoc struct {
+	AppIDs      []string `bson:"appIds"`
+	FromAddress string   `bson:"fromAddress"`
+	ToAddress   string   `bson:"toAddress"`
+}
+
+type vaaDoc struct {
+	ID  string `bson:"_id"`
+	Vaa []byte `bson:"vaas"`
+}
+
+type parsedVaaDoc struct {
+	ID                     string                    `bson:"_id"`
+	StandardizedProperties StandardizedPropertiesDoc `bson:"standardizedProperties"`
+}